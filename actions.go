@@ -1,9 +1,16 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	b "github.com/barsanuphe/endive/book"
 	e "github.com/barsanuphe/endive/endive"
@@ -15,8 +22,22 @@ const (
 	exportSelection   = "Exporting selection to %s..."
 	exportBookError   = "Error exporting books: %s"
 	exportFilterError = "Error filtering books for export"
+
+	defaultEditor       = "vi"
+	bulkEditErrorPrefix = "# ERROR: "
 )
 
+// confirmSelection of books found by search criteria before modifying them,
+// if there are more than configured.
+func confirmSelection(endive *Endive, books e.Collection) bool {
+	endive.UI.Display(books.Table())
+	count := len(books.Books())
+	if count <= endive.Config.ConfirmAbove {
+		return true
+	}
+	return endive.UI.Accept(fmt.Sprintf("Confirm modifying these %d books", count))
+}
+
 func editMetadata(endive *Endive, books []*b.Book, args ...string) error {
 	var rows [][]string
 	for _, book := range books {
@@ -37,6 +58,156 @@ func editMetadata(endive *Endive, books []*b.Book, args ...string) error {
 	return nil
 }
 
+// editListField adds or removes values of a list field for several books,
+// after showing what would change.
+func editListField(endive *Endive, books []*b.Book, field, operations string) error {
+	var rows [][]string
+	edited := make(map[*b.Book]*b.Book)
+	for _, book := range books {
+		editedBook := book.Copy()
+		if err := editedBook.EditList(field, operations); err != nil {
+			endive.UI.Errorf("Error editing %s for book ID#%d: %s\n", field, book.ID(), err.Error())
+			return err
+		}
+		diffs := book.OutputDiffTable(editedBook, true)
+		if len(diffs) == 0 {
+			continue
+		}
+		rows = append(rows, book.AddIDToDiff(diffs)...)
+		edited[book] = editedBook
+	}
+	if len(edited) == 0 {
+		endive.UI.Title("Nothing to change.")
+		return nil
+	}
+	endive.UI.Display(e.TabulateRows(rows, "ID", "Previous value", "New value"))
+	if !endive.UI.Accept(fmt.Sprintf("Confirm changing %s for %d books", field, len(edited))) {
+		endive.UI.Title("Nothing was changed.")
+		return nil
+	}
+	for _, book := range books {
+		editedBook, ok := edited[book]
+		if !ok {
+			continue
+		}
+		*book = *editedBook
+		if _, _, err := book.Refresh(); err != nil {
+			endive.UI.Errorf("Error refreshing book ID#%d\n", book.ID())
+			return err
+		}
+	}
+	endive.UI.Title("%s changed for %d books.\n", field, len(edited))
+	return nil
+}
+
+// bulkEdit several books at once, as a YAML document opened in $EDITOR.
+// The document is opened again until all values are valid.
+func bulkEdit(endive *Endive, books []*b.Book) error {
+	original, err := b.BulkEditYAML(books)
+	if err != nil {
+		return err
+	}
+	document := original
+	var edited []*b.Book
+	for {
+		document, err = editInEditor(document)
+		if err != nil {
+			return err
+		}
+		var errs []error
+		edited, errs = b.ApplyBulkEdit(books, document)
+		if len(errs) == 0 {
+			break
+		}
+		for _, err := range errs {
+			endive.UI.Error(err.Error())
+		}
+		if !endive.UI.Accept("Edit again") {
+			endive.UI.Title("Nothing was changed.")
+			return nil
+		}
+		document = withBulkEditErrors(document, errs)
+	}
+	if len(edited) == 0 {
+		endive.UI.Title("Nothing to change.")
+		return nil
+	}
+	var rows [][]string
+	for _, editedBook := range edited {
+		book := findBook(books, editedBook.ID())
+		rows = append(rows, book.AddIDToDiff(book.OutputDiffTable(editedBook, true))...)
+	}
+	endive.UI.Display(e.TabulateRows(rows, "ID", "Previous value", "New value"))
+	if !endive.UI.Accept(fmt.Sprintf("Confirm changes for %d books", len(edited))) {
+		endive.UI.Title("Nothing was changed.")
+		return nil
+	}
+	for _, editedBook := range edited {
+		book := findBook(books, editedBook.ID())
+		*book = *editedBook
+		if _, _, err := book.Refresh(); err != nil {
+			endive.UI.Errorf("Error refreshing book ID#%d\n", book.ID())
+			return err
+		}
+	}
+	endive.UI.Title("Changed %d books.\n", len(edited))
+	return nil
+}
+
+func findBook(books []*b.Book, id int) *b.Book {
+	for _, book := range books {
+		if book.ID() == id {
+			return book
+		}
+	}
+	return nil
+}
+
+// withBulkEditErrors as comments at the top of the document, replacing the
+// errors of the previous edit.
+func withBulkEditErrors(document []byte, errs []error) []byte {
+	lines := []string{}
+	for _, err := range errs {
+		lines = append(lines, bulkEditErrorPrefix+err.Error())
+	}
+	for _, line := range strings.Split(string(document), "\n") {
+		if !strings.HasPrefix(line, bulkEditErrorPrefix) {
+			lines = append(lines, line)
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// editInEditor opens contents in $EDITOR and returns the edited version.
+func editInEditor(contents []byte) ([]byte, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = defaultEditor
+	}
+	f, err := ioutil.TempFile("", "endive-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	// $EDITOR can include arguments
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], f.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.New("Error running " + editor + ": " + err.Error())
+	}
+	return ioutil.ReadFile(f.Name())
+}
+
 func refreshMetadata(endive *Endive, books []*b.Book, args ...string) error {
 	for _, book := range books {
 		if len(args) == 0 {
@@ -89,29 +260,586 @@ func reviewBook(endive *Endive, book *b.Book, rating, review string) error {
 }
 
 func setProgress(endive *Endive, books []*b.Book, progress string) error {
+	// reading, read and abandoned are logged as readings, starting or ending today
+	today, _ := b.CheckReadingDate("")
 	for _, book := range books {
-		// setting progress
-		if err := book.Set("progress", progress); err != nil {
+		var err error
+		switch progress {
+		case e.ProgressReading:
+			err = book.StartReading(today, "", "")
+		case e.ProgressRead:
+			err = book.FinishReading(today, "", "")
+		case e.ProgressAbandoned:
+			err = book.AbandonReading(today, "", "")
+		default:
+			err = book.Set("progress", progress)
+		}
+		if err != nil {
 			return err
 		}
 		endive.UI.Title("%s set as %s.\n", book.String(), progress)
-		// if first time set as read, set date too.
-		if book.Progress == "read" && book.ReadDate == "" {
-			book.SetReadDateToday()
-			endive.UI.Title("Set read date to today.")
+	}
+	return nil
+}
+
+// logReading of Books: started, finished or abandoned at a given date.
+func logReading(endive *Endive, books []*b.Book, event, date, format, notes string) error {
+	for _, book := range books {
+		var err error
+		switch event {
+		case readingStart:
+			err = book.StartReading(date, format, notes)
+		case readingFinish:
+			err = book.FinishReading(date, format, notes)
+		case readingAbandon:
+			err = book.AbandonReading(date, format, notes)
+		}
+		if err != nil {
+			return err
+		}
+		endive.UI.Title("%s set as %s.\n", book.String(), book.Progress)
+	}
+	return nil
+}
+
+func embedMetadata(endive *Endive, books []*b.Book) error {
+	if len(books) == 0 {
+		// all books with a non-retail epub, including those with a retail epub
+		for _, book := range endive.Library.Collection.Books() {
+			if book.(*b.Book).HasNonRetail() {
+				books = append(books, book.(*b.Book))
+			}
+		}
+	}
+	embedded := 0
+	for _, book := range books {
+		if !book.HasNonRetail() {
+			endive.UI.Warningf("Book ID#%d has no non-retail epub, retail epubs are never modified.\n", book.ID())
+			continue
+		}
+		epubs, err := book.EmbedMetadata()
+		for _, ep := range epubs {
+			// the new version of the epub must be known as imported
+			if _, err := endive.hashes.Add(ep.Hash); err != nil {
+				return err
+			}
+			endive.UI.Infof("Embedded metadata in %s\n", ep.FullPath())
+			embedded++
+		}
+		if err != nil {
+			endive.UI.Errorf("Error embedding metadata for book ID#%d: %s\n", book.ID(), err.Error())
+		}
+	}
+	if _, err := endive.hashes.Save(); err != nil {
+		return err
+	}
+	endive.UI.Title("Metadata embedded in %d epubs.\n", embedded)
+	return nil
+}
+
+func retagCollection(endive *Endive, dryRun bool) {
+	var rows [][]string
+	for _, book := range endive.Library.Collection.Books() {
+		bk := book.(*b.Book)
+		newTags := bk.Metadata.CleanTags(endive.Config)
+		if newTags.String() == bk.Metadata.Tags.String() {
+			continue
+		}
+		rows = append(rows, []string{strconv.Itoa(bk.ID()), bk.String(), bk.Metadata.Tags.String(), newTags.String()})
+		if !dryRun {
+			bk.Metadata.Tags = newTags
+		}
+	}
+	if len(rows) == 0 {
+		endive.UI.Title("Tag rules do not change any book.")
+		return
+	}
+	endive.UI.Display(e.TabulateRows(rows, "ID", "Book", "Current tags", "New tags"))
+	if dryRun {
+		endive.UI.Title("Tags would be changed for %d books.\n", len(rows))
+	} else {
+		endive.UI.Title("Tags changed for %d books.\n", len(rows))
+	}
+}
+
+func lintCollection(endive *Endive, checks []string, suggestAliases, fix bool) error {
+	issues, err := b.Lint(endive.Library.Collection, endive.Config, checks...)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		endive.UI.Title("No issue found.")
+		return nil
+	}
+	var rows [][]string
+	for _, issue := range issues {
+		rows = append(rows, []string{issue.Check, issue.IDs(), issue.Message})
+	}
+	endive.UI.Display(e.TabulateRows(rows, "Check", "IDs", "Issue"))
+	endive.UI.Title("Found %d issues.\n", len(issues))
+
+	if suggestAliases {
+		snippet, err := b.AliasSnippet(issues)
+		if err != nil {
+			return err
+		}
+		if snippet == "" {
+			endive.UI.Title("No alias to suggest.")
+		} else {
+			endive.UI.Title("Aliases to add to the configuration file:")
+			endive.UI.Display(snippet)
+		}
+	}
+	if !fix {
+		return nil
+	}
+	for _, issue := range issues {
+		endive.UI.SubPart(issue.Message)
+		fixed := []*b.Book{}
+		if issue.Fix != nil {
+			if endive.UI.Accept(issue.FixDescription) {
+				if err := issue.Fix(); err != nil {
+					endive.UI.Error(err.Error())
+					continue
+				}
+				fixed = issue.Books
+			}
+		} else if issue.Field != "" {
+			for _, book := range issue.Books {
+				if !endive.UI.Accept("Edit " + issue.Field + " for " + book.String()) {
+					continue
+				}
+				if err := book.EditField(issue.Field); err != nil {
+					endive.UI.Error(err.Error())
+					continue
+				}
+				fixed = append(fixed, book)
+			}
+		}
+		for _, book := range fixed {
+			if _, _, err := book.Refresh(); err != nil {
+				endive.UI.Errorf("Error refreshing book ID#%d\n", book.ID())
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findDuplicates in the collection, and merge them if asked to.
+func findDuplicates(endive *Endive, merge bool) error {
+	// fingerprints are computed once, and saved with the books
+	for _, book := range endive.Library.Collection.Books() {
+		if _, err := book.(*b.Book).UpdateFingerprints(); err != nil {
+			endive.UI.Warningf("Could not read the text of %s: %s\n", book.String(), err.Error())
+		}
+	}
+	duplicates := b.FindDuplicates(endive.Library.Collection)
+	if len(duplicates) == 0 {
+		endive.UI.Title("No duplicate found.")
+		return nil
+	}
+	var rows [][]string
+	for _, d := range duplicates {
+		rows = append(rows, []string{fmt.Sprintf("%d%%", int(d.Score*100)), strconv.Itoa(d.Books[0].ID()), d.Books[0].String(), strconv.Itoa(d.Books[1].ID()), d.Books[1].String(), strings.Join(d.Reasons, ", ")})
+	}
+	endive.UI.Display(e.TabulateRows(rows, "Score", "ID", "Book", "ID", "Book", "Reasons"))
+	endive.UI.Title("Found %d probable duplicates.\n", len(duplicates))
+	if !merge {
+		return nil
+	}
+	// removing merged books invalidates pointers to the books of the collection
+	pairs := [][2]int{}
+	for _, d := range duplicates {
+		pairs = append(pairs, [2]int{d.Books[0].ID(), d.Books[1].ID()})
+	}
+	for _, pair := range pairs {
+		first, err := endive.Library.Collection.FindByID(pair[0])
+		if err != nil {
+			continue
+		}
+		second, err := endive.Library.Collection.FindByID(pair[1])
+		if err != nil {
+			continue
+		}
+		if _, err := mergeBooks(endive, first.(*b.Book), second.(*b.Book)); err != nil {
+			endive.UI.Error(err.Error())
+		}
+	}
+	return nil
+}
+
+// mergeBooks describing the same book, after showing their differences.
+// The merged Book is removed from the collection.
+func mergeBooks(endive *Endive, first, second *b.Book) (bool, error) {
+	kept, merged := b.MergeOrder(first, second)
+	endive.UI.SubPart(fmt.Sprintf("ID#%d %s / ID#%d %s", kept.ID(), kept.String(), merged.ID(), merged.String()))
+	endive.UI.Display(e.TabulateRows(kept.OutputDiffTable(merged, true), "Kept", "Merged"))
+	if !endive.UI.Accept(fmt.Sprintf("Merge ID#%d into ID#%d", merged.ID(), kept.ID())) {
+		return false, nil
+	}
+	if err := kept.Merge(merged); err != nil {
+		return false, err
+	}
+	if _, _, err := kept.Refresh(); err != nil {
+		endive.UI.Errorf("Error refreshing book ID#%d\n", kept.ID())
+		return false, err
+	}
+	return true, endive.Library.Collection.RemoveByID(merged.ID())
+}
+
+// splitBook moves one of the epubs of a Book to a new Book: the epub of the
+// given edition, or else of the preferred retail or non-retail edition.
+func splitBook(endive *Endive, book *b.Book, edition int, isRetail bool) error {
+	k := edition - 1
+	if edition == 0 {
+		k = book.EditionIndex(isRetail)
+	}
+	newBook, err := book.Split(endive.Library.GenerateID(), k)
+	if err != nil {
+		return err
+	}
+	if endive.UI.Accept("Edit metadata of the new book") {
+		if err := newBook.EditField(); err != nil {
+			endive.UI.Errorf("Error editing metadata for book ID#%d\n", newBook.ID())
+		}
+	}
+	for _, bk := range []*b.Book{book, newBook} {
+		if _, _, err := bk.Refresh(); err != nil {
+			endive.UI.Errorf("Error refreshing book ID#%d\n", bk.ID())
+			return err
+		}
+	}
+	endive.Library.Collection.Add(newBook)
+	endive.UI.Title("Moved epub to new book %s with ID %d\n", newBook.String(), newBook.ID())
+	return nil
+}
+
+// linkWork of Books, translations or editions of the same work. Their
+// filenames are refreshed, since they now share the original year of the work.
+func linkWork(endive *Endive, books []*b.Book) error {
+	work, err := b.LinkWork(books...)
+	if err != nil {
+		return err
+	}
+	for _, bk := range books {
+		if _, _, err := bk.Refresh(); err != nil {
+			endive.UI.Errorf("Error refreshing book ID#%d\n", bk.ID())
+			return err
+		}
+	}
+	endive.UI.Title("%d books linked to work %s.\n", len(books), work.String())
+	return nil
+}
+
+// unlinkWork of Books, which are no longer editions of another work.
+func unlinkWork(endive *Endive, books []*b.Book) {
+	for _, bk := range books {
+		if bk.WorkID() == "" {
+			endive.UI.Warningf("%s is not linked to a work.\n", bk.String())
+			continue
+		}
+		bk.SetWork(nil)
+		endive.UI.Title("%s unlinked from its work.\n", bk.String())
+	}
+}
+
+// flagForReplacement the retail or non-retail epub of a Book, or the main
+// epub if the kind is not given.
+func flagForReplacement(endive *Endive, book *b.Book, kind, reason string, clear bool) error {
+	isRetail := book.HasRetail()
+	if kind != "" {
+		isRetail = kind == "retail"
+	}
+	if clear {
+		switch {
+		case kind == "":
+			book.ClearReplacementFlags()
+		case book.EditionIndex(isRetail) != -1:
+			book.Editions[book.EditionIndex(isRetail)].Epub.FlagForReplacement(false)
+		}
+		endive.UI.Title("Replacement flag removed for %s.\n", book.String())
+		return nil
+	}
+	if err := book.FlagForReplacement(isRetail, reason); err != nil {
+		return err
+	}
+	endive.UI.Title("Flagged %s for replacement.\n", book.String())
+	return nil
+}
+
+// removeBooks from the collection, moving their epubs to the trash.
+// If forget, their hashes are removed from the known hashes, so that they can
+// be imported again.
+func removeBooks(endive *Endive, books e.Collection, forget bool) error {
+	endive.UI.Display(books.Table())
+	ids := []int{}
+	for _, book := range books.Books() {
+		ids = append(ids, book.ID())
+	}
+	if !endive.UI.Accept(fmt.Sprintf("Move these %d books to the trash", len(ids))) {
+		return nil
+	}
+	// removing books invalidates pointers to the books of the collection
+	for _, id := range ids {
+		book, err := endive.Library.Collection.FindByID(id)
+		if err != nil {
+			return err
+		}
+		bk := book.(*b.Book)
+		hashes := []string{}
+		for _, ep := range bk.Epubs() {
+			hashes = append(hashes, ep.Hash)
+		}
+		if err := bk.Trash(); err != nil {
+			endive.UI.Errorf("Error moving book ID#%d to the trash: %s\n", id, err.Error())
+			continue
+		}
+		if forget {
+			forgetHashes(endive, hashes...)
+		}
+		if err := endive.Library.Collection.RemoveByID(id); err != nil {
+			return err
+		}
+		endive.UI.SubTitle("Moved book ID#%d to the trash", id)
+	}
+	_, err := endive.hashes.Save()
+	return err
+}
+
+func forgetHashes(endive *Endive, hashes ...string) {
+	for _, hash := range hashes {
+		endive.hashes.Remove(hash)
+	}
+}
+
+func showTrash(endive *Endive) error {
+	entries, err := b.ListTrash(endive.Config, endive.UI)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		endive.UI.Title("The trash is empty.")
+		return nil
+	}
+	var rows [][]string
+	for _, entry := range entries {
+		epubs := []string{}
+		if entry.Book.HasRetail() {
+			epubs = append(epubs, "retail")
+		}
+		if entry.Book.HasNonRetail() {
+			epubs = append(epubs, "non-retail")
 		}
+		rows = append(rows, []string{strconv.Itoa(entry.Book.ID()), entry.Book.String(), strings.Join(epubs, ", "), entry.Reason, entry.Date.Format("2006-01-02 15:04")})
 	}
+	endive.UI.Display(e.TabulateRows(rows, "ID", "Book", "Epubs", "Reason", "Date"))
+	return nil
+}
+
+// restoreFromTrash the last trashed epubs of books, given their IDs.
+// Epubs are given back to their book if it still exists and has no epub of
+// the same editions, or else to a new book.
+func restoreFromTrash(endive *Endive, ids []int) error {
+	entries, err := b.ListTrash(endive.Config, endive.UI)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		index := -1
+		for j, entry := range entries {
+			if entry.Book.ID() == id {
+				index = j
+			}
+		}
+		if index == -1 {
+			endive.UI.Errorf("No book with ID %d in the trash\n", id)
+			continue
+		}
+		restored, err := entries[index].Restore()
+		if err != nil {
+			endive.UI.Error(err.Error())
+			continue
+		}
+		entries = append(entries[:index], entries[index+1:]...)
+		for _, ep := range restored.Epubs() {
+			if ep.Hash != "" {
+				endive.hashes.Add(ep.Hash)
+			}
+		}
+		if existing, err := endive.Library.Collection.FindByID(id); err == nil {
+			bk := existing.(*b.Book)
+			known := false
+			for _, ed := range restored.Editions {
+				known = known || bk.HasEdition(ed)
+			}
+			if !known {
+				bk.Editions = append(bk.Editions, restored.Editions...)
+				if _, _, err := bk.Refresh(); err != nil {
+					return err
+				}
+				endive.UI.SubTitle("Restored epub to book %s with ID %d", bk.String(), bk.ID())
+				continue
+			}
+			restored.BookID = endive.Library.GenerateID()
+		}
+		if _, _, err := restored.Refresh(); err != nil {
+			return err
+		}
+		endive.Library.Collection.Add(restored)
+		endive.UI.SubTitle("Restored book %s with ID %d", restored.String(), restored.ID())
+	}
+	_, err = endive.hashes.Save()
+	return err
+}
+
+// emptyTrash of the entries older than a given age, deleting their epubs permanently.
+func emptyTrash(endive *Endive, olderThan time.Duration, forget bool) error {
+	entries, err := b.ListTrash(endive.Config, endive.UI)
+	if err != nil {
+		return err
+	}
+	old := []b.TrashEntry{}
+	for _, entry := range entries {
+		if time.Since(entry.Date) >= olderThan {
+			old = append(old, entry)
+		}
+	}
+	if len(old) == 0 {
+		endive.UI.Title("Nothing to delete from the trash.")
+		return nil
+	}
+	if !endive.UI.Accept(fmt.Sprintf("Permanently delete %d entries from the trash", len(old))) {
+		return nil
+	}
+	for _, entry := range old {
+		if err := entry.Delete(); err != nil {
+			return err
+		}
+		if forget {
+			forgetHashes(endive, entry.Hashes()...)
+		}
+	}
+	endive.UI.Title("Deleted %d entries from the trash.\n", len(old))
+	_, err = endive.hashes.Save()
+	return err
+}
+
+func showSeries(endive *Endive, name string, online bool) error {
+	books := b.FindSeries(endive.Library.Collection, name)
+	if len(books) == 0 {
+		return errors.New("No book found in series " + name)
+	}
+	var remote []b.SeriesEntry
+	if online {
+		var err error
+		if remote, err = books[0].Book.OnlineSeries(name); err != nil {
+			endive.UI.Warning("Could not get the complete series: " + err.Error())
+		}
+	}
+	var rows [][]string
+	for _, sb := range books {
+		version := "non-retail"
+		if sb.Book.HasRetail() {
+			version = "retail"
+		}
+		rows = append(rows, []string{sb.Position, strconv.Itoa(sb.Book.ID()), sb.Book.String(), sb.Book.Progress, sb.Book.Rating, version})
+	}
+	missing := b.MissingPositions(books, remote)
+	for _, entry := range missing {
+		title := "MISSING"
+		if entry.Title != "" {
+			title += ": " + entry.Title
+		}
+		rows = append(rows, []string{entry.Position, "", title, "", "", ""})
+	}
+	// missing books are shown in reading order too
+	sort.SliceStable(rows, func(j, k int) bool {
+		return b.SeriesIndex(rows[j][0]) < b.SeriesIndex(rows[k][0])
+	})
+	endive.UI.Title("%s: %d books, %d missing.\n", books[0].Name, len(books), len(missing))
+	endive.UI.Display(e.TabulateRows(rows, "#", "ID", "Book", "Progress", "Rating", "Version"))
+	return nil
+}
+
+func showNextInSeries(endive *Endive) {
+	series := b.NextInSeries(endive.Library.Collection)
+	if len(series) == 0 {
+		endive.UI.Title("No series being read.")
+		return
+	}
+	var rows [][]string
+	for _, s := range series {
+		rows = append(rows, []string{s.Name, fmt.Sprintf("%d/%d", s.Read, s.Total), s.Next.Position, strconv.Itoa(s.Next.Book.ID()), s.Next.Book.String()})
+	}
+	endive.UI.Display(e.TabulateRows(rows, "Series", "Read", "#", "ID", "Next book"))
+}
+
+func manageCover(endive *Endive, book *b.Book, action, source string) error {
+	switch action {
+	case "extract":
+		if err := book.ExtractCover(); err != nil {
+			return err
+		}
+		endive.UI.Title("Cover extracted from epub.")
+	case "set":
+		if err := book.SetCoverFrom(source); err != nil {
+			return err
+		}
+		endive.UI.Title("Cover set for %s.\n", book.String())
+	}
+	endive.UI.Display(book.ShowCover())
 	return nil
 }
 
 func showInfo(endive *Endive, book *b.Book) {
 	if book != nil {
 		fmt.Println(book.ShowInfo())
+		if editions := showWorkEditions(endive, book); editions != "" {
+			endive.UI.SubTitle("Editions of " + book.Metadata.Work.String())
+			fmt.Println(editions)
+		}
 	} else {
 		fmt.Println(endive.Library.ShowInfo())
 	}
 }
 
+// showStats about the books read, during a year if it is given, as tables or JSON.
+func showStats(endive *Endive, year string, asJSON bool) error {
+	books := []*b.Book{}
+	for _, book := range endive.Library.Collection.Books() {
+		books = append(books, book.(*b.Book))
+	}
+	stats := b.NewStats(books, year)
+	if asJSON {
+		data, err := json.MarshalIndent(stats, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	fmt.Println(stats.Table())
+	return nil
+}
+
+// showWorkEditions returns a table with the editions of all the Books linked
+// to the work of a Book, or an empty string if it is the only one.
+func showWorkEditions(endive *Endive, book *b.Book) string {
+	linked := endive.Library.Collection.Work(book.WorkID()).Books()
+	if len(linked) < 2 {
+		return ""
+	}
+	var rows [][]string
+	for _, gb := range linked {
+		bk := gb.(*b.Book)
+		for k, ed := range bk.Editions {
+			rows = append(rows, []string{strconv.Itoa(bk.ID()), strconv.Itoa(k + 1), bk.Metadata.Title(), ed.String(), bk.Progress})
+		}
+	}
+	return e.TabulateRows(rows, "ID", "Edition", "Title", "Description", "Progress")
+}
+
 func search(endive *Endive, parts []string, firstNBooks, lastNBooks int, sortBy string) {
 	query := strings.Join(parts, " ")
 	endive.UI.Debug("Searching for '" + query + "'...")
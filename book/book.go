@@ -1,7 +1,8 @@
 /*
 Package book is a subpackage of Endive, that aims to manipulate epub files and their metadata.
 
-A Book can hold at most 2 epubs: a retail version and/or a non-retail version.
+A Book can hold several editions (translations, reprints...), each with its own epub, retail or not.
+A retail epub trumps a non-retail epub of the same edition.
 
 Book Metadata starts with epub Metadata, and holds additionnal information retrieved from online sources (ie, Goodreads).
 
@@ -35,6 +36,8 @@ const (
 	ratingField   = "rating"
 	reviewField   = "review"
 	versions      = "versions"
+	workField     = "work"
+	readingsField = "readings"
 	exportedField = "exported"
 	// progress values
 	unread      = "unread"
@@ -64,26 +67,24 @@ var bookFieldMap = map[string]string{
 	exportedField: "IsExported",
 }
 
-var validProgress = []string{unread, read, reading, shortlisted}
-var validCategories = []string{fiction, nonfiction}
-var validTypes = []string{essay, biography, autobiography, novel, shortstory, anthology, poetry}
-var allFields = []string{idField, filenameField, authorField, titleField, yearField, editionYearField, publisherField, isbnField, descriptionField, numPagesField, languageField, categoryField, typeField, genreField, tagsField, seriesField, versions, progressField, readDateField, averageRatingField, ratingField, reviewField, exportedField}
+var allFields = []string{idField, filenameField, authorField, translatorField, editorField, titleField, subtitleField, yearField, editionYearField, publisherField, isbnField, identifiersField, descriptionField, numPagesField, languageField, categoryField, typeField, genreField, tagsField, seriesField, versions, progressField, readDateField, averageRatingField, ratingField, reviewField, exportedField}
 
-// CheckValidField checks if a field is valid.
-func CheckValidField(field string) (valid bool) {
-	_, valid = h.StringInSlice(field, allFields)
+// CheckValidField checks if a field is valid, including custom fields.
+func CheckValidField(field string, cfg e.Config) (valid bool) {
+	if _, valid = h.StringInSlice(field, allFields); !valid {
+		_, valid = cfg.CustomFields[field]
+	}
 	return
 }
 
 // Book can manipulate a book.
-// A Book can have multiple epub files.
+// A Book can have multiple epub files, one for each of its editions.
 type Book struct {
 	Config e.Config        `json:"-"`
 	UI     i.UserInterface `json:"-"`
 	BookID int             `json:"id"`
 	// associated files
-	RetailEpub    Epub `json:"retail"`
-	NonRetailEpub Epub `json:"nonretail"`
+	Editions []Edition `json:"editions"`
 	// metadata
 	Metadata Metadata `json:"metadata"`
 	// user info
@@ -92,6 +93,13 @@ type Book struct {
 	Rating     string `json:"rating"`
 	Review     string `json:"review"`
 	IsExported string `json:"exported"`
+	// all readings, progress and read date being derived from the latest ones
+	Readings ReadingLog `json:"readings,omitempty"`
+	// cover, and hash of the exported epub if it differs from the library copy
+	Cover        Cover  `json:"cover"`
+	ExportedHash string `json:"exported_hash"`
+	// custom fields defined in the configuration file
+	Custom map[string]string `json:"custom,omitempty"`
 }
 
 // NewBook constructs a valid new Epub
@@ -102,10 +110,7 @@ func NewBook(ui i.UserInterface, id int, filename string, c e.Config, isRetail b
 // NewBookWithMetadata constructs a valid new Epub
 func NewBookWithMetadata(ui i.UserInterface, id int, filename string, c e.Config, isRetail bool, i Metadata) *Book {
 	f := Epub{Filename: filename, Config: c, UI: ui, NeedsReplacement: e.False}
-	if isRetail {
-		return &Book{BookID: id, RetailEpub: f, Config: c, UI: ui, Metadata: i, Progress: "unread", IsExported: e.False}
-	}
-	return &Book{BookID: id, NonRetailEpub: f, Config: c, UI: ui, Metadata: i, Progress: "unread", IsExported: e.False}
+	return &Book{BookID: id, Editions: []Edition{newEdition(f, isRetail, i)}, Config: c, UI: ui, Metadata: i, Progress: e.ProgressUnread, IsExported: e.False}
 }
 
 // ID returns the Books ID according to the GenericBook interface
@@ -118,10 +123,13 @@ func (b *Book) HasHash(hash string) bool {
 	if hash == "" {
 		return false
 	}
-	if (b.HasRetail() && b.RetailEpub.Hash == hash) || (b.HasNonRetail() && b.NonRetailEpub.Hash == hash) {
-		return true
+	for _, ed := range b.Editions {
+		if ed.Epub.Hash == hash {
+			return true
+		}
 	}
-	return false
+	// exported copies with an embedded cover
+	return b.ExportedHash == hash
 }
 
 // LongString returns a long string representation of Epub
@@ -137,37 +145,65 @@ func (b *Book) String() string {
 // ShowInfo returns a table with relevant information about a book.
 func (b *Book) ShowInfo(fields ...string) string {
 	if len(fields) == 0 {
-		// select all fields
-		fields = allFields
+		// select all fields, the work of which the book is an edition and the reading log
+		fields = append(allFields, b.Config.CustomFields.Names()...)
+		fields = append(fields, workField, readingsField)
 	}
 	var rows [][]string
 	for _, field := range fields {
+		if value, ok := b.getCustom(field); ok {
+			if value != "" {
+				rows = append(rows, []string{strings.Title(strings.Replace(field, "_", " ", -1)), value})
+			}
+			continue
+		}
 		switch field {
 		case idField:
 			rows = append(rows, []string{"ID", strconv.Itoa(b.BookID)})
 		case filenameField:
 			rows = append(rows, []string{strings.Title(filenameField), b.MainEpub().Filename})
+		case translatorField, editorField, identifiersField:
+			if value, err := b.Get(field); err == nil && value != "" {
+				rows = append(rows, []string{strings.Title(field), value})
+			}
+		case subtitleField:
+			if b.Metadata.Subtitle != "" {
+				rows = append(rows, []string{strings.Title(subtitleField), b.Metadata.Subtitle})
+			}
+		case workField:
+			if b.Metadata.Work != nil {
+				rows = append(rows, []string{strings.Title(workField), b.Metadata.Work.String()})
+			}
+		case readingsField:
+			for k, r := range b.Readings {
+				rows = append(rows, []string{fmt.Sprintf("Reading %d", k+1), r.String()})
+			}
 		case numPagesField:
 			if b.Metadata.NumPages != "" {
 				rows = append(rows, []string{"Number of pages", b.Metadata.NumPages})
 			}
 		case versions:
-			available := ""
+			available := []string{}
 			if b.HasRetail() {
-				available += "retail "
-				rows = append(rows, []string{"Retail hash", b.RetailEpub.Hash})
-				if b.RetailEpub.NeedsReplacement == e.True {
-					rows = append(rows, []string{"Retail needs replacement", e.True})
-				}
+				available = append(available, "retail")
 			}
 			if b.HasNonRetail() {
-				available += "non-retail"
-				rows = append(rows, []string{"Non-Retail hash", b.NonRetailEpub.Hash})
-				if b.NonRetailEpub.NeedsReplacement == e.True {
-					rows = append(rows, []string{"Non-Retail needs replacement", e.True})
+				available = append(available, "non-retail")
+			}
+			preferred := b.PreferredEdition()
+			for k := range b.Editions {
+				ed := &b.Editions[k]
+				title := fmt.Sprintf("Edition %d", k+1)
+				if len(b.Editions) > 1 && ed == preferred {
+					title += " (preferred)"
+				}
+				rows = append(rows, []string{title, ed.String()})
+				rows = append(rows, []string{fmt.Sprintf("Edition %d hash", k+1), ed.Epub.Hash})
+				if ed.Epub.NeedsReplacement == e.True {
+					rows = append(rows, []string{fmt.Sprintf("Edition %d needs replacement", k+1), ed.Epub.replacementInfo()})
 				}
 			}
-			rows = append(rows, []string{"Available versions", available})
+			rows = append(rows, []string{"Available versions", strings.Join(available, " ")})
 		case averageRatingField:
 			if b.Metadata.AverageRating != "" {
 				rows = append(rows, []string{"Average Rating", b.Metadata.AverageRating})
@@ -176,6 +212,12 @@ func (b *Book) ShowInfo(fields ...string) string {
 			if b.IsExported == e.True {
 				rows = append(rows, []string{strings.Title(exportedField), e.True})
 			}
+		case languageField:
+			value := b.Metadata.Language
+			if name := e.LanguageName(value); name != "" {
+				value += " (" + name + ")"
+			}
+			rows = append(rows, []string{strings.Title(languageField), value})
 		case yearField, editionYearField:
 			value, err := b.Get(field)
 			if err != nil {
@@ -203,17 +245,14 @@ func (b *Book) FullPath() string {
 	return b.MainEpub().FullPath()
 }
 
-// MainEpub of a Book.
+// MainEpub of a Book, the epub of its preferred edition.
 func (b *Book) MainEpub() *Epub {
 	// assuming at least one epub is defined
-	if b.HasRetail() {
-		return &b.RetailEpub
-	} else if b.HasNonRetail() {
-		return &b.NonRetailEpub
-	} else {
-		b.UI.Warning("Book has no epub file!")
-		return nil
+	if ed := b.PreferredEdition(); ed != nil {
+		return &ed.Epub
 	}
+	b.UI.Warning("Book has no epub file!")
+	return nil
 }
 
 // SetExported set the main Epub as exported
@@ -227,11 +266,28 @@ func (b *Book) SetExported(isExported bool) {
 
 // SetProgress sets reading progress
 func (b *Book) SetProgress(progress string) (err error) {
-	progress = strings.ToLower(progress)
-	if _, isIn := h.StringInSlice(progress, validProgress); isIn {
-		b.Progress = progress
-	} else {
-		err = errors.New("Unknown reading progress: " + progress)
+	clean, err := b.Config.ProgressVocabulary().Clean(progress)
+	if err != nil {
+		return errors.New("Unknown reading progress: " + err.Error())
+	}
+	b.Progress = clean
+	return
+}
+
+// migrateVocabularies replaces values that are aliases of a configured term.
+func (b *Book) migrateVocabularies() (hasChanged bool) {
+	for _, v := range []struct {
+		value      *string
+		vocabulary e.Vocabulary
+	}{
+		{&b.Metadata.Category, b.Config.CategoryVocabulary()},
+		{&b.Metadata.Type, b.Config.TypeVocabulary()},
+		{&b.Progress, b.Config.ProgressVocabulary()},
+	} {
+		if clean, err := v.vocabulary.Clean(*v.value); err == nil && clean != *v.value {
+			*v.value = clean
+			hasChanged = true
+		}
 	}
 	return
 }
@@ -247,10 +303,24 @@ func (b *Book) SetReadDateToday() {
 	b.SetReadDate(currentDate.Format("2006-01-02"))
 }
 
-func (b *Book) generateNewName(fileTemplate string, isRetail bool) (newName string, err error) {
+// generateNewName for the epub of an edition. Editions other than the
+// preferred one are told apart with a label.
+func (b *Book) generateNewName(fileTemplate string, k int) (newName string, err error) {
 	if fileTemplate == "" {
 		return "", errors.New("Empty filename template")
 	}
+	if k < 0 || k >= len(b.Editions) {
+		return "", errors.New("Edition not found")
+	}
+	ed := b.Editions[k]
+	isRetail := ed.Retail
+	language, isbn := ed.Language, ed.ISBN
+	if language == "" {
+		language = b.Metadata.Language
+	}
+	if isbn == "" {
+		isbn = b.Metadata.ISBN
+	}
 
 	r := strings.NewReplacer(
 		"$a", "{{$a}}",
@@ -275,8 +345,8 @@ func (b *Book) generateNewName(fileTemplate string, isRetail bool) (newName stri
 	// replace with all valid epub parameters
 	tmpl := fmt.Sprintf(`{{$a := "%s"}}{{$y := "%s"}}{{$t := "%s"}}{{$l := "%s"}}{{$i := "%s"}}{{$s := "%s"}}{{$p := "%s"}}{{$c := "%s"}}{{$g := "%s"}}{{$r := "%s"}}%s`,
 		cleanPath(b.Metadata.Author()), b.Metadata.OriginalYear,
-		cleanPath(b.Metadata.Title()), b.Metadata.Language,
-		b.Metadata.ISBN, seriesString, b.Progress, b.Metadata.Category,
+		cleanPath(b.Metadata.Title()), language,
+		isbn, seriesString, b.Progress, b.Metadata.Category,
 		b.Metadata.Genre, retail, r.Replace(fileTemplate))
 
 	var doc bytes.Buffer
@@ -289,6 +359,9 @@ func (b *Book) generateNewName(fileTemplate string, isRetail bool) (newName stri
 	if !strings.Contains(fileTemplate, "$r") && isRetail {
 		newName += " [retail]"
 	}
+	if b.PreferredEdition() != &b.Editions[k] {
+		newName += " [" + cleanPath(ed.label(k+1)) + "]"
+	}
 	// making sure the path is relative
 	if strings.HasPrefix(newName, "/") {
 		newName = newName[1:]
@@ -301,13 +374,14 @@ func (b *Book) generateNewName(fileTemplate string, isRetail bool) (newName stri
 	return
 }
 
-// RefreshEpub one specific epub associated with this Book
-func (b *Book) RefreshEpub(epub Epub, isRetail bool) (bool, string, error) {
+// refreshEdition renames the epub of one specific edition of this Book.
+func (b *Book) refreshEdition(k int) (bool, string, error) {
+	epub := b.Editions[k].Epub
 	// do nothing if file does not exist
 	if epub.Filename == "" {
 		return false, "", errors.New("Does not exist")
 	}
-	newName, err := b.generateNewName(b.Config.EpubFilenameFormat, isRetail)
+	newName, err := b.generateNewName(b.Config.EpubFilenameFormat, k)
 	if err != nil {
 		return false, epub.Filename, err
 	}
@@ -333,8 +407,8 @@ func (b *Book) RefreshEpub(epub Epub, isRetail bool) (bool, string, error) {
 				// trying to generate a unique name.
 
 				// trying to add ISBN once to suffix, if it's not already in the filename.
-				if !isbnAdded && !strings.Contains(b.Config.EpubFilenameFormat, "$i") && b.Metadata.ISBN != "" {
-					suffix = "_" + b.Metadata.ISBN + e.EpubExtension
+				if isbn := b.Editions[k].ISBN; !isbnAdded && !strings.Contains(b.Config.EpubFilenameFormat, "$i") && isbn != "" {
+					suffix = "_" + isbn + e.EpubExtension
 					isbnAdded = true
 				} else {
 					// add randint to suffix
@@ -379,44 +453,29 @@ func (b *Book) Refresh() (wasRenamed []bool, newName []string, err error) {
 	// refresh and clean Metadata
 	b.Metadata.Clean(b.Config)
 
-	// refresh both epubs
-	var wasRenamedR, wasRenamedNR bool
-	var newNameR, newNameNR string
-	var errR, errNR error
-	if b.HasRetail() {
-		if _, exists := h.FileExists(b.RetailEpub.FullPath()); exists == nil {
-			wasRenamedR, newNameR, errR = b.RefreshEpub(b.RetailEpub, true)
-			if wasRenamedR {
-				b.RetailEpub.Filename = newNameR
-			}
-		} else {
-			b.UI.Warning("Missing retail epub " + b.RetailEpub.FullPath())
-			b.RetailEpub = Epub{}
+	// refresh all epubs, forgetting the missing ones
+	editions := []Edition{}
+	errorMsg := ""
+	for k := range b.Editions {
+		if _, exists := h.FileExists(b.Editions[k].Epub.FullPath()); exists != nil {
+			b.UI.Warning("Missing epub " + b.Editions[k].Epub.FullPath())
+			continue
 		}
+		editions = append(editions, b.Editions[k])
 	}
-	if b.HasNonRetail() {
-		if _, exists := h.FileExists(b.NonRetailEpub.FullPath()); exists == nil {
-			wasRenamedNR, newNameNR, errNR = b.RefreshEpub(b.NonRetailEpub, false)
-			if wasRenamedNR {
-				b.NonRetailEpub.Filename = newNameNR
-			}
-		} else {
-			b.UI.Warning("Missing nonretail epub " + b.NonRetailEpub.FullPath())
-			b.NonRetailEpub = Epub{}
-		}
-	}
-
-	// preparing output
-	wasRenamed = []bool{wasRenamedR, wasRenamedNR}
-	newName = []string{newNameR, newNameNR}
-	if errR != nil || errNR != nil {
-		errorMsg := ""
-		if errR != nil {
-			errorMsg += errR.Error()
+	b.Editions = editions
+	for k := range b.Editions {
+		renamed, name, errEd := b.refreshEdition(k)
+		if renamed {
+			b.Editions[k].Epub.Filename = name
 		}
-		if errNR != nil {
-			errorMsg += errNR.Error()
+		if errEd != nil {
+			errorMsg += errEd.Error()
 		}
+		wasRenamed = append(wasRenamed, renamed)
+		newName = append(newName, name)
+	}
+	if errorMsg != "" {
 		err = errors.New(errorMsg)
 	}
 	return
@@ -424,92 +483,80 @@ func (b *Book) Refresh() (wasRenamed []bool, newName []string, err error) {
 
 // HasRetail checks if a retail epub is available.
 func (b *Book) HasRetail() bool {
-	return b.RetailEpub.Filename != ""
+	return b.edition(true) != nil
 }
 
 // HasNonRetail checks if a non-retail epub is available.
 func (b *Book) HasNonRetail() bool {
-	return b.NonRetailEpub.Filename != ""
+	return b.edition(false) != nil
 }
 
 // HasEpub checks if the book has at least one epub
 func (b *Book) HasEpub() bool {
-	return b.HasRetail() || b.HasNonRetail()
+	return len(b.Editions) != 0
 }
 
-// AddEpub to the Library
+// AddEpub to the Library, as an edition described by the metadata of the epub.
 func (b *Book) AddEpub(path string, isRetail bool, hash string) (imported bool, err error) {
-	if isRetail {
-		if b.HasRetail() {
-			b.UI.Info("Trying to import retail epub although retail version already exists.")
-			if b.RetailEpub.NeedsReplacement == e.True {
-				// replace retail
-				err = b.removeEpub(isRetail)
-				if err != nil {
-					return
-				}
-				imported, err = b.Import(path, isRetail, hash)
-			}
-		} else {
-			// if no retail version exists, import
-			imported, err = b.Import(path, isRetail, hash)
-		}
+	ep := Epub{Filename: path, Config: b.Config, UI: b.UI}
+	info, err := ep.ReadMetadata()
+	if err != nil && err.Error() != "ISBN not found in epub" {
+		return
+	}
+	return b.AddEdition(path, isRetail, hash, info)
+}
 
-		if imported && b.HasNonRetail() {
-			// if a non-retail version existed, it is now trumped. Removing epub.
-			b.UI.Warning("Non-retail version trumped, removing.")
-			// replace ,nonretail
-			err = b.removeEpub(!isRetail)
-			if err != nil {
-				return
-			}
-		}
-	} else {
-		if b.HasRetail() {
-			b.UI.Info("Trying to import non-retail epub although retail version exists, ignoring.")
-		} else {
-			if b.HasNonRetail() {
-				b.UI.Info("Trying to import non-retail epub although a non-retail version already exists.")
-				if b.NonRetailEpub.NeedsReplacement == e.True {
-					// replace ,nonretail
-					b.UI.Warning("Replacing non-retail version, flagged for replacement.")
-					err = b.removeEpub(isRetail)
-					if err != nil {
-						return
-					}
-					imported, err = b.Import(path, isRetail, hash)
-				}
-			} else {
-				// import non retail if no version exists
-				imported, err = b.Import(path, isRetail, hash)
-			}
+// AddEdition to the Library, from an epub described by its Metadata.
+// A retail epub trumps a non-retail epub of the same edition, and an epub
+// flagged for replacement is replaced by a new epub of the same edition.
+// Epubs of other editions are added alongside the existing ones.
+func (b *Book) AddEdition(path string, isRetail bool, hash string, info Metadata) (imported bool, err error) {
+	ed := newEdition(Epub{}, isRetail, info)
+	k := b.findEdition(ed)
+	if k == -1 {
+		if b.HasEpub() {
+			b.UI.Info("Adding new edition: " + ed.String())
 		}
+		return b.importEdition(path, hash, ed)
+	}
+	known := b.Editions[k]
+	switch {
+	case isRetail && !known.Retail:
+		// if a non-retail version existed, it is now trumped. Removing epub.
+		b.UI.Warning("Non-retail version trumped, removing.")
+	case isRetail == known.Retail && known.Epub.NeedsReplacement == e.True:
+		b.UI.Warning("Replacing version flagged for replacement.")
+	case isRetail:
+		b.UI.Info("Trying to import retail epub although retail version already exists.")
+		return
+	default:
+		b.UI.Info("Trying to import non-retail epub although a version of this edition already exists, ignoring.")
+		return
 	}
-	return
+	ed.inherit(known)
+	if err = b.removeEdition(k); err != nil {
+		return
+	}
+	return b.importEdition(path, hash, ed)
 }
 
-// Remove an Epub from the library
-func (b *Book) removeEpub(isRetail bool) (err error) {
-	if isRetail {
-		// remove
-		err = os.Remove(b.RetailEpub.FullPath())
-		if err != nil {
-			return
-		}
-		b.RetailEpub = Epub{}
-	} else {
-		// remove
-		err = os.Remove(b.NonRetailEpub.FullPath())
-		if err != nil {
-			return
+// Import an Epub to the Library, for a new Book.
+func (b *Book) Import(path string, isRetail bool, hash string) (imported bool, err error) {
+	ed := newEdition(Epub{}, isRetail, b.Metadata)
+	// the epub may have been set with its path outside the library by NewBook
+	for k := range b.Editions {
+		if b.Editions[k].Epub.Filename == path {
+			ed = b.Editions[k]
+			ed.Retail = isRetail
+			b.Editions = append(b.Editions[:k], b.Editions[k+1:]...)
+			break
 		}
-		b.NonRetailEpub = Epub{}
 	}
-	return
+	return b.importEdition(path, hash, ed)
 }
 
-// Import an Epub to the Library
-func (b *Book) Import(path string, isRetail bool, hash string) (imported bool, err error) {
+// importEdition copies an epub to the Library, as a new edition of the Book.
+func (b *Book) importEdition(path, hash string, ed Edition) (imported bool, err error) {
 	// copy
 	dest := filepath.Join(b.Config.LibraryRoot, filepath.Base(path))
 	b.UI.Debug("Importing " + path + " to " + dest)
@@ -518,37 +565,71 @@ func (b *Book) Import(path string, isRetail bool, hash string) (imported bool, e
 		return
 	}
 	// make epub
-	ep := Epub{Filename: filepath.Base(path), Hash: hash, Config: b.Config, UI: b.UI, NeedsReplacement: e.False}
-	if isRetail {
-		b.RetailEpub = ep
-	} else {
-		b.NonRetailEpub = ep
-	}
+	ed.Epub = Epub{Filename: filepath.Base(path), Hash: hash, Config: b.Config, UI: b.UI, NeedsReplacement: e.False}
+	ed.Imported = time.Now().Local().Format(dateFormat)
+	b.Editions = append(b.Editions, ed)
 	// rename
 	_, _, err = b.Refresh()
 	if err != nil {
 		return
 	}
+	// cache cover, if it can be found
+	if !b.Cover.IsSet() {
+		if coverErr := b.ExtractCover(); coverErr != nil {
+			b.UI.Debug("No cover extracted: " + coverErr.Error())
+		}
+	}
 	return true, nil
 }
 
-// Check epubs integrity.
-func (b *Book) Check() (retailHasChanged bool, nonRetailHasChanged bool, err error) {
-	if b.HasNonRetail() {
-		nonRetailHasChanged, err = b.NonRetailEpub.Check()
+// EmbedMetadata writes the Book Metadata inside all its non-retail epubs, with
+// the ISBN, language, publisher and year of their own edition.
+// The updated epubs are returned, along with the errors of the epubs that
+// could not be updated.
+// Retail epubs are never modified, so that their hash can still be checked.
+func (b *Book) EmbedMetadata() ([]*Epub, error) {
+	updated := []*Epub{}
+	failed := []string{}
+	nonRetail := 0
+	for k := range b.Editions {
+		ed := &b.Editions[k]
+		if ed.Retail {
+			continue
+		}
+		nonRetail++
+		backup, err := ed.Epub.WriteMetadata(ed.metadata(b.Metadata))
 		if err != nil {
-			return
+			failed = append(failed, ed.Epub.Filename+": "+err.Error())
+			continue
 		}
+		b.UI.Debug("Original epub saved as " + backup)
+		updated = append(updated, &ed.Epub)
 	}
-	if b.HasRetail() {
-		retailHasChanged, err = b.RetailEpub.Check()
-		if err != nil {
-			return
+	if nonRetail == 0 {
+		return nil, errors.New("No non-retail epub to update for " + b.String())
+	}
+	if len(failed) != 0 {
+		return updated, errors.New(strings.Join(failed, "; "))
+	}
+	return updated, nil
+}
+
+// Check epubs integrity.
+func (b *Book) Check() (retailHasChanged bool, nonRetailHasChanged bool, err error) {
+	for k := range b.Editions {
+		hasChanged, checkErr := b.Editions[k].Epub.Check()
+		if checkErr != nil {
+			return retailHasChanged, nonRetailHasChanged, checkErr
 		}
-		if retailHasChanged {
-			err = errors.New("Retail Epub hash has changed")
+		if b.Editions[k].Retail {
+			retailHasChanged = retailHasChanged || hasChanged
+		} else {
+			nonRetailHasChanged = nonRetailHasChanged || hasChanged
 		}
 	}
+	if retailHasChanged {
+		err = errors.New("Retail Epub hash has changed")
+	}
 	return
 }
 
@@ -12,8 +12,8 @@ import (
 )
 
 const (
-	progressUsage = "Your progress for this book: unread, shortlisted, reading or read."
-	readDateUsage = "When you finished reading this book."
+	progressUsage = "Your progress for this book, as defined in the configuration file."
+	readDateUsage = "When you finished reading this book, logged as a finished reading."
 	ratingUsage   = "Give a rating between 0 and 5."
 	reviewUsage   = "Your review of this book."
 )
@@ -22,6 +22,8 @@ var usageMap = map[string]string{
 	tagsField:        tagsUsage,
 	seriesField:      seriesUsage,
 	authorField:      authorUsage,
+	translatorField:  translatorUsage,
+	editorField:      editorUsage,
 	yearField:        yearUsage,
 	editionYearField: editionYearUsage,
 	languageField:    languageUsage,
@@ -30,7 +32,9 @@ var usageMap = map[string]string{
 	genreField:       genreUsage,
 	isbnField:        isbnUsage,
 	titleField:       titleUsage,
+	subtitleField:    subtitleUsage,
 	descriptionField: descriptionUsage,
+	identifiersField: identifiersUsage,
 	publisherField:   publisherUsage,
 	progressField:    progressUsage,
 	readDateField:    readDateUsage,
@@ -90,7 +94,7 @@ func (b *Book) ForceMetadataFieldRefresh(field string) (err error) {
 		return err
 	}
 	// set value
-	return b.Metadata.Set(field, value)
+	return b.Metadata.Set(field, value, b.Config)
 }
 
 // EditField in current Metadata associated with the Book.
@@ -99,7 +103,7 @@ func (b *Book) EditField(args ...string) error {
 	case 0:
 		// completely interactive edit over all fields
 		atLeastOneWrong := false
-		for _, field := range allFields {
+		for _, field := range append(allFields, b.Config.CustomFields.Names()...) {
 			if err := b.editSpecificField(field, ""); err != nil {
 				atLeastOneWrong = true
 				b.UI.Warning("Could not assign new value to field " + field + ", continuing.")
@@ -120,6 +124,9 @@ func (b *Book) EditField(args ...string) error {
 // Get Book field value
 func (b *Book) Get(field string) (value string, err error) {
 	var structField reflect.Value
+	if value, ok := b.getCustom(field); ok {
+		return value, nil
+	}
 	value, err = b.Metadata.Get(field)
 	if err != nil {
 		_, structField, _, err = getField(b, bookFieldMap, field)
@@ -133,8 +140,11 @@ func (b *Book) Get(field string) (value string, err error) {
 
 // Set a field value for Book or Metadata
 func (b *Book) Set(field, value string) error {
+	if customField, ok := b.Config.CustomFields[field]; ok {
+		return b.setCustom(customField, value)
+	}
 	// try to set Metadata fields first
-	err := b.Metadata.Set(field, value)
+	err := b.Metadata.Set(field, value, b.Config)
 	if err != nil {
 		publicFieldName, structField, canBeSet, err := getField(b, bookFieldMap, field)
 		if err != nil {
@@ -147,16 +157,18 @@ func (b *Book) Set(field, value string) error {
 		switch publicFieldName {
 		case progressField:
 			// check it's a valid progress
-			if _, isIn := h.StringInSlice(value, validProgress); !isIn {
-				return errors.New("Invalid reading progress: " + value)
+			progress, err := b.Config.ProgressVocabulary().Clean(value)
+			if err != nil {
+				return errors.New("Invalid reading progress: " + err.Error())
 			}
-			structField.SetString(value)
+			structField.SetString(progress)
 		case readDateField:
 			// check it's a valid date
 			if _, err = time.Parse("2006-01-02", value); err != nil {
 				return errors.New("Invalid read date: " + value)
 			}
-			structField.SetString(value)
+			// the read date is derived from the reading log
+			return b.FinishReading(value, "", "")
 		case ratingField:
 			// checking rating is between 0 and 5
 			val, err := strconv.ParseFloat(value, 32)
@@ -181,6 +193,9 @@ func (b *Book) editSpecificField(field string, value string) (err error) {
 		if !ok {
 			usage = ""
 		}
+		if customField, ok := b.Config.CustomFields[field]; ok {
+			usage = customField.Usage()
+		}
 		longField := false
 		if field == reviewField || field == descriptionField {
 			longField = true
@@ -206,7 +221,7 @@ func (b *Book) OutputDiffTable(o *Book, diffOnly bool) [][]string {
 	if !diffOnly {
 		rows = append(rows, []string{b.String(), o.String()})
 	}
-	for _, field := range allFields {
+	for _, field := range append(allFields, b.Config.CustomFields.Names()...) {
 		iValue, err := b.Get(field)
 		if err != nil {
 			iValue = couldNotRetrieveValue
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	en "github.com/barsanuphe/endive/endive"
 	"github.com/barsanuphe/endive/mock"
 )
 
@@ -54,6 +55,7 @@ func TestBookSetGet(t *testing.T) {
 	// set Progress
 	err = e.Set(progressField, "a")
 	assert.NotNil(err, invalidValue)
+	validProgress := standardTestConfig.ProgressVocabulary().Terms()
 	for _, vp := range validProgress {
 		err := e.Set(progressField, vp)
 		assert.Nil(err, validValue)
@@ -72,6 +74,10 @@ func TestBookSetGet(t *testing.T) {
 	err = e.Set(readDateField, "2013-12-15")
 	assert.Nil(err, validValue)
 	assert.Equal("2013-12-15", e.ReadDate)
+	assert.Equal(ReadingLog{{Finished: "2013-12-15"}}, e.Readings)
+	assert.Equal(en.ProgressRead, e.Progress)
+	err = e.Set(readDateField, "2999-12-15")
+	assert.NotNil(err, invalidValue)
 	// get readdate
 	value, err = e.Get(readDateField)
 	assert.Nil(err, validField)
@@ -64,7 +64,7 @@ func SortBooks(books []Book, orderBy string) {
 		return p1.Metadata.EditionYear < p2.Metadata.EditionYear
 	}
 	author := func(p1, p2 *Book) bool {
-		return p1.Metadata.Author() < p2.Metadata.Author()
+		return p1.Metadata.AuthorSortName() < p2.Metadata.AuthorSortName()
 	}
 	title := func(p1, p2 *Book) bool {
 		return p1.Metadata.Title() < p2.Metadata.Title()
@@ -33,7 +33,7 @@ var epubs = []struct {
 		"2005",
 		"en",
 		"dc325b3aceb77d9f943425728c037fdcaf4af58e3abd771a8094f2424455cc03",
-		`{"id":0,"retail":{"filename":"test/pg16328.epub","hash":"dc325b3aceb77d9f943425728c037fdcaf4af58e3abd771a8094f2424455cc03","replace":"false"},"nonretail":{"filename":"","hash":"","replace":""},"metadata":{"title":"Beowulf / An Anglo-Saxon Epic Poem","image_url":"","num_pages":"","authors":null,"isbn":"","year":"2005","edition_year":"2005","description":"","series":null,"average_rating":"","tags":[{"name":"dragons -- poetry"}],"category":"Unknown","type":"Unknown","genre":"monsters -- poetry","language":"en","publisher":""},"progress":"unread","readdate":"","rating":"","review":"","exported":"false"}`,
+		`{"id":0,"editions":[{"epub":{"filename":"test/pg16328.epub","hash":"dc325b3aceb77d9f943425728c037fdcaf4af58e3abd771a8094f2424455cc03","replace":"false"},"retail":true}],"metadata":{"title":"Beowulf / An Anglo-Saxon Epic Poem","subtitle":"","image_url":"","num_pages":"","authors":null,"isbn":"","year":"2005","edition_year":"2005","description":"","series":null,"average_rating":"","tags":[{"name":"monsters -- poetry"},{"name":"dragons -- poetry"}],"category":"Unknown","type":"Unknown","genre":"epic poetry, english (old)","language":"en","publisher":"","contributors":{"trl":["J. Lesslie Hall"]},"sort_names":{"J. Lesslie Hall":"Hall, J. Lesslie (John Lesslie)"},"identifiers":null},"progress":"unread","readdate":"","rating":"","review":"","exported":"false","cover":{"filename":"","source":""},"exported_hash":""}`,
 		"Unknown 2005 Beowulf - An Anglo-Saxon Epic Poem",
 		"Unknown 2005 Beowulf - An Anglo-Saxon Epic Poem [retail]",
 		"en/Unknown/2005. [Unknown] (Beowulf - An Anglo-Saxon Epic Poem)",
@@ -45,7 +45,7 @@ var epubs = []struct {
 		"2006",
 		"fr",
 		"acd2b8eba1b11456bacf11e690edf56bc57774053668644ef34f669138ebdd9a",
-		`{"id":1,"retail":{"filename":"test/pg17989.epub","hash":"acd2b8eba1b11456bacf11e690edf56bc57774053668644ef34f669138ebdd9a","replace":"false"},"nonretail":{"filename":"","hash":"","replace":""},"metadata":{"title":"Le comte de Monte-Cristo, Tome I","image_url":"","num_pages":"","authors":["Alexandre Dumas"],"isbn":"","year":"2006","edition_year":"2006","description":"","series":null,"average_rating":"","tags":[{"name":"revenge -- fiction"},{"name":"adventure stories"},{"name":"prisoners -- fiction"},{"name":"france -- history -- 19th century -- fiction"},{"name":"pirates -- fiction"},{"name":"dantès, edmond (fictitious character) -- fiction"}],"category":"Unknown","type":"Unknown","genre":"historical fiction","language":"fr","publisher":""},"progress":"unread","readdate":"","rating":"","review":"","exported":"false"}`,
+		`{"id":1,"editions":[{"epub":{"filename":"test/pg17989.epub","hash":"acd2b8eba1b11456bacf11e690edf56bc57774053668644ef34f669138ebdd9a","replace":"false"},"retail":true}],"metadata":{"title":"Le comte de Monte-Cristo, Tome I","subtitle":"","image_url":"","num_pages":"","authors":["Alexandre Dumas"],"isbn":"","year":"2006","edition_year":"2006","description":"","series":null,"average_rating":"","tags":[{"name":"revenge -- fiction"},{"name":"adventure stories"},{"name":"prisoners -- fiction"},{"name":"france -- history -- 19th century -- fiction"},{"name":"pirates -- fiction"},{"name":"dantès, edmond (fictitious character) -- fiction"}],"category":"Unknown","type":"Unknown","genre":"historical fiction","language":"fr","publisher":"","contributors":null,"sort_names":{"Alexandre Dumas":"Dumas, Alexandre"},"identifiers":null},"progress":"unread","readdate":"","rating":"","review":"","exported":"false","cover":{"filename":"","source":""},"exported_hash":""}`,
 		"Alexandre Dumas 2006 Le comte de Monte-Cristo, Tome I",
 		"Alexandre Dumas 2006 Le comte de Monte-Cristo, Tome I [retail]",
 		"fr/Alexandre Dumas/2006. [Alexandre Dumas] (Le comte de Monte-Cristo, Tome I)",
@@ -66,7 +66,8 @@ func TestMain(m *testing.M) {
 	parentDir = filepath.Dir(wd)
 	tags := make(map[string][]string)
 	tags["science-fiction"] = []string{"sci-fi"}
-	standardTestConfig = en.Config{LibraryRoot: parentDir, TagAliases: tags}
+	languageAliases := map[string][]string{"fr-ca": {"fr-CA"}}
+	standardTestConfig = en.Config{LibraryRoot: parentDir, TagAliases: tags, LanguageAliases: languageAliases}
 	// init logger
 	err = ui.InitLogger("log_testing")
 	if err != nil {
@@ -92,7 +93,7 @@ func TestBookJSON(t *testing.T) {
 		}
 		e.Metadata = info
 
-		err = e.RetailEpub.GetHash()
+		err = e.MainEpub().GetHash()
 		assert.Nil(err, "Error getting hash for "+e.FullPath())
 
 		jsonString, err := e.JSON()
@@ -146,13 +147,13 @@ func TestBookNewName(t *testing.T) {
 		assert.Equal(e.IsExported, en.False)
 
 		// test generateNewName()
-		_, err = e.generateNewName("", !isRetail)
+		_, err = e.generateNewName("", 0)
 		assert.NotNil(err, "cannot generate name without template")
-		newName1, err := e.generateNewName("$a $y $t", !isRetail)
+		newName1, err := e.generateNewName("$a $y $t", 0)
 		assert.Nil(err, "Error generating new name")
 		assert.Equal(newName1, testEpub.expectedFormat1, "Error getting new name")
 
-		newName2, err := e.generateNewName("$l/$a/$y. [$a] ($t)", !isRetail)
+		newName2, err := e.generateNewName("$l/$a/$y. [$a] ($t)", 0)
 		assert.Nil(err, "Error generating new name")
 		assert.Equal(newName2, testEpub.expectedFormat2, "Error getting new name")
 
@@ -164,7 +165,7 @@ func TestBookNewName(t *testing.T) {
 		}
 		e.Metadata = info
 
-		newName1, err = e.generateNewName("$a $y $t", isRetail)
+		newName1, err = e.generateNewName("$a $y $t", 0)
 		assert.Nil(err, "Error generating new name")
 		assert.Equal(newName1, testEpub.expectedFormat1Retail, "Error getting new name")
 	}
@@ -196,7 +197,7 @@ func TestBookRefresh(t *testing.T) {
 		wasRenamed, newName, err := e.Refresh()
 		assert.Nil(err, "Error generating new name")
 		assert.True(wasRenamed[0], "Error renaming "+tempCopy)
-		assert.False(wasRenamed[1], "Error: should not have rename non-existent non-retail epub.")
+		assert.Equal(1, len(wasRenamed), "Error: should only have refreshed one epub.")
 		assert.Equal(newName[0], testEpub.expectedFormat1Retail+en.EpubExtension, "Error renaming %s "+tempCopy)
 
 		// getting epub path relative to parent dir (ie simulated library root) for comparison
@@ -240,6 +241,14 @@ func TestBookProgress(t *testing.T) {
 	err = e.SetProgress("mhiuh")
 	assert.NotNil(err, "Error setting progress should have failed")
 	assert.Equal(e.Progress, "shortlisted", "Error setting progress")
+
+	// configured progress states
+	e.Config.Progress = en.Vocabulary{unread: nil, read: nil, "abandoned": {"dnf"}}
+	err = e.SetProgress("DNF")
+	assert.Nil(err, "Error setting configured progress")
+	assert.Equal("abandoned", e.Progress)
+	err = e.SetProgress(shortlisted)
+	assert.NotNil(err, "shortlisted is not configured")
 }
 
 // TestBookSearchOnline tests for SearchOnline
@@ -44,10 +44,10 @@ func (bks *Books) Propagate(ui i.UserInterface, c e.Config) {
 	for i := range *bks {
 		(*bks)[i].Config = c
 		(*bks)[i].UI = ui
-		(*bks)[i].NonRetailEpub.Config = c
-		(*bks)[i].NonRetailEpub.UI = ui
-		(*bks)[i].RetailEpub.Config = c
-		(*bks)[i].RetailEpub.UI = ui
+		for _, ep := range (*bks)[i].Epubs() {
+			ep.Config = c
+			ep.UI = ui
+		}
 	}
 }
 
@@ -105,6 +105,14 @@ func (bks *Books) Progress(progress string) e.Collection {
 	return res
 }
 
+// Work among Books: the editions and translations linked to a work ID.
+func (bks *Books) Work(id string) e.Collection {
+	work := bks.filter(func(b *Book) bool { return id != "" && b.WorkID() == id })
+	var res e.Collection
+	res = &work
+	return res
+}
+
 // Retail among Books.
 func (bks *Books) Retail() e.Collection {
 	retail := bks.filter(func(b *Book) bool { return b.HasRetail() })
@@ -121,6 +129,14 @@ func (bks *Books) NonRetailOnly() e.Collection {
 	return res
 }
 
+// NeedsReplacement among Books: books with an epub flagged for replacement.
+func (bks *Books) NeedsReplacement() e.Collection {
+	flagged := bks.filter(func(b *Book) bool { return b.NeedsReplacement() })
+	var res e.Collection
+	res = &flagged
+	return res
+}
+
 // Exported among Books.
 func (bks *Books) Exported() e.Collection {
 	exported := bks.filter(func(b *Book) bool { return b.IsExported == e.True })
@@ -165,7 +181,12 @@ func (bks *Books) FindByFullPath(filename string) (e.GenericBook, error) {
 		return nil, errors.New("empty path")
 	}
 	b := bks.findUnique(func(b *Book) bool {
-		return b.RetailEpub.FullPath() == filename || b.NonRetailEpub.FullPath() == filename
+		for _, ep := range b.Epubs() {
+			if ep.FullPath() == filename {
+				return true
+			}
+		}
+		return false
 	})
 	if b.ID() == 0 {
 		return nil, errors.New("Could not find book with epub " + filename)
@@ -176,13 +197,29 @@ func (bks *Books) FindByFullPath(filename string) (e.GenericBook, error) {
 }
 
 // FindByMetadata among known Books
-func (bks *Books) FindByMetadata(isbn, authors, title string) (e.GenericBook, error) {
+// Identifiers other than the ISBN can be given in kind:value form.
+// Books are found by identifier, or by identical author and title: fuzzy
+// matches are found with FindSimilar.
+func (bks *Books) FindByMetadata(isbn, authors, title string, identifiers ...string) (e.GenericBook, error) {
 	isbnCandidate, err := e.CleanISBN(isbn)
-	if (authors == "" && title == "") && err != nil {
+	o := Metadata{ISBN: isbnCandidate, Authors: []string{authors}, BookTitle: title}
+	for _, identifier := range identifiers {
+		o.addIdentifierFromString(identifier)
+	}
+	if (authors == "" && title == "") && err != nil && len(o.Identifiers) == 0 {
 		return nil, errors.New("invalid isbn and/or empty author and title")
 	}
-	o := Metadata{ISBN: isbnCandidate, Authors: []string{authors}, BookTitle: title}
 	b := bks.findUnique(func(b *Book) bool {
+		// other editions of a known book
+		if err == nil && b.hasEditionISBN(isbnCandidate) {
+			return true
+		}
+		if b.Metadata.HasSameIdentifier(o) {
+			return true
+		}
+		if authors == "" && title == "" || b.Metadata.HasDifferentISBN(o) {
+			return false
+		}
 		return b.Metadata.IsSimilar(o)
 	})
 	if b.ID() == 0 {
@@ -199,7 +236,12 @@ func (bks *Books) FindByHash(hash string) (e.GenericBook, error) {
 		return nil, errors.New("empty hash")
 	}
 	b := bks.findUnique(func(b *Book) bool {
-		return b.RetailEpub.Hash == hash || b.NonRetailEpub.Hash == hash
+		for _, ep := range b.Epubs() {
+			if ep.Hash == hash {
+				return true
+			}
+		}
+		return false
 	})
 	if b.ID() == 0 {
 		return nil, errors.New("Could not find book with hash " + hash)
@@ -244,6 +286,46 @@ func (bks *Books) Tags() (tags map[string]int) {
 	return
 }
 
+// TagTree counts the books for every level of hierarchical tags.
+// Each book is counted once for a level, even if it has several tags below it.
+func (bks *Books) TagTree() (tags map[string]int) {
+	tags = make(map[string]int)
+	for _, book := range *bks {
+		levels := make(map[string]bool)
+		for _, tag := range book.Metadata.Tags {
+			for _, level := range e.TagAncestors(tag.Name) {
+				levels[level] = true
+			}
+		}
+		for level := range levels {
+			tags[level]++
+		}
+	}
+	return
+}
+
+// MigrateVocabularies of all Books: categories, types and progress that have
+// become aliases of a configured term, after it was renamed, are replaced by the term.
+func (bks *Books) MigrateVocabularies() (migrated int) {
+	for j := range *bks {
+		if (*bks)[j].migrateVocabularies() {
+			migrated++
+		}
+	}
+	return
+}
+
+// MigrateImportDates of all Books: epubs imported before import dates were
+// saved get the date their file was last modified.
+func (bks *Books) MigrateImportDates() (migrated int) {
+	for j := range *bks {
+		if (*bks)[j].migrateImportDates() {
+			migrated++
+		}
+	}
+	return
+}
+
 // Series associated with known epubs.
 func (bks *Books) Series() (series map[string]int) {
 	series = make(map[string]int)
@@ -336,6 +418,12 @@ func (bks Books) Table() string {
 
 // Sort books
 func (bks Books) Sort(sortBy string) {
+	if len(bks) != 0 {
+		if field, ok := bks[0].Config.CustomFields[sortBy]; ok {
+			sortByCustomField(bks, field)
+			return
+		}
+	}
 	SortBooks(bks, sortBy)
 }
 
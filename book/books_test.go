@@ -43,8 +43,8 @@ func TestBooks(t *testing.T) {
 	books.Propagate(ui, cfg)
 	assert.Equal(books[0].Config, cfg, expectedCfgPropagation)
 	assert.Equal(books[0].UI, ui, expectedUIPropagation)
-	assert.Equal(books[1].NonRetailEpub.Config, cfg, expectedCfgPropagation)
-	assert.Equal(books[1].RetailEpub.UI, ui, expectedUIPropagation)
+	assert.Equal(books[1].Editions[0].Epub.Config, cfg, expectedCfgPropagation)
+	assert.Equal(books[1].Editions[0].Epub.UI, ui, expectedUIPropagation)
 
 	// test First()
 	assert.Equal(1, len(books.First(1).Books()), expectedFirstBook)
@@ -108,7 +108,7 @@ func TestBooks(t *testing.T) {
 
 	// Tags()
 	tags := books.Tags()
-	assert.Equal(7, len(tags))
+	assert.Equal(8, len(tags))
 	num, ok = tags["dragons -- poetry"]
 	assert.True(ok, "tag should be in map")
 	assert.Equal(1, num, "tag belongs to 1 book")
@@ -118,7 +118,17 @@ func TestBooks(t *testing.T) {
 	assert.Equal(2, len(incomplete.Books()))
 	// test Tags
 	tagsMap := books.Tags()
-	assert.Equal(7, len(tagsMap))
+	assert.Equal(8, len(tagsMap))
+	// test TagTree
+	books[0].Metadata.Tags = Tags{{Name: "fiction/sf/space-opera"}, {Name: "fiction/sf"}}
+	books[1].Metadata.Tags = Tags{{Name: "fiction/fantasy"}}
+	assert.Equal(map[string]int{"fiction": 2, "fiction/sf": 1, "fiction/sf/space-opera": 1, "fiction/fantasy": 1}, books.TagTree())
+	// test MigrateVocabularies, after renaming a type
+	books[0].Config.Types = endive.Vocabulary{"short story": {"shortstory"}}
+	books[0].Metadata.Type = "shortstory"
+	assert.Equal(1, books.MigrateVocabularies())
+	assert.Equal("short story", books[0].Metadata.Type)
+	assert.Equal(0, books.MigrateVocabularies())
 	// test Series
 	seriesMap := books.Series()
 	assert.Equal(0, len(seriesMap))
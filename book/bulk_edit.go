@@ -0,0 +1,137 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// bulkEditFields can be edited for several books at once, in this order.
+var bulkEditFields = []string{authorField, translatorField, editorField, titleField, subtitleField, yearField, editionYearField, publisherField, isbnField, identifiersField, descriptionField, languageField, categoryField, typeField, genreField, tagsField, seriesField, progressField, readDateField, ratingField, reviewField}
+
+// BulkEditYAML of the editable fields of several books, in one YAML document.
+func BulkEditYAML(books []*Book) ([]byte, error) {
+	entries := []yaml.MapSlice{}
+	for _, book := range books {
+		entry := yaml.MapSlice{{Key: idField, Value: book.ID()}}
+		for _, field := range append(bulkEditFields, book.Config.CustomFields.Names()...) {
+			value, err := book.Get(field)
+			if err != nil {
+				return nil, err
+			}
+			entry = append(entry, yaml.MapItem{Key: field, Value: value})
+		}
+		entries = append(entries, entry)
+	}
+	return yaml.Marshal(entries)
+}
+
+// ApplyBulkEdit parses an edited BulkEditYAML document, and returns edited
+// copies of the books that were changed.
+// Only changed fields are set, and all invalid values are reported, by book.
+func ApplyBulkEdit(books []*Book, data []byte) ([]*Book, []error) {
+	var entries []yaml.MapSlice
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, []error{errors.New("Invalid YAML: " + err.Error())}
+	}
+	byID := make(map[int]*Book)
+	for _, book := range books {
+		byID[book.ID()] = book
+	}
+	var edited []*Book
+	var errs []error
+	seen := make(map[int]bool)
+	for j, entry := range entries {
+		book, err := bulkEditBook(entry, byID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Entry #%d: %s", j+1, err.Error()))
+			continue
+		}
+		if seen[book.ID()] {
+			errs = append(errs, fmt.Errorf("Book ID#%d: edited twice", book.ID()))
+			continue
+		}
+		seen[book.ID()] = true
+		editedBook := book.Copy()
+		changed := false
+		for _, item := range entry {
+			field := strings.ToLower(fmt.Sprint(item.Key))
+			if field == idField {
+				continue
+			}
+			if !CheckValidField(field, book.Config) {
+				errs = append(errs, fmt.Errorf("Book ID#%d: "+invalidField, book.ID(), field))
+				continue
+			}
+			if !book.isBulkEditable(field) {
+				errs = append(errs, fmt.Errorf("Book ID#%d: "+cannotSetField, book.ID(), field))
+				continue
+			}
+			value := bulkEditValue(item.Value)
+			if current, err := book.Get(field); err == nil && current == value {
+				continue
+			}
+			if err := editedBook.Set(field, value); err != nil {
+				errs = append(errs, fmt.Errorf("Book ID#%d: %s: %s", book.ID(), field, err.Error()))
+				continue
+			}
+			changed = true
+		}
+		if changed {
+			edited = append(edited, editedBook)
+		}
+	}
+	if len(errs) != 0 {
+		return nil, errs
+	}
+	return edited, nil
+}
+
+// bulkEditBook edited by an entry, among the books being edited.
+func bulkEditBook(entry yaml.MapSlice, books map[int]*Book) (*Book, error) {
+	for _, item := range entry {
+		if strings.ToLower(fmt.Sprint(item.Key)) != idField {
+			continue
+		}
+		id, ok := item.Value.(int)
+		if !ok {
+			return nil, fmt.Errorf("Invalid ID %v", item.Value)
+		}
+		book, ok := books[id]
+		if !ok {
+			return nil, fmt.Errorf("Book ID#%d is not being edited", id)
+		}
+		return book, nil
+	}
+	return nil, errors.New("Missing ID")
+}
+
+func (b *Book) isBulkEditable(field string) bool {
+	if _, ok := b.Config.CustomFields[field]; ok {
+		return true
+	}
+	for _, f := range bulkEditFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// bulkEditValue as a string, whatever the YAML type used by the user.
+func bulkEditValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		// lists are also accepted for list fields
+		items := []string{}
+		for _, item := range v {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ", ")
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
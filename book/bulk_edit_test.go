@@ -0,0 +1,72 @@
+package book
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	en "github.com/barsanuphe/endive/endive"
+)
+
+func TestBulkEdit(t *testing.T) {
+	fmt.Println("+ Testing BulkEditYAML() and ApplyBulkEdit()...")
+	assert := assert.New(t)
+	cfg := standardTestConfig
+	cfg.CustomFields = en.CustomFields{"price": {Name: "price", Type: en.FieldFloat}}
+	books := []*Book{}
+	for j := range epubs {
+		b := NewBook(ui, j+1, epubs[j].filename, cfg, isRetail)
+		assert.Nil(b.Set(titleField, fmt.Sprintf("Title %d", j+1)))
+		assert.Nil(b.Set(tagsField, "scifi"))
+		books = append(books, b)
+	}
+	document, err := BulkEditYAML(books)
+	assert.Nil(err)
+	assert.Contains(string(document), "title: Title 1")
+	assert.Contains(string(document), "price: \"\"")
+	assert.NotContains(string(document), "filename:")
+
+	// unchanged document
+	edited, errs := ApplyBulkEdit(books, document)
+	assert.Nil(errs)
+	assert.Equal(0, len(edited))
+
+	// changing a few fields, in any YAML form
+	changed := strings.Replace(string(document), "title: Title 2", "title: New Title", 1)
+	changed = strings.Replace(changed, "tags: scifi", "tags: [scifi, classic]", 1)
+	changed = strings.Replace(changed, "price: \"\"", "price: 9.5", 1)
+	edited, errs = ApplyBulkEdit(books, []byte(changed))
+	assert.Nil(errs)
+	assert.Equal(2, len(edited))
+	assert.Equal(1, edited[0].ID())
+	assert.Equal("scifi, classic", edited[0].Metadata.Tags.String())
+	assert.Equal("9.5", edited[0].Custom["price"])
+	assert.Equal(2, edited[1].ID())
+	assert.Equal("New Title", edited[1].Metadata.Title())
+	// originals are not modified
+	assert.Equal("Title 2", books[1].Metadata.Title())
+	assert.Equal("scifi", books[0].Metadata.Tags.String())
+
+	// invalid values are reported for each book
+	invalid := strings.Replace(string(document), "price: \"\"", "price: cheap", 1)
+	invalid = strings.Replace(invalid, "title: Title 2", "title: New Title\n  rating: \"12\"", 1)
+	edited, errs = ApplyBulkEdit(books, []byte(invalid))
+	assert.Nil(edited)
+	if assert.Equal(2, len(errs)) {
+		assert.Contains(errs[0].Error(), "Book ID#1: price")
+		assert.Contains(errs[1].Error(), "Book ID#2: rating")
+	}
+	for _, wrong := range []string{
+		"- id: 99\n  title: thing\n",
+		"- title: thing\n",
+		"- id: 1\n  filename: thing.epub\n",
+		"- id: 1\n  unknown: thing\n",
+		"- id: 1\n- id: 1\n",
+		"not: a list\n",
+	} {
+		_, errs = ApplyBulkEdit(books, []byte(wrong))
+		assert.Equal(1, len(errs), wrong)
+	}
+}
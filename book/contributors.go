@@ -0,0 +1,101 @@
+package book
+
+import (
+	"sort"
+	"strings"
+
+	h "github.com/barsanuphe/helpers"
+)
+
+// MARC relator codes for the roles endive knows about.
+const (
+	authorRole       = "aut"
+	translatorRole   = "trl"
+	editorRole       = "edt"
+	illustratorRole  = "ill"
+	introductionRole = "aui"
+	contributorRole  = "ctb"
+	bookProducerRole = "bkp"
+)
+
+// roleNames are the human-readable names of roles.
+var roleNames = map[string]string{
+	authorRole:       "author",
+	translatorRole:   "translator",
+	editorRole:       "editor",
+	illustratorRole:  "illustrator",
+	introductionRole: "introduction",
+	contributorRole:  "contributor",
+}
+
+// goodReadsRoles maps Goodreads author roles to relator codes.
+var goodReadsRoles = map[string]string{
+	"":              authorRole,
+	"translator":    translatorRole,
+	"translation":   translatorRole,
+	"editor":        editorRole,
+	"illustrator":   illustratorRole,
+	"illustrations": illustratorRole,
+	"introduction":  introductionRole,
+	"foreword":      introductionRole,
+}
+
+// Contributors are the people who worked on a book, other than its authors, by role.
+type Contributors map[string][]string
+
+// Add a contributor with a given role, if not already known.
+func (c *Contributors) Add(role, name string) {
+	name = strings.TrimSpace(name)
+	if name == "" || role == "" {
+		return
+	}
+	if *c == nil {
+		*c = make(Contributors)
+	}
+	if _, isIn := h.StringInSlice(name, (*c)[role]); !isIn {
+		(*c)[role] = append((*c)[role], name)
+	}
+}
+
+// Set all contributors for a role.
+func (c *Contributors) Set(role string, names ...string) {
+	if *c != nil {
+		delete(*c, role)
+	}
+	for _, name := range names {
+		c.Add(role, name)
+	}
+}
+
+// Get the contributors with a role.
+func (c Contributors) Get(role string) string {
+	return strings.Join(c[role], ", ")
+}
+
+// String returns all contributors and their roles.
+func (c Contributors) String() string {
+	roles := []string{}
+	for role := range c {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	contributors := []string{}
+	for _, role := range roles {
+		roleName, ok := roleNames[role]
+		if !ok {
+			roleName = role
+		}
+		for _, name := range c[role] {
+			contributors = append(contributors, name+" ("+roleName+")")
+		}
+	}
+	return strings.Join(contributors, ", ")
+}
+
+// goodReadsRole converts a Goodreads author role to a relator code.
+func goodReadsRole(role string) string {
+	if code, ok := goodReadsRoles[strings.ToLower(strings.TrimSpace(role))]; ok {
+		return code
+	}
+	return contributorRole
+}
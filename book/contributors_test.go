@@ -0,0 +1,75 @@
+package book
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	en "github.com/barsanuphe/endive/endive"
+	"github.com/stretchr/testify/assert"
+)
+
+const testGoodReadsAuthors = `<book>
+  <title>The Last Wish</title>
+  <authors>
+    <author><id>38569</id><name>Andrzej Sapkowski</name><role></role></author>
+    <author><id>1</id><name>Danusia Stok</name><role>Translator</role></author>
+    <author><id>2</id><name>Some One</name><role>Cover Artist</role></author>
+  </authors>
+</book>`
+
+func TestContributors(t *testing.T) {
+	fmt.Println("+ Testing Contributors...")
+	assert := assert.New(t)
+	var c Contributors
+	c.Add(translatorRole, "Danusia Stok")
+	c.Add(translatorRole, " Danusia Stok ")
+	c.Add(editorRole, "Some Editor")
+	c.Add(editorRole, "")
+	assert.Equal("Danusia Stok", c.Get(translatorRole))
+	assert.Equal("Some Editor (editor), Danusia Stok (translator)", c.String())
+	c.Set(translatorRole, "A", " B")
+	assert.Equal("A, B", c.Get(translatorRole))
+	c.Set(editorRole)
+	assert.Equal("", c.Get(editorRole))
+
+	assert.Equal(authorRole, goodReadsRole(""))
+	assert.Equal(translatorRole, goodReadsRole("Translator"))
+	assert.Equal(contributorRole, goodReadsRole("Cover Artist"))
+}
+
+func TestGoodReadsContributors(t *testing.T) {
+	fmt.Println("+ Testing goodReadsBook.metadata()...")
+	assert := assert.New(t)
+	g := goodReadsBook{}
+	assert.Nil(xml.Unmarshal([]byte(testGoodReadsAuthors), &g))
+	info := g.metadata()
+	assert.Equal("The Last Wish", info.Title())
+	assert.Equal([]string{"Andrzej Sapkowski"}, info.Authors)
+	assert.Equal("Danusia Stok", info.Contributors.Get(translatorRole))
+	assert.Equal("Some One", info.Contributors.Get(contributorRole))
+}
+
+func TestMetadataContributors(t *testing.T) {
+	fmt.Println("+ Testing Metadata contributors...")
+	assert := assert.New(t)
+	cfg := en.Config{AuthorAliases: map[string][]string{"Danusia Stok": {"D. Stok"}, "Andrzej Sapkowski": {"A. Sapkowski"}}}
+	i := Metadata{Authors: []string{"A. Sapkowski"}}
+	assert.Nil(i.Set(translatorField, "D. Stok, Other Translator", standardTestConfig))
+	assert.Nil(i.Set(editorField, "Some Editor", standardTestConfig))
+	i.SetSortName("A. Sapkowski", "Sapkowski, A.")
+	i.SetSortName("Some Editor", "Some Editor")
+	i.useAliases(cfg)
+
+	// only authors are displayed as authors
+	assert.Equal("Andrzej Sapkowski", i.Author())
+	value, err := i.Get(translatorField)
+	assert.Nil(err)
+	assert.Equal("Danusia Stok, Other Translator", value)
+	value, err = i.Get(editorField)
+	assert.Nil(err)
+	assert.Equal("Some Editor", value)
+	// sort names follow aliases, and are only kept if useful
+	assert.Equal(map[string]string{"Andrzej Sapkowski": "Sapkowski, A."}, i.SortNames)
+	assert.Equal("Sapkowski, A.", i.AuthorSortName())
+}
@@ -0,0 +1,345 @@
+package book
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+)
+
+const (
+	coverFromEpub = "epub"
+	coverFromFile = "file"
+	coverFromURL  = "url"
+
+	coverExtension  = ".jpg"
+	thumbnailDir    = "thumbnails"
+	thumbnailHeight = 200
+	jpegQuality     = 90
+
+	newCoverID       = "endive-cover"
+	newCoverFilename = "endive-cover.jpg"
+)
+
+// Cover of a Book, chosen among the epub cover and remote or local images.
+// The image itself is kept in the cover directory, along with a thumbnail.
+type Cover struct {
+	Filename string `json:"filename"` // relative to the cover directory
+	Source   string `json:"source"`
+}
+
+// IsSet checks if a cover was chosen.
+func (c Cover) IsSet() bool {
+	return c.Filename != ""
+}
+
+// findCoverItem in the OPF manifest.
+func findCoverItem(doc opfDocument) (opfItem, error) {
+	// EPUB3: cover-image property
+	for _, item := range doc.Items {
+		if item.hasProperty("cover-image") {
+			return item, nil
+		}
+	}
+	// EPUB2: <meta name="cover" content="item id"/>
+	for _, meta := range doc.Metas {
+		if meta.Name == "cover" {
+			for _, item := range doc.Items {
+				// some epubs point directly to the file
+				if item.ID == meta.Content || item.Href == meta.Content {
+					return item, nil
+				}
+			}
+		}
+	}
+	// last resort: any image that looks like a cover
+	for _, item := range doc.Items {
+		if strings.HasPrefix(item.MediaType, "image/") && (strings.Contains(strings.ToLower(item.ID), "cover") || strings.Contains(strings.ToLower(item.Href), "cover")) {
+			return item, nil
+		}
+	}
+	return opfItem{}, errors.New("Could not find cover in epub")
+}
+
+// ExtractCover returns the cover image found in the epub.
+func (e *Epub) ExtractCover() ([]byte, error) {
+	opfPath, opf, err := readOPF(e.FullPath())
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parseOPFDocument(opf)
+	if err != nil {
+		return nil, err
+	}
+	item, err := findCoverItem(doc)
+	if err != nil {
+		return nil, err
+	}
+	return readEpubFile(e.FullPath(), itemPath(opfPath, item.Href))
+}
+
+// embedCover in an epub, adding the image to the replaced files.
+// If the epub already has a cover, it is replaced, otherwise a new cover is
+// declared in the returned OPF.
+func embedCover(opfPath string, opf []byte, img image.Image, replacements map[string][]byte) ([]byte, error) {
+	doc, err := parseOPFDocument(opf)
+	if err != nil {
+		return opf, err
+	}
+	if item, err := findCoverItem(doc); err == nil {
+		// keeping the same file and format, so that the manifest stays valid
+		data, err := encodeImage(img, item.MediaType)
+		if err != nil {
+			return opf, err
+		}
+		replacements[itemPath(opfPath, item.Href)] = data
+		return opf, nil
+	}
+	data, err := encodeImage(img, "image/jpeg")
+	if err != nil {
+		return opf, err
+	}
+	replacements[itemPath(opfPath, newCoverFilename)] = data
+	return declareCover(opf, doc.isEpub3())
+}
+
+// declareCover adds a new cover image to a raw OPF file.
+func declareCover(opf []byte, epub3 bool) ([]byte, error) {
+	raw := string(opf)
+	// empty manifests have nothing to add to otherwise
+	raw = strings.Replace(raw, "<manifest/>", "<manifest>\n  </manifest>", 1)
+	manifestEnd := strings.LastIndex(raw, "</manifest>")
+	metadataEnd := strings.LastIndex(raw, "</metadata>")
+	if manifestEnd == -1 || metadataEnd == -1 {
+		return opf, errors.New("Could not declare new cover in OPF")
+	}
+	item := `  <item id="` + newCoverID + `" href="` + newCoverFilename + `" media-type="image/jpeg"`
+	if epub3 {
+		item += ` properties="cover-image"`
+	}
+	item += "/>\n  "
+	meta := `  <meta name="cover" content="` + newCoverID + `"/>` + "\n  "
+	// inserting from the end so that indexes stay valid
+	if manifestEnd > metadataEnd {
+		raw = raw[:manifestEnd] + item + raw[manifestEnd:]
+		raw = raw[:metadataEnd] + meta + raw[metadataEnd:]
+	} else {
+		raw = raw[:metadataEnd] + meta + raw[metadataEnd:]
+		raw = raw[:manifestEnd] + item + raw[manifestEnd:]
+	}
+	return []byte(raw), nil
+}
+
+// readEpubFile returns the contents of a file inside an epub.
+func readEpubFile(epubPath, name string) ([]byte, error) {
+	r, err := zip.OpenReader(epubPath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return readZipFile(&r.Reader, name)
+}
+
+// encodeImage in the format given by its media type.
+func encodeImage(img image.Image, mediaType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch mediaType {
+	case "image/png":
+		err = png.Encode(&buf, img)
+	case "image/gif":
+		err = gif.Encode(&buf, img, nil)
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	return buf.Bytes(), err
+}
+
+// thumbnail of an image, with a maximum height.
+func thumbnail(img image.Image, height int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dy() <= height {
+		return img
+	}
+	width := bounds.Dx() * height / bounds.Dy()
+	if width == 0 {
+		width = 1
+	}
+	thumb := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			thumb.Set(x, y, img.At(bounds.Min.X+x*bounds.Dx()/width, bounds.Min.Y+y*bounds.Dy()/height))
+		}
+	}
+	return thumb
+}
+
+// CoverPath returns the path of the Book cover.
+func (b *Book) CoverPath() string {
+	if !b.Cover.IsSet() {
+		return ""
+	}
+	return filepath.Join(b.Config.CoverDir, b.Cover.Filename)
+}
+
+// ThumbnailPath returns the path of the Book cover thumbnail.
+func (b *Book) ThumbnailPath() string {
+	if !b.Cover.IsSet() {
+		return ""
+	}
+	return filepath.Join(b.Config.CoverDir, thumbnailDir, b.Cover.Filename)
+}
+
+// coverImage returns the decoded Book cover.
+func (b *Book) coverImage() (image.Image, error) {
+	data, err := ioutil.ReadFile(b.CoverPath())
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// SetCover from image data, saving it with its thumbnail in the cover directory.
+func (b *Book) SetCover(data []byte, source string) error {
+	if b.Config.CoverDir == "" {
+		return errors.New("Cover directory is not set")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return errors.New("Invalid cover image: " + err.Error())
+	}
+	if err := os.MkdirAll(filepath.Join(b.Config.CoverDir, thumbnailDir), 0777); err != nil {
+		return err
+	}
+	cover := Cover{Filename: strconv.Itoa(b.ID()) + coverExtension, Source: source}
+	coverData, err := encodeImage(img, "image/jpeg")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(b.Config.CoverDir, cover.Filename), coverData, 0777); err != nil {
+		return err
+	}
+	thumbData, err := encodeImage(thumbnail(img, thumbnailHeight), "image/jpeg")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(b.Config.CoverDir, thumbnailDir, cover.Filename), thumbData, 0777); err != nil {
+		return err
+	}
+	b.Cover = cover
+	return nil
+}
+
+// ExtractCover from the main epub and use it as the Book cover.
+func (b *Book) ExtractCover() error {
+	if !b.HasEpub() {
+		return errors.New("No epub for " + b.String())
+	}
+	data, err := b.MainEpub().ExtractCover()
+	if err != nil {
+		return err
+	}
+	return b.SetCover(data, coverFromEpub)
+}
+
+// SetCoverFrom a local image file or a URL.
+// Without location, the cover URL found by online metadata providers is used.
+func (b *Book) SetCoverFrom(location string) error {
+	if location == "" {
+		location = b.Metadata.ImageURL
+	}
+	if location == "" {
+		return errors.New("No cover location given, and no online cover known for " + b.String())
+	}
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		data, err := getRequest(location)
+		if err != nil {
+			return err
+		}
+		return b.SetCover(data, coverFromURL)
+	}
+	validPath, err := h.FileExists(location)
+	if err != nil {
+		return errors.New("Cover file does not exist: " + location)
+	}
+	data, err := ioutil.ReadFile(validPath)
+	if err != nil {
+		return err
+	}
+	return b.SetCover(data, coverFromFile)
+}
+
+// ShowCover returns a table with information about the Book cover.
+func (b *Book) ShowCover() string {
+	var rows [][]string
+	if !b.Cover.IsSet() {
+		rows = append(rows, []string{"Cover", "none"})
+	} else {
+		rows = append(rows, []string{"Cover", b.CoverPath()})
+		rows = append(rows, []string{"Thumbnail", b.ThumbnailPath()})
+		rows = append(rows, []string{"Source", b.Cover.Source})
+		if img, err := b.coverImage(); err == nil {
+			rows = append(rows, []string{"Dimensions", fmt.Sprintf("%dx%d", img.Bounds().Dx(), img.Bounds().Dy())})
+		} else {
+			rows = append(rows, []string{"Dimensions", couldNotRetrieveValue})
+		}
+	}
+	if b.Metadata.ImageURL != "" {
+		rows = append(rows, []string{"Online cover", b.Metadata.ImageURL})
+	}
+	return e.TabulateRows(rows, "Cover", b.String())
+}
+
+// ExportTo copies the main epub to destination.
+// If a cover was chosen from another source than the epub, it is embedded in the copy,
+// along with the subject codes of the book tags, if they are configured.
+func (b *Book) ExportTo(destination string) error {
+	b.ExportedHash = ""
+	var img image.Image
+	if b.Cover.IsSet() && b.Cover.Source != coverFromEpub {
+		var err error
+		if img, err = b.coverImage(); err != nil {
+			b.UI.Warning("Could not read cover for " + b.String() + ", exporting epub without it.")
+		}
+	}
+	subjects := b.Metadata.SubjectCodes(b.Config)
+	if img == nil && len(subjects) == 0 {
+		return h.CopyFile(b.FullPath(), destination)
+	}
+
+	opfPath, opf, err := readOPF(b.FullPath())
+	if err != nil {
+		return err
+	}
+	replacements := make(map[string][]byte)
+	if img != nil {
+		if opf, err = embedCover(opfPath, opf, img, replacements); err != nil {
+			return err
+		}
+	}
+	if len(subjects) != 0 {
+		if opf, err = addOPFSubjects(opf, subjects); err != nil {
+			return err
+		}
+	}
+	replacements[opfPath] = opf
+	if err := rewriteEpub(b.FullPath(), destination, replacements); err != nil {
+		return err
+	}
+	// the exported file is different from the epub in the library
+	b.ExportedHash, err = h.CalculateSHA256(destination)
+	return err
+}
@@ -0,0 +1,132 @@
+package book
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	en "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCoverOPF = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="2.0" unique-identifier="id">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:title>Title</dc:title>
+    <meta name="cover" content="item-cover"/>
+  </metadata>
+  <manifest>
+    <item id="item-cover" href="images/front.png" media-type="image/png"/>
+    <item id="text" href="text.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+</package>`
+
+func testImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	return img
+}
+
+func TestFindCoverItem(t *testing.T) {
+	fmt.Println("+ Testing findCoverItem()...")
+	assert := assert.New(t)
+	// EPUB2
+	doc, err := parseOPFDocument([]byte(testCoverOPF))
+	assert.Nil(err)
+	item, err := findCoverItem(doc)
+	assert.Nil(err)
+	assert.Equal("images/front.png", item.Href)
+	assert.Equal("OEBPS/images/front.png", itemPath("OEBPS/content.opf", item.Href))
+	// EPUB3
+	doc, err = parseOPFDocument([]byte(testOPF3))
+	assert.Nil(err)
+	_, err = findCoverItem(doc)
+	assert.NotNil(err, "No cover declared")
+	withCover, err := declareCover([]byte(testOPF3), true)
+	assert.Nil(err)
+	doc, err = parseOPFDocument(withCover)
+	assert.Nil(err)
+	item, err = findCoverItem(doc)
+	assert.Nil(err)
+	assert.Equal(newCoverFilename, item.Href)
+	assert.True(item.hasProperty("cover-image"))
+}
+
+func TestThumbnail(t *testing.T) {
+	fmt.Println("+ Testing thumbnail()...")
+	assert := assert.New(t)
+	thumb := thumbnail(testImage(300, 600), thumbnailHeight)
+	assert.Equal(100, thumb.Bounds().Dx())
+	assert.Equal(thumbnailHeight, thumb.Bounds().Dy())
+	// small images are kept as is
+	thumb = thumbnail(testImage(30, 60), thumbnailHeight)
+	assert.Equal(60, thumb.Bounds().Dy())
+}
+
+func TestBookCover(t *testing.T) {
+	fmt.Println("+ Testing Book.SetCover(), ExportTo()...")
+	assert := assert.New(t)
+	coverDir, err := ioutil.TempDir("", "endive-covers")
+	assert.Nil(err)
+	defer os.RemoveAll(coverDir)
+	c := standardTestConfig
+	c.CoverDir = coverDir
+
+	bk := NewBook(ui, 5, epubs[0].filename, c, isRetail)
+	assert.False(bk.Cover.IsSet())
+	// test epubs have no cover
+	assert.NotNil(bk.ExtractCover())
+
+	// setting a cover from a file
+	data, err := encodeImage(testImage(300, 600), "image/png")
+	assert.Nil(err)
+	coverFile := filepath.Join(coverDir, "front.png")
+	assert.Nil(ioutil.WriteFile(coverFile, data, 0777))
+	assert.Nil(bk.SetCoverFrom(coverFile))
+	assert.Equal(Cover{Filename: "5.jpg", Source: coverFromFile}, bk.Cover)
+	_, err = h.FileExists(bk.CoverPath())
+	assert.Nil(err)
+	_, err = h.FileExists(bk.ThumbnailPath())
+	assert.Nil(err)
+	assert.NotNil(bk.SetCoverFrom(filepath.Join(coverDir, "nope.png")))
+
+	// exporting with the cover embedded
+	dst := filepath.Join(coverDir, "export.epub")
+	assert.Nil(bk.ExportTo(dst))
+	assert.NotEqual("", bk.ExportedHash)
+	assert.NotEqual(epubs[0].expectedSha256, bk.ExportedHash)
+	assert.True(bk.HasHash(bk.ExportedHash))
+
+	// the exported epub now has a cover
+	exported := Epub{Filename: dst, UI: ui, Config: c}
+	extracted, err := exported.ExtractCover()
+	assert.Nil(err)
+	other := NewBook(ui, 6, epubs[0].filename, c, isRetail)
+	assert.Nil(other.SetCover(extracted, coverFromEpub))
+	img, err := other.coverImage()
+	assert.Nil(err)
+	assert.Equal(300, img.Bounds().Dx())
+	assert.Equal(600, img.Bounds().Dy())
+
+	// covers from the epub are not embedded again
+	assert.Nil(other.ExportTo(dst))
+	assert.Equal("", other.ExportedHash)
+
+	// subject codes are added to exported epubs
+	other.Config.TagSubjects = en.TagSubjects{"fiction/fantasy": en.SubjectCode{Authority: en.AuthorityBISAC, Code: "FIC009000"}}
+	other.Metadata.Tags = Tags{{Name: "fiction/fantasy/urban"}}
+	assert.Nil(other.ExportTo(dst))
+	assert.NotEqual("", other.ExportedHash)
+	_, opf, err := readOPF(dst)
+	assert.Nil(err)
+	assert.Contains(string(opf), "<dc:subject>FIC009000</dc:subject>")
+}
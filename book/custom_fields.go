@@ -0,0 +1,51 @@
+package book
+
+import (
+	"errors"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+)
+
+// CheckCustomFields do not use the name of a built-in field.
+func CheckCustomFields(cfg e.Config) error {
+	for _, name := range cfg.CustomFields.Names() {
+		if _, isIn := h.StringInSlice(name, allFields); isIn {
+			return errors.New("Custom field " + name + " has the name of a built-in field")
+		}
+	}
+	return nil
+}
+
+// getCustom returns the value of a custom field, if it is defined in the configuration file.
+func (b *Book) getCustom(field string) (string, bool) {
+	if _, ok := b.Config.CustomFields[field]; !ok {
+		return "", false
+	}
+	return b.Custom[field], true
+}
+
+// setCustom validates and sets the value of a custom field.
+// An empty value removes it.
+func (b *Book) setCustom(field e.CustomField, value string) error {
+	clean, err := field.Clean(value)
+	if err != nil {
+		return err
+	}
+	if clean == "" {
+		delete(b.Custom, field.Name)
+		return nil
+	}
+	if b.Custom == nil {
+		b.Custom = make(map[string]string)
+	}
+	b.Custom[field.Name] = clean
+	return nil
+}
+
+// sortByCustomField sorts books using a custom field.
+func sortByCustomField(books []Book, field e.CustomField) {
+	By(func(p1, p2 *Book) bool {
+		return field.Less(p1.Custom[field.Name], p2.Custom[field.Name])
+	}).Sort(books)
+}
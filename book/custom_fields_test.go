@@ -0,0 +1,52 @@
+package book
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	en "github.com/barsanuphe/endive/endive"
+)
+
+func TestBookCustomFields(t *testing.T) {
+	fmt.Println("+ Testing Book custom fields...")
+	assert := assert.New(t)
+	cfg := standardTestConfig
+	cfg.CustomFields = en.CustomFields{
+		"price":   {Name: "price", Type: en.FieldFloat},
+		"lent_to": {Name: "lent_to", Type: en.FieldString},
+	}
+	assert.True(CheckValidField("price", cfg))
+	assert.False(CheckValidField("price", standardTestConfig))
+	assert.True(CheckValidField(titleField, cfg))
+	assert.Nil(CheckCustomFields(cfg))
+	cfg.CustomFields["title"] = en.CustomField{Name: "title", Type: en.FieldString}
+	assert.NotNil(CheckCustomFields(cfg), "custom field shadows a built-in field")
+	delete(cfg.CustomFields, "title")
+
+	books := Books{}
+	for j, price := range []string{"12", "9.50", ""} {
+		b := NewBook(ui, j+1, epubs[0].filename, cfg, isRetail)
+		assert.Nil(b.Set("price", price))
+		books = append(books, *b)
+	}
+	value, err := books[1].Get("price")
+	assert.Nil(err)
+	assert.Equal("9.5", value)
+	assert.NotNil(books[0].Set("price", "cheap"))
+	assert.Equal("12", books[0].Custom["price"])
+	// unset
+	assert.Nil(books[0].Set("lent_to", "Alice"))
+	assert.Contains(books[0].ShowInfo(), "Alice")
+	assert.Contains(books[0].ShowInfo("lent_to"), "Lent To")
+	assert.Nil(books[0].Set("lent_to", ""))
+	_, isSet := books[0].Custom["lent_to"]
+	assert.False(isSet)
+	assert.False(strings.Contains(books[0].ShowInfo(), "Lent To"))
+
+	// sort
+	books.Sort("price")
+	assert.Equal([]int{3, 2, 1}, []int{books[0].ID(), books[1].ID(), books[2].ID()})
+}
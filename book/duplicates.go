@@ -0,0 +1,337 @@
+package book
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+const (
+	// above this score, two books are probably the same
+	duplicateThreshold = 0.9
+	// below these similarities, titles or authors are considered different.
+	// sequels often have close titles: "Children of Time", "Children of Ruin".
+	minTitleSimilarity  = 0.85
+	minAuthorSimilarity = 0.6
+	// number of minimum hashes in a content fingerprint
+	fingerprintSize = 32
+	// number of consecutive words hashed together
+	fingerprintShingle = 5
+	// bytes of text read from an epub to compute its fingerprint
+	fingerprintTextSize = 500000
+	// above this similarity, two epubs share the same text
+	minFingerprintSimilarity = 0.5
+)
+
+var (
+	// words ignored at the start of titles, in the languages endive knows
+	titleArticles = map[string]bool{
+		"the": true, "a": true, "an": true,
+		"le": true, "la": true, "les": true, "l": true, "un": true, "une": true,
+		"der": true, "die": true, "das": true, "ein": true, "eine": true,
+		"el": true, "los": true, "las": true, "il": true, "lo": true,
+	}
+	// "Left Hand of Darkness, The"
+	trailingArticleRegexp = regexp.MustCompile(`(?i)^(.+),\s*(the|a|an|le|la|les|l')$`)
+	// volume numbers, which tell apart books with otherwise similar titles
+	titleNumberRegexp = regexp.MustCompile(`^([0-9]+|[ivxlc]+)$`)
+	// "Excession (Culture, #5)"
+	titleSeriesRegexp = regexp.MustCompile(`\s*[(\[][^)\]]*#\s*[0-9.,\-]+[)\]]\s*$`)
+)
+
+// normalizeTitle for fuzzy comparisons: lowercase words, without series
+// information or leading article.
+func normalizeTitle(title string) string {
+	title = titleSeriesRegexp.ReplaceAllString(strings.TrimSpace(title), "")
+	if m := trailingArticleRegexp.FindStringSubmatch(title); m != nil {
+		title = m[1]
+	}
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > 1 && titleArticles[words[0]] {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// titleNumbers of a normalized title, such as volume numbers.
+func titleNumbers(title string) string {
+	numbers := []string{}
+	for _, word := range strings.Fields(title) {
+		if titleNumberRegexp.MatchString(word) {
+			numbers = append(numbers, word)
+		}
+	}
+	return strings.Join(numbers, " ")
+}
+
+// authorKey for fuzzy comparisons: the sorted words of the authors' names,
+// once their aliases are resolved.
+// "Banks, Iain" and "Iain M. Banks" have the same key.
+func authorKey(authors []string, cfg e.Config) string {
+	words := make(map[string]bool)
+	for _, author := range authors {
+		if author == "" || author == unknown {
+			continue
+		}
+		for _, word := range strings.Fields(e.NormalizeName(authorAlias(cfg, author))) {
+			words[word] = true
+		}
+	}
+	key := []string{}
+	for word := range words {
+		key = append(key, word)
+	}
+	sort.Strings(key)
+	return strings.Join(key, " ")
+}
+
+// Similarity with another Metadata, from 0 (different) to 1 (same book), with
+// the reasons why they are similar.
+// Identifiers are compared first, then normalized titles and authors, with
+// aliases resolved according to the configuration.
+// Different ISBNs rule out a match.
+func (i *Metadata) Similarity(o Metadata, cfg e.Config) (float64, []string) {
+	if i.HasSameIdentifier(o) {
+		return 1, []string{"same identifier"}
+	}
+	if i.HasDifferentISBN(o) {
+		return 0, nil
+	}
+	ti, to := normalizeTitle(i.BookTitle), normalizeTitle(o.BookTitle)
+	if ti == "" || to == "" {
+		return 0, nil
+	}
+	titleScore := e.Similarity(ti, to)
+	if titleScore < minTitleSimilarity || titleNumbers(ti) != titleNumbers(to) {
+		return 0, nil
+	}
+	// unknown authors cannot confirm a match, nor rule it out
+	authorScore := 0.5
+	ai, ao := authorKey(i.Authors, cfg), authorKey(o.Authors, cfg)
+	if ai != "" && ao != "" {
+		authorScore = e.Similarity(ai, ao)
+		if authorScore < minAuthorSimilarity {
+			return 0, nil
+		}
+	}
+	reasons := []string{similarityReason("title", titleScore), similarityReason("author", authorScore)}
+	return 0.6*titleScore + 0.4*authorScore, reasons
+}
+
+func similarityReason(field string, score float64) string {
+	switch {
+	case score == 1:
+		return "same " + field
+	case score == 0.5:
+		return "unknown " + field
+	default:
+		return fmt.Sprintf("similar %s (%d%%)", field, int(score*100))
+	}
+}
+
+// textFingerprint of a text: the minimum hashes of its overlapping sequences
+// of words, for several hash functions.
+// Two texts share the same proportion of minimum hashes as of sequences.
+func textFingerprint(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) < fingerprintShingle {
+		return ""
+	}
+	mins := make([]uint64, fingerprintSize)
+	for k := range mins {
+		mins[k] = math.MaxUint64
+	}
+	for j := 0; j+fingerprintShingle <= len(words); j++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[j:j+fingerprintShingle], " ")))
+		base := h.Sum64()
+		for k := range mins {
+			if v := mixHash(base ^ (uint64(k+1) * 0x9E3779B97F4A7C15)); v < mins[k] {
+				mins[k] = v
+			}
+		}
+	}
+	fingerprint := ""
+	for _, v := range mins {
+		fingerprint += fmt.Sprintf("%08x", uint32(v>>32))
+	}
+	return fingerprint
+}
+
+// mixHash spreads the bits of a hash, to derive independent hash functions.
+func mixHash(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// fingerprintSimilarity between two text fingerprints, from 0 to 1.
+func fingerprintSimilarity(a, b string) float64 {
+	if a == "" || len(a) != len(b) {
+		return 0
+	}
+	same, total := 0, 0
+	for j := 0; j+8 <= len(a); j += 8 {
+		if a[j:j+8] == b[j:j+8] {
+			same++
+		}
+		total++
+	}
+	return float64(same) / float64(total)
+}
+
+// UpdateFingerprints of the epubs of a Book, if they are not known.
+func (b *Book) UpdateFingerprints() (hasChanged bool, err error) {
+	for _, ep := range b.Epubs() {
+		changed, err := ep.UpdateFingerprint()
+		if err != nil {
+			return hasChanged, err
+		}
+		hasChanged = hasChanged || changed
+	}
+	return
+}
+
+// fingerprint of the text of the main epub of a Book.
+func (b *Book) fingerprint() string {
+	if ed := b.PreferredEdition(); ed != nil {
+		return ed.Epub.Fingerprint
+	}
+	return ""
+}
+
+// Duplicate is a pair of Books that are probably the same.
+type Duplicate struct {
+	Books   [2]*Book
+	Score   float64
+	Reasons []string
+}
+
+// similarity of the Metadata of a Book with other Metadata, also comparing
+// the ISBNs of all its editions.
+func (b *Book) similarity(o Metadata) (float64, []string) {
+	if o.ISBN != "" && b.hasEditionISBN(o.ISBN) {
+		return 1, []string{"same identifier"}
+	}
+	return b.Metadata.Similarity(o, b.Config)
+}
+
+// duplicateScore of two Books, using their metadata and the text of their epubs.
+func (b *Book) duplicateScore(o *Book) (float64, []string) {
+	score, reasons := b.similarity(o.Metadata)
+	if score != 1 {
+		for _, ed := range b.Editions {
+			if ed.ISBN != "" && o.hasEditionISBN(ed.ISBN) {
+				score, reasons = 1, []string{"same identifier"}
+				break
+			}
+		}
+	}
+	if s := fingerprintSimilarity(b.fingerprint(), o.fingerprint()); s >= minFingerprintSimilarity {
+		reasons = append(reasons, fmt.Sprintf("same text (%d%%)", int(s*100)))
+		score = math.Max(score, s)
+	}
+	return score, reasons
+}
+
+// duplicateKeys of a Book: only Books sharing one of them can be duplicates.
+// Metadata need a similar author, so at least one author word is shared.
+// Epubs with the same text share at least one part of their fingerprints.
+func (b *Book) duplicateKeys() []string {
+	keys := []string{}
+	for _, word := range strings.Fields(authorKey(b.Metadata.Authors, b.Config)) {
+		// initials and particles are too common to be useful
+		if len([]rune(word)) > 2 {
+			keys = append(keys, "author:"+word)
+		}
+	}
+	for _, ed := range b.Editions {
+		if ed.ISBN != "" {
+			keys = append(keys, "isbn:"+ed.ISBN)
+		}
+	}
+	if b.Metadata.ISBN != "" {
+		keys = append(keys, "isbn:"+b.Metadata.ISBN)
+	}
+	for kind, value := range b.Metadata.Identifiers {
+		if value != "" {
+			keys = append(keys, kind+":"+value)
+		}
+	}
+	fingerprint := b.fingerprint()
+	for j := 0; j+8 <= len(fingerprint); j += 8 {
+		keys = append(keys, fmt.Sprintf("text%d:%s", j, fingerprint[j:j+8]))
+	}
+	return keys
+}
+
+// FindDuplicates in a collection, the most probable first.
+// Only Books sharing a duplicate key are compared.
+func FindDuplicates(collection e.Collection) []Duplicate {
+	books := []*Book{}
+	buckets := make(map[string][]int)
+	for _, book := range collection.Books() {
+		b := book.(*Book)
+		for _, key := range b.duplicateKeys() {
+			buckets[key] = append(buckets[key], len(books))
+		}
+		books = append(books, b)
+	}
+	compared := make(map[[2]int]bool)
+	duplicates := []Duplicate{}
+	for _, bucket := range buckets {
+		for j, first := range bucket {
+			for _, second := range bucket[j+1:] {
+				pair := [2]int{first, second}
+				if first == second || compared[pair] {
+					continue
+				}
+				compared[pair] = true
+				b, o := books[first], books[second]
+				if score, reasons := b.duplicateScore(o); score >= duplicateThreshold {
+					duplicates = append(duplicates, Duplicate{Books: [2]*Book{b, o}, Score: score, Reasons: reasons})
+				}
+			}
+		}
+	}
+	// buckets are not ordered: sorting by score, then by ID
+	sort.Slice(duplicates, func(j, k int) bool {
+		if duplicates[j].Score != duplicates[k].Score {
+			return duplicates[j].Score > duplicates[k].Score
+		}
+		if duplicates[j].Books[0].ID() != duplicates[k].Books[0].ID() {
+			return duplicates[j].Books[0].ID() < duplicates[k].Books[0].ID()
+		}
+		return duplicates[j].Books[1].ID() < duplicates[k].Books[1].ID()
+	})
+	return duplicates
+}
+
+// FindSimilar Book in a collection, the most similar to the given Metadata, with
+// the reasons why they are similar, or nil if no Book is similar enough.
+// Matches on identifiers are certain, fuzzy matches must be confirmed.
+func FindSimilar(collection e.Collection, o Metadata) (*Book, []string) {
+	var best *Book
+	var bestScore float64
+	var bestReasons []string
+	for _, book := range collection.Books() {
+		b := book.(*Book)
+		if score, reasons := b.similarity(o); score >= duplicateThreshold && score > bestScore {
+			best, bestScore, bestReasons = b, score, reasons
+		}
+	}
+	return best, bestReasons
+}
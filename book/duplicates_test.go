@@ -0,0 +1,151 @@
+package book
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicatesMatcher(t *testing.T) {
+	fmt.Println("+ Testing Metadata.Similarity()...")
+	assert := assert.New(t)
+
+	assert.Equal("left hand of darkness", normalizeTitle("The Left Hand of Darkness"))
+	assert.Equal("left hand of darkness", normalizeTitle("Left Hand of Darkness, The"))
+	assert.Equal("excession", normalizeTitle("Excession (Culture, #5)"))
+	assert.Equal("étranger", normalizeTitle("L'Étranger"))
+	assert.Equal("it", normalizeTitle("It"))
+
+	cfg := e.Config{AuthorAliases: map[string][]string{"Iain M. Banks": {"Iain Banks"}}}
+	assert.Equal("banks iain", authorKey([]string{"Banks, Iain"}, cfg))
+	assert.Equal("banks iain", authorKey([]string{"Iain Banks"}, cfg))
+	assert.Equal("", authorKey([]string{unknown}, cfg))
+
+	book := Metadata{BookTitle: "The Left Hand of Darkness", Authors: []string{"Ursula K. Le Guin"}}
+	candidates := []struct {
+		metadata  Metadata
+		duplicate bool
+	}{
+		{Metadata{BookTitle: "Left Hand of Darkness, The", Authors: []string{"Le Guin, Ursula"}}, true},
+		{Metadata{BookTitle: "The Left Hand of Darknes", Authors: []string{"Ursula K. Le Guinn"}}, true},
+		{Metadata{BookTitle: "The Left Hand of Darkness", Authors: []string{"Iain M. Banks"}}, false},
+		{Metadata{BookTitle: "The Dispossessed", Authors: []string{"Ursula K. Le Guin"}}, false},
+		{Metadata{BookTitle: "The Left Hand of Darkness"}, false},
+		{Metadata{BookTitle: "Other", ISBN: "9780441478125"}, false},
+	}
+	for _, c := range candidates {
+		score, _ := book.Similarity(c.metadata, cfg)
+		assert.Equal(c.duplicate, score >= duplicateThreshold, c.metadata.BookTitle)
+	}
+	book.ISBN = "9780441478125"
+	score, reasons := book.Similarity(candidates[5].metadata, cfg)
+	assert.Equal(1.0, score)
+	assert.Equal([]string{"same identifier"}, reasons)
+
+	// volumes of the same book
+	tome1 := Metadata{BookTitle: "Le comte de Monte-Cristo, Tome I", Authors: []string{"Alexandre Dumas"}}
+	score, _ = tome1.Similarity(Metadata{BookTitle: "Le comte de Monte-Cristo, Tome II", Authors: []string{"Alexandre Dumas"}}, cfg)
+	assert.Equal(0.0, score)
+
+	// sequels with close titles
+	sequels := [][2]Metadata{
+		{{BookTitle: "Children of Time", Authors: []string{"Adrian Tchaikovsky"}}, {BookTitle: "Children of Ruin", Authors: []string{"Adrian Tchaikovsky"}}},
+		{{BookTitle: "Dragonsong", Authors: []string{"Anne McCaffrey"}}, {BookTitle: "Dragonsinger", Authors: []string{"Anne McCaffrey"}}},
+	}
+	for _, s := range sequels {
+		score, _ = s[0].Similarity(s[1], cfg)
+		assert.True(score < duplicateThreshold, s[1].BookTitle)
+	}
+
+	// different ISBNs rule out a match
+	edition := Metadata{BookTitle: "The Left Hand of Darkness", Authors: []string{"Ursula K. Le Guin"}, ISBN: "9780441007318"}
+	score, _ = book.Similarity(edition, cfg)
+	assert.Equal(0.0, score)
+	edition.ISBN = ""
+	score, _ = book.Similarity(edition, cfg)
+	assert.Equal(1.0, score)
+}
+
+func TestDuplicatesFingerprint(t *testing.T) {
+	fmt.Println("+ Testing textFingerprint()...")
+	assert := assert.New(t)
+
+	text := strings.Repeat("It was a bright cold day in April, and the clocks were striking thirteen. ", 3)
+	text += "Winston Smith, his chin nuzzled into his breast in an effort to escape the vile wind, slipped quickly through the glass doors of Victory Mansions."
+	fingerprint := textFingerprint(text)
+	assert.Equal(fingerprintSize*8, len(fingerprint))
+	assert.Equal(fingerprint, textFingerprint(strings.ToUpper(text)))
+	assert.True(fingerprintSimilarity(fingerprint, textFingerprint(text+" The end.")) >= minFingerprintSimilarity)
+	assert.True(fingerprintSimilarity(fingerprint, textFingerprint("Call me Ishmael. Some years ago, never mind how long precisely, having little money in my purse.")) < minFingerprintSimilarity)
+	assert.Equal("", textFingerprint("too short"))
+	assert.Equal(0.0, fingerprintSimilarity("", ""))
+
+	// from epubs
+	ep := Epub{Filename: epubs[0].filename, Config: standardTestConfig, UI: ui}
+	changed, err := ep.UpdateFingerprint()
+	assert.Nil(err)
+	assert.True(changed)
+	other := Epub{Filename: epubs[1].filename, Config: standardTestConfig, UI: ui}
+	_, err = other.UpdateFingerprint()
+	assert.Nil(err)
+	assert.True(fingerprintSimilarity(ep.Fingerprint, other.Fingerprint) < minFingerprintSimilarity)
+	changed, err = ep.UpdateFingerprint()
+	assert.Nil(err)
+	assert.False(changed)
+}
+
+func TestDuplicatesFind(t *testing.T) {
+	fmt.Println("+ Testing FindDuplicates(), MergeOrder()...")
+	assert := assert.New(t)
+
+	collection := &Books{}
+	titles := []string{"Excession", "Use of Weapons", "Excession (Culture, #5)", "Use of weapons"}
+	for j, title := range titles {
+		b := NewBookWithMetadata(ui, j+1, epubs[0].filename, standardTestConfig, j < 2, Metadata{BookTitle: title, Authors: []string{"Iain M. Banks"}})
+		collection.Add(b)
+	}
+	duplicates := FindDuplicates(collection)
+	if assert.Equal(2, len(duplicates)) {
+		for _, d := range duplicates {
+			assert.Equal(d.Books[0].ID()+2, d.Books[1].ID())
+			assert.Equal(1.0, d.Score)
+		}
+	}
+
+	// fuzzy matches
+	similar, reasons := FindSimilar(collection, Metadata{BookTitle: "Excesion", Authors: []string{"Iain M. Banks"}})
+	if assert.NotNil(similar) {
+		assert.Equal(1, similar.ID())
+		assert.Equal([]string{"similar title (88%)", "same author"}, reasons)
+	}
+	similar, _ = FindSimilar(collection, Metadata{BookTitle: "Inversions", Authors: []string{"Iain M. Banks"}})
+	assert.Nil(similar)
+
+	// only books sharing an author, identifier or text are compared
+	assert.Equal([]string{"author:banks", "author:iain"}, collection.findUnique(func(b *Book) bool { return b.ID() == 1 }).duplicateKeys())
+
+	// retail trumps, then the oldest book
+	retail, _ := collection.FindByID(1)
+	nonRetail, _ := collection.FindByID(3)
+	other, _ := collection.FindByID(4)
+	kept, merged := MergeOrder(nonRetail.(*Book), retail.(*Book))
+	assert.Equal(1, kept.ID())
+	assert.Equal(3, merged.ID())
+	kept, merged = MergeOrder(other.(*Book), nonRetail.(*Book))
+	assert.Equal(3, kept.ID())
+	assert.Equal(4, merged.ID())
+	assert.NotNil(retail.(*Book).Merge(retail.(*Book)))
+
+	// user data
+	a := &Book{Progress: e.ProgressUnread, Rating: "4"}
+	o := &Book{Progress: e.ProgressRead, ReadDate: "2016-01-02", Rating: "2", Review: "Good.", Custom: map[string]string{"source": "gift"}}
+	a.mergeUserData(o)
+	assert.Equal(e.ProgressRead, a.Progress)
+	assert.Equal("2016-01-02", a.ReadDate)
+	assert.Equal("4", a.Rating)
+	assert.Equal("Good.", a.Review)
+	assert.Equal("gift", a.Custom["source"])
+}
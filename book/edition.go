@@ -0,0 +1,279 @@
+package book
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// Edition of a Book: an epub, and what distinguishes it from the other
+// editions of the same book (translations, reprints...).
+type Edition struct {
+	Epub        Epub   `json:"epub"`
+	Retail      bool   `json:"retail"`
+	ISBN        string `json:"isbn,omitempty"`
+	Language    string `json:"language,omitempty"`
+	Publisher   string `json:"publisher,omitempty"`
+	EditionYear string `json:"edition_year,omitempty"`
+	// date at which the epub was imported, if known
+	Imported string `json:"imported,omitempty"`
+}
+
+// newEdition for an epub, described by its Metadata.
+func newEdition(ep Epub, isRetail bool, info Metadata) Edition {
+	return Edition{Epub: ep, Retail: isRetail, ISBN: info.ISBN, Language: info.Language, Publisher: info.Publisher, EditionYear: info.EditionYear}
+}
+
+// metadata of an Edition: the Metadata of its Book, with what distinguishes
+// the Edition.
+func (ed Edition) metadata(info Metadata) Metadata {
+	m := info.copy()
+	for _, f := range []struct {
+		field *string
+		value string
+	}{
+		{&m.ISBN, ed.ISBN},
+		{&m.Language, ed.Language},
+		{&m.Publisher, ed.Publisher},
+		{&m.EditionYear, ed.EditionYear},
+	} {
+		if f.value != "" {
+			*f.field = f.value
+		}
+	}
+	return m
+}
+
+// sameAs checks if two Editions describe the same publication: same ISBN if
+// both are known, or else same language, if known.
+func (ed Edition) sameAs(o Edition) bool {
+	if ed.ISBN != "" && o.ISBN != "" {
+		return ed.ISBN == o.ISBN
+	}
+	return ed.Language == "" || o.Language == "" || ed.Language == o.Language
+}
+
+// inherit what is known about the Edition it replaces, if unknown.
+func (ed *Edition) inherit(known Edition) {
+	for _, v := range []struct{ value, known *string }{
+		{&ed.ISBN, &known.ISBN}, {&ed.Language, &known.Language}, {&ed.Publisher, &known.Publisher}, {&ed.EditionYear, &known.EditionYear},
+	} {
+		if *v.value == "" {
+			*v.value = *v.known
+		}
+	}
+}
+
+// String describes an Edition.
+func (ed Edition) String() string {
+	description := []string{"non-retail"}
+	if ed.Retail {
+		description[0] = "retail"
+	}
+	for _, value := range []string{ed.Language, ed.ISBN, ed.Publisher, ed.EditionYear} {
+		if value != "" {
+			description = append(description, value)
+		}
+	}
+	return strings.Join(description, ", ")
+}
+
+// label distinguishing the filename of an Edition from the preferred one.
+func (ed Edition) label(n int) string {
+	label := []string{}
+	for _, value := range []string{ed.Language, ed.EditionYear, ed.ISBN} {
+		if value != "" {
+			label = append(label, value)
+		}
+	}
+	if len(label) == 0 {
+		return "edition " + strconv.Itoa(n)
+	}
+	return strings.Join(label, " ")
+}
+
+// prefers checks if an Edition is preferred to another, according to the rule.
+// Ties are left to the order of the editions.
+func (ed Edition) prefers(o Edition, rule e.EditionRule) bool {
+	for _, criterion := range rule {
+		switch criterion.Name {
+		case e.EditionRetail:
+			if ed.Retail != o.Retail {
+				return ed.Retail
+			}
+		case e.EditionLanguage:
+			edRank, oRank := languageRank(ed.Language, criterion.Languages), languageRank(o.Language, criterion.Languages)
+			if edRank != oRank {
+				return edRank < oRank
+			}
+		case e.EditionNewest, e.EditionOldest:
+			// editions with an unknown year come last
+			edYear, edErr := strconv.Atoi(ed.EditionYear)
+			oYear, oErr := strconv.Atoi(o.EditionYear)
+			switch {
+			case edErr != nil && oErr != nil, edErr == nil && oErr == nil && edYear == oYear:
+			case edErr != nil || oErr != nil:
+				return edErr == nil
+			case criterion.Name == e.EditionNewest:
+				return edYear > oYear
+			default:
+				return edYear < oYear
+			}
+		}
+	}
+	return false
+}
+
+// languageRank in a list of preferred languages, other languages come last.
+func languageRank(language string, languages []string) int {
+	for rank, l := range languages {
+		if l == language {
+			return rank
+		}
+	}
+	return len(languages)
+}
+
+// preferredEdition index of a Book, among the editions satisfying a condition,
+// or -1 if none does.
+func (b *Book) preferredEdition(f func(Edition) bool) int {
+	preferred := -1
+	for k, ed := range b.Editions {
+		if f(ed) && (preferred == -1 || ed.prefers(b.Editions[preferred], b.Config.EditionRule())) {
+			preferred = k
+		}
+	}
+	return preferred
+}
+
+// PreferredEdition of a Book, used for its filename and exports, or nil if it has no epub.
+func (b *Book) PreferredEdition() *Edition {
+	if k := b.preferredEdition(func(Edition) bool { return true }); k != -1 {
+		return &b.Editions[k]
+	}
+	return nil
+}
+
+// EditionIndex of the preferred retail or non-retail edition of a Book, or -1 if there is none.
+func (b *Book) EditionIndex(isRetail bool) int {
+	return b.preferredEdition(func(ed Edition) bool { return ed.Retail == isRetail })
+}
+
+// edition of a Book, the preferred one among its retail or non-retail editions,
+// or nil if there is none.
+func (b *Book) edition(isRetail bool) *Edition {
+	if k := b.EditionIndex(isRetail); k != -1 {
+		return &b.Editions[k]
+	}
+	return nil
+}
+
+// findEdition index of a Book describing the same publication as an Edition,
+// or -1 if it is a new edition.
+func (b *Book) findEdition(o Edition) int {
+	for k, ed := range b.Editions {
+		if ed.sameAs(o) {
+			return k
+		}
+	}
+	return -1
+}
+
+// hasEditionISBN checks if an edition of the Book has the given ISBN.
+func (b *Book) hasEditionISBN(isbn string) bool {
+	for _, ed := range b.Editions {
+		if ed.ISBN == isbn {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEdition checks if the Book has an epub of the same publication as an Edition.
+func (b *Book) HasEdition(ed Edition) bool {
+	return b.findEdition(ed) != -1
+}
+
+// IsNewEdition checks if an epub with the given Metadata would be a new edition of the Book.
+func (b *Book) IsNewEdition(info Metadata) bool {
+	return b.HasEpub() && !b.HasEdition(newEdition(Epub{}, false, info))
+}
+
+// ImportDate of a Book: when its first known epub was imported, or an empty
+// string if unknown.
+func (b *Book) ImportDate() string {
+	imported := ""
+	for _, ed := range b.Editions {
+		if ed.Imported != "" && (imported == "" || ed.Imported < imported) {
+			imported = ed.Imported
+		}
+	}
+	return imported
+}
+
+// migrateImportDates of epubs imported before import dates were saved: they
+// are assumed to have been imported when their file was last modified.
+func (b *Book) migrateImportDates() (hasChanged bool) {
+	for k := range b.Editions {
+		ed := &b.Editions[k]
+		if ed.Imported != "" {
+			continue
+		}
+		if info, err := os.Stat(ed.Epub.FullPath()); err == nil {
+			ed.Imported = info.ModTime().Local().Format(dateFormat)
+			hasChanged = true
+		}
+	}
+	return
+}
+
+// Epubs of all editions of a Book.
+func (b *Book) Epubs() []*Epub {
+	epubs := []*Epub{}
+	for k := range b.Editions {
+		epubs = append(epubs, &b.Editions[k].Epub)
+	}
+	return epubs
+}
+
+// removeEdition from a Book, moving its epub to the trash.
+func (b *Book) removeEdition(k int) error {
+	if k < 0 || k >= len(b.Editions) {
+		return errors.New("Edition not found")
+	}
+	return b.trash(TrashReasonSuperseded, k)
+}
+
+// UnmarshalJSON migrates books saved before editions were introduced, with
+// at most one retail and one non-retail epub, and before the reading log,
+// with only the date of the first reading.
+func (b *Book) UnmarshalJSON(data []byte) error {
+	type book Book
+	legacy := struct {
+		*book
+		RetailEpub    *Epub `json:"retail"`
+		NonRetailEpub *Epub `json:"nonretail"`
+	}{book: (*book)(b)}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	if len(b.Readings) == 0 && b.ReadDate != "" {
+		b.Readings = ReadingLog{{Finished: b.ReadDate}}
+	}
+	if len(b.Editions) != 0 {
+		return nil
+	}
+	for _, ed := range []struct {
+		epub     *Epub
+		isRetail bool
+	}{{legacy.RetailEpub, true}, {legacy.NonRetailEpub, false}} {
+		if ed.epub != nil && ed.epub.Filename != "" {
+			b.Editions = append(b.Editions, newEdition(*ed.epub, ed.isRetail, b.Metadata))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package book
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookEditionsMigration(t *testing.T) {
+	fmt.Println("+ Testing Book.UnmarshalJSON()...")
+	assert := assert.New(t)
+
+	legacy := `{"id": 4, "retail": {"filename": "retail.epub", "hash": "r", "replace": "false"}, "nonretail": {"filename": "nonretail.epub", "hash": "nr", "replace": "true"}, "metadata": {"title": "Dune", "isbn": "9780441172719", "language": "en", "publisher": "Ace", "edition_year": "1990"}}`
+	var bk Book
+	assert.Nil(json.Unmarshal([]byte(legacy), &bk))
+	assert.Equal(4, bk.ID())
+	if assert.Equal(2, len(bk.Editions)) {
+		assert.True(bk.Editions[0].Retail)
+		assert.Equal("r", bk.Editions[0].Epub.Hash)
+		assert.Equal("9780441172719", bk.Editions[0].ISBN)
+		assert.Equal("en", bk.Editions[0].Language)
+		assert.Equal("Ace", bk.Editions[0].Publisher)
+		assert.Equal("1990", bk.Editions[0].EditionYear)
+		assert.False(bk.Editions[1].Retail)
+		assert.Equal(e.True, bk.Editions[1].Epub.NeedsReplacement)
+	}
+
+	// saved with editions
+	data, err := json.Marshal(&bk)
+	assert.Nil(err)
+	assert.NotContains(string(data), `"nonretail":`)
+	var saved Book
+	assert.Nil(json.Unmarshal(data, &saved))
+	assert.Equal(bk.Editions, saved.Editions)
+}
+
+func TestBookPreferredEdition(t *testing.T) {
+	fmt.Println("+ Testing Book.PreferredEdition()...")
+	assert := assert.New(t)
+
+	c := standardTestConfig
+	bk := NewBookWithMetadata(ui, 1, "original.epub", c, !isRetail, Metadata{BookTitle: "Dune", Authors: []string{"Frank Herbert"}, OriginalYear: "1965", Language: "en", EditionYear: "1990"})
+	assert.Equal("original.epub", bk.PreferredEdition().Epub.Filename)
+	bk.Editions = append(bk.Editions,
+		Edition{Epub: Epub{Filename: "translation.epub"}, Retail: true, Language: "fr", ISBN: "9782266233200", EditionYear: "2012"},
+		Edition{Epub: Epub{Filename: "reprint.epub"}, Language: "en", ISBN: "9780340960196", EditionYear: "2005"},
+	)
+	assert.True(bk.HasRetail())
+	assert.True(bk.HasNonRetail())
+
+	// retail first, by default
+	assert.Equal("translation.epub", bk.MainEpub().Filename)
+	assert.Equal(1, bk.EditionIndex(isRetail))
+	assert.Equal(0, bk.EditionIndex(!isRetail))
+	// language first, then the most recent edition
+	bk.Config.PreferredEdition = e.EditionRule{{Name: e.EditionLanguage, Languages: []string{"en"}}, {Name: e.EditionNewest}}
+	assert.Equal("reprint.epub", bk.MainEpub().Filename)
+	assert.Equal(2, bk.EditionIndex(!isRetail))
+	bk.Config.PreferredEdition = e.EditionRule{{Name: e.EditionOldest}}
+	assert.Equal("original.epub", bk.MainEpub().Filename)
+
+	// filenames
+	name, err := bk.generateNewName("$a $y $t [$l]", 0)
+	assert.Nil(err)
+	assert.Equal("Frank Herbert 1965 Dune [en]", name)
+	name, err = bk.generateNewName("$a $y $t [$l]", 1)
+	assert.Nil(err)
+	assert.Equal("Frank Herbert 1965 Dune [fr] [retail] [fr 2012 9782266233200]", name)
+	_, err = bk.generateNewName("$a $y $t", 3)
+	assert.NotNil(err)
+
+	// editions
+	assert.False(bk.IsNewEdition(Metadata{ISBN: "9780340960196"}))
+	assert.False(bk.IsNewEdition(Metadata{Language: "fr"}))
+	assert.True(bk.IsNewEdition(Metadata{Language: "de"}))
+	assert.True(bk.IsNewEdition(Metadata{ISBN: "9788420664606", Language: "fr"}))
+	books := Books{*bk}
+	found, err := books.FindByMetadata("9782266233200", "", "")
+	assert.Nil(err)
+	assert.Equal(1, found.ID())
+
+	// metadata embedded in the epub of an edition
+	info := bk.Editions[2].metadata(bk.Metadata)
+	assert.Equal("9780340960196", info.ISBN)
+	assert.Equal("2005", info.EditionYear)
+	assert.Equal("Dune", info.BookTitle)
+	assert.Equal("1990", bk.Metadata.EditionYear)
+	info = bk.Editions[0].metadata(bk.Metadata)
+	assert.Equal("1990", info.EditionYear)
+}
+
+func TestBookAddEdition(t *testing.T) {
+	fmt.Println("+ Testing Book.AddEdition()...")
+	assert := assert.New(t)
+	libraryDir, err := ioutil.TempDir("", "endive-library")
+	assert.Nil(err)
+	defer os.RemoveAll(libraryDir)
+	c := standardTestConfig
+	c.LibraryRoot = libraryDir
+	c.TrashDir = filepath.Join(libraryDir, "trash")
+	c.EpubFilenameFormat = "$a $t"
+	source := filepath.Join(parentDir, epubs[0].filename)
+
+	assert.Nil(h.CopyFile(source, filepath.Join(libraryDir, "original.epub")))
+	bk := NewBookWithMetadata(ui, 1, "original.epub", c, isRetail, Metadata{BookTitle: "Dune", Authors: []string{"Frank Herbert"}, Language: "en"})
+
+	// new edition
+	imported, err := bk.AddEdition(source, !isRetail, "fr", Metadata{Language: "fr", ISBN: "9782266233200"})
+	assert.Nil(err)
+	assert.True(imported)
+	assert.Equal(2, len(bk.Editions))
+	// same edition, already retail
+	imported, err = bk.AddEdition(source, !isRetail, "en", Metadata{Language: "en"})
+	assert.Nil(err)
+	assert.False(imported)
+	// retail version trumps the non-retail one
+	imported, err = bk.AddEdition(source, isRetail, "fr-retail", Metadata{Language: "fr"})
+	assert.Nil(err)
+	assert.True(imported)
+	assert.False(bk.HasNonRetail())
+	if assert.Equal(2, len(bk.Editions)) {
+		assert.Equal("9782266233200", bk.Editions[1].ISBN)
+		assert.Equal("fr-retail", bk.Editions[1].Epub.Hash)
+		for _, ep := range bk.Epubs() {
+			_, err = h.FileExists(ep.FullPath())
+			assert.Nil(err, ep.FullPath())
+		}
+		assert.NotEqual(bk.Editions[0].Epub.Filename, bk.Editions[1].Epub.Filename)
+	}
+	entries, err := ListTrash(c, ui)
+	assert.Nil(err)
+	if assert.Equal(1, len(entries)) {
+		assert.Equal([]string{"fr"}, entries[0].Hashes())
+	}
+}
@@ -1,14 +1,15 @@
 package book
 
 import (
+	"archive/zip"
 	"errors"
+	"os"
 	"path/filepath"
 
-	"github.com/barsanuphe/epubgo"
-
 	en "github.com/barsanuphe/endive/endive"
 	h "github.com/barsanuphe/helpers"
 	i "github.com/barsanuphe/helpers/ui"
+	"github.com/kennygrant/sanitize"
 )
 
 // Epub can manipulate an epub file.
@@ -16,9 +17,11 @@ type Epub struct {
 	Config en.Config       `json:"-"`
 	UI     i.UserInterface `json:"-"`
 
-	Filename         string `json:"filename"` // relative to LibraryRoot
-	Hash             string `json:"hash"`
-	NeedsReplacement string `json:"replace"`
+	Filename          string `json:"filename"` // relative to LibraryRoot
+	Hash              string `json:"hash"`
+	NeedsReplacement  string `json:"replace"`
+	ReplacementReason string `json:"replace_reason,omitempty"`
+	Fingerprint       string `json:"fingerprint,omitempty"`
 }
 
 // FullPath returns the absolute file path.
@@ -46,7 +49,16 @@ func (e *Epub) FlagForReplacement(flag bool) {
 		e.NeedsReplacement = en.True
 	} else {
 		e.NeedsReplacement = en.False
+		e.ReplacementReason = ""
+	}
+}
+
+// replacementInfo describes why an epub needs replacement.
+func (e *Epub) replacementInfo() string {
+	if e.ReplacementReason != "" {
+		return en.True + " (" + e.ReplacementReason + ")"
 	}
+	return en.True
 }
 
 // Check the retail epub integrity.
@@ -63,139 +75,171 @@ func (e *Epub) Check() (hasChanged bool, err error) {
 	return
 }
 
+// readText of the documents of the epub, in reading order, up to size bytes.
+// If fromMiddle, reading starts from the middle of the book.
+func (e *Epub) readText(fromMiddle bool, size int) (string, error) {
+	r, err := zip.OpenReader(e.FullPath())
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	opfPath, err := findOPFPath(&r.Reader)
+	if err != nil {
+		return "", err
+	}
+	opf, err := readZipFile(&r.Reader, opfPath)
+	if err != nil {
+		return "", err
+	}
+	doc, err := parseOPFDocument(opf)
+	if err != nil {
+		return "", err
+	}
+	documents := spineDocuments(doc)
+	start := 0
+	if fromMiddle {
+		start = len(documents) / 2
+	}
+	var text string
+	for j := range documents {
+		href := documents[(start+j)%len(documents)]
+		data, err := readZipFile(&r.Reader, itemPath(opfPath, href))
+		if err != nil {
+			e.UI.Debug(err.Error())
+			continue
+		}
+		text += " " + sanitize.HTML(string(data))
+		if len(text) >= size {
+			break
+		}
+	}
+	return text, nil
+}
+
+// UpdateFingerprint of the text of the epub, if it is not known.
+func (e *Epub) UpdateFingerprint() (bool, error) {
+	if e.Filename == "" || e.Fingerprint != "" {
+		return false, nil
+	}
+	text, err := e.readText(false, fingerprintTextSize)
+	if err != nil {
+		return false, err
+	}
+	e.Fingerprint = textFingerprint(text)
+	return e.Fingerprint != "", nil
+}
+
 // ReadMetadata from epub file
 func (e *Epub) ReadMetadata() (info Metadata, err error) {
 	e.UI.Debugf("Reading metadata from %s\n", e.FullPath())
-	book, err := epubgo.Open(e.FullPath())
+	_, opf, err := readOPF(e.FullPath())
 	if err != nil {
 		err = errors.New("Error parsing EPUB")
 		return
 	}
-	defer book.Close()
+	opfInfo, err := parseOPFMetadata(opf)
+	if err != nil {
+		err = errors.New("Error parsing EPUB metadata")
+		return
+	}
 
 	// year
-	dateEvents, nonFatalErr := book.MetadataElement("date")
-	if nonFatalErr != nil || len(dateEvents) == 0 {
-		e.UI.Debug("Parsing EPUB: no date found")
+	if year, nonFatalErr := opfInfo.PublicationYear(); nonFatalErr == nil {
+		info.EditionYear = year
 	} else {
-		found := false
-		// try to find date associated with "publication" event
-		for _, el := range dateEvents {
-			for _, evt := range el.Attr {
-				if evt == "publication" {
-					info.EditionYear = el.Content[0:4]
-					found = true
-					break
-				}
-			}
-			if found {
-				break
-			}
-		}
-		// else reverting to first date found
-		if !found {
-			// using first date found
-			info.EditionYear = dateEvents[0].Content[0:4]
-		}
+		e.UI.Debug("Parsing EPUB: no date found")
 	}
 	// by default, assuming it's a first edition
 	info.OriginalYear = info.EditionYear
 	// title
-	if results, err := getFirstFieldValue(book, "title"); err == nil {
-		info.BookTitle = results
+	info.BookTitle = opfInfo.Title
+	info.Subtitle = opfInfo.Subtitle
+	// authors and other contributors
+	if authors := opfInfo.Authors(); len(authors) != 0 {
+		info.Authors = authors
+	}
+	for _, c := range opfInfo.Creators {
+		// the software that produced the epub is not a contributor
+		if c.Role == bookProducerRole {
+			continue
+		}
+		if c.Role != authorRole {
+			info.Contributors.Add(c.Role, c.Name)
+		}
+		info.SetSortName(c.Name, c.FileAs)
 	}
-	// authors
-	if results, err := getFieldValues(book, "creator"); err == nil {
-		info.Authors = []string{}
-		info.Authors = append(info.Authors, results...)
+	// series
+	if len(opfInfo.Series) != 0 {
+		info.Series = opfInfo.Series
 	}
 	// language
-	if results, err := getFirstFieldValue(book, "language"); err == nil {
-		info.Language = results
-	}
+	info.Language = opfInfo.Language
 	// description
-	if results, err := getFirstFieldValue(book, "description"); err == nil {
-		info.Description = results
-	}
+	info.Description = opfInfo.Description
 	// tags
-	if results, err := getFieldValues(book, "subject"); err == nil {
+	if len(opfInfo.Subjects) != 0 {
 		info.Tags = Tags{}
-		for _, t := range results {
+		for _, t := range opfInfo.Subjects {
 			info.Tags.Add(Tag{Name: t})
 		}
 	}
 	// publisher
-	if results, err := getFirstFieldValue(book, "publisher"); err == nil {
-		info.Publisher = results
-	}
+	info.Publisher = opfInfo.Publisher
 
 	// ISBN
-	nonFatalErr = e.findISBN(book, &info)
+	isbn, nonFatalErr := opfInfo.ISBN()
 	if nonFatalErr != nil {
 		e.UI.Warningf("ISBN could not be found in %s!!", e.FullPath())
 		err = nonFatalErr
 	}
+	info.ISBN = isbn
+	// other identifiers
+	for _, identifier := range opfInfo.Identifiers {
+		if kind, id, found := parseIdentifier(identifier.Value, identifier.Scheme); found {
+			info.Identifiers.Add(kind, id)
+		}
+	}
 
+	info.Tags.Clean(e.Config.TagRules, en.TagSourceEpub)
+	// series information is often found in titles
+	info.detectSeries()
 	// cleaning metadata
 	info.Clean(e.Config)
 	return
 }
 
-func getFirstFieldValue(epub *epubgo.Epub, field string) (string, error) {
-	values, err := getFieldValues(epub, field)
-	if err == nil && len(values) != 0 {
-		return values[0], nil
+// WriteMetadata to the epub file, replacing the metadata it contained.
+// A backup of the original file is kept, and the new hash is calculated.
+func (e *Epub) WriteMetadata(info Metadata) (backup string, err error) {
+	e.UI.Debugf("Writing metadata to %s\n", e.FullPath())
+	opfPath, opf, err := readOPF(e.FullPath())
+	if err != nil {
+		return
 	}
-	return "", err
-}
-func getFieldValues(epub *epubgo.Epub, field string) ([]string, error) {
-	values := []string{}
-	results, err := epub.MetadataElement(field)
-	if err == nil {
-		for _, v := range results {
-			values = append(values, v.Content)
-		}
+	newOPF, err := updateOPFMetadata(opf, info)
+	if err != nil {
+		return
 	}
-	return values, err
-}
-
-func (e *Epub) findISBN(book *epubgo.Epub, i *Metadata) error {
-	// get the identifier
-	identifiers, nonFatalErr := book.MetadataElement("identifier")
-	if nonFatalErr == nil && len(identifiers) != 0 {
-		// try to find isbn
-		for _, el := range identifiers {
-			// try to find isbn in content
-			isbn, err := en.CleanISBN(el.Content)
-			if err == nil {
-				i.ISBN = isbn
-				return err
-			}
-			// try to find isbn in the attributes
-			// it shouldn't be there, but retail epubs have awful metadata
-			for _, evt := range el.Attr {
-				isbn, err = en.CleanISBN(evt)
-				if err == nil {
-					i.ISBN = isbn
-					return err
-				}
-			}
-		}
+	// backup original epub
+	backup, err = en.GetBackupUniqueName(filepath.Base(e.Filename))
+	if err != nil {
+		return
 	}
-	// try getting source if not already found
-	sources, nonFatalErr := book.MetadataElement("source")
-	if nonFatalErr == nil && len(sources) != 0 {
-		// try to find isbn
-		for _, el := range sources {
-			// clean results
-			isbn, err := en.CleanISBN(el.Content)
-			if err == nil {
-				i.ISBN = isbn
-				return err
-			}
-		}
+	if err = os.MkdirAll(filepath.Dir(backup), 0777); err != nil {
+		return
 	}
-
-	// if no valid result, return err
-	return errors.New("ISBN not found in epub")
+	if err = h.CopyFile(e.FullPath(), backup); err != nil {
+		return
+	}
+	// write to temporary file, then replace
+	temp := e.FullPath() + ".tmp"
+	if err = writeEpubWithOPF(e.FullPath(), temp, opfPath, newOPF); err != nil {
+		os.Remove(temp)
+		return
+	}
+	if err = os.Rename(temp, e.FullPath()); err != nil {
+		return
+	}
+	err = e.GetHash()
+	return
 }
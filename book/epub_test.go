@@ -13,9 +13,9 @@ func TestEpubGetHash(t *testing.T) {
 	assert := assert.New(t)
 	for i, testEpub := range epubs {
 		e := NewBook(ui, i, testEpub.filename, standardTestConfig, true)
-		err := e.RetailEpub.GetHash()
+		err := e.MainEpub().GetHash()
 		assert.Nil(err, "Error calculating hash for "+e.FullPath())
-		assert.Equal(e.RetailEpub.Hash, testEpub.expectedSha256, "Error calculating sha256")
+		assert.Equal(e.MainEpub().Hash, testEpub.expectedSha256, "Error calculating sha256")
 	}
 }
 
@@ -24,12 +24,12 @@ func TestEpubFlagReplacement(t *testing.T) {
 	assert := assert.New(t)
 	for i, testEpub := range epubs {
 		e := NewBook(ui, i, testEpub.filename, standardTestConfig, true)
-		assert.Equal(e.RetailEpub.NeedsReplacement, endive.False)
+		assert.Equal(e.MainEpub().NeedsReplacement, endive.False)
 
-		e.RetailEpub.FlagForReplacement(true)
-		assert.Equal(e.RetailEpub.NeedsReplacement, endive.True)
-		e.RetailEpub.FlagForReplacement(false)
-		assert.Equal(e.RetailEpub.NeedsReplacement, endive.False)
+		e.MainEpub().FlagForReplacement(true)
+		assert.Equal(e.MainEpub().NeedsReplacement, endive.True)
+		e.MainEpub().FlagForReplacement(false)
+		assert.Equal(e.MainEpub().NeedsReplacement, endive.False)
 	}
 }
 
@@ -38,28 +38,28 @@ func TestEpubCheck(t *testing.T) {
 	fmt.Println("+ Testing Epub.SetRetail()...")
 	assert := assert.New(t)
 	e := NewBook(ui, 0, epubs[0].filename, standardTestConfig, isRetail)
-	e.RetailEpub.GetHash()
+	e.MainEpub().GetHash()
 
 	// checking retail
-	hasChanged, err := e.RetailEpub.Check()
+	hasChanged, err := e.MainEpub().Check()
 	assert.Nil(err, "Error checking hash for "+e.FullPath())
 	assert.False(hasChanged, "Error: ebook should be not have changed")
 
-	oldHash := e.RetailEpub.Hash
-	e.RetailEpub.Hash = ""
-	hasChanged, err = e.RetailEpub.Check()
+	oldHash := e.MainEpub().Hash
+	e.MainEpub().Hash = ""
+	hasChanged, err = e.MainEpub().Check()
 	assert.Nil(err, "Error checking retail hash")
 	assert.True(hasChanged, "Error: ebook has changed")
 
 	// testing non-retail
-	e.RetailEpub.Hash = oldHash
+	e.MainEpub().Hash = oldHash
 	// checking non retail
-	hasChanged, err = e.RetailEpub.Check()
+	hasChanged, err = e.MainEpub().Check()
 	assert.Nil(err, "Error checking retail hash")
 	assert.False(hasChanged, "Error: ebook should be not have changed")
 
-	e.RetailEpub.Hash = ""
-	hasChanged, err = e.RetailEpub.Check()
+	e.MainEpub().Hash = ""
+	hasChanged, err = e.MainEpub().Check()
 	assert.Nil(err, "Error checking non retail hash, should have been ok")
 	assert.True(hasChanged, "Error: ebook has changed")
 }
@@ -0,0 +1,151 @@
+package book
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// Kinds of identifiers endive knows about.
+const (
+	isbnIdentifier        = "isbn"
+	asinIdentifier        = "asin"
+	goodReadsIdentifier   = "goodreads"
+	openLibraryIdentifier = "openlibrary"
+	uuidIdentifier        = "uuid"
+)
+
+// identifierSchemes maps OPF schemes and prefixes to kinds of identifiers.
+var identifierSchemes = map[string]string{
+	"isbn":        isbnIdentifier,
+	"asin":        asinIdentifier,
+	"amazon":      asinIdentifier,
+	"mobi-asin":   asinIdentifier,
+	"goodreads":   goodReadsIdentifier,
+	"openlibrary": openLibraryIdentifier,
+	"olid":        openLibraryIdentifier,
+	"uuid":        uuidIdentifier,
+}
+
+var (
+	goodReadsURLRegexp   = regexp.MustCompile(`goodreads\.com/book/show/([0-9]+)`)
+	openLibraryURLRegexp = regexp.MustCompile(`openlibrary\.org/(?:books|works)/(OL[0-9]+[MW])`)
+	asinRegexp           = regexp.MustCompile(`^B[0-9A-Z]{9}$`)
+	uuidRegexp           = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Identifiers of a book on external sites, by kind.
+// The ISBN is not part of them: it has its own Metadata field.
+type Identifiers map[string]string
+
+// Add an identifier, replacing any previous value of the same kind.
+func (ids *Identifiers) Add(kind, value string) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	value = strings.TrimSpace(value)
+	if kind == "" || value == "" || kind == isbnIdentifier {
+		return
+	}
+	if *ids == nil {
+		*ids = make(Identifiers)
+	}
+	(*ids)[kind] = value
+}
+
+// String returns all identifiers in kind:value form.
+func (ids Identifiers) String() string {
+	return strings.Join(ids.List(), ", ")
+}
+
+// List of identifiers in kind:value form, sorted.
+func (ids Identifiers) List() []string {
+	list := []string{}
+	for kind, value := range ids {
+		list = append(list, kind+":"+value)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// parseIdentifier from a dc:identifier value and its scheme.
+func parseIdentifier(value, scheme string) (kind, id string, found bool) {
+	value = strings.TrimSpace(value)
+	lower := strings.ToLower(value)
+	// URLs
+	if m := goodReadsURLRegexp.FindStringSubmatch(value); m != nil {
+		return goodReadsIdentifier, m[1], true
+	}
+	if m := openLibraryURLRegexp.FindStringSubmatch(value); m != nil {
+		return openLibraryIdentifier, m[1], true
+	}
+	// explicit scheme
+	if kind, ok := identifierSchemes[strings.ToLower(strings.TrimSpace(scheme))]; ok {
+		return kind, strings.TrimPrefix(strings.TrimPrefix(value, "urn:"+kind+":"), kind+":"), true
+	}
+	// "urn:kind:value" and calibre's "kind:value"
+	parts := strings.SplitN(strings.TrimPrefix(lower, "urn:"), ":", 2)
+	if len(parts) == 2 {
+		if kind, ok := identifierSchemes[parts[0]]; ok {
+			return kind, value[len(value)-len(parts[1]):], true
+		}
+	}
+	// recognizable values
+	if uuidRegexp.MatchString(value) {
+		return uuidIdentifier, lower, true
+	}
+	if asinRegexp.MatchString(value) {
+		return asinIdentifier, value, true
+	}
+	return "", "", false
+}
+
+// Identifier returns the value of an identifier of any kind, including ISBN.
+func (i *Metadata) Identifier(kind string) string {
+	if kind == isbnIdentifier {
+		return i.ISBN
+	}
+	return i.Identifiers[kind]
+}
+
+// HasSameIdentifier checks if both Metadata share an identifier.
+func (i *Metadata) HasSameIdentifier(o Metadata) bool {
+	if i.ISBN != "" && i.ISBN == o.ISBN {
+		return true
+	}
+	for kind, value := range i.Identifiers {
+		if value != "" && o.Identifiers[kind] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDifferentISBN checks if both Metadata have a known ISBN, and they differ.
+func (i *Metadata) HasDifferentISBN(o Metadata) bool {
+	return i.ISBN != "" && o.ISBN != "" && i.ISBN != o.ISBN
+}
+
+// mergeIdentifiers from another Metadata, keeping known values.
+func (i *Metadata) mergeIdentifiers(o *Metadata) {
+	for kind, value := range o.Identifiers {
+		if i.Identifiers[kind] == "" {
+			i.Identifiers.Add(kind, value)
+		}
+	}
+}
+
+// addIdentifierFromString in kind:value form.
+func (i *Metadata) addIdentifierFromString(identifier string) {
+	parts := strings.SplitN(identifier, ":", 2)
+	if len(parts) != 2 {
+		return
+	}
+	if strings.ToLower(parts[0]) == isbnIdentifier {
+		if isbn, err := e.CleanISBN(parts[1]); err == nil {
+			i.ISBN = isbn
+		}
+		return
+	}
+	i.Identifiers.Add(parts[0], parts[1])
+}
@@ -0,0 +1,81 @@
+package book
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIdentifier(t *testing.T) {
+	fmt.Println("+ Testing parseIdentifier()...")
+	assert := assert.New(t)
+	for _, c := range []struct {
+		value        string
+		scheme       string
+		expectedKind string
+		expectedID   string
+	}{
+		{"12007", "GOODREADS", goodReadsIdentifier, "12007"},
+		{"goodreads:12007", "", goodReadsIdentifier, "12007"},
+		{"https://www.goodreads.com/book/show/12007.Use_of_Weapons", "URI", goodReadsIdentifier, "12007"},
+		{"urn:amazon:B002TXZRQI", "", asinIdentifier, "B002TXZRQI"},
+		{"B002TXZRQI", "", asinIdentifier, "B002TXZRQI"},
+		{"http://openlibrary.org/books/OL7353617M", "", openLibraryIdentifier, "OL7353617M"},
+		{"urn:uuid:0ADF2006-7812-4675-9c27-47699d21c4a2", "", uuidIdentifier, "0ADF2006-7812-4675-9c27-47699d21c4a2"},
+		{"0adf2006-7812-4675-9c27-47699d21c4a2", "", uuidIdentifier, "0adf2006-7812-4675-9c27-47699d21c4a2"},
+		{"urn:isbn:9780316029186", "", isbnIdentifier, "9780316029186"},
+		{"http://www.gutenberg.org/ebooks/16328", "URI", "", ""},
+		{"42", "calibre", "", ""},
+	} {
+		kind, id, found := parseIdentifier(c.value, c.scheme)
+		assert.Equal(c.expectedKind, kind, c.value)
+		assert.Equal(c.expectedID, id, c.value)
+		assert.Equal(c.expectedKind != "", found, c.value)
+	}
+}
+
+func TestMetadataIdentifiers(t *testing.T) {
+	fmt.Println("+ Testing Metadata identifiers...")
+	assert := assert.New(t)
+	i := Metadata{}
+	assert.Nil(i.Set(identifiersField, "goodreads:12007, asin:B002TXZRQI, isbn:0-306-40615-2", standardTestConfig))
+	assert.Equal("9780306406157", i.ISBN)
+	assert.Equal("12007", i.Identifier(goodReadsIdentifier))
+	assert.Equal("9780306406157", i.Identifier(isbnIdentifier))
+	value, err := i.Get(identifiersField)
+	assert.Nil(err)
+	assert.Equal("asin:B002TXZRQI, goodreads:12007", value)
+	assert.NotNil(i.Set(identifiersField, "12007", standardTestConfig), "missing kind")
+
+	// similar if sharing any identifier
+	o := Metadata{Identifiers: Identifiers{goodReadsIdentifier: "12007"}}
+	assert.True(i.HasSameIdentifier(o))
+	assert.True(i.IsSimilar(o))
+	o = Metadata{Identifiers: Identifiers{goodReadsIdentifier: "1"}}
+	assert.False(i.HasSameIdentifier(o))
+	// known identifiers are kept when merging
+	o.Identifiers.Add(openLibraryIdentifier, "OL7353617M")
+	i.mergeIdentifiers(&o)
+	assert.Equal("asin:B002TXZRQI, goodreads:12007, openlibrary:OL7353617M", i.Identifiers.String())
+}
+
+func TestEpubReadIdentifiers(t *testing.T) {
+	fmt.Println("+ Testing Epub.ReadMetadata() identifiers...")
+	assert := assert.New(t)
+	opf, err := ioutil.ReadFile(filepath.Join(parentDir, "test", "opf", "epub2_calibre.opf"))
+	assert.Nil(err)
+	path := filepath.Join(parentDir, "test", "identifiers_test.epub")
+	assert.Nil(writeTestEpub(path, opf))
+	defer os.Remove(path)
+
+	e := Epub{Filename: path, UI: ui, Config: standardTestConfig}
+	info, err := e.ReadMetadata()
+	assert.Nil(err)
+	assert.Equal("9780748110513", info.ISBN)
+	expected := Identifiers{goodReadsIdentifier: "12007", asinIdentifier: "B002TXZRQI", uuidIdentifier: "0adf2006-7812-4675-9c27-47699d21c4a2"}
+	assert.Equal(expected, info.Identifiers)
+}
@@ -0,0 +1,187 @@
+package book
+
+import (
+	"archive/zip"
+	"regexp"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+	"github.com/kennygrant/sanitize"
+)
+
+const (
+	printISBN = "print"
+	ebookISBN = "ebook"
+
+	// number of documents scanned at each end of the spine
+	isbnScanDocuments = 3
+	// characters around an ISBN label searched for a number, or what it applies to
+	isbnLabelWindow = 40
+)
+
+var (
+	isbnLabelRegexp     = regexp.MustCompile(`(?i)isbn`)
+	isbnNumberRegexp    = regexp.MustCompile(`[0-9][0-9\-‐–\s]{8,16}[0-9Xx]`)
+	isbnQualifierRegexp = regexp.MustCompile(`^\s*[(\[]([^)\]]*)[)\]]`)
+	ebookLabels         = []string{"ebook", "e-book", "eisbn", "e-isbn", "epub", "electronic", "digital", "kindle", "mobi"}
+	printLabels         = []string{"print", "hardcover", "hardback", "paperback", "pbk", "hbk", "trade"}
+)
+
+// ISBNCandidate is an ISBN found in the contents of an epub.
+type ISBNCandidate struct {
+	ISBN string
+	Kind string // printISBN, ebookISBN, or empty if unknown
+}
+
+// String returns the ISBN, and what it applies to, if known.
+func (c ISBNCandidate) String() string {
+	if c.Kind == "" {
+		return c.ISBN
+	}
+	return c.ISBN + " (" + c.Kind + ")"
+}
+
+// FindISBNCandidates in the first and last documents of the epub.
+// Ebook ISBNs come first, since they describe this edition.
+func (e *Epub) FindISBNCandidates() ([]ISBNCandidate, error) {
+	r, err := zip.OpenReader(e.FullPath())
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	opfPath, err := findOPFPath(&r.Reader)
+	if err != nil {
+		return nil, err
+	}
+	opf, err := readZipFile(&r.Reader, opfPath)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parseOPFDocument(opf)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []ISBNCandidate
+	for _, href := range isbnScanHrefs(doc) {
+		data, err := readZipFile(&r.Reader, itemPath(opfPath, href))
+		if err != nil {
+			e.UI.Debug(err.Error())
+			continue
+		}
+		candidates = addISBNCandidates(candidates, scanISBNs(sanitize.HTML(string(data)))...)
+	}
+	return sortISBNCandidates(candidates), nil
+}
+
+// isbnScanHrefs returns the first and last documents of the spine, where
+// copyright pages usually are.
+func isbnScanHrefs(doc opfDocument) []string {
+	documents := spineDocuments(doc)
+	if len(documents) <= 2*isbnScanDocuments {
+		return documents
+	}
+	return append(documents[:isbnScanDocuments], documents[len(documents)-isbnScanDocuments:]...)
+}
+
+// spineDocuments lists the hrefs of the (x)html documents of the spine, in reading order.
+func spineDocuments(doc opfDocument) []string {
+	items := make(map[string]opfItem)
+	for _, item := range doc.Items {
+		items[item.ID] = item
+	}
+	var documents []string
+	for _, ref := range doc.Spine {
+		if item, ok := items[ref.IDRef]; ok && strings.Contains(item.MediaType, "html") {
+			documents = append(documents, item.Href)
+		}
+	}
+	return documents
+}
+
+// scanISBNs finds valid ISBNs following "ISBN" labels in text.
+func scanISBNs(text string) []ISBNCandidate {
+	var candidates []ISBNCandidate
+	for _, label := range isbnLabelRegexp.FindAllStringIndex(text, -1) {
+		after := textWindow(text, label[1], label[1]+isbnLabelWindow)
+		number := isbnNumberRegexp.FindStringIndex(after)
+		if number == nil {
+			continue
+		}
+		isbn, err := e.CleanISBN(after[number[0]:number[1]])
+		if err != nil {
+			continue
+		}
+		// what the ISBN applies to is usually right before the label,
+		// or right after the number: "eBook ISBN: ...", "ISBN ... (hardcover)"
+		before := textWindow(text, label[0]-isbnLabelWindow/2, label[0])
+		// not going back to a previous sentence or ISBN
+		if idx := strings.LastIndexAny(before, ".;\n0123456789"); idx != -1 {
+			before = before[idx+1:]
+		}
+		context := strings.ToLower(before + text[label[0]:label[1]])
+		if qualifier := isbnQualifierRegexp.FindStringSubmatch(text[label[1]+number[1]:]); qualifier != nil {
+			context += " " + strings.ToLower(qualifier[1])
+		}
+		candidates = addISBNCandidates(candidates, ISBNCandidate{ISBN: isbn, Kind: isbnKind(context)})
+	}
+	return candidates
+}
+
+// textWindow returns text[start:end], within the bounds of text.
+func textWindow(text string, start, end int) string {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(text) {
+		end = len(text)
+	}
+	return text[start:end]
+}
+
+// isbnKind guesses if an ISBN is for the print or ebook edition, from its label.
+func isbnKind(context string) string {
+	for _, label := range ebookLabels {
+		if strings.Contains(context, label) {
+			return ebookISBN
+		}
+	}
+	for _, label := range printLabels {
+		if strings.Contains(context, label) {
+			return printISBN
+		}
+	}
+	return ""
+}
+
+// addISBNCandidates to a list, keeping the first known kind for each ISBN.
+func addISBNCandidates(candidates []ISBNCandidate, others ...ISBNCandidate) []ISBNCandidate {
+	for _, o := range others {
+		known := false
+		for j := range candidates {
+			if candidates[j].ISBN == o.ISBN {
+				known = true
+				if candidates[j].Kind == "" {
+					candidates[j].Kind = o.Kind
+				}
+				break
+			}
+		}
+		if !known {
+			candidates = append(candidates, o)
+		}
+	}
+	return candidates
+}
+
+// sortISBNCandidates: ebook, then unknown, then print ISBNs, keeping the order they were found in.
+func sortISBNCandidates(candidates []ISBNCandidate) []ISBNCandidate {
+	var sorted []ISBNCandidate
+	for _, kind := range []string{ebookISBN, "", printISBN} {
+		for _, c := range candidates {
+			if c.Kind == kind {
+				sorted = append(sorted, c)
+			}
+		}
+	}
+	return sorted
+}
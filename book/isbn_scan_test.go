@@ -0,0 +1,85 @@
+package book
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testScanOPF = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" xmlns:dc="http://purl.org/dc/elements/1.1/" version="3.0" unique-identifier="uid">
+  <metadata>
+    <dc:identifier id="uid">urn:uuid:0adf2006-7812-4675-9c27-47699d21c4a2</dc:identifier>
+    <dc:title>The Last Wish</dc:title>
+  </metadata>
+  <manifest>
+    <item id="cover" href="cover.xhtml" media-type="application/xhtml+xml"/>
+    <item id="copyright" href="Text/copyright.xhtml" media-type="application/xhtml+xml"/>
+    <item id="css" href="style.css" media-type="text/css"/>
+    %s
+    <item id="about" href="Text/about.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine>
+    <itemref idref="cover"/>
+    <itemref idref="copyright"/>
+    %s
+    <itemref idref="about"/>
+  </spine>
+</package>`
+
+const testCopyrightPage = `<html xmlns="http://www.w3.org/1999/xhtml"><body>
+<p>Copyright &#169; 2008 by Andrzej Sapkowski.</p>
+<p>Hardcover ISBN: 0-316-02918-1</p>
+<p>eBook ISBN: 978-0-316-05519-2</p>
+<p>Print ISBN 123-4-567-89012-3</p>
+</body></html>`
+
+func TestScanISBNs(t *testing.T) {
+	fmt.Println("+ Testing scanISBNs()...")
+	assert := assert.New(t)
+	for text, expected := range map[string][]ISBNCandidate{
+		"ISBN 978-0-316-02918-6":                            {{ISBN: "9780316029186"}},
+		"ISBN-13: 9780316029186 (paperback)":                {{ISBN: "9780316029186", Kind: printISBN}},
+		"e-ISBN: 9780316055192. Print ISBN: 0-316-02918-1.": {{ISBN: "9780316055192", Kind: ebookISBN}, {ISBN: "9780316029186", Kind: printISBN}},
+		"ISBN 978 0 316 02918 6 (ebook) ISBN 9780316029186": {{ISBN: "9780316029186", Kind: ebookISBN}},
+		"ISBN: 1234567890":                                  nil,
+		"9780316029186, without label":                      nil,
+	} {
+		assert.Equal(expected, scanISBNs(text), text)
+	}
+}
+
+func TestFindISBNCandidates(t *testing.T) {
+	fmt.Println("+ Testing Epub.FindISBNCandidates()...")
+	assert := assert.New(t)
+	path := filepath.Join(parentDir, "test", "isbn_scan_test.epub")
+	defer os.Remove(path)
+
+	// copyright page near the start, chapters in the middle are not scanned
+	items, refs := "", ""
+	documents := []testEpubFile{
+		{"OEBPS/cover.xhtml", "<html><body>The Last Wish</body></html>"},
+		{"OEBPS/Text/copyright.xhtml", testCopyrightPage},
+		{"OEBPS/Text/about.xhtml", "<html><body>About the author</body></html>"},
+	}
+	for j := 0; j < 10; j++ {
+		items += fmt.Sprintf(`<item id="ch%d" href="Text/ch%d.xhtml" media-type="application/xhtml+xml"/>`, j, j)
+		refs += fmt.Sprintf(`<itemref idref="ch%d"/>`, j)
+		chapter := "<p>Chapter</p>"
+		if j == 5 {
+			chapter = "<p>ISBN 9780575083653</p>"
+		}
+		documents = append(documents, testEpubFile{fmt.Sprintf("OEBPS/Text/ch%d.xhtml", j), chapter})
+	}
+	assert.Nil(writeTestEpub(path, []byte(fmt.Sprintf(testScanOPF, items, refs)), documents...))
+
+	e := Epub{Filename: path, UI: ui, Config: standardTestConfig}
+	candidates, err := e.FindISBNCandidates()
+	assert.Nil(err)
+	expected := []ISBNCandidate{{ISBN: "9780316055192", Kind: ebookISBN}, {ISBN: "9780316029186", Kind: printISBN}}
+	assert.Equal(expected, candidates)
+	assert.Equal("9780316055192 (ebook)", candidates[0].String())
+}
@@ -0,0 +1,130 @@
+package book
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	en "github.com/barsanuphe/endive/endive"
+)
+
+const (
+	// number of ranked trigrams kept in a profile
+	languageProfileSize = 1000
+	// bytes of text read from an epub to detect its language
+	languageSampleSize = 20000
+	// below this number of trigrams, detection is unreliable
+	minLanguageTrigrams = 100
+	// above this relative distance to the closest profile, the language is
+	// undetermined
+	maxLanguageDistance = 0.75
+	// below this relative margin between the two closest profiles, the
+	// language is undetermined
+	minLanguageMargin = 0.02
+)
+
+var (
+	languageProfiles     map[string]ngramProfile
+	languageProfilesOnce sync.Once
+)
+
+// ngramProfile ranks the most frequent trigrams of a text.
+type ngramProfile struct {
+	ranks map[string]int
+	total int
+}
+
+// newNgramProfile from a text, using words padded with underscores.
+func newNgramProfile(text string) ngramProfile {
+	counts := make(map[string]int)
+	total := 0
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, word := range words {
+		runes := []rune("_" + word + "_")
+		for j := 0; j+3 <= len(runes); j++ {
+			counts[string(runes[j:j+3])]++
+			total++
+		}
+	}
+	trigrams := make([]string, 0, len(counts))
+	for trigram := range counts {
+		trigrams = append(trigrams, trigram)
+	}
+	sort.Slice(trigrams, func(j, k int) bool {
+		if counts[trigrams[j]] == counts[trigrams[k]] {
+			return trigrams[j] < trigrams[k]
+		}
+		return counts[trigrams[j]] > counts[trigrams[k]]
+	})
+	if len(trigrams) > languageProfileSize {
+		trigrams = trigrams[:languageProfileSize]
+	}
+	profile := ngramProfile{ranks: make(map[string]int), total: total}
+	for rank, trigram := range trigrams {
+		profile.ranks[trigram] = rank
+	}
+	return profile
+}
+
+// distance between two profiles, using the out-of-place measure.
+func (p ngramProfile) distance(o ngramProfile) (distance int) {
+	for trigram, rank := range p.ranks {
+		if otherRank, ok := o.ranks[trigram]; ok {
+			if otherRank > rank {
+				distance += otherRank - rank
+			} else {
+				distance += rank - otherRank
+			}
+		} else {
+			distance += languageProfileSize
+		}
+	}
+	return
+}
+
+// detectLanguage of a text, among the bundled language profiles.
+// The language is undetermined if the text is too far from all profiles, or
+// too close to several of them.
+func detectLanguage(text string) (string, error) {
+	languageProfilesOnce.Do(func() {
+		languageProfiles = make(map[string]ngramProfile)
+		for language, trigrams := range languageProfileTrigrams {
+			profile := ngramProfile{ranks: make(map[string]int), total: len(trigrams)}
+			for rank, trigram := range trigrams {
+				profile.ranks[trigram] = rank
+			}
+			languageProfiles[language] = profile
+		}
+	})
+	profile := newNgramProfile(text)
+	if profile.total < minLanguageTrigrams {
+		return "", errors.New("not enough text to detect language")
+	}
+	best, bestDistance, secondDistance := "", -1, -1
+	for language, languageProfile := range languageProfiles {
+		distance := profile.distance(languageProfile)
+		if bestDistance == -1 || distance < bestDistance || (distance == bestDistance && language < best) {
+			best, bestDistance, secondDistance = language, distance, bestDistance
+		} else if secondDistance == -1 || distance < secondDistance {
+			secondDistance = distance
+		}
+	}
+	// distances relative to the worst possible distance, with no trigram in common
+	maxDistance := float64(len(profile.ranks) * languageProfileSize)
+	if float64(bestDistance)/maxDistance > maxLanguageDistance || float64(secondDistance-bestDistance)/maxDistance < minLanguageMargin {
+		return en.UndeterminedLanguage, nil
+	}
+	return best, nil
+}
+
+// DetectLanguage of the epub from a sample of its text.
+func (e *Epub) DetectLanguage() (string, error) {
+	// starting from the middle of the book, away from front and back matter
+	sample, err := e.readText(true, languageSampleSize)
+	if err != nil {
+		return "", err
+	}
+	return detectLanguage(sample)
+}
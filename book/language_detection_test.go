@@ -0,0 +1,49 @@
+package book
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	en "github.com/barsanuphe/endive/endive"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	fmt.Println("+ Testing detectLanguage()...")
+	assert := assert.New(t)
+	for expected, text := range map[string]string{
+		"en": "The ship came into the harbour late in the evening, when most of the town was already asleep and only a few lights were still burning in the windows along the water. Nobody was waiting for them on the quay.",
+		"de": "Das Schiff kam spät am Abend in den Hafen, als die meisten Leute in der Stadt schon schliefen und nur noch wenige Lichter in den Fenstern am Wasser brannten. Niemand wartete auf sie am Kai.",
+		"es": "El barco entró en el puerto tarde por la noche, cuando la mayor parte del pueblo ya dormía y solo quedaban algunas luces encendidas en las ventanas junto al agua. Nadie los esperaba en el muelle.",
+		"it": "La nave entrò nel porto a tarda sera, quando quasi tutta la città dormiva già e solo poche luci erano ancora accese alle finestre lungo l'acqua. Nessuno li aspettava sulla banchina.",
+		"ru": "Корабль вошёл в гавань поздно вечером, когда большая часть города уже спала и лишь несколько огней ещё горели в окнах у воды. Никто не ждал их на пристани.",
+	} {
+		language, err := detectLanguage(text)
+		assert.Nil(err)
+		assert.Equal(expected, language, text)
+	}
+	// unknown languages and gibberish are undetermined
+	for _, text := range []string{
+		"Mae pob person yn cael ei eni'n rhydd ac yn gydradd â'i gilydd o ran urddas a hawliau. Fe'u cynysgaeddir â rheswm a chydwybod, a dylai pob un ymddwyn y naill at y llall mewn ysbryd cymodlon.",
+		strings.Repeat("qwx zzkj plorft vvbn xkcd mmmz grrth kjhg fdsq ", 10),
+	} {
+		language, err := detectLanguage(text)
+		assert.Nil(err)
+		assert.Equal(en.UndeterminedLanguage, language, text)
+	}
+	_, err := detectLanguage("Too short.")
+	assert.NotNil(err, "expected error, sample too short")
+}
+
+func TestEpubDetectLanguage(t *testing.T) {
+	fmt.Println("+ Testing Epub.DetectLanguage()...")
+	assert := assert.New(t)
+	for _, testEpub := range epubs {
+		e := Epub{Filename: testEpub.filename, UI: ui, Config: standardTestConfig}
+		language, err := e.DetectLanguage()
+		assert.Nil(err)
+		assert.Equal(testEpub.expectedLanguage, language)
+	}
+}
@@ -0,0 +1,41 @@
+// Code generated by language_profiles_generate.go; DO NOT EDIT.
+
+package book
+
+// languageProfileTrigrams are the most frequent trigrams of each language,
+// by rank.
+var languageProfileTrigrams = map[string][]string{
+	"bg": {"на_", "_на", "не_", "_за", "_пр", "ане", "_не", "_из", "та_", "_по", "то_", "ван", "те_", "за_", "да_", "_да", "ите", "ка_", "ия_", "но_", "_от", "_се", "ва_", "ата", "_е_", "_ко", "се_", "ен_", "пре", "_фа", "айл", "фай", "ени", "ран", "_съ", "про", "_мо", "ред", "мен", "ни_", "оже", "ира", "мож", "раз", "ето", "_в_", "_с_", "под", "ове", "при", "ият", "ава", "же_", "от_", "ден", "ция", "_оп", "_ре", "ния", "_ст", "ани", "ост", "_ра", "ние", "_об", "ста", "ри_", "пра", "ска", "анд", "ки_", "_им", "ие_", "_и_", "име", "ли_", "ект", "_до", "ат_", "кат", "пол", "ът_", "изв", "ави", "ест", "ент", "ото", "ежд", "пци", "зва", "опц", "дав", "нат", "йл_", "рав", "или", "ств", "лен", "тел", "нит", "ма_", "изп", "ята", "ход", "нет", "ори", "дан", "ете", "неп", "_гр", "жда", "_са", "нда", "тор", "_ин", "сле", "сто", "зна", "ти_", "са_", "ком", "лед", "аци", "ме_", "ена", "_сл", "_бе", "it_", "реш", "зад", "тан", "дър", "_то", "_кл", "вър", "ома", "зве", "ят_", "ада", "ве_", "ман", "ато", "оме", "рек", "_ар", "лов", "ате", "_gi", "ез_", "git", "аде", "_ди", "нов", "_па", "каз", "_ка", "ява", "веж", "гре", "ода", "лон", "ива", "ват", "нос", "вил", "во_", "пис", "ука", "епр", "де_", "йло", "аза", "_ни", "_ук", "_ил", "_си", "пъл", "олз", "лзв", "сти", "_въ", "дир", "дел", "ика", "ст_", "ова", "ешк", "зап", "ко_", "чен", "без", "_но", "ире", "мат", "мес", "рма", "ром", "стр", "ълн", "орм", "ед_", "спе", "шка", "ист", "_къ", "ети", "од_", "яне", "кто", "фор", "_вр", "усп", "ъм_", "_бъ", "изт", "ичн", "рем", "тва", "тов", "към", "ква", "ърж", "дад", "бъд", "нен", "рой", "ржа", "арт", "обе", "уме", "вер", "дат", "ено", "кет", "гра", "ла_", "еме", "еус", "мер", "али", "ене", "рес", "еде", "ел_", "нти", "бек", "ви_", "_зн", "_пъ", "екс", "три", "ешн", "ра_", "_вс", "зпо", "изх", "едн", "сва", "неу", "лни", "клю", "лно", "люч", "тно", "ако", "зхо", "_та", "_ак", "зат", "ина", "вен", "рен", "ърв", "ъде", "рия", "_re", "_ма", "тек", "зпъ", "_ве", "айт", "ема", "раб", "нот", "_дъ", "_сп", "по_", "ан_", "мо_", "ати", "рат", "еле", "нал", "зи_", "кло", "дар", "нт_", "вет", "пеш", "_ви", "има", "реж", "або", "арг", "он_", "аст", "лна", "мет", "той", "шно", "бро", "бот", "зда", "пак", "едо", "тро", "ече", "ции", "ртн", "ски", "ана", "еди", "тир", "_бр", "_ли", "аке", "бра", "_те", "алн", "кон", "ано", "йно", "нск", "ойн", "съз", "ъзд", "ващ", "вре", "поз", "пос", "гум", "иет", "ргу", "сте", "тво", "лив", "чет", "ой_", "_фо", "азд", "ача", "кти", "апи", "обр", "ува", "рси", "зде", "рит", "че_", "ще_", "сам", "тен", "дни", "ели", "аме", "ии_", "ди_", "лик", "амо", "_ба", "ито", "жа_", "код", "нас", "але", "_де", "дре", "акв", "дов", "тич", "същ", "инд", "фик", "иле", "лав", "дек", "със", "ер_", "_кр", "_тр", "чно", "_ня", "_ос", "лне", "чис", "илн", "огр", "отв", "_че", "ови", "_co", "път", "кра", "нак", "еля", "_вх", "одд", "нде", "ока", "рез", "тни", "общ", "реб", "вид", "тре", "лип", "кри", "елн", "тит", "пот", "тар", "бли", "дос", "вхо", "нте", "рам", "чва", "ерс", "ак_", "авя", "ипс", "_ед", "ник", "зан", "апа", "иск", "le_", "съо", "псв", "рво", "изи", "сим", "ера", "оди", "тер", "бло", "чак", "еку", "ози", "иде", "нач", "зтр", "връ", "озн", "отк", "упр", "бва", "они", "доб", "абл", "отр", "нес", "кт_", "луч", "тря", "хра", "нни", "пов", "ми_", "ифи", "нео", "съд", "ъв_", "ъдъ", "_ус", "жен", "вме", "дъл", "им_", "йте", "оре", "ци_", "_n_", "арх", "епо", "нил", "рхи", "сич", "сия", "_st", "ддъ", "_хр", "бав", "ичк", "оба", "яма", "онт", "осл", "_ид", "ита", "йла", "лищ", "мал", "_а_", "опи", "отн", "ърш", "ор_", "тав", "чни", "лит", "одр", "ази", "рог", "ери", "спи", "вол", "вян", "ет_", "изч", "исл", "азв", "ащи", "_de", "er_", "ряб", "ябв", "във", "иит", "обн", "вси", "зав", "кла", "ням", "ща_", "ъще", "_ад", "_св", "олу", "тиф", "_he", "анс", "бит", "изр", "нди", "тим", "se_", "ло_", "мац", "кал", "оде", "чки", "адр", "лок", "щот", "тоз", "хив", "ид_", "инф", "нфо", "бай", "еск", "ище", "оча", "дин", "ъс_", "съв", "_дв", "ета", "йто", "оче", "_би", "вия", "зли", "нац", "яна", "ал_", "веч", "вор", "_no", "_ме", "азм", "зме", "_pa", "вка", "кан", "ров", "ес_", "ини", "лът", "очи", "низ", "инт", "чна", "дно", "соч", "тем", "кои", "кса", "мян", "одн", "рив", "скв", "щен", "бно", "акт", "ll_", "въз", "ck_", "еоб", "ляв", "мно", "ъоб", "тат", "анн", "ица", "сис", "зра", "тра", "_ча", "бще", "_in", "два", "чит", "исъ", "нап", "ици", "оце", "роч", "щи_", "йст", "сък", "тър", "есъ", "игн", "нта", "рил", "_ис", "паз", "пар", "дна", "пор", "реп", "ов_", "сиг", "стъ", "es_", "док", "дуп", "лян", "роц", "уст", "_чи", "си_", "сли", "_го", "зир", "рир", "_ша", "дал", "до_", "точ", "шаб", "вяв", "лиз", "рег", "тви", "_мн", "баз", "еду", "ном", "оле", "щес", "_це", "te_", "сло", "вар", "кущ", "_fi", "ile", "жде", "инс", "тна", "час", "on_", "тът", "цес", "et_", "енл", "ими", "кци", "ючв", "ама", "ис_", "ръз", "ъзк", "вув", "нли", "тву", "_ан", "_уп", "вто", "кой", "_ло", "ead", "мод", "оит", "тик", "авн", "йск", "так", "ойк", "сек", "_ma", "sta", "жан", "лож", "ниц", "обх", "цел", "овя", "олн", "вай", "еда", "fil", "жим", "зчи", "иче", "ога", "обв", "тта", "ово", "азл", "отд", "ълж", "гат", "рие", "_ет", "ce_", "она", "_со", "авъ", "ба_", "виш", "оду", "аря", "еба", "изо", "лич", "ткр", "_s_", "вал", "нст", "чал", "аща", "аз_", "_al", "ch_", "дул", "йлъ", "шен", "що_", "ара", "лаг", "очн", "чи_", "_d_", "бхо", "гна", "зис", "вед", "ора", "все", "ver", "иса", "тве", "убл", "гла", "дим", "зам", "звъ", "опр", "ък_", "вив", "го_", "ежи", "еси", "ши_", "_t_", "доп", "лев", "нир", "тка", "_др", "аже", "_су", "тъп", "_di", "ack", "ейс", "оне", "_ср", "ad_", "_c_", "_ск", "ека", "кръ", "ъвм", "_a_", "_pr", "кит", "леч", "пка", "ца_", "еки", "тал", "_se", "ed_", "re_", "вна", "_lo", "дру", "ин_", "лжи", "оля", "стт", "пуб", "пър", "ъпк", "con", "ари", "шир", "_f_", "раж", "_чр", "вни", "оку", "_ав", "тег", "чре", "щат", "гру", "заг", "кст", "няв", "руг", "сен", "чав", "дво", "ийс", "кси", "ула", "ърз", "sh_", "ай_", "изб", "бви", "ике", "кс_", "кум", "лем", "мин", "одм", "рва", "свъ", "ate", "дмо", "ивк", "тда", "_вк", "дес", "едв", "оло", "тив", "_ще", "all", "еби", "епу", "из_", "ит_", "мак", "тме", "чин", "_b_", "ect", "азн", "вкл", "отм", "рад", "рии", "руп", "сре", "ча_", "лат", "_su", "no_", "атв", "ила", "пус", "рти", "_тъ", "ref", "ага", "исв", "нам", "рна", "hea", "аве", "ака", "зво", "зка", "ков", "int", "ion", "lin", "бир", "га_", "егл", "зте", "ик_", "ним", "роб", "ръп", "таз", "_оч", "омп", "пир", "ршв", "шва", "яко", "дач", "лск", "ойт", "урс", "агл", "ив_", "имо", "rt_", "ter", "как", "чат", "_sh", "_ta", "me_", "авт", "кац", "кач", "кол", "_ex", "_si", "_вм", "вие", "ивн", "све", "уча", "ъст", "_un", "al_", "che", "or_", "rea", "пер", "ъщо", "_li", "_x_", "_фу", "at_", "ащ_", "ерв", "ерн", "нул", "реи", "рол", "рск", "loc", "бел", "вит", "лас", "опу", "унк", "ган", "ези", "ену", "иви", "изк", "онф", "фун", "щия", "ърс", "in_", "аск", "дит", "иси", "лир", "нкц", "ращ", "ало", "арч", "коп", "рче"},
+	"ca": {"_de", "de_", "_no", "es_", "_el", "el_", "_es", "no_", "er_", "_co", "ió_", "la_", "_la", "_s_", "_a_", "_un", "ent", "per", "at_", "_ha", "_re", "_en", "que", "_pe", "nt_", "ar_", "est", "_l_", "_ca", "_fi", "ció", "ha_", "en_", "_po", "da_", "_d_", "_se", "al_", "és_", "_in", "ls_", "fit", "txe", "xer", "itx", "un_", "con", "com", "des", "sta", "ra_", "_pr", "na_", "re_", "aci", "men", "ts_", "or_", "ta_", "ect", "ica", "del", "les", "tra", "_di", "nom", "ia_", "_al", "els", "ion", "ut_", "eix", "pro", "_és", "_si", "_pa", "res", "om_", "ada", "ns_", "it_", "_qu", "_ex", "ix_", "ers", "_am", "tor", "esp", "gut", "ter", "ist", "aqu", "rs_", "cte", "_le", "str", "amb", "ri_", "ir_", "eu_", "_ma", "rec", "_ll", "ons", "_ar", "ot_", "for", "tat", "ina", "_i_", "mb_", "et_", "_mo", "ant", "_tr", "tre", "ida", "ont", "ori", "una", "ca_", "esc", "_fo", "nci", "sió", "ue_", "lit", "era", "_op", "cio", "_su", "stà", "pre", "car", "pot", "orm", "spe", "te_", "ogu", "err", "int", "rma", "pog", "omp", "ntr", "ssi", "_ac", "dir", "nte", "ifi", "ble", "uet", "_o_", "fic", "ari", "se_", "pci", "ver", "git", "rro", "ten", "ura", "ade", "tà_", "tro", "an_", "_er", "sen", "lla", "ost", "_ob", "itz", "ran", "opc", "_so", "act", "tes", "eta", "lid", "le_", "ona", "_gi", "ues", "tza", "_or", "àli", "all", "bre", "ma_", "ort", "_ve", "lic", "paq", "egu", "rad", "ror", "ire", "cap", "_va", "emp", "ord", "ual", "can", "_us", "vàl", "dre", "ste", "cto", "ame", "cad", "ali", "mat", "cri", "id_", "cia", "fer", "par", "us_", "_te", "os_", "abl", "scr", "nti", "ita", "den", "min", "met", "iu_", "_và", "is_", "mpr", "eci", "val", "ctu", "egi", "més", "ess", "nvi", "pec", "_mi", "rea", "dor", "tar", "mis", "_lí", "_fa", "anc", "nar", "one", "pos", "ll_", "mos", "nat", "arà", "seg", "nal", "ser", "ies", "nca", "ènc", "ssa", "íni", "si_", "_aq", "efe", "iss", "loc", "ode", "ign", "ria", "sa_", "_me", "ge_", "anv", "lín", "tur", "ref", "als", "cam", "lle", "nts", "tal", "ici", "cac", "pri", "cci", "_ta", "tua", "inc", "imi", "ume", "_to", "arg", "mer", "rti", "ins", "st_", "lor", "_da", "rsi", "erm", "cif", "_cr", "ema", "odu", "_an", "cat", "man", "nta", "rdr", "ass", "rre", "alt", "tem", "_fe", "ecu", "_ap", "rob", "lat", "tan", "lli", "nia", "rod", "ere", "inf", "va_", "tab", "ara", "_ad", "mpl", "ap_", "onf", "cre", "_em", "ado", "cor", "nfo", "rep", "via", "ili", "_hi", "alo", "reg", "tge", "tin", "ime", "_lo", "ret", "ure", "ic_", "tip", "ats", "nde", "sig", "atg", "rac", "_gr", "ome", "_he", "lis", "_ti", "cla", "ol_", "rim", "_st", "fal", "ora", "_ba", "sti", "igu", "_fu", "tic", "ert", "ase", "oba", "_cl", "fin", "duï", "ete", "rt_", "por", "til", "omi", "té_", "bli", "ple", "_im", "dif", "ini", "nst", "exe", "gur", "rat", "obr", "ens", "jec", "orr", "nse", "hi_", "ït_", "def", "leg", "ors", "omé", "sio", "uït", "mac", "ide", "iqu", "_n_", "rar", "iva", "_br", "lim", "pli", "osi", "reb", "obj", "bje", "sit", "ex_", "xec", "eli", "sor", "_bi", "eny", "tec", "bra", "ecc", "pus", "enc", "lar", "oca", "ès_", "exi", "eme", "ràc", "eni", "sub", "qui", "àct", "gra", "_ge", "ing", "_do", "cal", "lem", "ero", "nic", "_bl", "neg", "fil", "ibl", "mod", "sup", "tei", "ipu", "tid", "ele", "ext", "lau", "han", "on_", "rèn", "unt", "ro_", "riu", "rta", "ena", "dis", "ati", "erè", "ind", "nes", "nfi", "_ne", "au_", "bas", "nya", "equ", "dex", "gui", "ets", "rgu", "odi", "usa", "exp", "rem", "uar", "amp", "xis", "_vo", "laç", "_au", "ces", "tiv", "sco", "_bu", "pod", "cti", "dad", "eri", "ndi", "cie", "gir", "pla", "gum", "arx", "pat", "sat", "nor", "sua", "qua", "vis", "eba", "ima", "ell", "zar", "mpa", "iar", "ine", "blo", "gna", "cut", "in_", "rd_", "ula", "tot", "ale", "lec", "mpo", "rna", "pon", "erv", "fig", "usu", "índ", "edi", "mar", "ris", "_ab", "_ín", "fon", "bat", "rme", "tri", "imp", "rei", "art", "rxi", "sis", "_li", "aut", "emo", "eti", "xiu", "ros", "dar", "ler", "dic", "mbr", "sec", "uit", "arr", "omb", "ote", "_af", "ive", "fec", "var", "ana", "ate", "ial", "_ut", "uti", "ren", "orn", "ene", "gen", "ova", "rip", "_ig", "rup", "usi", "_mé", "roc", "uer", "sob", "uta", "ava", "cod", "llo", "mit", "_av", "end", "spo", "cta", "fus", "obt", "_nú", "núm", "ou_", "_as", "_pu", "atu", "ile", "nen", "afe", "its", "mes", "ree", "tam", "oc_", "aç_", "ege", "_té", "det", "ngu", "apl", "vol", "up_", "_id", "aba", "lti", "nir", "rca", "enl", "oni", "acc", "reu", "sol", "dat", "mal", "nda", "nll", "rov", "rit", "ltr", "bui", "bal", "erò", "mid", "pen", "rò_", "_ho", "etr", "ram", "úme", "ese", "cer", "col", "ern", "iur", "ota", "alm", "cur", "_ni", "feg", "ja_", "ixi", "arb", "let", "uda", "ça_", "tiu", "mot", "rmi", "sca", "seu", "ul_", "lan", "pra", "gru", "ito", "efi", "_ce", "arc", "ata", "nac", "sos", "tit", "sel", "ang", "ard", "isp", "me_", "mp_", "req", "ms_", "ogr", "il_", "itu", "pte", "ck_", "rbr", "tiq", "atr", "_nu", "aix", "esa", "ipt", "rà_", "sh_", "gis", "gin", "sim", "amí", "din", "ece", "rop", "ans", "dul", "gno", "rog", "ps_", "lad", "bte", "mí_", "ole", "rqu", "_at", "rel", "rig", "ús_", "_ja", "tex", "ead", "ner", "tad", "apa", "ear", "red", "unc", "_ch", "_et", "lt_", "ni_", "tàn", "lme", "nad", "nec", "opi", "and", "ce_", "cs_", "xt_", "yal", "_x_", "ges", "ill", "ite", "tim", "ecl", "mem", "çal", "lin", "apç", "ng_", "pça", "upr", "che", "gei", "nit", "ult", "_ús", "güe", "lta", "ch_", "fix", "abi", "ope", "rés", "spa", "cid", "dep", "dia", "eco", "iab", "mas", "rol", "sar", "mpt", "oin", "_ei", "_om", "bin", "_oc", "bil", "zac", "rev", "ama", "tru", "urs", "fun", "ove", "clo", "ega", "len", "oms", "ots", "im_", "ack", "ein", "ubm", "uci", "di_", "spr", "uan", "use", "ànd", "epo", "pré", "_c_", "bri", "not", "ute", "nco", "ad_", "nou", "cés", "rvi", "ede", "epa", "lac", "_p_", "bar", "bol", "eda", "exc", "nté", "dei", "sin", "_f_", "hel", "ian", "ne_", "nça", "òri", "ed_", "iat", "lon", "ón_", "coi", "uto", "xpr", "aul", "rra", "_sa", "_só", "inv", "nie", "són", "rpr", "_mà", "adm", "hab", "nve", "_pi", "bé_", "lei", "mòd", "últ", "ocu", "teu", "òdu", "but", "inu", "_t_", "iet", "rir", "_du", "ban", "rav", "vid", "ped", "bit", "dit", "ff_", "ido", "pia", "púb", "sic", "úbl", "_pl", "tio", "cle", "oss", "cum", "mps", "ral", "ric", "rn_", "ras", "rio", "rom", "tac", "don", "mbo", "nov", "oct", "_sh", "_vi", "erc", "ixa", "olu", "ong", "pac", "daç", "rte", "_ur", "tif", "uri", "doc", "hea", "ig_", "bmò", "ept", "ya_", "_fl", "emò", "imb", "lir", "pt_", "uin", "nce", "squ", "vos", "_fr", "_ra", "are", "deb", "epe", "mòr", "ore", "aus", "ga_", "lte", "ry_", "eso", "uni", "cep", "mei", "ron", "uid", "xid", "_mu", "aça", "_aj", "pie", "rib", "üen", "elp", "vel", "za_", "rl_", "sum", "ís_", "ala", "nne", "num", "ux_", "age", "ai_", "ua_", "ule", "leu", "oce", "rer", "ior", "sep", "bor", "epú", "iff", "rin", "adr", "am_", "ncl", "set", "uei", "zer", "egü", "rce", "aju", "cop", "duc", "ea_", "hor", "pas", "ueu", "_ai", "cul", "ego", "fra", "ibu", "ict", "ixe", "ols", "rge", "ve_", "àti"},
+	"cs": {"_ne", "ní_", "_po", "_př", "_pr", "je_", "sou", "_na", "_so", "_se", "pro", "na_", "oub", "bor", "ubo", "ení", "_je", "_vy", "sta", "ze_", "pře", "ová", "_za", "ván", "ný_", "né_", "se_", "ova", "_ch", "ání", "at_", "ch_", "ce_", "rov", "_od", "chy", "or_", "uje", "hyb", "_do", "_st", "it_", "no_", "vat", "pou", "ro_", "zna", "ké_", "_v_", "ho_", "při", "ost", "uži", "ou_", "pod", "neb", "_a_", "_kl", "pří", "lze", "lo_", "ent", "kon", "ru_", "_ko", "nel", "_ná", "oru", "stu", "elz", "_ve", "le_", "res", "te_", "líč", "lat", "ky_", "ná_", "_ba", "to_", "_s_", "ouž", "cí_", "men", "_vý", "_re", "em_", "nep", "ba_", "klí", "kaz", "nen", "ské", "nač", "en_", "ast", "tel", "ých", "atn", "_ad", "tav", "ate", "ka_", "_ar", "ku_", "ový", "ebo", "slo", "adr", "_zn", "pla", "tup", "dre", "bo_", "ny_", "_ro", "_in", "odp", "řep", "_ob", "str", "yba", "vyp", "vol", "_ja", "pis", "zen", "ři_", "_sp", "tu_", "ého", "ter", "ína", "_zá", "ver", "lov", "nov", "ové", "pín", "et_", "st_", "byl", "prá", "epí", "hod", "nak", "dno", "van", "nam", "vý_", "_pa", "ek_", "_al", "_li", "_sy", "bal", "_ma", "řen", "odn", "dat", "tí_", "če_", "ako", "ick", "ina", "ist", "sti", "ty_", "ta_", "ím_", "for", "řád", "ko_", "_da", "měn", "jak", "sel", "oče", "íka", "řík", "_no", "_řá", "por", "esá", "pov", "áze", "ak_", "alí", "náz", "čís", "án_", "lož", "nas", "orm", "ace", "led", "ume", "ově", "raz", "mu_", "epl", "_ce", "_už", "sář", "iva", "dpo", "ry_", "ně_", "pra", "ící", "lik", "ran", "živ", "_sk", "zad", "_de", "tov", "la_", "az_", "_čí", "tin", "nt_", "alo", "ale", "ráv", "li_", "poč", "eno", "_ho", "_by", "dov", "áno", "že_", "not", "ten", "_fo", "oku", "ezn", "_to", "íst", "ech", "nos", "řed", "roz", "dní", "kov", "ti_", "lic", "do_", "mén", "_zp", "edn", "de_", "žit", "lík", "id_", "aný", "vyt", "pol", "_ta", "_z_", "pos", "ven", "_si", "arg", "vé_", "jíc", "ač_", "čen", "tra", "ytv", "_mo", "elh", "tný", "ísl", "še_", "sah", "_n_", "čas", "by_", "ont", "_jm", "vá_", "_ka", "_te", "ali", "sle", "zí_", "cho", "ign", "ave", "lha", "ci_", "tní", "ele", "am_", "er_", "ev_", "hal", "oro", "nou", "ká_", "_bu", "_me", "_sl", "_o_", "ert", "ifi", "ovo", "žád", "íč_", "bra", "mi_", "_zm", "est", "len", "ádk", "čet", "nýc", "_co", "dán", "eze", "nez", "obr", "jmé", "změ", "ací", "odk", "rac", "ují", "tor", "_k_", "spo", "íče", "ače", "bud", "oto", "zev", "klá", "lní", "_lo", "_he", "šti", "jed", "_op", "_žá", "fik", "cké", "žad", "ati", "poz", "tro", "ího", "obs", "pok", "es_", "ena", "ins", "_vo", "ích", "ve_", "ádn", "ste", "nte", "ění", "tvo", "áln", "gum", "rgu", "ec_", "kte", "tif", "vní", "výc", "sku", "_be", "ces", "sto", "ít_", "tuj", "kód", "yst", "ika", "poj", "_ak", "up_", "_ty", "oli", "pin", "tal", "voř", "výs", "íše", "_sh", "akt", "ite", "su_", "ním", "typ", "píš", "tů_", "ané", "olo", "ktu", "lu_", "ram", "át_", "jso", "áva", "ede", "ený", "onč", "kát", "ves", "yl_", "and", "bez", "zná", "arc", "ode", "ros", "ček", "zpr", "_ji", "ata", "ovn", "rav", "sys", "den", "mís", "ém_", "_ex", "avi", "ne_", "tar", "ypí", "ame", "iká", "ods", "_kt", "_ur", "epo", "ečn", "nal", "nám", "řet", "ory", "rom", "ým_", "_kó", "bsa", "erz", "vu_", "ků_", "ává", "vst", "_js", "ené", "jen", "nit", "ší_", "věř", "ada", "el_", "ská", "ář_", "čte", "_ov", "azy", "eli", "rch", "sko", "_bý", "_di", "dst", "mát", "nst", "eby", "ole", "dka", "ser", "ude", "ýt_", "být", "něn", "pli", "upi", "_vs", "pu_", "ýst", "hel", "tém", "upn", "_va", "cer", "láv", "stn", "_pl", "kup", "chi", "adá", "esl", "nsk", "uží", "žij", "_ře", "ud_", "néh", "sté", "áve", "mac", "rmá", "met", "rti", "_ča", "vel", "oje", "sez", "sig", "exi", "ext", "_id", "éno", "tic", "má_", "níh", "ybn", "lou", "aze", "dný", "ouz", "ráz", "vyž", "zov", "iko", "náv", "ožn", "_hl", "ato", "nor", "jí_", "ký_", "sov", "is_", "ají", "eln", "_ap", "xis", "aro", "dek", "int", "nu_", "ota", "áře", "isu", "nut", "řes", "dpi", "rou", "rů_", "ahu", "ití", "ožk", "al_", "eká", "rat", "roj", "roc", "ejn", "gra", "tab", "yža", "kom", "oce", "ajt", "ard", "baj", "du_", "hla", "mez", "ado", "duj", "etě", "tiv", "těz", "dro", "žen", "liz", "mus", "tex", "rve", "ční", "aci", "azu", "ici", "ara", "tře", "ému", "in_", "_zo", "tat", "va_", "_ot", "děl", "lok", "ove", "tan", "_fi", "_čt", "kud", "rit", "var", "orů", "ví_", "cov", "ed_", "inf", "rma", "káv", "mož", "rát", "_sm", "dy_", "nfo", "uze", "_mí", "hes", "on_", "ód_", "dné", "esk", "hoz", "lez", "neo", "ut_", "erv", "vac", "výr", "_zd", "ačn", "ly_", "nem", "omp", "_vš", "hov", "jte", "od_", "_ze", "ell", "lin", "liš", "vše", "ště", "dvo", "hiv", "lsk", "teč", "zob", "_mi", "nto", "_ig", "_nu", "ije", "tit", "olb", "omo", "nes", "obn", "onf", "ože", "tri", "zac", "zor", "ným", "osl", "uto", "_i_", "one", "ozí", "gno", "she", "tom", "huj", "nic", "rep", "mat", "psa", "tné", "ern", "ote", "rán", "_ke", "ete", "íli", "_au", "sob", "nda", "nás", "ogr", "sí_", "ala", "ažd", "fil", "kaž", "las", "nál", "ozn", "dan", "dos", "ylo", "zap", "_mu", "_u_", "dí_", "véh", "ře_", "ll_", "pre", "říz", "ilo", "nej", "ntr", "ísk", "_uk", "_vl", "_zí", "aví", "dok", "par", "rol", "ást", "ěře", "šec", "rze", "zís", "dar", "ile", "lad", "per", "rní", "usí", "dku", "imp", "nec", "ori", "sla", "řit", "šen", "aut", "rob", "sa_", "říl", "iš_", "můž", "vy_", "zi_", "zu_", "zy_", "ůže", "kos", "lit", "rl_", "_vz", "pri", "upu", "ez_", "lán", "mov", "oho", "ruj", "ík_", "_an", "ope", "rog", "tev", "ány", "re_", "ivn", "níc", "oko", "pom", "_su", "aco", "des", "lem", "out", "_sc", "_čá", "nul", "omě", "ved", "áto", "_bi", "_má", "inu", "tná", "řil", "iza", "net", "prv", "urč", "ort", "rot", "íku", "amu", "ázv", "era", "ice", "rdn", "ušt", "áde", "ýra", "nfi", "ore", "blo", "con", "vra", "_fu", "ie_", "isk", "ket", "ouč", "oře", "dle", "fig", "_im", "těn", "dař", "iv_", "pon", "sym", "chn", "da_", "pot", "tua", "zdn", "ána", "ázd", "odd", "ual", "čás", "azí", "eré", "esu", "ust", "vit", "čí_", "čů_", "aři", "bli", "lav", "spu", "xt_", "zec", "átu", "etr", "fun", "ji_", "nti", "ock", "oři", "cíl", "dir", "kce", "pam", "_cí", "_kr", "ami", "asn", "fro", "us_", "ěnn", "ěze", "bol", "hle", "me_", "edo", "igu", "kac", "pat", "ský", "zdr", "kem", "mpl", "bí_", "cit", "esa", "moc", "po_", "rip", "ubl", "žív", "_zk", "gur", "tno", "mbo", "pt_", "_dv", "aní", "eru", "min", "nta", "za_", "ám_", "_pi", "hra", "rý_", "ymb", "_us", "ese", "ide", "kou", "lby", "pub", "sy_", "ura", "_kd", "_ví", "ind", "víc", "_vr", "evř", "iso", "oda", "vou", "řip", "chá", "dá_", "kum", "rsk", "ávr", "íce", "_t_", "ack", "dlo", "gná", "hu_", "kdy", "ré_", "_ni", "_uv", "aná", "ekt", "kol", "lné", "nat", "pní", "yp_", "ačí", "bný", "ezp", "loh", "spr", "ybí", "_e_", "_p_", "_tr", "adu", "ang", "ani", "ená", "eži", "il_", "již", "ném", "ska", "alt", "aně", "dou", "epu", "itn", "tej", "_ca", "_d_", "_la", "_x_", "ddě", "ion", "loc", "stř", "íze", "řít", "_c_", "_mů", "ere", "iž_", "kla", "nee", "sat", "stí", "tak", "ulo", "war", "cký", "eex", "jin", "nce", "unk", "zav", "_če"},
+	"da": {"er_", "et_", "en_", "kke", "ke_", "for", "ikk", "_fo", "_ik", "ing", "ere", "til", "_de", "nde", "_ti", "il_", "de_", "ter", "_in", "or_", "_af", "der", "fil", "ler", "_fi", "_er", "lle", "_me", "ed_", "re_", "ver", "ng_", "es_", "ne_", "ind", "_st", "_en", "_ko", "ent", "and", "ste", "end", "_ka", "sta", "sk_", "te_", "_i_", "den", "_ud", "ret", "an_", "ger", "af_", "ion", "ive", "_br", "nge", "at_", "nte", "rug", "bru", "_re", "tte", "ede", "se_", "ang", "og_", "gen", "med", "kan", "al_", "men", "ers", "le_", "_ve", "om_", "tal", "els", "lin", "_sk", "skr", "und", "rin", "_ma", "ell", "dig", "_so", "on_", "isk", "eri", "lse", "det", "nin", "_an", "kri", "mme", "lig", "tio", "ker", "_op", "nne", "ata", "_at", "_fe", "_li", "ati", "del", "ejl", "fej", "ig_", "_pa", "_un", "pro", "_og", "el_", "_pr", "_el", "ren", "ile", "str", "kun", "_ku", "kom", "yld", "ern", "ken", "dat", "st_", "ldi", "som", "_ad", "gle", "gt_", "tet", "_vi", "gyl", "ge_", "all", "nav", "avn", "_fr", "rer", "uge", "_sy", "jl_", "man", "_al", "ngs", "giv", "ser", "_si", "riv", "nd_", "ven", "_se", "_et", "res", "vis", "ndt", "_ar", "_ta", "eks", "_ug", "_på", "_te", "_sa", "ska", "dt_", "kal", "ort", "des", "egn", "ill", "ugy", "_be", "vær", "på_", "kon", "pe_", "mat", "nt_", "tan", "mer", "len", "lde", "ett", "teg", "ved", "nøg", "øgl", "iv_", "val", "var", "igt", "is_", "_fl", "ske", "orm", "ove", "fra", "ngi", "ra_", "_mi", "nst", "ens", "_nø", "ist", "nsk", "age", "ige", "ar_", "unn", "afs", "sti", "rel", "jer", "ner", "tre", "omm", "dre", "_bl", "_hv", "_væ", "id_", "kat", "vet", "ug_", "int", "lag", "rne", "_ha", "pak", "rma", "sel", "akk", "dsk", "lt_", "ode", "inj", "_da", "nje", "_ge", "_na", "vn_", "log", "rsk", "ppe", "fin", "ert", "_he", "lem", "ont", "rt_", "ve_", "rog", "alg", "lok", "_læ", "red", "one", "kti", "_lo", "hed", "typ", "ype", "stø", "ign", "rst", "sni", "amm", "ndr", "_no", "sse", "gn_", "_gr", "ins", "tat", "ess", "ore", "sen", "est", "pre", "sym", "dst", "nta", "ta_", "lut", "ble", "elt", "_nu", "ndo", "gra", "tor", "_di", "ram", "ume", "eli", "rse", "sam", "ide", "ift", "me_", "mma", "ag_", "let", "slu", "_mo", "get", "ons", "fla", "_bi", "tem", "ate", "rsi", "_ov", "_om", "ars", "mbo", "opr", "uds", "bol", "met", "old", "it_", "kod", "ast", "_kr", "in_", "rdi", "ard", "bli", "sio", "gan", "ark", "nda", "omp", "rte", "tiv", "ten", "ekt", "ude", "ymb", "ans", "_ek", "_po", "_x_", "lad", "_sp", "tid", "nor", "ns_", "cer", "dva", "hol", "em_", "_va", "eme", "rd_", "_n_", "lan", "alt", "_ty", "nds", "eng", "sæt", "_co", "_uk", "_sl", "dar", "fik", "tek", "ant", "ils", "vne", "ara", "lis", "_to", "arg", "læs", "mel", "reg", "sko", "_la", "adv", "_du", "min", "ærd", "ele", "fte", "før", "un_", "æng", "ski", "gru", "ifi", "elo", "har", "oke", "rki", "rif", "_fu", "alo", "app", "tab", "_tr", "per", "enn", "esk", "hvi", "uke", "_su", "ogr", "tar", "tis", "kst", "tag", "nit", "_ba", "erv", "ine", "_do", "ænd", "ted", "bel", "gst", "ut_", "num", "mod", "nkt", "_fø", "par", "nke", "ære", "ene", "rve", "ked", "lla", "ran", "fje", "mis", "spr", "_fj", "ord", "tas", "rat", "fer", "por", "rre", "sys", "_gi", "_id", "abe", "gel", "ndl", "nen", "læn", "try", "kræ", "upp", "yst", "dga", "ft_", "net", "tes", "du_", "vil", "kt_", "lg_", "sis", "akt", "rup", "ves", "tro", "lat", "æve", "fsl", "han", "nfo", "ræv", "lva", "lst", "ela", "kil", "ryk", "sto", "je_", "led", "ses", "art", "ol_", "sek", "sig", "atu", "lli", "pri", "us_", "_ki", "_ny", "era", "nal", "_ca", "ilv", "ks_", "lge", "ade", "ato", "ér_", "tør", "eho", "efi", "ari", "tur", "_æn", "lev", "tni", "sid", "ame", "inf", "pen", "ika", "ali", "am_", "hen", "_by", "_må", "fun", "unk", "pos", "adg", "ale", "ors", "lik", "_go", "ids", "_ef", "_ne", "byt", "kiv", "ykk", "are", "ce_", "eve", "nli", "_ig", "ntr", "ase", "ces", "dda", "ite", "rke", "isl", "ør_", "eds", "ges", "yte", "eft", "ild", "tra", "rol", "yk_", "bes", "æse", "øre", "ina", "kel", "mal", "rti", "lsk", "to_", "tri", "bin", "efe", "roc", "utt", "tøt", "do_", "fsn", "øtt", "di_", "igh", "ørr", "dte", "dli", "ime", "ost", "ink", "lyk", "adr", "ald", "ref", "rn_", "spe", "gno", "nes", "udt", "um_", "oce", "ket", "ld_", "ll_", "_ap", "gge", "iti", "rgu", "tel", "dis", "sik", "def", "gum", "hel", "rep", "_ch", "gna", "umm", "enl", "ghe", "ien", "ivn", "æt_", "sly", "die", "ete", "gsk", "mpo", "nce", "onf", "udl", "ave", "dle", "nul", "rit", "_ro", "ad_", "ass", "kor", "map", "vid", "_pi", "emm", "ori", "ubl", "_bo", "_us", "din", "lon", "ri_", "tru", "bas", "deh", "tin", "ørs", "hve", "ice", "eci", "tom", "dir", "les", "enc", "ema", "oge", "rea", "_f_", "edi", "fle", "år_", "_ga", "bil", "eta", "opd", "yde", "bag", "dde", "dlø", "dtr", "ria", "san", "_s_", "fre", "god", "ilb", "jek", "søg", "che", "kab", "kum", "ngl", "tif", "tus", "epu", "ire", "lba", "nat", "ndi", "nse", "ev_", "pec", "tig", "ur_", "_bu", "ana", "ier", "mar", "iln", "pun", "ærk", "hån", "oku", "ånd", "op_", "sin", "_fa", "lte", "pub", "_ho", "pda", "løb", "enh", "odk", "tak", "_sæ", "dok", "lna", "nfi", "_pe", "dke", "eti", "imp", "ini", "ols", "pt_", "_au", "_im", "_kø", "føl", "kør", "rem", "igu", "loc", "att", "ris", "ølg", "ber", "fig", "liv", "mul", "set", "sfi", "så_", "ænk", "beh", "gur", "_ra", "fel", "ik_", "ope", "_le", "kif", "ndd", "oll", "con", "doe", "od_", "rig", "vel", "sst", "ktu", "mak", "nam", "lta", "mål", "rva", "_mu", "dta", "ege", "_ni", "gne", "mon", "orv", "aks", "gni", "_ak", "_gy", "_r_", "kte", "nti", "_c_", "aut", "ck_", "ul_", "dri", "ogs", "out", "rek", "erh", "ffe", "gis", "ia_", "na_", "øde", "_ob", "_pl", "_så", "bar", "nga", "nhe", "syn", "kse", "ny_", "pon", "top", "ank", "blo", "uel", "øns", "_or", "ala", "bet", "da_", "dni", "hov", "ilg", "nsp", "rim", "_ce", "ima", "off", "sh_", "abi", "ma_", "ian", "ise", "orb", "_nå", "_t_", "erl", "ilf", "rhe", "sle", "th_", "_lu", "ock", "pla", "vor", "war", "_e_", "gde", "oka", "ult", "_d_", "_åb", "ese", "ksi", "ætt", "ak_", "ani", "ena", "ple", "åbn", "ned", "obj", "ruk", "_sh", "cif", "olo", "opi", "_hu", "_wi", "esi", "ica", "præ", "rip", "udf", "ura", "utn", "_ke", "bje", "ect", "gns", "kop", "spo", "_hj", "agt", "rad", "rg_", "rib", "run", "sor", "egi", "ekv", "igs", "kve", "vir", "_hå", "dek", "irk", "når", "uff", "uve", "beg", "fic", "la_", "ngd", "obl", "ork", "rbi", "rli", "rom", "ud_", "_l_", "bit", "ch_", "eau", "edd", "niv", "nve", "ogi", "ssi", "_a_", "adt", "gsf", "kol", "nog", "ts_", "ure", "æns", "_ex", "_uv", "ami", "mpl", "mær", "ole", "oli", "rna", "tch", "tim", "ukt", "afi", "dan", "lti", "nis", "olk", "ong", "rs_", "ryd", "au_", "cen", "føj", "græ", "jen", "lit", "no_", "pan", "gin", "lp_", "luk", "oer", "put", "ry_", "vea", "øje", "_jo", "ash", "ili", "løs", "nu_", "ti_", "_ru", "ffi", "rak", "tol", "uri", "ytt", "cha", "erf", "kla", "kre", "lic", "rev", "rk_", "uni", "uto", "aba", "bne", "erb", "lyd", "pat", "hæn", "iks", "nto", "æld", "_ur", "ful", "lke", "pil", "rce", "rum", "_ub", "fri", "ls_", "mas"},
+	"de": {"en_", "er_", "ich", "sch", "_de", "ein", "der", "cht", "che", "ung", "den", "te_", "_be", "es_", "ver", "ht_", "_da", "ch_", "_ni", "_au", "nde", "ie_", "nic", "_un", "ate", "_di", "in_", "on_", "_ei", "dat", "_ve", "die", "gen", "_in", "ng_", "ten", "ert", "ben", "ier", "_we", "nte", "zei", "ist", "rde", "tei", "_an", "ter", "ine", "it_", "st_", "_si", "rt_", "ion", "ers", "_ge", "ste", "ere", "ent", "isc", "_vo", "wer", "end", "nge", "eic", "ren", "_ko", "_zu", "nen", "ehl", "feh", "ne_", "_er", "hen", "aus", "_fe", "_re", "ei_", "nd_", "ige", "sse", "le_", "_is", "_pa", "tio", "eit", "chl", "mit", "erd", "_fü", "ang", "sie", "men", "ber", "et_", "und", "_mi", "für", "ür_", "_wi", "auf", "_ke", "sta", "bei", "ell", "_ka", "ann", "kan", "and", "_sc", "de_", "hle", "von", "_st", "_ze", "abe", "_al", "len", "nn_", "geb", "des", "rei", "ebe", "ese", "tig", "ern", "_se", "kei", "kon", "ge_", "ges", "rte", "sen", "_na", "ler", "nnt", "_ma", "im_", "sel", "lle", "ame", "run", "nis", "rd_", "erz", "_en", "wen", "hre", "_pr", "ind", "lis", "_ar", "he_", "erw", "ach", "nam", "rze", "for", "ati", "her", "_co", "lti", "lte", "ode", "lic", "üss", "as_", "nt_", "wir", "el_", "em_", "eru", "uf_", "ült", "gül", "chn", "se_", "re_", "zu_", "_op", "alt", "tze", "ing", "lüs", "_le", "hlü", "um_", "das", "_ab", "_me", "ile", "ket", "ird", "ite", "all", "an_", "tel", "ur_", "pti", "us_", "_no", "one", "ege", "me_", "gab", "ls_", "lt_", "ies", "eil", "chr", "_ta", "war", "ien", "rst", "unt", "esc", "ort", "eim", "opt", "usg", "ger", "ass", "zen", "_bi", "_od", "_nu", "vor", "ens", "rwe", "_gi", "al_", "at_", "ngü", "is_", "mat", "onn", "chi", "enn", "pro", "hni", "tzt", "est", "_so", "fer", "_ha", "omm", "nut", "utz", "orm", "ner", "akt", "_fo", "enu", "ign", "int", "be_", "etz", "übe", "age", "_um", "mer", "_ak", "hal", "art", "ser", "set", "als", "_üb", "ali", "ene", "git", "ess", "efe", "spe", "anz", "rma", "hl_", "les", "tie", "nst", "tet", "rie", "_wa", "ran", "eig", "tte", "mme", "änd", "lge", "wei", "_sp", "its", "_gr", "kom", "_li", "geg", "res", "ete", "ins", "ngs", "ake", "tes", "tra", "ll_", "ord", "_ne", "_ba", "gt_", "tan", "fun", "lie", "ts_", "gef", "rch", "uch", "_wu", "ekt", "_im", "era", "zer", "nga", "pak", "wur", "urd", "zt_", "ume", "nze", "tat", "rsc", "tor", "nor", "com", "sio", "_sy", "gel", "ele", "rsi", "sig", "spr", "itt", "_ch", "ori", "erf", "erh", "ara", "nac", "ech", "str", "dar", "_te", "ar_", "lag", "ktu", "sge", "_la", "_su", "erg", "_sa", "atu", "or_", "_ex", "ck_", "erl", "ale", "det", "eib", "err", "rbe", "ss_", "ast", "eie", "ini", "nda", "ede", "_es", "oll", "rn_", "_ob", "_he", "sti", "cha", "isi", "lau", "_mo", "ig_", "nne", "kti", "_hi", "rge", "ahl", "sin", "hes", "ühr", "fen", "han", "ina", "füh", "hin", "arg", "kt_", "neu", "ari", "_lo", "eld", "rti", "mmi", "pas", "wor", "rne", "uel", "nun", "iti", "_br", "_mu", "hla", "tem", "erb", "man", "ntr", "mod", "rha", "dem", "mal", "zah", "arb", "ard", "bar", "pri", "tiv", "lin", "ifi", "zie", "nur", "nfo", "rec", "onf", "lan", "rat", "lei", "_do", "dun", "are", "bef", "id_", "_tr", "_fi", "wie", "tur", "ken", "ont", "ütz", "enz", "_fa", "ad_", "erk", "ons", "ibe", "per", "ück", "lun", "tre", "ref", "ric", "cke", "rüc", "ani", "iel", "stü", "eme", "inf", "odu", "rea", "tri", "rep", "amm", "sei", "zum", "_n_", "nal", "gli", "nie", "pei", "rag", "bes", "bra", "gum", "tas", "_du", "sam", "urc", "typ", "nat", "rgu", "rla", "tal", "pat", "fol", "nes", "bin", "am_", "erv", "mus", "hlg", "hte", "ntf", "tüt", "lös", "omp", "rin", "tfe", "tar", "ble", "eri", "sga", "ndi", "igu", "_qu", "gna", "nch", "ika", "nbe", "rac", "äng", "ack", "anc", "arc", "eue", "olg", "ext", "llt", "par", "bek", "_sh", "ide", "aut", "fal", "bje", "_ad", "_po", "bit", "gra", "hne", "ala", "tim", "ade", "eis", "con", "sis", "zur", "_än", "elt", "obj", "att", "ive", "tch", "jek", "egi", "bun", "ruf", "sic", "rwa", "ndu", "sit", "ote", "tis", "que", "ppe", "ram", "uss", "tab", "pos", "kat", "efu", "füg", "unb", "num", "tun", "_za", "vie", "dur", "mar", "no_", "eka", "prü", "net", "eng", "ore", "leg", "rve", "och", "ce_", "chs", "tif", "_ca", "_ho", "ehe", "pfa", "ban", "nta", "hri", "ld_", "gno", "na_", "osi", "ry_", "hei", "om_", "tli", "suc", "zug", "dig", "rit", "zus", "ra_", "la_", "tsc", "fig", "ssw", "ual", "ns_", "ied", "_ig", "fil", "ri_", "igt", "sh_", "_pi", "aub", "hel", "nfi", "ock", "bel", "sys", "nsp", "pe_", "min", "gru", "nti", "ve_", "ant", "rse", "gur", "_bu", "atc", "nit", "reg", "las", "ana", "eug", "pac", "kte", "ehr", "yp_", "exi", "_bl", "ag_", "gan", "tua", "lat", "iff", "nzu", "abl", "arn", "rüf", "_kö", "yst", "il_", "met", "bt_", "lem", "_id", "eer", "pal", "swo", "tag", "ex_", "ito", "ndo", "ria", "ft_", "kön", "lik", "ett", "lli", "_to", "por", "lee", "ösc", "_ro", "rer", "rna", "ela", "ta_", "fik", "umm", "_ga", "ena", "ead", "mbo", "ust", "rs_", "ink", "tex", "zeu", "emo", "hat", "nwe", "fad", "mel", "zwi", "_ti", "uge", "_kr", "bli", "nth", "oli", "rem", "önn", "fin", "eln", "fra", "gin", "upp", "ma_", "rfo", "bas", "ut_", "meh", "ong", "pra", "ura", "nem", "rnu", "_vi", "ds_", "epo", "kal", "gew", "rup", "wäh", "öff", "loc", "pen", "san", "inz", "_mö", "_zi", "ga_", "emp", "ffe", "erm", "pre", "bis", "yte", "_pf", "dex", "_s_", "bol", "ima", "xis", "enb", "hie", "mpo", "byt", "chu", "unk", "oze", "_je", "_us", "ash", "ute", "zte", "ibu", "dir", "oni", "tek", "iss", "lls", "nk_", "ian", "_fr", "eta", "imi", "uer", "wis", "_zw", "ank", "_ty", "ke_", "sym", "ufe", "zes", "_va", "dus", "ed_", "_or", "dre", "geh", "mög", "sol", "ögl", "hiv", "hlt", "ruc", "uri", "_a_", "mma", "hua", "rau", "rre", "iv_", "nkt", "ezi", "fel", "ral", "zun", "ase", "_by", "bge", "izi", "xt_", "eut", "ire", "org", "ia_", "ild", "sve", "inc", "rig", "esi", "ope", "ssi", "_ap", "inn", "roz", "kop", "_gü", "ata", "ogr", "abg", "ec_", "umb", "_ih", "da_", "spa", "var", "_pu", "adr", "ln_", "use", "alb", "usa", "imm", "lde", "ue_", "_bo", "ik_", "_ku", "_lö", "blo", "gis", "ivi", "let", "nza", "ama", "bil", "ses", "gs_", "lsc", "hli", "llu", "not", "ris", "äre", "aga", "etr", "ost", "rog", "_ki", "ail", "gun", "ka_", "beg", "fli", "hab", "hr_", "nts", "ufr", "_ur", "ain", "del", "aft", "wan", "ymb", "_wo", "ato", "mie", "usf", "os_", "ält", "ema", "_e_", "süd", "pie", "tro", "ähl", "_am", "_sü", "nke", "rom", "sub", "tt_", "get", "ieb", "tzu", "hän", "mon", "sfü", "_d_", "dul", "ili", "kar", "_c_", "rif", "wes", "anw", "erp", "fne", "fru", "ohn", "th_", "_oh", "_el", "ffn", "grö", "itu", "pel", "ro_", "gem", "log", "nan", "stl", "bee", "edi", "haf", "the", "to_", "tue", "ni_", "rsp", "ial", "kis", "oma", "ans", "out", "röß", "une", "ora", "ise", "she", "ai_", "cod", "gle", "rda", "_kl", "een", "gre", "inh", "nba", "rbi", "öße", "ory", "pt_", "dis", "gri", "oka", "ars", "ihr", "mbe", "tai", "rek", "enk", "tus", "auc", "bre", "gro", "nsc", "jed", "ff_", "häl", "ua_", "elp", "tin", "uto", "ze_", "ßer", "urü", "_at", "_fu", "hol", "_as"},
+	"el": {"_το", "ου_", "το_", "ση_", "αι_", "ης_", "_απ", "ος_", "_δε", "να_", "_αρ", "_κα", "του", "ία_", "_αν", "ας_", "δεν", "ει_", "εν_", "ρχε", "μα_", "_στ", "ικό", "_πρ", "_τη", "ων_", "αρχ", "ια_", "_δι", "ματ", "στο", "_με", "τικ", "_επ", "κό_", "μέν", "χεί", "_να", "_συ", "_η_", "_πα", "σης", "_υπ", "γρα", "_εί", "τε_", "στη", "ίνα", "ής_", "κατ", "είν", "ναι", "είο", "ιστ", "για", "τα_", "ηση", "_γι", "οπο", "προ", "δια", "απο", "ται", "επι", "τη_", "_χρ", "ην_", "_εν", "χει", "υπο", "ραφ", "την", "νο_", "εί_", "λογ", "ανα", "ού_", "ετα", "ρισ", "της", "ική", "ές_", "με_", "αν_", "_μη", "ατο", "αρα", "υνα", "αλλ", "δυν", "ών_", "κή_", "_κλ", "τος", "_αδ", "ίο_", "μη_", "από", "ένο", "ες_", "σε_", "_αλ", "ατά", "_σε", "_πο", "ατι", "κά_", "μεν", "στε", "ός_", "παρ", "ομα", "πό_", "και", "ναμ", "ίας", "ποι", "_έγ", "περ", "ισμ", "των", "ίου", "ικά", "_δη", "_πε", "λει", "όνο", "ιο_", "στα", "ωση", "νατ", "_τα", "ικο", "ατα", "ημα", "_πλ", "δημ", "ερι", "κυρ", "πιλ", "τή_", "ένα", "νομ", "νικ", "δικ", "ειδ", "ηκε", "ακέ", "ετε", "κέτ", "αυτ", "γή_", "συν", "μετ", "κε_", "ποτ", "κλε", "ρήσ", "it_", "έχε", "εργ", "πακ", "_μπ", "που", "ρακ", "θηκ", "λλα", "ολή", "_αυ", "_σφ", "αση", "μία", "αφή", "λμα", "χρή", "φάλ", "σφά", "άλμ", "τυχ", "ουρ", "αμί", "τον", "εση", "σία", "τερ", "σιμ", "_gi", "στή", "ανά", "λικ", "ντο", "ραμ", "_εγ", "τρο", "git", "ιλο", "ον_", "λή_", "_εκ", "αρι", "ις_", "ορι", "ρησ", "γγρ", "τασ", "μή_", "ρα_", "αμμ", "_έχ", "αδυ", "αντ", "ρο_", "ήστ", "ασί", "έγκ", "γκυ", "_γρ", "ιμο", "αφο", "σμέ", "γνω", "τήρ", "_ή_", "φορ", "_ο_", "κού", "ολο", "νωσ", "κτρ", "συμ", "φή_", "ιση", "_όν", "_re", "_δυ", "_ει", "ογή", "_έν", "_μα", "εντ", "λαγ", "ποί", "άστ", "σει", "τολ", "βολ", "er_", "_σύ", "_τω", "πορ", "ακτ", "τά_", "_τι", "γασ", "ταν", "τεί", "_co", "_ορ", "οίη", "υργ", "_μι", "ίησ", "μπο", "καν", "ργα", "υρο", "ροσ", "θεί", "ντα", "ογρ", "πισ", "πολ", "τησ", "γκα", "είτ", "ιου", "πει", "_χα", "χρη", "οι_", "μισ", "σα_", "ρά_", "τοπ", "ίνε", "_εξ", "ντι", "πάρ", "άγν", "εκτ", "έτο", "ρέπ", "ως_", "_κε", "έπε", "ρικ", "τάσ", "_μέ", "νει", "ρου", "ενο", "ησι", "εδο", "ποσ", "τρέ", "πλή", "ακο", "μός", "οδο", "χαρ", "_τε", "ημο", "γές", "ημε", "μοπ", "ενό", "ους", "υς_", "ατί", "λεί", "ήμα", "ορε", "υτό", "ιδι", "γία", "υση", "χία", "διε", "ημέ", "ημι", "κτή", "νου", "ομέ", "ριθ", "νη_", "άρχ", "ιθμ", "πλη", "ίστ", "ουν", "λου", "τό_", "υπά", "_κο", "ορί", "_βρ", "υχί", "διο", "εγκ", "μιο", "μερ", "ύνα", "_σα", "οτυ", "νων", "στι", "γλώ", "δύν", "_έκ", "_λε", "πρό", "ρη_", "σύν", "οιη", "κρα", "λώσ", "οστ", "ρος", "σμα", "τία", "ήση", "ιακ", "_τρ", "id_", "ρεί", "ώσσ", "_κρ", "σμό", "_γλ", "όμε", "λόγ", "ροε", "δεσ", "ιών", "νδε", "τηρ", "δο_", "εισ", "ρατ", "ργί", "αδύ", "_μο", "σετ", "_νο", "εια", "ικα", "τελ", "_ακ", "ατη", "οκρ", "ριο", "ανο", "αστ", "τύπ", "υν_", "απα", "γρά", "μια", "οντ", "ήκτ", "γίν", "λήκ", "τρα", "_γί", "εφα", "ρεσ", "συγ", "ειο", "ενε", "μοκ", "ένη", "ανι", "ητι", "νετ", "ρίσ", "σμο", "τής", "χωρ", "ένω", "ατε", "ελε", "σσα", "δου", "μμή", "τοι", "αγή", "υρη", "_ομ", "ατή", "ερμ", "νόμ", "κρυ", "_νέ", "δος", "ούν", "τιμ", "_πι", "δομ", "εία", "εις", "νοη", "τις", "ενη", "αμε", "ποθ", "ωρί", "_ελ", "ίζε", "γής", "δοσ", "οβο", "ομά", "ρμα", "σημ", "επα", "εστ", "θα_", "χου", "ύπο", "ολι", "υμπ", "_pa", "εγγ", "οημ", "ονι", "πρέ", "τας", "ύνδ", "_αγ", "_ερ", "_τύ", "θήκ", "_οι", "εσμ", "νάγ", "σας", "τητ", "όμα", "ίτα", "υστ", "_ma", "γου", "δεδ", "ιού", "καθ", "κου", "λλά", "παν", "πικ", "φαλ", "φο_", "ητή", "μού", "_de", "ζετ", "ικε", "ποβ", "ακα", "ενα", "ληρ", "οιή", "es_", "έγγ", "έλε", "ίων", "γισ", "ευσ", "ηρί", "_μό", "έτα", "δα_", "εικ", "on_", "επε", "μόν", "οση", "ρομ", "χε_", "_he", "αδι", "ειρ", "εων", "θημ", "τημ", "_λή", "κεν", "μπι", "σεω", "υνθ", "ετο", "ιτε", "ονο", "ρίζ", "_lo", "_ον", "κλά", "πέτ", "τέλ", "ame", "ίσε", "ήτα", "ίς_", "αιτ", "λλο", "νισ", "φής", "_ήτ", "_αφ", "έτυ", "αρμ", "γαλ", "μμα", "συσ", "τισ", "υχε", "_εμ", "_κά", "_μή", "te_", "άτω", "απέ", "είω", "in_", "ήσε", "μάτ", "_st", "_χω", "έκδ", "ακρ", "κδο", "κομ", "λά_", "πεδ", "_tr", "εξα", "νθη", "ογέ", "_n_", "ίπε", "ιασ", "κα_", "νερ", "παι", "πογ", "πομ", "ρετ", "ταλ", "_θα", "ed_", "ll_", "ασμ", "ιμή", "λεσ", "υμβ", "ψη_", "ερο", "ιαδ", "κόν", "λάδ", "νία", "άδο", "άλλ", "ήκη", "επί", "ετι", "καλ", "ρίς", "έθη", "αγρ", "θετ", "ωστ", "_λο", "ήρα", "αιρ", "μάδ", "στρ", "τάλ", "le_", "αδρ", "ικέ", "ργο", "τήμ", "φαρ", "άλο", "ιήσ", "μο_", "νγκ", "ύν_", "μέσ", "νότ", "πιτ", "_άγ", "αλα", "ιδο", "κών", "ογι", "ομι", "ράφ", "ροφ", "υτή", "_νε", "con", "ακό", "αλι", "γμα", "δρο", "μακ", "τυπ", "όδο", "_ση", "ζει", "λο_", "μβο", "τηκ", "_λί", "ck_", "ετά", "θμό", "μην", "ρει", "τογ", "_in", "_pr", "γιο", "διά", "ιαγ", "ρες", "ρμο", "ρολ", "όνα", "_ασ", "ion", "me_", "αίρ", "βικ", "ιαν", "ιδί", "κολ", "μασ", "ναφ", "οιχ", "όρι", "ότη", "loc", "μαν", "νημ", "_ex", "_us", "_άκ", "se_", "αφα", "δί_", "ορά", "_εφ", "_ως", "ιας", "νεκ", "ρέθ", "_al", "_su", "et_", "int", "lin", "ίτε", "ηκτ", "ητα", "κρά", "ληκ", "ρας", "ώσε", "com", "άκυ", "άν_", "ηθε", "μικ", "νυμ", "οθε", "παλ", "ριν", "υμα", "ock", "άδα", "κει", "οθή", "ουσ", "ρια", "ng_", "sta", "έα_", "εμφ", "ξη_", "_πη", "αγω", "αρτ", "ατό", "γωγ", "εκρ", "ιάζ", "μογ", "οφο", "ούμ", "πηγ", "ποδ", "ραβ", "ρυθ", "ότε", "βρέ", "ηρο", "ικώ", "κο_", "κρο", "κός", "νά_", "νες", "ουθ", "ρασ", "ile", "ήρι", "δοπ", "θμι", "λίσ", "λος", "σικ", "υθμ", "_γε", "_ου", "κη_", "μον", "ρού", "ότα", "_τέ", "sh_", "άζε", "έλο", "έχο", "λλι", "ναλ", "νοι", "πτο", "_ch", "_ρο", "_ρυ", "ter", "γκρ", "είσ", "θος", "κτι", "οει", "υγχ", "_ca", "_se", "che", "ead", "tri", "αβι", "αρά", "εύθ", "κής", "κοπ", "νση", "ογο", "χώρ", "_na", "ce_", "κωδ", "νευ", "νον", "ωδι", "_κω", "_όρ", "αγν", "ανί", "δε_", "ιρι", "λαν", "μεγ", "τός", "_fi", "_sh", "_έξ", "rea", "ανε", "ηκα", "λήψ", "ριέ", "ρωσ", "ύμε", "_βο", "_δο", "ack", "nam", "αγγ", "αθο", "βλη", "ιγμ", "ιερ", "πάν", "πος", "τεσ", "_δί", "all", "ήθη", "γγλ", "γλι", "οπι", "πιε", "ταξ", "υνδ", "_πά", "ad_", "ίσκ", "γοπ", "ιρά", "κρε", "κτη", "λα_", "μής", "νέα", "ξοδ", "σου", "τού", "υνσ", "φάν", "fil", "ing", "άνι", "αγέ", "ιεύ", "ιμέ", "μαλ", "ολό", "ομή", "ονό", "υγκ", "_s_", "έξο", "ήτη", "δη_", "ιέχ", "ιεσ", "κές", "μήν", "σκο", "_λα", "or_", "ref", "ser", "use", "άνε", "ήψη", "βασ", "ωμέ", "ch_", "ήνυ", "ίδα", "ιγρ", "ιτρ", "νέο", "νή_", "_by", "_c_", "_σχ", "ver", "ίες", "αλί", "αφέ", "θού", "λευ", "λων", "μμέ", "μό_", "πίπ", "ρόσ", "_un", "_σο", "nt_", "αλε", "γει", "ειτ", "μεί", "νό_", "σφα", "τρι", "ωμα", "_li", "_άλ", "έτω", "αλό", "ευτ", "κεί", "μίσ", "ρία", "χος", "_λά", "al_", "log", "απε", "ετή", "θμί", "θορ", "θυν", "λετ", "λών", "ρεο", "ωρι", "_ημ", "_όλ", "pro", "άντ", "ιά_", "ιοθ", "ιχε", "κευ", "λές", "λεγ", "οιο", "ρέχ", "ύθυ", "_id", "ent", "re_", "sun", "un_", "άνο", "ένε", "εολ", "ιτο", "κλη", "νησ", "ργε", "ρυσ", "υπτ", "φρα", "ώνε", "_mi", "_εσ", "_εύ", "ίρε", "γχο", "ερώ", "θέσ", "ιαφ", "λής", "λίδ", "τήσ", "τι_", "φαί", "όγο", "_di", "_γα"},
+	"en": {"ed_", "_in", "ion", "on_", "_re", "ng_", "ing", "tio", "_th", "le_", "_co", "_no", "or_", "the", "_to", "er_", "es_", "ect", "to_", "ile", "not", "ot_", "he_", "_se", "_fi", "for", "_fo", "in_", "is_", "nd_", "ent", "_of", "fil", "ter", "te_", "and", "cti", "_de", "ati", "of_", "_is", "nt_", "an_", "ate", "_un", "_pr", "se_", "_ma", "re_", "_a_", "_ca", "_st", "ted", "_pa", "_us", "_di", "th_", "_an", "_ex", "_li", "st_", "it_", "val", "ble", "al_", "ame", "ut_", "con", "_wi", "me_", "_op", "res", "com", "ali", "_ar", "et_", "ge_", "ess", "use", "nam", "rea", "id_", "sec", "_be", "ry_", "_al", "_sy", "ver", "abl", "_ch", "ist", "ith", "ead", "all", "loc", "out", "rec", "ns_", "can", "sta", "wit", "lin", "cat", "at_", "_en", "int", "_na", "ve_", "ort", "ons", "as_", "ts_", "_su", "ste", "_on", "_lo", "ad_", "_si", "ang", "ch_", "tin", "str", "err", "ers", "ine", "de_", "ly_", "en_", "_do", "ran", "ll_", "ins", "pec", "sym", "ne_", "lid", "age", "nte", "no_", "_ke", "ce_", "mat", "ire", "pre", "set", "_or", "mbo", "ign", "men", "bol", "pro", "_er", "_me", "ail", "sio", "tor", "_ta", "_wa", "ymb", "ive", "rro", "_va", "ror", "_sh", "be_", "key", "ic_", "inv", "led", "_ba", "por", "rin", "ack", "nst", "_mo", "ld_", "nva", "rel", "omm", "cha", "ss_", "_fa", "_ha", "pti", "ode", "han", "orm", "_ad", "exp", "era", "_sp", "_as", "ind", "oca", "dat", "rt_", "ct_", "ol_", "red", "opt", "ann", "_ou", "are", "ont", "war", "cte", "per", "ssi", "man", "_so", "put", "tri", "add", "_mi", "dir", "_fr", "ize", "nno", "rma", "ern", "_wh", "sin", "ay_", "dis", "nde", "_la", "def", "ope", "che", "nge", "ore", "_gi", "chi", "_he", "_tr", "ara", "rat", "ren", "thi", "rs_", "ck_", "end", "arg", "pac", "_ve", "fai", "_by", "rd_", "ult", "ser", "_ne", "_bi", "les", "_nu", "ica", "nin", "om_", "upp", "_at", "sup", "pe_", "_da", "spe", "ory", "emo", "ove", "rom", "her", "ere", "ber", "_ge", "reg", "ue_", "tab", "num", "_bu", "_mu", "elo", "ata", "ifi", "omp", "enc", "ase", "eci", "tru", "his", "_t_", "ain", "_po", "_le", "ass", "est", "ow_", "lis", "rsi", "mbe", "typ", "ype", "cod", "ite", "par", "tur", "_cr", "lt_", "oun", "ian", "ds_", "ure", "cre", "mod", "ntr", "_x_", "alu", "low", "ze_", "ls_", "nor", "nal", "egi", "rem", "ces", "ey_", "ppo", "pla", "ref", "fie", "din", "rge", "der", "pat", "cou", "_ty", "und", "lic", "fro", "arc", "umb", "ext", "git", "rit", "eco", "sh_", "har", "tar", "tch", "mes", "ord", "nly", "ta_", "lue", "iti", "onl", "cal", "act", "sig", "sed", "own", "tra", "arn", "llo", "fin", "one", "rch", "_sa", "mit", "pri", "ume", "rte", "ten", "ty_", "eat", "siz", "_s_", "equ", "lay", "nat", "tes", "get", "uld", "oul", "you", "ple", "inf", "ina", "nfo", "rn_", "cor", "wor", "cif", "utp", "tpu", "cto", "rac", "ach", "_ob", "_yo", "nta", "gis", "_b_", "_te", "up_", "tat", "_br", "ust", "unk", "nab", "rep", "uct", "rni", "ruc", "jec", "nce", "mma", "_im", "qui", "ote", "bra", "ele", "_ra", "inc", "_gr", "anc", "wn_", "us_", "ink", "hen", "our", "ert", "ari", "has", "now", "sho", "ex_", "xpe", "el_", "hin", "_if", "pen", "tem", "ddr", "hea", "by_", "tha", "aul", "tiv", "ast", "ner", "ong", "mov", "nch", "spl", "kno", "atu", "atc", "mis", "am_", "efi", "_up", "bje", "do_", "but", "rou", "ade", "nts", "efa", "req", "sou", "fau", "fer", "obj", "nti", "off", "_ab", "ndi", "una", "if_", "ard", "tec", "eve", "ene", "_it", "pt_", "_ti", "isp", "non", "whi", "rce", "ned", "lat", "tim", "ock", "ges", "gen", "ied", "ill", "sse", "_au", "bas", "ide", "min", "_ap", "exi", "mer", "dre", "tic", "nkn", "lan", "ram", "art", "ar_", "tai", "bit", "ena", "ori", "ace", "oes", "iss", "_wr", "_ac", "eas", "ete", "unt", "ou_", "cur", "ime", "onf", "ded", "len", "tre", "des", "lea", "ini", "doe", "wri", "tal", "ese", "ies", "dex", "gno", "try", "mus", "tan", "deb", "app", "att", "hel", "rna", "_ig", "edi", "em_", "roc", "_pl", "med", "mmi", "gs_", "_wo", "ant", "gra", "pli", "pos", "bug", "hil", "kin", "mal", "ary", "sag", "dia", "ria", "too", "ute", "bad", "ec_", "rre", "whe", "emp", "gro", "eri", "uth", "_pe", "mar", "aut", "mor", "_n_", "nk_", "kag", "sub", "fic", "ys_", "_cl", "erm", "ath", "_bo", "ebu", "gin", "isa", "ols", "ro_", "cka", "eng", "uir", "_fl", "rie", "fix", "oo_", "new", "run", "_ka", "rev", "_pi", "yte", "eme", "nit", "_id", "byt", "oup", "_fu", "ee_", "loa", "gum", "lle", "unc", "ix_", "pas", "ps_", "tte", "rgu", "cke", "den", "nes", "how", "dif", "ffs", "rti", "ecu", "gna", "sti", "que", "ger", "mpl", "ses", "owe", "_ov", "ial", "oce", "_du", "mul", "inp", "nds", "_sc", "emb", "fse", "nco", "lti", "lon", "npu", "eck", "oc_", "del", "oth", "ash", "hec", "las", "let", "_ru", "hou", "um_", "ree", "any", "ven", "ave", "ia_", "rip", "ew_", "urc", "aga", "fo_", "_el", "_qu", "ma_", "its", "fou", "mon", "tho", "erv", "_r_", "wer", "sto", "ela", "xte", "lar", "ug_", "xt_", "efe", "erg", "ami", "osi", "_cu", "_em", "nda", "win", "eys", "sel", "_we", "mpo", "adi", "hat", "imp", "sen", "uns", "usi", "met", "ani", "ret", "eed", "spa", "ero", "ny_", "ose", "ke_", "ork", "mem", "tex", "op_", "oll", "ala", "ule", "_ea", "ip_", "ssa", "_ho", "bin", "abi", "sha", "nsi", "tag", "evi", "mpt", "ish", "dd_", "_ro", "ues", "ima", "ctu", "eld", "lem", "nfi", "rmi", "ogr", "_ce", "log", "alt", "ars", "sit", "iel", "tia", "col", "_pu", "lit", "orr", "ppl", "hiv", "sys", "odu", "ond", "ra_", "exe", "ked", "ri_", "ett", "_v_", "fig", "mac", "rog", "_af", "ona", "ema", "ake", "oad", "rve", "pda", "la_", "elp", "ity", "ngu", "acc", "ff_", "iat", "gni", "upd", "_d_", "un_", "dul", "ify", "xis", "lab", "uni", "sol", "ks_", "ur_", "ede", "urr", "mic", "oin", "dle", "flo", "ir_", "rse", "uri", "yst", "ig_", "rm_", "cce", "gua", "lag", "hit", "ag_", "epo", "may", "scr", "dit", "nre", "zer", "ice", "iff", "don", "imi", "ens", "rig", "mak", "she", "sor", "_c_", "fla", "ndl", "_e_", "mpa", "ngl", "nto", "xpr", "_hi", "_vi", "clu", "dep", "unr", "ell", "lly", "ppe", "_m_", "ffe", "lib", "sam", "cri", "ubl", "_ag", "xec", "aft", "ax_", "eta", "fte", "ict", "lec", "mag", "une", "fy_", "na_", "ana", "mme", "nex", "_go", "fol", "epe", "ral", "var", "ap_", "rib", "lf_", "igh", "mp_", "shi", "tti", "vel", "_av", "ost", "cog", "ogn", "ft_", "bli", "rar", "ild", "lp_", "fun", "hav", "ili", "nee", "_bl", "mai", "nsu", "pin", "tif", "nga", "uil", "cts", "ega", "tus", "usa", "ric", "zed", "igu", "ket", "tro", "_ze", "gn_", "_hu", "een", "lig", "ual", "_f_", "pc_", "sem", "aba", "ila", "rth", "nse", "ull", "wil", "ude", "eac", "ito", "nct", "oli", "was", "eso", "bal", "erf", "eli", "sca", "bac", "ged", "lac", "_l_", "cop", "det", "ngt", "dec", "giv", "wed", "ava", "tip", "mpr", "ms_", "odi", "old", "poi", "rol", "cac", "cut", "lud", "nu_", "pon", "_i_", "ibu", "dar", "irs", "lim", "ags", "ato", "esc", "hes", "lte", "ora", "ved", "niz", "rk_", "ga_", "_dy", "bui", "ear", "ler", "sab", "ibl", "ski", "ak_", "gth", "ipl", "mbl", "ous", "rl_", "mas", "bi_", "ful", "ron", "sn_", "_sk", "sa_", "ssw", "nne", "sum", "syn", "car", "mot"},
+	"eo": {"as_", "la_", "_la", "_de", "on_", "_ne", "ro_", "oj_", "ta_", "de_", "sta", "_ma", "osi", "aj_", "est", "_do", "ier", "dos", "sie", "_es", "to_", "ne_", "_ko", "jn_", "ero", "mal", "ita", "_po", "tas", "_pr", "_en", "_re", "_se", "_el", "ata", "lo_", "_al", "_ka", "_no", "mo_", "_li", "do_", "io_", "ojn", "an_", "_in", "ent", "igi", "era", "kon", "ebl", "ali", "nom", "is_", "por", "ant", "kom", "no_", "aŭ_", "nto", "da_", "or_", "pro", "en_", "man", "val", "aro", "lin", "_ar", "ilo", "_si", "and", "omo", "bla", "_su", "las", "for", "men", "lig", "kaj", "per", "tro", "taj", "ist", "ndo", "_ti", "_ku", "_aŭ", "_pa", "ran", "al_", "nta", "iu_", "ume", "ko_", "_ki", "na_", "sti", "_uz", "ra_", "ver", "_ĉi", "ini", "_tr", "ten", "gi_", "_an", "_fo", "cio", "igo", "eni", "_pe", "_eb", "ndi", "kun", "nte", "_nu", "ter", "ri_", "ont", "_mo", "_va", "ara", "pri", "er_", "_er", "sig", "ori", "_me", "_ĉe", "ajn", "el_", "sto", "lon", "un_", "eli", "vas", "go_", "ign", "toj", "_op", "ida", "mon", "ron", "_di", "lid", "oma", "_fi", "alo", "rar", "iga", "ia_", "tat", "vo_", "_da", "roj", "tra", "ni_", "ate", "_du", "ti_", "erm", "ind", "ova", "ujo", "ona", "orm", "tan", "gra", "int", "ing", "ton", "res", "ado", "_ni", "dat", "ntr", "ala", "te_", "opc", "pci", "se_", "end", "bli", "num", "str", "ces", "ekt", "_pl", "ord", "pre", "arg", "rov", "eru", "oro", "fin", "par", "kce", "tri", "don", "als", "loj", "_ap", "esp", "omp", "rib", "lis", "tig", "_kr", "iko", "ong", "_sa", "blo", "po_", "ank", "eva", "ika", "jo_", "ifi", "nst", "ana", "eks", "lan", "suk", "ato", "ukc", "_lo", "_ve", "gno", "_ek", "ako", "ena", "nat", "ka_", "um_", "sis", "ruj", "ava", "_ĝi", "kri", "rig", "_ŝa", "hav", "fer", "dik", "nda", "va_", "_st", "lsu", "ovi", "esi", "alt", "skr", "_gr", "ple", "eno", "nio", "sen", "fil", "_at", "emo", "ern", "so_", "dif", "ari", "akt", "dum", "mer", "taŭ", "kto", "ion", "moj", "ma_", "ioj", "lor", "nen", "nov", "ono", "kie", "pon", "git", "_le", "abl", "_ta", "sub", "_ba", "kti", "nig", "ele", "elo", "met", "pak", "ati", "vi_", "lik", "nor", "ers", "ĉi_", "enc", "ola", "_te", "ert", "mod", "rto", "kla", "ngv", "ret", "rma", "iel", "uza", "_sk", "ina", "pos", "tiu", "ort", "sil", "voj", "_ha", "_un", "co_", "pli", "gvo", "ora", "eko", "ga_", "nek", "eta", "ino", "ser", "igu", "leg", "_vi", "_ge", "len", "kre", "mpo", "ram", "rsi", "mes", "reg", "_ri", "anĝ", "egi", "imo", "kst", "los", "am_", "uma", "le_", "rgu", "sa_", "gru", "ren", "sek", "ĉiu", "ur_", "rmo", "tem", "ins", "lav", "_mi", "til", "gum", "tal", "mar", "niu", "eso", "eti", "ŝlo", "tit", "_kl", "noj", "art", "ive", "oli", "rea", "_ŝl", "odi", "ost", "_sp", "li_", "nev", "nti", "var", "dis", "tip", "aĵo", "kci", "sim", "unu", "ans", "_co", "rak", "gas", "_ak", "tiv", "nur", "ide", "kur", "sio", "nka", "ete", "inf", "nal", "one", "ogr", "ĝi_", "esa", "eto", "roc", "unk", "nfo", "lem", "uzi", "efe", "doj", "ria", "rit", "rme", "ĝis", "in_", "laj", "rat", "ubl", "avo", "rio", "tis", "eri", "omb", "iuj", "lva", "rog", "den", "oce", "pub", "tek", "_or", "ipo", "ame", "ang", "mbr", "alv", "atu", "oni", "kas", "rmi", "rol", "gil", "nga", "_n_", "ĉen", "pov", "ŝan", "ajt", "apa", "iva", "_bi", "raj", "rim", "erv", "zi_", "ile", "kta", "zan", "_fu", "_vo", "ila", "ste", "ĝas", "ega", "ldo", "lok", "nan", "ura", "mem", "_he", "dev", "ere", "st_", "ĉe_", "cez", "rom", "ago", "ale", "baj", "gu_", "olo", "spe", "tum", "rti", "bro", "ras", "tab", "re_", "tol", "ama", "ca_", "lek", "gan", "iki", "iĝa", "spu", "tin", "ivo", "mak", "nha", "oko", "van", "con", "kod", "ulo", "bo_", "dan", "nu_", "rek", "zo_", "_fr", "dek", "ite", "lir", "oka", "unt", "ani", "ges", "sam", "ed_", "rno", "ŝel", "ask", "ba_", "enh", "cia", "jto", "lat", "ula", "ĝo_", "_ŝe", "iĝi", "kiu", "oku", "dit", "enu", "ezo", "ngr", "aba", "aci", "ias", "_so", "eme", "gna", "nkt", "os_", "spr", "tre", "_ru", "ark", "ili", "_a_", "_ok", "nco", "kit", "kro", "odo", "ol_", "ond", "tik", "emp", "fun", "goj", "gon", "mit", "tor", "_be", "ekz", "es_", "nca", "abo", "ave", "ĉef", "kor", "mpl", "gor", "laŭ", "me_", "mor", "pas", "ref", "vid", "vit", "vor", "bol", "bte", "mis", "ome", "rei", "sed", "ubt", "uti", "amo", "ema", "ibo", "nt_", "ast", "lim", "nas", "tak", "lta", "nit", "olu", "son", "_ol", "alp", "ene", "iam", "kum", "mat", "mi_", "rec", "spo", "uto", "_ag", "_ci", "aln", "kat", "min", "_bo", "cif", "dig", "mbo", "nkc", "san", "spa", "_ce", "_ke", "ald", "zis", "_ig", "iti", "lno", "ons", "rdi", "tio", "maj", "rki", "tar", "vel", "vol", "ĵo_", "ela", "fra", "iab", "non", "bor", "eci", "imb", "ngo", "nko", "om_", "rac", "amp", "onv", "pec", "rna", "_fa", "_je", "aks", "ekv", "lti", "nul", "tu_", "bon", "lab", "rvi", "rdo", "_ad", "_ja", "_ro", "ble", "etr", "id_", "lia", "rda", "ua_", "ano", "tes", "elp", "ens", "ski", "uj_", "ult", "_hi", "bel", "eco", "hel", "imi", "koj", "rik", "rua", "tur", "_av", "_us", "apo", "fik", "iun", "jon", "ada", "der", "kan", "kap", "ore", "pac", "ris", "rup", "_et", "_pu", "rin", "daj", "di_", "et_", "fia", "gla", "irs", "ngl", "niv", "tad", "eku", "iri", "kia", "kig", "kis", "rem", "us_", "das", "dir", "kar", "kva", "lit", "opi", "_s_", "alf", "kop", "loc", "naj", "_ca", "_id", "isa", "rst", "umo", "atr", "ja_", "rab", "vaj", "anc", "dok", "ota", "fon", "iaj", "lar", "rip", "ar_", "sup", "ŝab", "ici", "ivi", "_ho", "aki", "je_", "les", "all", "ce_", "kam", "mig", "vig", "_pi", "kzi", "uo_", "zon", "enp", "ibu", "lte", "mpa", "pet", "_ra", "aka", "guj", "ine", "kso", "_fe", "_fl", "_t_", "ber", "ito", "lt_", "nar", "stu", "_bl", "_ga", "iks", "isp", "ope", "avi", "ira", "ki_", "nia", "upo", "ven", "ĝin", "_p_", "arĥ", "ian", "ke_", "ner", "plu", "ubs", "dre", "lfe", "mum", "nci", "nĝo", "suf", "ujn", "uni", "eda", "erp", "ksi", "ock", "reo", "sul", "zma", "eo_", "joj", "sal", "vat", "_c_", "abe", "adr", "duk", "ire", "rad", "rĥi", "_e_", "eki", "ime", "let", "ll_", "net", "nie", "nsu", "ose", "pen", "sur", "uda", "aco", "gal", "gat", "ior", "nĝi", "poj", "sat", "sin", "ĥiv", "_bu", "_mu", "apl", "bil", "bsk", "but", "ei_", "ido", "ktu", "nde", "nsi", "obl", "pan", "sud", "aĝo", "eca", "imu", "ink", "izo", "paŝ", "ps_", "pun", "rap", "rd_", "reĝ", "rg_", "ŝut", "_ur", "det", "gul", "npa", "rl_", "_l_", "aŭi", "cit", "gis", "idi", "ie_", "inu", "uta", "tia", "_na", "ak_", "edi", "ger", "kol", "_x_", "ibi", "isi", "ve_", "viz", "_br", "alk", "aŝa", "bin", "eza", "gaj", "ig_", "mas", "mul", "ril", "rta", "_d_", "_to", "esk", "isd", "izi", "nea", "rie", "ska", "ukt", "zu_", "_as", "_wi", "din", "ea_", "egu", "nve", "ovo", "zas", "ck_", "eat", "gad", "kaŭ", "nd_", "pra", "si_", "ufi", "_f_", "_tu", "his", "lur", "nam", "nse", "tim", "tru", "uzm", "za_", "aja", "at_", "baz", "cel", "edo", "ild", "kaz", "ng_", "niĝ", "ure", "uzu", "flu", "gio", "ife", "leb", "mba", "rob", "sas", "_il", "_r_", "_sh", "apr", "efa", "elt", "ty_", "ust", "ŝa_", "eam", "erf", "evi", "ial", "ien", "lpl", "poz", "rok", "rt_", "aza", "cid", "isk", "kra", "neb"},
+	"es": {"_de", "de_", "_no", "do_", "_se", "el_", "_co", "no_", "os_", "es_", "ón_", "_el", "ión", "_es", "_en", "_la", "se_", "_re", "ar_", "la_", "ent", "con", "ció", "en_", "ra_", "ado", "_in", "_pa", "_un", "or_", "te_", "as_", "to_", "est", "par", "da_", "nte", "ro_", "al_", "ara", "fic", "ica", "tra", "aci", "ero", "ta_", "com", "_pu", "que", "_fi", "er_", "str", "sta", "ion", "_ca", "ido", "des", "era", "un_", "ada", "per", "_pr", "_di", "_al", "rec", "cio", "men", "_si", "na_", "on_", "_lo", "cci", "ist", "ede", "_ar", "che", "ida", "res", "lid", "ndo", "ien", "ntr", "re_", "and", "esp", "del", "nto", "pue", "ect", "ued", "lo_", "_op", "_a_", "los", "por", "nes", "rad", "ivo", "her", "one", "ich", "ter", "io_", "arc", "_po", "ont", "_qu", "esc", "cad", "ue_", "ali", "enc", "rio", "den", "ecc", "ble", "car", "bre", "ene", "ten", "_ex", "vo_", "mit", "pro", "una", "tro", "err", "dos", "dir", "_so", "spe", "_ha", "_us", "le_", "rch", "rma", "omb", "ma_", "mbr", "_fa", "ifi", "_ma", "it_", "nci", "tos", "áli", "vál", "nom", "ori", "_ti", "ina", "chi", "ver", "las", "ran", "_va", "sec", "pre", "_y_", "ire", "hiv", "tor", "_er", "reg", "all", "ce_", "cto", "sió", "ir_", "act", "omp", "cia", "_mo", "_su", "po_", "tar", "for", "ste", "_ta", "fal", "ura", "iza", "ia_", "pci", "rro", "cac", "int", "stá", "lic", "_o_", "tad", "rea", "ror", "ca_", "tiv", "orm", "rar", "abl", "so_", "_ac", "opc", "tes", "qui", "ato", "ant", "ser", "liz", "ama", "ere", "olo", "ona", "mo_", "_ve", "cer", "_ob", "_me", "dor", "ari", "_fu", "ite", "cla", "_pe", "in_", "_li", "inv", "nst", "ins", "egi", "nta", "les", "ea_", "cid", "ndi", "_te", "val", "arg", "mie", "eci", "nal", "mer", "git", "bol", "ici", "ctu", "ece", "rta", "_sa", "_lí", "ne_", "tie", "end", "ual", "eta", "nea", "ces", "_bi", "sin", "tá_", "usa", "mpo", "nti", "ete", "ers", "nvá", "min", "emp", "_tr", "inc", "ort", "_le", "ve_", "rac", "pos", "nco", "_fo", "ema", "ope", "ace", "amb", "ini", "tab", "_ad", "cam", "tip", "ecu", "ave", "lec", "_cr", "erm", "gis", "ros", "uet", "_cl", "deb", "lor", "alo", "cre", "iva", "go_", "co_", "_gi", "pec", "_ra", "cri", "ami", "dad", "scr", "ono", "rmi", "fin", "def", "lav", "mbi", "ras", "mbo", "ner", "an_", "ico", "ili", "sol", "tru", "tam", "ubi", "sal", "odo", "mod", "noc", "ase", "cti", "bic", "mpl", "til", "igu", "ref", "ume", "ert", "ibl", "ad_", "jet", "_da", "rsi", "esi", "oca", "das", "dat", "_sí", "ram", "_an", "onf", "obj", "ren", "aba", "_au", "bje", "omo", "tal", "ipo", "uta", "oci", "orr", "_mu", "nde", "gen", "tua", "cif", "dic", "ple", "sím", "tan", "ímb", "cor", "_st", "lín", "sco", "ame", "dis", "nar", "rab", "udo", "íne", "_im", "aqu", "va_", "_cu", "_gr", "_to", "ord", "ita", "sca", "mas", "rib", "ext", "ier", "ing", "jo_", "_nú", "uer", "tec", "ore", "be_", "reu", "equ", "exp", "eto", "imi", "ale", "efe", "_mi", "ebe", "_ba", "_ab", "_nu", "osi", "pud", "ena", "tur", "paq", "mac", "art", "_ap", "lar", "lla", "uie", "eub", "man", "núm", "ruc", "_or", "rde", "mat", "efi", "ios", "ria", "lis", "sar", "ade", "ati", "ine", "sa_", "ha_", "imp", "rep", "zar", "úme", "_ni", "_vá", "nic", "uti", "ens", "vis", "tic", "fue", "sit", "nad", "ucc", "inf", "fer", "pri", "ues", "_x_", "_ut", "_ce", "_ej", "edi", "uar", "nid", "ló_", "seg", "si_", "ice", "alt", "_má", "_em", "nfo", "_ge", "dif", "lló", "ind", "gra", "mpa", "jec", "eje", "nfi", "eri", "aza", "oce", "zad", "unt", "esa", "ele", "iad", "emo", "ile", "gur", "omm", "loc", "ign", "ará", "mue", "bas", "año", "ño_", "asi", "iso", "laz", "iti", "ide", "rti", "_id", "_ne", "cal", "ead", "eso", "lad", "ora", "ons", "red", "lta", "ost", "tre", "tri", "tem", "dig", "pac", "_có", "egu", "id_", "ern", "ala", "pla", "eco", "exi", "uen", "rra", "_av", "ito", "mar", "_he", "pon", "dmi", "lem", "mañ", "pli", "sti", "tin", "bit", "adm", "sen", "sig", "ear", "igo", "ay_", "cód", "tas", "lin", "nla", "eti", "rel", "tid", "enl", "mpr", "za_", "are", "mmi", "ódi", "bor", "rre", "xis", "hay", "ll_", "_pi", "lim", "nsa", "rim", "ese", "fec", "lac", "uto", "_s_", "_do", "_n_", "ota", "gar", "rup", "avi", "roc", "abe", "eli", "ibi", "lee", "ol_", "pat", "rgu", "tod", "irm", "fig", "ima", "vos", "_sh", "cab", "cua", "gum", "var", "eo_", "fra", "opo", "eme", "_fr", "cte", "sua", "sub", "der", "llo", "cut", "fir", "ás_", "nue", "nor", "ba_", "fil", "rev", "aut", "tif", "usu", "nec", "_ru", "ias", "dem", "et_", "oma", "isp", "det", "anc", "_bl", "gun", "omi", "spa", "_as", "cue", "unc", "itu", "ega", "cas", "nca", "ía_", "rit", "mos", "uev", "me_", "és_", "bli", "dia", "iar", "lti", "rte", "mis", "nin", "bla", "erv", "ian", "can", "uci", "ial", "baj", "rop", "_at", "ch_", "más", "ata", "atr", "eer", "hac", "rem", "bra", "índ", "sia", "ajo", "ate", "mal", "_ín", "bia", "ula", "yte", "abr", "byt", "sio", "ime", "sh_", "sis", "odi", "rda", "voc", "cha", "_bo", "rga", "_by", "req", "cta", "sto", "rca", "eno", "evo", "son", "nda", "age", "xpr", "sim", "blo", "ech", "eña", "ult", "use", "_ch", "alm", "let", "hel", "_ig", "gui", "ral", "spo", "ela", "fun", "obt", "med", "eni", "gru", "señ", "pen", "aje", "dep", "oni", "ts_", "gua", "_r_", "lan", "obr", "rut", "sob", "_bu", "tex", "upo", "ún_", "ck_", "spl", "ecl", "ell", "gno", "ogr", "ulo", "iem", "ang", "zam", "met", "ote", "ólo", "apl", "tán", "dar", "lam", "war", "abi", "vid", "_só", "col", "gme", "lon", "rse", "sól", "_ll", "_ár", "rno", "dul", "mad", "ola", "bri", "opi", "nt_", "rid", "ack", "st_", "je_", "nam", "tim", "uan", "ed_", "ibu", "sel", "_eq", "amp", "ya_", "án_", "_fl", "rog", "und", "usi", "nos", "cen", "oto", "últ", "ge_", "bio", "ana", "din", "ino", "sac", "clu", "etr", "ret", "ími", "ga_", "isi", "lím", "ron", "uso", "ano", "ars", "bir", "epo", "evi", "_ag", "bin", "oin", "sop", "_ya", "ngu", "ong", "at_", "nsi", "ond", "xte", "rbo", "apa", "rir", "sib", "áct", "_hi", "erd", "loq", "iqu", "gre", "ree", "bte", "nd_", "su_", "ng_", "_fe", "ive", "rqu", "saj", "rvi", "ila", "mot", "olu", "sad", "pun", "rob", "uel", "_lu", "uiv", "_d_", "dec", "fus", "eda", "len", "mon", "mpi", "odu", "ber", "ff_", "rt_", "uni", "acc", "oba", "rl_", "tac", "_e_", "_pl", "_et", "aus", "eva", "rol", "uit", "coi", "pil", "tró", "_c_", "am_", "not", "ría", "duc", "did", "mem", "ngo", "cur", "mód", "nen", "ódu", "_du", "adi", "_vi", "oqu", "vac", "mic", "_om", "pia", "ast", "ls_", "rón", "ijo", "lve", "_na", "tag", "gin", "orc", "eal", "imo", "lug", "rna", "cap", "eas", "ric", "ard", "cod", "ic_", "lat", "mor", "eza", "iab", "san", "lt_", "rip", "ut_", "árb", "ced", "mag", "rác", "sde", "_ci", "rá_", "rig", "vor", "epa", "nza", "spu", "tiq", "lme", "tat", "tir", "epe", "imb", "ior", "lit", "vad", "_ur", "_v_", "ach", "ani", "esd", "lea", "púb", "sos", "tio", "úbl", "ee_", "xto", "uga", "he_", "óli", "cop", "ill", "rso", "sup", "ute", "ash", "inu", "mbó", "num", "_ho", "ból", "cos", "ef_", "gún", "rod", "_gu", "rat", "rd_", "zac", "_ot", "ací", "its", "nac", "pt_", "otr", "ct_", "exc", "leg", "smo", "_t_", "iat", "ocu"},
+	"et": {"ne_", "_ka", "_võ", "ise", "ail", "fai", "ta_", "uta", "mis", "ud_", "le_", "se_", "on_", "sta", "_fa", "da_", "ga_", "iga", "ili", "ei_", "_ei", "_on", "_se", "kas", "us_", "tud", "_va", "_vi", "asu", "atu", "_ko", "id_", "st_", "sut", "end", "_vä", "ja_", "_ku", "est", "ata", "ine", "ti_", "min", "imi", "_sa", "ole", "väl", "ist", "ami", "ast", "li_", "te_", "võt", "tus", "vig", "el_", "_si", "älj", "eri", "ed_", "või", "ali", "ava", "ik_", "nim", "sel", "ada", "stu", "lis", "_ja", "_ni", "eer", "tam", "_ar", "kui", "_re", "nda", "ime", "ide", "ks_", "de_", "ui_", "aja", "ust", "_ol", "_te", "_su", "lja", "tat", "_al", "_pa", "si_", "ald", "il_", "kir", "_sü", "loo", "_lo", "ane", "_nu", "lt_", "_mi", "nne", "use", "ndi", "ita", "is_", "_po", "eta", "õi_", "mi_", "ri_", "lik", "ab_", "gan", "_li", "saa", "lda", "sis", "nes", "eks", "kon", "ega", "tme", "jas", "_ta", "num", "es_", "it_", "_pr", "and", "_ki", "er_", "emi", "ste", "irj", "_mä", "ele", "äär", "ma_", "und", "õnn", "tu_", "õtm", "di_", "kat", "ümb", "ent", "ead", "ad_", "ing", "sea", "_jä", "_lu", "val", "ära", "et_", "vii", "aks", "ema", "ni_", "rea", "eid", "bol", "ida", "süm", "sen", "tad", "mbo", "_ba", "ite", "rit", "tal", "gi_", "_kä", "õti", "umb", "lin", "me_", "oll", "tav", "_ke", "ont", "men", "dat", "oog", "ama", "mat", "ade", "_ma", "_st", "al_", "tee", "_an", "ase", "sed", "aad", "na_", "_tu", "eem", "tan", "_mu", "_ve", "pol", "_tü", "_in", "sti", "itu", "ari", "alo", "dis", "eel", "kee", "lem", "oon", "jär", "mal", "lid", "ima", "inu", "rii", "_ig", "ile", "nul", "aal", "aa_", "nd_", "oli", "ver", "kse", "tak", "_la", "ess", "kor", "_to", "ahe", "ate", "_lõ", "ge_", "isi", "sio", "ogi", "vai", "as_", "pro", "uur", "suu", "tei", "arg", "ra_", "ia_", "käs", "tsi", "aat", "at_", "eba", "all", "ber", "iiv", "ani", "jut", "_pi", "uud", "lõp", "ete", "mää", "ign", "ndm", "see", "iku", "rid", "ult", "vad", "rje", "mit", "ea_", "ood", "sam", "_n_", "_pe", "ain", "lju", "_ho", "mbe", "iki", "sit", "ioo", "lok", "lit", "_eb", "iik", "ral", "tab", "dus", "mas", "_le", "kus", "aga", "ala", "an_", "isa", "muu", "sek", "ng_", "ume", "nte", "nt_", "sõn", "kim", "lii", "oni", "_ee", "ati", "rgu", "ina", "_pu", "_tä", "rju", "esi", "orm", "sal", "bai", "lli", "ser", "era", "iks", "taj", "_õn", "aar", "uut", "aba", "res", "ssi", "ses", "ärg", "ara", "lla", "ivi", "jun", "tri", "_sõ", "la_", "met", "uba", "des", "tek", "tim", "aõn", "baõ", "pea", "rol", "tte", "tun", "_as", "ant", "eva", "_er", "_vo", "eme", "üst", "hen", "nti", "vah", "_so", "_üh", "aik", "dme", "ika", "tor", "_ai", "arv", "gum", "vab", "bar", "kaa", "orr", "rmi", "sse", "süs", "tro", "itt", "_av", "rin", "_et", "oma", "ter", "_es", "blo", "ntr", "_bl", "aid", "ll_", "fik", "rus", "_de", "dam", "koo", "_no", "ikk", "oet", "rat", "ol_", "tüh", "iat", "mel", "uge", "ühe", "_au", "eis", "iid", "_ri", "str", "par", "vas", "hoi", "iit", "ub_", "ute", "ee_", "rsi", "_co", "_uu", "naa", "ühi", "gu_", "ngu", "rra", "vat", "ini", "ldi", "rtu", "adi", "aeg", "med", "ori", "sem", "tag", "gus", "mes", "aas", "lub", "em_", "etu", "iti", "loe", "ots", "taa", "tse", "bri", "ib_", "ku_", "mbr", "vää", "ait", "eli", "uru", "õne", "in_", "lug", "sig", "ärt", "_nä", "dar", "ksi", "maa", "mär", "puu", "ard", "eda", "ka_", "laa", "man", "ord", "sim", "udu", "_aa", "_pä", "pet", "det", "kst", "oia", "ore", "ree", "_me", "ell", "lei", "rim", "_fi", "ndu", "vaj", "vor", "_gr", "ers", "iri", "jad", "tüü", "äit", "_mo", "age", "hel", "ki_", "ntu", "ten", "koh", "ran", "ang", "oot", "lle", "_ül", "gem", "hii", "jal", "adr", "nor", "pik", "_ju", "_om", "_s_", "eld", "ere", "kku", "ool", "ale", "aut", "gno", "täi", "_ne", "ana", "ar_", "dre", "iig", "kes", "_ae", "nam", "ram", "utu", "vit", "dad", "dub", "evi", "mus", "pre", "õim", "fil", "uue", "üüp", "_i_", "_t_", "ind", "inf", "näi", "ope", "tit", "toe", "tut", "_ra", "lus", "rgi", "tel", "tes", "tii", "alt", "asi", "nfo", "arh", "kun", "õpe", "_en", "ba_", "rhi", "va_", "_di", "eal", "mee", "pak", "sii", "_tõ", "_är", "alg", "av_", "gas", "nev", "töö", "ärj", "aan", "nud", "ut_", "eat", "elt", "sa_", "_oo", "_tö", "adm", "ake", "bat", "lki", "rve", "_bi", "del", "dma", "erv", "ese", "int", "kom", "od_", "ude", "_kõ", "egu", "iss", "sin", "ss_", "_aj", "meg", "üle", "moo", "teg", "var", "gna", "jat", "led", "mev", "äis", "äsk", "dab", "idi", "odi", "per", "rva", "_na", "ass", "ena", "rup", "_fo", "gru", "oks", "rek", "uti", "amm", "dit", "ke_", "kõi", "rge", "uni", "_do", "_ro", "ost", "sul", "õik", "ato", "llk", "nde", "ted", "_c_", "_d_", "_ha", "did", "nen", "roo", "sid", "_id", "_is", "gra", "oke", "pos", "seg", "vee", "ert", "ref", "tle", "con", "lai", "nna", "rja", "üp_", "okk", "re_", "tin", "ul_", "ula", "_l_", "dir", "eku", "gid", "oo_", "rot", "suf", "hte", "no_", "ota", "rog", "nik", "_e_", "_lü", "_ti", "alu", "eab", "omi", "tea", "täh", "_ag", "ifi", "käi", "og_", "ond", "rv_", "tar", "tmi", "ve_", "ce_", "deg", "eet", "his", "iim", "iir", "ill", "kts", "lam", "one", "pi_", "set", "äsu", "õib", "_f_", "_r_", "ev_", "for", "hem", "las", "lat", "ode", "rda", "ull", "vi_", "_op", "als", "ame", "ene", "het", "ogr", "oom", "raa", "eav", "igu", "its", "kuu", "let", "lse", "nal", "reg", "una", "aki", "ees", "mad", "pal", "umi", "äsi", "_m_", "ats", "kud", "lev", "uid", "ule", "_p_", "ion", "iva", "tid", "voo", "ege", "he_", "hja", "iht", "mik", "mil", "päi", "ren", "sus", "tur", "uda", "van", "_u_", "ekt", "iis", "pii", "rt_", "rti", "_un", "_us", "_v_", "bin", "ien", "ink", "ril", "umm", "uri", "_a_", "_da", "gne", "hik", "kti", "lek", "sei", "esk", "os_", "pee", "tas", "um_", "ai_", "are", "nta", "or_", "sum", "uva", "äli", "ärk", "fo_", "hi_", "iin", "tif", "vea", "õig", "_he", "_x_", "_ük", "ire", "kri", "les", "poo", "rds", "õpp", "üks", "_b_", "_kü", "ela", "luu", "nee", "ua_", "urv", "ähe", "_jo", "_tr", "_wa", "dav", "der", "jes", "lan", "mei", "osi", "ot_", "pri", "ria", "rma", "sil", "ufi", "usi", "_sh", "anu", "du_", "ede", "eko", "ix_", "ken", "rg_", "ris", "unk", "upi", "äev", "äiv", "_ot", "egi", "eni", "hal", "ldu", "nkt", "out", "päe", "ure", "õrg", "_k_", "daj", "dmi", "een", "kid", "lee", "mid", "oka", "omp", "pid", "rec", "rik", "soo", "uko", "ves", "võr", "agu", "amu", "ck_", "dvä", "en_", "erm", "idu", "jät", "pp_", "rel", "sk_", "tem", "upp", "uus", "abi", "ars", "ect", "ike", "itl", "jao", "kk_", "kla", "lig", "oop", "rgn", "sia", "sih", "ulg", "vak", "_ab", "_ip", "enn", "gal", "kki", "mer", "mne", "nag", "nk_", "oos", "red", "alv", "ask", "ged", "ip_", "itm", "jan", "kod", "puh", "rc_", "rem", "war", "_lä", "etr", "iv_", "ive", "juh", "len", "lim", "loc", "oen", "oht", "oov", "põh", "ry_", "up_", "uua", "ööt", "_mõ", "am_", "eti", "ht_", "iil", "ize", "kut", "onn", "ovi", "pia", "rak", "rvu", "th_", "ur_", "_sp", "aok", "iip", "je_", "leh", "lil", "non", "ort", "sai", "san", "vus", "_bu", "_kr", "_põ", "bas", "efe", "fer", "git", "ivs", "lge", "lne", "mme", "por", "rav", "rib", "tis"},
+	"fi": {"en_", "ist", "ta_", "on_", "nen", "ine", "_ei", "ei_", "ett", "_va", "in_", "sto", "ell", "le_", "ost", "_kä", "tie", "_ko", "oit", "sta", "_vi", "an_", "lin", "sa_", "tet", "lli", "_ti", "edo", "ssa", "ied", "dos", "_tu", "äyt", "itt", "_ta", "lle", "vir", "tä_", "_ol", "ste", "tta", "_si", "ttu", "rhe", "irh", "ole", "käy", "_on", "een", "tu_", "ite", "tus", "ain", "ton", "ali", "lit", "taa", "tee", "itu", "eel", "ja_", "tti", "_li", "val", "us_", "ise", "tel", "to_", "ent", "ttä", "aa_", "men", "_ar", "ava", "et_", "ia_", "nni", "tte", "_lo", "_sy", "lla", "la_", "ess", "nis", "aan", "hee", "tun", "mis", "all", "_lu", "ksi", "ime", "mat", "_pa", "_mu", "rit", "koh", "kis", "set", "sti", "stu", "mer", "lis", "hte", "ytt", "_sa", "imi", "enn", "käs", "its", "sen", "mää", "si_", "eri", "tää", "äär", "vai", "tsi", "än_", "sym", "_ku", "nim", "joi", "utt", "_as", "oso", "_re", "ala", "tav", "_la", "ato", "ivi", "tii", "voi", "soi", "oli", "etu", "lai", "ään", "ää_", "hko", "loh", "ohk", "_vo", "luk", "ita", "oll", "_ka", "min", "bol", "rek", "mbo", "sky", "_su", "ter", "äsk", "ymb", "isä", "ill", "ois", "eta", "kki", "int", "_ja", "tai", "ti_", "ake", "eki", "oht", "_se", "est", "lä_", "kir", "aus", "ase", "_al", "irj", "iin", "uut", "per", "va_", "ust", "nta", "_po", "sä_", "koo", "tul", "ri_", "var", "te_", "onn", "ssä", "sis", "uku", "att", "ume", "_ha", "_tä", "erk", "tam", "ema", "ote", "arv", "nne", "_jo", "ark", "epä", "_ep", "nte", "stä", "kse", "ran", "ais", "_nä", "ata", "sin", "uot", "tin", "ees", "_me", "uet", "_en", "ko_", "iä_", "_ma", "_pi", "aik", "lue", "_ni", "ty_", "rkk", "sii", "oi_", "ses", "ytä", "tui", "ama", "rki", "ope", "_x_", "nti", "rvo", "äri", "ai_", "he_", "ila", "era", "sim", "oa_", "_ki", "sek", "_ty", "elm", "ui_", "_op", "at_", "ros", "dot", "ood", "ami", "tue", "net", "odo", "uks", "unt", "ulo", "iss", "ot_", "tyy", "_ve", "_to", "ijo", "til", "ver", "na_", "_jä", "ori", "it_", "_ri", "isi", "oko", "sia", "uva", "llä", "päo", "äon", "vaa", "kti", "_yh", "alu", "tty", "odi", "toi", "los", "and", "kan", "sij", "vat", "ndi", "un_", "ian", "tio", "lii", "suo", "kem", "mi_", "eks", "li_", "iir", "rja", "_oh", "ota", "ast", "_mä", "ity", "use", "iet", "päi", "unn", "_os", "_ot", "_no", "ien", "kok", "_ke", "aat", "kon", "poi", "_bi", "_pu", "jen", "lau", "kom", "met", "tas", "tuu", "ass", "ika", "oss", "ude", "_vä", "_in", "sal", "äin", "sit", "kit", "ero", "hde", "itä", "ink", "_av", "kai", "ut_", "del", "den", "muo", "tar", "tem", "yyp", "ikk", "täm", "ttö", "tuk", "äis", "omi", "oon", "ohj", "ers", "lta", "ina", "see", "sio", "di_", "emi", "uor", "_pr", "ome", "ypp", "_od", "iit", "num", "asa", "aki", "aks", "rsi", "ppi", "rjo", "roi", "ulk", "dat", "ara", "ati", "ntt", "pal", "ans", "_n_", "tau", "riv", "tys", "ulu", "aut", "ele", "nto", "_ra", "pi_", "uri", "iiv", "yte", "ämä", "lma", "ekt", "muu", "eis", "vo_", "irr", "toj", "ka_", "let", "yht", "pro", "ärä", "_mi", "hak", "oja", "elo", "se_", "_di", "las", "ott", "hje", "toa", "aro", "kee", "ppu", "uus", "uud", "ki_", "tot", "ket", "rin", "bit", "arg", "yks", "os_", "_yl", "uis", "_st", "jä_", "ens", "mui", "jäl", "säl", "_uu", "ana", "_pä", "äll", "oto", "ua_", "uol", "tos", "ari", "tei", "_mo", "ion", "pak", "_de", "rro", "iti", "io_", "väl", "da_", "_co", "pit", "lem", "oin", "lei", "uur", "jel", "luo", "maa", "raa", "ön_", "ida", "oni", "saa", "man", "äli", "lop", "eit", "nnu", "jär", "etä", "jes", "mä_", "ohd", "tis", "dia", "_et", "alt", "ind", "lee", "ten", "vii", "täs", "lev", "kop", "uom", "det", "rje", "ärj", "ken", "kke", "tit", "mal", "aam", "oid", "nde", "avu", "kuv", "ke_", "uke", "van", "ma_", "ont", "puu", "ios", "uin", "_te", "ant", "ro_", "arm", "kio", "nss", "ire", "er_", "ssi", "suu", "ue_", "nna", "mät", "näy", "ris", "ys_", "yöt", "eti", "tom", "vak", "aih", "sel", "uod", "ann", "no_", "älä", "dis", "kin", "dir", "kos", "san", "elt", "kaa", "kko", "tuo", "utu", "naa", "ose", "kka", "ial", "kal", "kun", "_hu", "opu", "opi", "tö_", "uee", "aul", "nus", "kua", "laa", "oje", "syö", "eht", "hto", "loc", "löy", "vie", "_pe", "aal", "kui", "dek", "tto", "_ai", "ask", "_lö", "ode", "puo", "_an", "_er", "_so", "ilm", "män", "tal", "ku_", "lia", "täj", "dit", "iia", "ppä", "sak", "tor", "nki", "oma", "rä_", "uss", "eto", "rel", "tia", "isk", "lmä", "yli", "ntä", "ese", "ame", "gum", "mon", "rgu", "sää", "huo", "näp", "äpp", "ike", "vel", "_nu", "asi", "pai", "lko", "aar", "tak", "tum", "lat", "ora", "täv", "ert", "äjä", "_ul", "_äl", "eke", "vit", "din", "eet", "esi", "ots", "sty", "säi", "_es", "kel", "lel", "rii", "ska", "vis", "oka", "yty", "eli", "ide", "ske", "adi", "lki", "pää", "yt_", "_da", "iot", "mas", "sar", "ete", "opp", "isu", "_le", "kut", "läh", "mio", "ätt", "_lä", "_r_", "aja", "nai", "lke", "ual", "nt_", "oc_", "oim", "ril", "tsa", "äen", "ile", "näi", "one", "ält", "par", "vää", "_il", "aad", "tön", "vin", "kor", "pu_", "uja", "irt", "ltä", "ne_", "smä", "_us", "tae", "de_", "inn", "änn", "äsi", "jon", "tuj", "_he", "akk", "ävä", "nno", "vi_", "älk", "ein", "ll_", "jos", "nnä", "_fi", "aes", "kea", "ono", "vas", "ker", "olt", "teh", "unk", "alk", "avi", "es_", "lan", "tuv", "iht", "ini", "ivu", "kie", "kä_", "sos", "end", "nsi", "yhd", "ytö", "iva", "nee", "osi", "obj", "htu", "jau", "ova", "yn_", "fil", "jek", "kij", "mit", "nut", "uu_", "raj", "via", "_un", "ee_", "kää", "sam", "ät_", "ätö", "abi", "bje", "fun", "luu", "sem", "_ob", "skä", "umi", "_ab", "ea_", "sav", "äim", "_ne", "ima", "ipp", "nä_", "tol", "äiv", "lim", "tös", "äsm", "_eh", "aka", "tek", "tod", "kil", "uun", "_fu", "hta", "öte", "öyt", "iko", "ioi", "ioo", "kul", "lus", "ria", "ect", "kot", "kur", "nin", "tok", "yst", "_el", "_s_", "tse", "unu", "ämi", "eja", "lku", "des", "kyk", "ait", "koi", "mmä", "rak", "ajo", "auk", "ic_", "mel", "oik", "tua", "usa", "imm", "inu", "oda", "aaj", "eni", "ky_", "yä_", "is_", "tan", "ute", "anh", "len", "lil", "ona", "re_", "rta", "aim", "ene", "lip", "_ed", "_t_", "ely", "iak", "oti", "sse", "atu", "lek", "yko", "iuk", "kak", "kat", "mpi", "rik", "tri", "ura", "iel", "liu", "pis", "ble", "hin", "ino", "kas", "loi", "nkt", "ryh", "yhm", "_ex", "_fo", "non", "not", "ore", "put", "ii_", "yle", "_sh", "ed_", "ede", "ija", "rat", "uts", "äss", "hen", "ign", "jok", "otu", "sop", "typ", "uto", "_yk", "jit", "ls_", "sku", "tim", "tsu", "al_", "apa", "hit", "mut", "nd_", "ra_", "rea", "tka", "lmi", "oks", "ukk", "dol", "hdo", "ing", "emä", "hdi", "nol", "ove", "yri", "_sä", "jas", "pin", "sil", "tyk", "uta", "alv", "ani", "eva", "ivä", "ng_", "olu", "atk", "bi_", "mak", "me_", "nsa", "pre", "too", "töm", "emb", "hmä", "inä", "sko", "täe", "vil", "_dy", "emp", "koa", "rt_", "dyn", "lve", "nyt", "ohi", "ort", "_hy", "_is", "str", "sol", "tym", "yna", "aje", "art", "kei", "kum", "rty", "usk", "älj", "_ba", "aav", "ch_", "rva", "sp_", "st_", "ale", "el_", "osa", "syn", "yde", "äät", "elu", "haa", "kes", "mäi", "ni_", "rm_", "siv", "täy", "vät", "_ov", "eko"},
+	"fr": {"_de", "de_", "es_", "le_", "ion", "er_", "on_", "_le", "tio", "re_", "_co", "ent", "ur_", "_pa", "nt_", "_la", "_in", "ne_", "la_", "les", "ns_", "fic", "_un", "que", "_no", "te_", "our", "_d_", "_l_", "ich", "_re", "chi", "eur", "ati", "_po", "_en", "ier", "ble", "_fi", "men", "pas", "ue_", "as_", "con", "est", "_dé", "_es", "st_", "lis", "che", "res", "tre", "des", "cti", "ect", "un_", "hie", "et_", "en_", "_se", "pou", "_su", "_li", "com", "du_", "dan", "_ma", "_ré", "ans", "ire", "ssi", "_du", "_pr", "_da", "ant", "ge_", "rs_", "_ch", "uti", "_à_", "ibl", "par", "ess", "ts_", "_im", "ée_", "se_", "onn", "age", "ili", "pos", "ign", "ons", "eme", "_au", "it_", "_n_", "ver", "nte", "til", "iqu", "_so", "val", "ist", "ter", "mpo", "une", "_ut", "ali", "imp", "ont", "and", "ce_", "cha", "_mo", "ec_", "rre", "ers", "ten", "_op", "_ne", "ise", "omm", "nom", "us_", "_ex", "sib", "sio", "ut_", "str", "me_", "nde", "lle", "ide", "oss", "is_", "_av", "ser", "_ar", "ifi", "_tr", "ar_", "_si", "_va", "ort", "_ou", "ert", "ave", "al_", "_pe", "non", "_qu", "err", "ntr", "_a_", "aut", "ran", "man", "_do", "_lo", "_sy", "ure", "_ve", "tte", "_et", "_ta", "_fo", "ale", "_ce", "rti", "sse", "rée", "ien", "act", "ive", "sta", "_ca", "int", "_éc", "ie_", "_di", "nti", "sec", "inc", "per", "cor", "rec", "for", "cat", "_er", "té_", "ite", "ou_", "pti", "pro", "ara", "vec", "end", "nco", "ins", "at_", "ir_", "ind", "anc", "tur", "ica", "nce", "ées", "omp", "opt", "ill", "ang", "isa", "ren", "abl", "ode", "_sa", "déf", "ffi", "sup", "om_", "au_", "arg", "att", "ouv", "reu", "orm", "mat", "ez_", "ate", "ais", "gne", "ini", "êtr", "_êt", "ous", "oir", "in_", "pre", "mod", "tan", "_st", "lid", "_af", "fin", "tra", "her", "rou", "ssa", "por", "nst", "upp", "air", "_al", "aff", "tif", "tie", "_at", "teu", "dre", "he_", "pe_", "nne", "tai", "lig", "orr", "rép", "sym", "pri", "mbo", "ces", "_ét", "rma", "tro", "éch", "_ba", "mme", "reg", "_ap", "bol", "és_", "enc", "_te", "_to", "_bi", "_pl", "tes", "ére", "leu", "son", "ve_", "aqu", "ymb", "ule", "min", "peu", "sat", "ste", "_cl", "sur", "egi", "nal", "éri", "iti", "san", "ell", "_ac", "ass", "ole", "rai", "gis", "nda", "rer", "uet", "ett", "tiv", "tou", "qui", "ail", "cte", "cod", "_ob", "inv", "épe", "don", "née", "adr", "sou", "sig", "sag", "uve", "el_", "ina", "_vo", "rch", "tat", "urs", "all", "pér", "nts", "_mi", "ors", "ère", "_gr", "_me", "_cr", "ux_", "ine", "éfi", "rsi", "out", "ctu", "nta", "rat", "cal", "rem", "nu_", "_gi", "éra", "tru", "ace", "nnu", "éci", "eut", "erm", "_sp", "tré", "loc", "ala", "bre", "_b_", "ets", "arc", "pré", "toi", "typ", "ype", "_vi", "nva", "nné", "dif", "nor", "_an", "app", "nat", "lie", "ng_", "uct", "_ty", "si_", "rto", "den", "mma", "isé", "cri", "nd_", "an_", "rge", "ruc", "git", "jou", "paq", "car", "lan", "tal", "emp", "mpl", "_ad", "hec", "ond", "ori", "rac", "cré", "lus", "ute", "ait", "har", "oit", "pon", "il_", "tri", "ndu", "ong", "rie", "mit", "exp", "ext", "ume", "sor", "onf", "rit", "dép", "esp", "rt_", "ité", "oca", "lon", "ndi", "tiq", "ues", "nes", "rop", "auc", "fér", "_fa", "_s_", "pla", "_oc", "écu", "tab", "ern", "_nu", "gno", "fau", "uis", "_id", "spé", "jet", "_br", "réa", "cer", "lor", "péc", "liq", "dis", "mis", "lem", "lec", "nit", "inf", "gue", "plu", "seu", "ens", "_ig", "rce", "sem", "rgu", "omb", "ram", "mbr", "ari", "ppr", "nfo", "uan", "art", "op_", "tem", "ré_", "opé", "bas", "mer", "gra", "écr", "spo", "ppo", "mai", "_sé", "cle", "cun", "ro_", "rés", "sé_", "éta", "han", "doi", "its", "vou", "cif", "ngu", "exi", "nch", "ile", "_mé", "ase", "mar", "obj", "pli", "ris", "ucu", "emi", "rel", "_il", "bje", "ach", "déc", "mér", "rmi", "éfa", "ls_", "ni_", "qua", "mal", "ef_", "été", "dat", "réf", "gna", "id_", "eau", "iff", "iss", "bra", "_gé", "dex", "num", "ll_", "nir", "bit", "dir", "ain", "no_", "_pi", "ex_", "ord", "ore", "mot", "ime", "lef", "usi", "lat", "ana", "réc", "uel", "_sh", "fil", "ava", "cou", "_x_", "gro", "lac", "imi", "_he", "ieu", "édi", "ner", "uer", "oup", "lag", "mes", "uil", "amp", "eco", "_ho", "oin", "rd_", "ct_", "der", "pac", "erv", "oct", "ème", "rim", "aux", "rte", "ult", "bli", "ra_", "éro", "met", "_ab", "_na", "tèr", "lit", "ron", "ona", "urc", "roc", "_jo", "_ka", "nfi", "rro", "ima", "ès_", "ui_", "hor", "nqu", "ctè", "gum", "sui", "ttr", "vai", "éme", "tet", "van", "dit", "if_", "nue", "atu", "ham", "ian", "umé", "lim", "pen", "veu", "upe", "_ra", "ête", "mon", "ani", "pu_", "exé", "nge", "uiv", "ard", "cho", "emb", "odi", "pte", "ch_", "éné", "_as", "uto", "_bo", "éad", "xte", "eul", "fie", "hem", "ri_", "tor", "_pu", "lin", "uni", "tec", "nou", "_or", "gén", "rne", "ata", "_mu", "ple", "nér", "oré", "sée", "ot_", "ura", "onc", "sti", "anq", "tag", "tin", "cet", "equ", "qué", "_ni", "rni", "mp_", "pui", "_ha", "_fu", "ué_", "cut", "pat", "rip", "tée", "lic", "éfé", "rté", "nam", "sys", "né_", "ps_", "era", "fix", "fon", "hel", "ven", "ela", "hiv", "ges", "nie", "req", "ing", "ler", "xéc", "igu", "ial", "_r_", "ote", "cie", "ria", "déb", "ois", "èqu", "mpa", "vid", "arr", "moi", "ppl", "lai", "spa", "xe_", "fig", "éer", "isi", "oni", "mul", "dés", "ger", "yst", "acc", "nai", "xis", "sit", "fus", "mpr", "but", "dia", "tue", "rir", "urn", "dep", "lé_", "ses", "tit", "tex", "lab", "log", "itu", "ms_", "_aj", "aîn", "ta_", "ubl", "vea", "rme", "îne", "_bu", "_ci", "haî", "ame", "ema", "sen", "rve", "éte", "_em", "auv", "elo", "ajo", "gur", "tis", "iel", "mmi", "fié", "ret", "ept", "rom", "var", "env", "ref", "mag", "stè", "are", "odu", "tèm", "épa", "oce", "ech", "rès", "lém", "xpr", "_c_", "_ga", "eni", "lez", "nct", "uch", "uva", "_né", "ièr", "_sc", "_wa", "ai_", "col", "prè", "ed_", "scr", "bin", "poi", "_bl", "set", "uri", "vir", "diq", "sol", "voi", "nsi", "qu_", "têt", "ami", "oli", "ck_", "mau", "na_", "_am", "lir", "rap", "ora", "ssu", "tar", "tir", "apr", "epu", "eux", "not", "fai", "rta", "mac", "riq", "_fl", "ma_", "osi", "tia", "uvé", "éti", "ead", "ici", "ié_", "to_", "émo", "ad_", "dar", "os_", "pil", "llé", "_ro", "hin", "_él", "iva", "rea", "vé_", "dem", "nse", "_tê", "cen", "enu", "lti", "mbl", "rif", "sie", "nan", "tch", "ndo", "_on", "dét", "ga_", "mmé", "ral", "fs_", "_t_", "am_", "nem", "rde", "sto", "élé", "squ", "utr", "oms", "ésa", "_be", "ama", "_fr", "sus", "ixe", "olo", "cop", "inu", "isp", "_vé", "clu", "ouc", "rib", "tés", "éle", "eui", "hit", "dev", "ia_", "rog", "due", "nci", "vér", "ipt", "vis", "dul", "iat", "nga", "pt_", "rôl", "sa_", "_v_", "hou", "ivi", "ena", "niq", "sac", "ul_", "um_", "use", "_el", "hua", "ock", "vez", "éce", "sau", "war", "ète", "méd", "off", "or_", "ic_", "ogr", "sh_", "_m_", "bal", "ibu", "nve", "rio", "_e_", "_ge", "_us", "sez", "aba", "cur", "deb", "oma", "rév", "uss", "abi", "ppe", "ack", "éré", "ffé", "olu", "ôle", "ban", "eu_", "sai", "_ti", "ôt_", "sél", "bor", "fo_", "_o_", "_ko", "ff_", "trô", "éca", "be_", "gem", "mém", "vel", "blo", "_ai", "nel", "syn", "éga", "amm", "erc"},
+	"gl": {"_de", "de_", "_no", "do_", "on_", "_co", "os_", "non", "ión", "se_", "ro_", "_se", "ar_", "ón_", "_o_", "_es", "_pa", "ent", "_a_", "as_", "ció", "_un", "_re", "ra_", "da_", "fic", "_do", "_in", "te_", "_po", "est", "ado", "un_", "_fi", "to_", "con", "ica", "que", "ara", "_ma", "eir", "par", "es_", "aci", "_ca", "che", "iro", "no_", "ta_", "_pr", "ich", "men", "nte", "hei", "en_", "al_", "er_", "sta", "_si", "or_", "_li", "res", "_en", "_te", "ina", "_da", "ter", "ido", "pro", "des", "io_", "bel", "el_", "and", "ist", "tra", "com", "rio", "ndo", "ma_", "ou_", "_é_", "co_", "ari", "rec", "_di", "ha_", "nto", "ia_", "_fo", "ect", "_mo", "ada", "an_", "ca_", "err", "ste", "pos", "na_", "_ch", "la_", "_ba", "per", "rro", "_er", "ont", "_qu", "nha", "ao_", "_su", "ali", "car", "unh", "lic", "_ex", "_sa", "lo_", "ing", "ten", "ome", "ema", "ntr", "_me", "ura", "tec", "esp", "ue_", "_so", "esc", "ang", "ala", "_ao", "_pe", "íbe", "rad", "int", "me_", "use", "pre", "_na", "ida", "por", "_us", "_e_", "ng_", "_ka", "ere", "ori", "_ar", "ato", "cha", "ete", "_al", "nom", "nta", "tor", "tos", "_fa", "ran", "nal", "so_", "_ta", "po_", "_ou", "_lo", "cia", "cto", "nci", "ort", "eci", "síb", "nti", "ana", "ode", "osí", "és_", "ns_", "tal", "_an", "act", "str", "ian", "ico", "tic", "ano", "is_", "go_", "stá", "uci", "ele", "lid", "for", "_as", "tes", "all", "ant", "sin", "uet", "_le", "dos", "cla", "aqu", "nga", "spe", "ifi", "man", "re_", "cri", "llo", "duc", "omp", "iza", "tar", "_os", "ai_", "cac", "ese", "paq", "_la", "ama", "_ac", "rod", "dor", "sió", "era", "odu", "ir_", "ri_", "cad", "óns", "ave", "ver", "nde", "_ha", "ici", "ros", "le_", "pec", "tem", "ume", "end", "qui", "pod", "dir", "ona", "ser", "tro", "scr", "sen", "lem", "liz", "_op", "_va", "ion", "ua_", "abe", "mo_", "_or", "amb", "cor", "axe", "ga_", "_ni", "_ve", "den", "inc", "xe_", "ece", "ero", "orr", "cer", "las", "ve_", "ius", "rep", "_at", "áli", "cid", "gar", "rma", "tiv", "tur", "ita", "vál", "ame", "ire", "ito", "_vá", "ase", "min", "ngu", "_gr", "ind", "_ti", "oma", "ong", "_bu", "arg", "ign", "tad", "enc", "lec", "ciu", "ima", "lin", "orm", "tá_", "_ap", "_mi", "exi", "oi_", "bli", "can", "ers", "rea", "egu", "fal", "mas", "ade", "mar", "mer", "nor", "vo_", "aga", "emp", "mit", "nos", "ras", "_im", "asi", "dis", "ecl", "ria", "_id", "_to", "ndi", "gua", "sig", "ati", "cio", "mac", "rac", "ata", "be_", "nar", "uar", "nda", "in_", "_au", "ivo", "oca", "_cr", "ale", "ord", "_el", "alo", "lar", "rte", "mal", "equ", "eri", "ini", "ora", "pci", "mpr", "_bo", "foi", "alt", "dad", "erm", "eme", "gur", "nst", "_tr", "ani", "_bi", "cam", "ins", "mat", "dat", "ira", "mbi", "ino", "tan", "_pu", "usu", "púb", "úbl", "der", "lis", "sua", "rre", "tas", "imi", "lan", "ore", "gno", "hav", "tab", "án_", "aut", "chi", "ecu", "nic", "rar", "_du", "cci", "eco", "_wa", "rmi", "are", "opc", "_nu", "val", "ren", "rsi", "rta", "top", "aba", "mpo", "id_", "ace", "cif", "esi", "bas", "eta", "ite", "rit", "sa_", "_ob", "ba_", "spo", "cre", "deb", "za_", "_ko", "ert", "lla", "rib", "tua", "eo_", "epú", "ipo", "omo", "ula", "iña", "tri", "ual", "sis", "loc", "cal", "mai", "sco", "ngo", "reg", "iva", "rde", "gra", "hua", "tip", "uri", "req", "_gu", "ctu", "onf", "son", "ará", "li_", "var", "_ad", "_ku", "_sh", "bal", "cte", "ce_", "les", "mon", "olo", "tin", "anc", "dio", "ech", "iti", "mpa", "sca", "_ru", "seg", "spa", "xo_", "eng", "mor", "nco", "san", "zo_", "ble", "cti", "isp", "xec", "lor", "one", "ces", "exp", "ibi", "quí", "xa_", "zac", "hai", "rab", "xis", "_ga", "ios", "ost", "uír", "ña_", "ak_", "art", "atu", "pri", "ute", "_ce", "usa", "eli", "sti", "_am", "_bl", "atr", "cum", "fer", "ber", "ide", "ocu", "_ab", "_tu", "lad", "liñ", "nov", "wa_", "ell", "uto", "_má", "cen", "ea_", "edi", "ens", "ern", "gan", "igo", "nad", "pat", "uta", "_em", "_ne", "adi", "das", "doc", "et_", "nat", "tam", "ine", "ol_", "_xa", "ili", "ebe", "imp", "pli", "ric", "íre", "_pi", "_xe", "mba", "tid", "fra", "idi", "nfi", "pen", "ral", "_be", "rem", "rim", "unt", "_mu", "erv", "han", "lim", "sar", "cta", "igu", "ram", "blo", "eso", "ni_", "rgu", "am_", "ola", "va_", "_cl", "ais", "avi", "ban", "fil", "inf", "ron", "rup", "ur_", "war", "ard", "fon", "gui", "mes", "mos", "apa", "ill", "nin", "ous", "_fr", "_ya", "fin", "let", "loq", "max", "ll_", "nfo", "rra", "saí", "bia", "dic", "gad", "gum", "nec", "sur", "iga", "ka_", "mpl", "nes", "rda", "tre", "uin", "bre", "iso", "ive", "roc", "aka", "exe", "hin", "mod", "uid", "_n_", "bus", "eti", "_nú", "awa", "gru", "rqu", "dan", "esa", "ila", "odo", "rom", "_ag", "ias", "kar", "oro", "ote", "oñe", "rei", "uni", "upo", "bar", "dun", "efe", "epe", "ext", "núm", "ya_", "zar", "_wi", "apo", "ate", "dia", "ede", "hel", "iar", "kan", "squ", "abr", "aro", "ear", "eno", "itu", "dar", "emo", "esq", "lta", "oto", "pe_", "ret", "vis", "_ra", "cas", "det", "dif", "mi_", "pol", "vel", "_ho", "_s_", "ami", "apl", "fac", "lou", "nza", "uso", "úme", "_av", "ega", "ela", "ons", "ple", "rin", "ung", "us_", "_ci", "_za", "opo", "ref", "sim", "_he", "bo_", "bri", "ile", "los", "nse", "omi", "osi", "rga", "rut", "ábe", "_ke", "ena", "sto", "tán", "uiv", "_ro", "_ur", "har", "lon", "nan", "pan", "sia", "_br", "lav", "ngl", "ota", "arq", "niv", "oni", "uma", "ec_", "lac", "nia", "obt", "opa", "rso", "yan", "nac", "ne_", "sh_", "uan", "aya", "bor", "ova", "rob", "sel", "tir", "bir", "gun", "hi_", "lés", "nsa", "oqu", "red", "_cu", "ham", "med", "nam", "oce", "rvi", "uer", "_só", "coñ", "cur", "ial", "rne", "só_", "ñec", "ecc", "eit", "iom", "mad", "sol", "tig", "vid", "ía_", "_is", "fig", "ond", "ope", "out", "rti", "und", "wan", "_vi", "ane", "bin", "she", "xes", "ach", "dem", "ime", "iri", "lig", "lti", "mái", "nun", "oin", "pal", "til", "xte", "áis", "chu", "di_", "epa", "oli", "pa_", "úa_", "anz", "cos", "il_", "imb", "odi", "rón", "asa", "lle", "nés", "orn", "arr", "emb", "gal", "nex", "rak", "rir", "tou", "áct", "_ki", "opi", "rác", "sam", "tru", "xpr", "_gi", "aiú", "amo", "ch_", "glé", "iús", "len", "ler", "mak", "ult", "xer", "ída", "_lu", "ee_", "exa", "ibu", "oll", "sax", "sub", "ára", "_ng", "aíd", "it_", "ixo", "ogr", "tax", "win", "don", "iad", "lia", "lt_", "mbo", "nag", "ole", "om_", "pac", "rex", "tim", "um_", "_hu", "_ár", "amp", "coi", "col", "elo", "eu_", "gul", "tif", "urs", "uru", "ash", "bra", "ene", "lat", "pot", "rus", "vos", "ún_", "_có", "_fl", "erd", "ixe", "pui", "rna", "soc", "tex", "adu", "cat", "del", "ezo", "pt_", "rez", "ien", "lib", "obr", "wer", "bte", "oa_", "pun", "ulo", "ves", "vol", "_il", "acc", "ava", "cód", "lei", "mix", "sit", "yte", "índ", "_by", "ahu", "at_", "cut", "ke_", "oba", "rog", "ude", "vor", "_fu", "_ge", "byt", "cab", "cod", "ice", "num", "poi", "poñ", "ría", "sac", "sob", "tom", "ype", "én_", "_st", "dep", "dig", "nya", "sep", "si_", "sic", "tai", "uem", "xit", "ús_", "año", "bil", "bio", "ixt", "sha", "sun", "ti_", "ton", "uec", "adm", "cap", "ein", "gaz", "ock", "oné"},
+	"hr": {"je_", "_pr", "_po", "ije", "na_", "_za", "ka_", "_ne", "_na", "ja_", "ki_", "ni_", "_da", "_je", "dat", "ne_", "ato", "anj", "ti_", "ski", "sta", "tek", "ote", "_ko", "tot", "cij", "nje", "za_", "rij", "ija", "ke_", "_ni", "_iz", "_st", "ori", "no_", "pre", "nij", "_se", "ost", "ran", "ira", "_u_", "pro", "_re", "se_", "_mo", "men", "ma_", "om_", "pri", "red", "li_", "zna", "ako", "va_", "ika", "_s_", "_sa", "ta_", "iti", "_is", "_i_", "_op", "ra_", "_od", "jed", "ko_", "_do", "lja", "ent", "eka", "jen", "nak", "_ra", "ist", "nja", "_vr", "ili", "mog", "ogu", "ju_", "tor", "ati", "tav", "te_", "van", "jan", "_ka", "_ar", "_su", "sti", "pos", "_di", "_br", "ak_", "_in", "ani", "guć", "pis", "_gr", "aci", "_il", "_si", "pod", "_im", "ena", "ina", "an_", "nos", "roj", "će_", "ime", "tan", "pci", "edn", "lik", "opc", "bro", "dir", "raz", "ren", "sto", "eke", "_zn", "ema", "me_", "laz", "nsk", "ava", "tre", "ima", "are", "dan", "nem", "_sv", "ova", "rek", "nic", "eni", "ire", "_ak", "ana", "ara", "kom", "isp", "ret", "ve_", "og_", "str", "iva", "alj", "ris", "vi_", "_ma", "oj_", "gre", "kor", "ora", "ih_", "ume", "nar", "jel", "ku_", "ekt", "uće", "lje", "dno", "usp", "nu_", "oda", "ešk", "ao_", "ano", "_ba", "_ti", "st_", "reš", "to_", "lju", "eva", "_ov", "_sp", "la_", "_de", "eno", "od_", "val", "aka", "_bi", "da_", "sa_", "ri_", "kto", "eme", "im_", "ali", "mje", "ji_", "ici", "poz", "_ve", "pra", "kov", "eli", "_us", "enj", "nt_", "iše", "kao", "avi", "gra", "vor", "era", "for", "ata", "ica", "orm", "ada", "edb", "rem", "_sl", "še_", "ula", "vrš", "jev", "_al", "_me", "ce_", "pot", "rat", "rav", "_ta", "izv", "_tr", "kon", "ca_", "ver", "spi", "nev", "ove", "drž", "koj", "var", "ška", "_no", "_up", "sni", "por", "eta", "er_", "ore", "ita", "_pa", "vri", "jer", "nik", "rma", "zad", "ozn", "jsk", "vlj", "ci_", "nte", "nov", "oje", "arg", "avl", "est", "pje", "su_", "spj", "ska", "tri", "_fo", "az_", "ini", "tra", "odr", "res", "_lo", "čin", "_ob", "čit", "ene", "_li", "tak", "en_", "sig", "že_", "and", "pon", "opi", "vje", "ari", "eda", "tar", "nog", "ičk", "eci", "ovi", "tip", "isa", "on_", "adr", "azi", "ovn", "vni", "zni", "ave", "nav", "ite", "mat", "mo_", "aj_", "lič", "vez", "ede", "emo", "reb", "le_", "aln", "ont", "sam", "ba_", "amo", "edi", "ezi", "klj", "lo_", "ogr", "rad", "oji", "juč", "omp", "one", "izl", "_va", "azn", "nač", "ans", "eku", "upo", "ust", "đen", "nal", "oli", "spr", "enu", "ona", "_to", "rin", "nat", "nih", "ik_", "ing", "ter", "dar", "pok", "zla", "_n_", "bit", "sad", "dre", "iju", "otr", "rža", "den", "ice", "tal", "ifi", "at_", "elo", "int", "lin", "nom", "ine", "nim", "_ul", "dni", "ane", "bli", "is_", "tvo", "_uk", "ajt", "ama", "pov", "ign", "raj", "_be", "gum", "ovo", "rgu", "sim", "rit", "tir", "or_", "rom", "ved", "epo", "ala", "esk", "ipk", "zap", "jem", "oka", "stv", "spo", "nut", "_a_", "ng_", "fik", "piš", "rši", "nda", "nep", "vel", "rep", "rab", "_kr", "_vi", "eri", "rst", "stu", "jez", "zav", "spe", "_os", "lok", "ziv", "_co", "etk", "mor", "ake", "čni", "isn", "iz_", "_nu", "mij", "vrs", "rni", "rsk", "avr", "id_", "sli", "rik", "usk", "eks", "jes", "ame", "kra", "ši_", "baj", "abl", "ači", "met", "mož", "čki", "adn", "ard", "ij_", "ovj", "kre", "sva", "_fi", "es_", "olj", "oče", "tva", "iči", "nti", "re_", "al_", "pec", "sko", "zor", "abi", "kac", "pop", "_či", "man", "tu_", "ukl", "aza", "ijs", "okr", "tsk", "aže", "aju", "et_", "ram", "mak", "vak", "ezn", "izr", "ože", "_te", "_ur", "nta", "oku", "pol", "ređ", "ći_", "_ci", "bi_", "tat", "tiv", "_pi", "roc", "uje", "ad_", "ar_", "mal", "par", "_so", "eve", "_ap", "sus", "ces", "elj", "opu", "arh", "rhi", "zra", "ček", "fil", "lan", "be_", "slo", "ži_", "_ot", "hiv", "nju", "_kl", "api", "eko", "_un", "eti", "rir", "zvo", "ern", "osl", "tim", "vre", "dak", "dij", "em_", "oce", "_sh", "in_", "ins", "kaž", "avn", "tup", "_sk", "ađe", "dnj", "ičn", "vno", "bol", "ez_", "eki", "de_", "ate", "voj", "cif", "lic", "_o_", "lij", "viš", "mac", "ron", "vo_", "nor", "dop", "ile", "kci", "rno", "aja", "inu", "ga_", "lem", "ste", "šte", "rea", "upa", "_oz", "pli", "bez", "ed_", "lon", "oja", "tin", "bra", "di_", "ebn", "mbo", "oni", "_mi", "pe_", "atu", "ll_", "obi", "odn", "vit", "imb", "ivn", "rdn", "tit", "zic", "aps", "arn", "kol", "lni", "dok", "ere", "lat", "_du", "ast", "dos", "jav", "lov", "per", "sve", "enc", "uči", "_c_", "_on", "aje", "dbe", "gna", "_bl", "_uz", "naz", "nič", "rog", "tni", "_sm", "am_", "osn", "tov", "tro", "ind", "toj", "zin", "mer", "tab", "utn", "des", "ive", "ruk", "ubl", "zvr", "_fu", "cir", "lsk", "odi", "oto", "san", "vij", "bil", "io_", "blo", "din", "išt", "žan", "iko", "ion", "_um", "klo", "kum", "oko", "ozi", "ref", "_en", "odu", "ten", "zan", "jek", "omi", "ras", "dba", "jab", "mar", "pub", "rip", "evi", "les", "obr", "ske", "epu", "isk", "loc", "nit", "šir", "dod", "gle", "omo", "poč", "osi", "apo", "pa_", "toč", "dna", "pom", "put", "taj", "tom", "ens", "uta", "či_", "_lj", "_ru", "eus", "kst", "psk", "_cr", "_fr", "_t_", "rim", "čen", "kri", "ode", "tno", "_la", "ars", "bna", "fun", "igu", "ila", "kla", "ntr", "_he", "_id", "las", "_ok", "dvo", "rol", "aži", "edo", "jus", "nul", "ope", "oru", "ozo", "pak", "reu", "_d_", "ječ", "nam", "nji", "rak", "_ad", "efi", "fic", "gur", "kiv", "lji", "min", "neo", "orn", "ort", "uda", "vim", "ćen", "_e_", "_l_", "ble", "međ", "tve", "atn", "gla", "ure", "_ig", "cim", "ješ", "nen", "ink", "mpo", "ock", "reg", "rez", "vaj", "oči", "rap", "rup", "umj", "čka", "_dv", "kti", "rov", "vat", "vna", "čno", "_ca", "_ju", "ck_", "eng", "gno", "isu", "tke", "aba", "ačk", "gov", "gru", "inf", "ip_", "it_", "pun", "ut_", "vu_", "_p_", "eće", "iji", "kad", "nađ", "nst", "ser", "_dr", "adi", "eđu", "lav", "nfo", "nkc", "unk", "us_", "zij", "_x_", "iks", "iv_", "kan", "nad", "urn", "_v_", "apa", "ert", "ned", "neu", "ivi", "ks_", "skr", "sno", "tka", "ven", "_ge", "_nj", "ok_", "_pu", "bje", "mpl", "sla", "tič", "zik", "aro", "bin", "eba", "ele", "ket", "she", "zag", "pan", "ući", "asp", "gu_", "kal", "rec", "una", "urs", "dsk", "eđa", "nd_", "vod", "_f_", "_mr", "_om", "lno", "ype", "ze_", "otv", "šta", "def", "fin", "nei", "_ri", "apl", "ela", "rna", "sje", "_če", "jto", "ono", "rti", "uni", "uzi", "zim", "čke", "ers", "esi", "ilj", "kop", "omj", "riv", "rzi", "zat", "eis", "esa", "iza", "kaz", "num", "prv", "rač", "rl_", "spa", "suf", "ušt", "_el", "mem", "typ", "_wi", "akt", "alt", "con", "ese", "kat", "puš", "ros", "uži", "đaj", "ški", "slu", "_bu", "_ha", "ače", "bri", "iri", "ngl", "uzo", "ber", "dje", "efe", "euz", "ide", "ple", "_uč", "aki", "lis", "sh_", "učn", "već", "bu_", "net", "rac", "skl", "_bo", "_ex", "dob", "kam", "msk", "nci", "oma", "svo", "_au", "_ki", "_pe", "ack", "kup", "ojs", "ons", "rve", "uče", "mod", "mon", "smi", "tel", "com", "erv", "fer", "izi", "liz", "oft", "rži", "sof", "ća_", "_r_", "ale", "do_", "hel", "nek", "pac", "sek", "uga", "užn", "esu", "ets", "eći", "ino", "jst", "lir", "oba"},
+	"hu": {"_a_", "_ne", "_sz", "em_", "_me", "_az", "az_", "en_", "ele", "nem", "_ki", "ájl", "fáj", "len", "tt_", "tel", "ása", "meg", "és_", "sa_", "_ha", "_fá", "tás", "cso", "gy_", "et_", "_ka", "_el", "_le", "ek_", "ara", "_be", "egy", "asz", "nál", "_va", "_kö", "ok_", "men", "_ér", "_eg", "tés", "ak_", "es_", "_hi", "has", "_cs", "ncs", "sze", "agy", "an_", "ás_", "szn", "ssz", "ent", "hat", "jl_", "zná", "sít", "_al", "lt_", "ter", "_ta", "ett", "ény", "ése", "_fe", "ítá", "fel", "se_", "at_", "_fo", "al_", "_pa", "ért", "tal", "lít", "ott", "tár", "sol", "áll", "rás", "for", "tó_", "_mi", "_és", "jel", "kap", "cs_", "ran", "ene", "hoz", "_ke", "sza", "tum", "apc", "pcs", "szá", "_re", "vén", "par", "or_", "ató", "ere", "ja_", "nt_", "int", "el_", "ker", "het", "net", "zet", "min", "vag", "anc", "eze", "kor", "rak", "re_", "zés", "_z_", "ált", "hib", "kez", "oló", "_ad", "rvé", "lat", "_ar", "érv", "si_", "ála", "gye", "szt", "va_", "kar", "er_", "ni_", "let", "zám", "llí", "íté", "ra_", "akt", "sor", "mez", "lha", "ba_", "zás", "ik_", "lás", "írá", "ely", "rte", "us_", "ség", "_he", "os_", "_ho", "nak", "szi", "vál", "elm", "ány", "zer", "lis", "ren", "inc", "um_", "ező", "lle", "yte", "hel", "_so", "ala", "ló_", "iba", "ind", "lme", "on_", "tar", "nyt", "_te", "_ma", "alá", "_vá", "art", "_pr", "_tö", "eg_", "is_", "köz", "_ni", "_si", "end", "ti_", "_bi", "ete", "lye", "nye", "nek", "ato", "ez_", "orm", "_li", "tet", "kte", "yel", "les", "név", "_in", "_je", "nin", "kön", "ban", "nyv", "rt_", "sik", "ve_", "öny", "oz_", "_né", "dat", "eál", "_fi", "esz", "ár_", "ta_", "ell", "csa", "mag", "rmá", "sak", "ték", "ver", "rté", "_ké", "ada", "vtá", "yvt", "oma", "sok", "öve", "tot", "ume", "ha_", "ntu", "eti", "iss", "ezé", "nde", "ega", "ság", "gad", "pro", "ész", "atá", "beá", "_de", "_ál", "_vi", "áso", "ike", "val", "ége", "ont", "ert", "_lé", "alm", "vet", "_ko", "öss", "év_", "elő", "rül", "ben", "ozá", "lap", "som", "ző_", "elt", "tre", "res", "arg", "erü", "ül_", "ai_", "ehe", "st_", "_ve", "ára", "_is", "_ku", "ot_", "áló", "át_", "ist", "ll_", "lva", "_ös", "ill", "elh", "leh", "eme", "ite", "tat", "lét", "nev", "ók_", "lma", "lcs", "lok", "osí", "_ut", "ata", "olá", "_ol", "kel", "tő_", "ási", "ásá", "ort", "maz", "ia_", "nyo", "ció", "eje", "nos", "els", "ető", "ime", "iír", "kií", "lin", "ret", "án_", "szo", "_ez", "_tá", "ges", "lto", "ztá", "eté", "köv", "sz_", "fej", "kat", "yez", "eve", "olv", "toz", "asá", "_ny", "_id", "vas", "tke", "van", "vis", "le_", "_co", "lép", "_es", "lem", "rta", "ési", "bb_", "mer", "elv", "fig", "oro", "rés", "lés", "ulc", "_má", "kul", "_új", "lta", "nte", "_n_", "elé", "kén", "etk", "kus", "leg", "iku", "vég", "hez", "rek", "dsz", "nds", "tör", "ámo", "ne_", "zik", "ult", "am_", "reh", "tok", "_do", "ang", "li_", "mat", "mód", "_po", "esí", "por", "zó_", "_ba", "_vé", "las", "ána", "ól_", "ono", "ket", "yes", "ati", "igy", "oly", "fol", "met", "áli", "átu", "bil", "den", "ént", "éte", "azo", "ván", "szü", "rat", "_mó", "_ír", "orr", "kim", "ág_", "tle", "áci", "álh", "ám_", "and", "kil", "vek", "kül", "ál_", "ére", "lan", "zon", "_en", "arc", "etl", "gat", "tol", "_fr", "egh", "ító", "de_", "ord", "sta", "zab", "_át", "ako", "jlo", "kód", "vel", "ölt", "olg", "oss", "kép", "lla", "más", "oga", "rgu", "ült", "kal", "táv", "gum", "ess", "pít", "ék_", "pus", "te_", "dás", "_na", "_se", "ado", "cím", "_ak", "ag_", "eng", "hív", "kér", "lya", "ma_", "rlá", "bem", "vol", "ng_", "típ", "ég_", "étr", "ípu", "lál", "mán", "mát", "ten", "yam", "ce_", "ink", "lók", "ésé", "gra", "ese", "_mé", "ame", "tan", "osz", "per", "épé", "dot", "hag", "oka", "ram", "zto", "nty", "lté", "_st", "tek", "yos", "áro", "ítv", "_tu", "yet", "zár", "_cí", "ódo", "ksé", "mok", "tha", "zük", "üks", "tja", "tán", "ől_", "sul", "zen", "_bá", "ilé", "pés", "rch", "rol", "tén", "gi_", "rrá", "tyű", "zin", "ód_", "lán", "vár", "ama", "gál", "túl", "éne", "_mu", "hit", "in_", "tes", "íte", "_di", "_er", "ki_", "zig", "_no", "om_", "ris", "sek", "zol", "be_", "kon", "ago", "ny_", "éke", "cse", "erz", "ltá", "ély", "adv", "dva", "ism", "nge", "opo", "ri_", "tik", "tor", "_ré", "adá", "apé", "pér", "inf", "lve", "omá", "töl", "ali", "lep", "lja", "ák_", "ávo", "_nu", "gje", "koz", "umo", "dél", "nfo", "_kó", "dol", "ly_", "_tú", "_t_", "jez", "mel", "ép_", "_ap", "_tí", "eke", "ign", "tos", "za_", "_ig", "ana", "get", "uto", "báj", "egj", "epí", "szl", "szó", "dok", "jeg", "na_", "son", "tva", "dít", "rzi", "tri", "ájt", "fog", "mér", "_ti", "idő", "ol_", "zat", "_ro", "ar_", "ege", "nyi", "rto", "sen", "tve", "err", "ion", "isz", "mog", "sér", "biz", "eha", "dik", "sop", "tám", "ul_", "_ca", "tom", "zió", "ánc", "nél", "chí", "ke_", "sra", "vum", "ét_", "eho", "füg", "la_", "ros", "stá", "ót_", "özt", "ügg", "_to", "erl", "ika", "kif", "lső", "ívu", "_lo", "kum", "áho", "_an", "ezt", "set", "sme", "str", "aló", "ani", "edé", "ogy", "ons", "ozó", "tla", "bel", "ide", "bvá", "ból", "oli", "rog", "ső_", "tko", "ged", "ghi", "hiú", "hos", "id_", "ile", "iús", "zte", "árs", "úsu", "it_", "jln", "mác", "nto", "ona", "von", "gya", "ogr", "oku", "tér", "unk", "ább", "ásr", "rsa", "sas", "sás", "álá", "_e_", "abv", "kko", "nul", "ton", "zel", "_fü", "_kí", "ain", "atl", "ezi", "olí", "_op", "hog", "rea", "att", "bet", "fil", "gre", "nc_", "szk", "ad_", "han", "_sa", "alk", "blo", "ió_", "_fu", "etö", "izt", "rre", "_ja", "dő_", "elü", "gok", "ina", "jlt", "pon", "don", "ern", "mun", "fri", "már", "ors", "rhe", "zak", "_am", "ajt", "eri", "erm", "haj", "lül", "rok", "én_", "_la", "_ür", "ozz", "tag", "úl_", "_da", "álj", "új_", "_mo", "ate", "ing", "okk", "rít", "utá", "_gy", "alo", "mar", "mbo", "nsá", "nta", "zha", "éré", "ív_", "gyz", "kis", "lak", "üre", "_su", "lek", "lgá", "_jo", "kít", "töb", "öbb", "apo", "atk", "del", "eko", "jes", "nd_", "bol", "mén", "nka", "akk", "ián", "kív", "nag", "sáh", "ull", "él_", "enn", "enő", "ezd", "gyo", "ibá", "zi_", "élk", "hiá", "lel", "me_", "rd_", "ser", "ssé", "vül", "con", "kés", "lik", "nőr", "pe_", "tta", "zim", "öz_", "_s_", "aso", "azá", "dos", "iók", "ját", "lez", "nal", "nté", "nít", "ola", "onl", "rom", "ssí", "szí", "szö", "_ch", "eta", "imb", "lkü", "lát", "égr", "alt", "ení", "gná", "hal", "kiv", "nce", "nyz", "yan", "yzé", "íth", "ívü", "egé", "gys", "ife", "sat", "tev", "yen", "gyá", "vő_", "yűk", "ímk", "_c_", "_mű", "bej", "evő", "lal", "lje", "lka", "lsz", "nk_", "töm", "ásk", "ást", "íto", "enc", "láí", "no_", "rin", "tív", "áír", "épe", "ari", "iva", "lő_", "rny", "_sp", "_ug", "ext", "ine", "nor", "old", "vez", "yás", "zot", "zt_", "_i_", "_ur", "ajd", "efe", "eli", "ge_", "mi_", "roz", "tez", "tte", "zes", "zzá", "etr", "ig_", "pt_", "ria", "aut", "bbi", "loc", "pot", "tra", "iná", "_kü", "elj", "kör", "the", "örö", "ash", "ber", "bi_", "gos", "hiv", "rál", "szé", "tót", "ula", "vid", "zta", "_bl", "kai", "ldá", "lot", "lsó", "ols", "ope", "vat", "_il", "ejl", "lgo", "tab", "érh", "akí", "era", "ngo", "rán"},
+	"id": {"an_", "kan", "_da", "_di", "ak_", "_me", "_ti", "ng_", "ang", "ida", "dak", "tid", "si_", "men", "_pe", "at_", "_se", "_be", "eng", "ala", "ah_", "ber", "_ke", "nga", "ter", "per", "kas", "ri_", "ari", "ika", "_in", "_re", "al_", "_ba", "asi", "uk_", "ata", "as_", "_te", "_ta", "_un", "gan", "ntu", "da_", "unt", "tuk", "apa", "rka", "pat", "yan", "ada", "_ya", "_ko", "_de", "ama", "erk", "lam", "dal", "ali", "ara", "am_", "_pa", "ran", "dar", "dap", "er_", "tan", "aka", "_ma", "era", "ing", "mem", "ar_", "ma_", "it_", "uka", "ung", "eri", "lan", "_sa", "nya", "and", "pen", "han", "nam", "ai_", "_si", "seb", "una", "ya_", "gun", "aga", "_ad", "nda", "_na", "ngg", "bua", "emb", "den", "is_", "ngk", "_bu", "_ga", "gal", "lah", "nta", "_ha", "id_", "_va", "ini", "_st", "nak", "_ar", "mba", "dan", "ent", "ela", "ena", "bar", "on_", "_ka", "rin", "et_", "ila", "int", "ke_", "_su", "or_", "ta_", "val", "ni_", "_bi", "_at", "ebu", "bol", "en_", "in_", "ka_", "_no", "ol_", "_ja", "_op", "lid", "di_", "_co", "eks", "_la", "tak", "us_", "ili", "str", "gka", "lik", "ik_", "isi", "pil", "_an", "bah", "sta", "iha", "mas", "ist", "set", "mat", "mbo", "tor", "tar", "le_", "de_", "au_", "ste", "kom", "_ca", "ers", "bag", "elu", "_al", "dia", "_pr", "ia_", "lih", "erl", "el_", "kun", "tau", "aru", "ind", "asa", "dir", "aba", "_lo", "ori", "ban", "ian", "san", "lua", "uah", "ode", "_pi", "aha", "ra_", "ver", "ipe", "kon", "ket", "ati", "gag", "es_", "sim", "ris", "ire", "uar", "lai", "na_", "_gi", "uat", "end", "git", "tik", "_po", "lok", "uan", "uku", "rsi", "ura", "har", "atu", "oka", "_le", "bel", "sa_", "amb", "jan", "for", "tu_", "esi", "tam", "ti_", "nal", "nde", "rek", "dik", "ant", "rma", "_mo", "te_", "ek_", "man", "esa", "ekt", "alu", "pan", "_li", "imb", "mbu", "any", "ert", "emu", "ggu", "ga_", "reg", "_ak", "rel", "eta", "ite", "orm", "tem", "hka", "pa_", "ut_", "buk", "akt", "aan", "_ni", "ruk", "tah", "_gu", "nti", "dis", "ana", "lka", "ksi", "ren", "erb", "ens", "ong", "aik", "pro", "arg", "dit", "eba", "sal", "_fo", "did", "ere", "ope", "rak", "pe_", "res", "kar", "sik", "ole", "ur_", "kel", "tif", "pad", "eti", "uru", "egi", "isa", "aca", "lin", "rus", "_ku", "nte", "nsi", "ion", "ula", "_fi", "nst", "ks_", "lis", "agi", "asu", "pre", "mod", "mpa", "tas", "amp", "tip", "_ch", "lat", "ed_", "pak", "bun", "gis", "igu", "ike", "ile", "re_", "jal", "la_", "_ve", "tka", "rik", "tri", "dip", "kto", "ume", "suk", "rah", "ins", "nil", "idu", "_ob", "ele", "tel", "apu", "ref", "but", "gga", "pem", "pes", "ndi", "bac", "leh", "duk", "um_", "_mu", "dat", "elo", "ina", "tin", "mit", "_ap", "ten", "bai", "tru", "dib", "ca_", "_x_", "tat", "bje", "obj", "omi", "nan", "ilk", "ote", "se_", "has", "eru", "_to", "_he", "_sp", "kat", "spe", "ema", "ses", "ap_", "bal", "li_", "nka", "mer", "ete", "fik", "_mi", "_sh", "pus", "ifi", "dae", "err", "nd_", "ua_", "eme", "eny", "dek", "lu_", "omp", "uba", "mpi", "ih_", "nt_", "sar", "aer", "gat", "hir", "def", "jek", "ken", "enu", "sin", "emi", "fil", "ima", "eh_", "il_", "sam", "_do", "chi", "ead", "rap", "nja", "rla", "ern", "if_", "sel", "uks", "utu", "_en", "_ek", "akh", "tra", "gai", "ngh", "_wa", "emo", "ngu", "mor", "ain", "fer", "ras", "aya", "kur", "sis", "ker", "awa", "ria", "bit", "ene", "car", "ir_", "nci", "rep", "emp", "rti", "tur", "_hi", "imp", "ita", "khi", "inf", "ll_", "onf", "unc", "ont", "aks", "nfo", "_er", "kod", "enc", "erj", "ame", "efe", "ja_", "ani", "anj", "kal", "hap", "_tu", "psi", "uga", "dig", "ui_", "gab", "gia", "hon", "saa", "gur", "_ex", "kti", "din", "nis", "muk", "ate", "mel", "por", "atk", "rja", "rro", "edi", "lal", "ror", "cab", "ec_", "no_", "_pu", "abe", "aku", "ck_", "ki_", "ack", "gha", "un_", "ars", "ink", "bat", "ad_", "lum", "ok_", "sit", "ve_", "lur", "pli", "tio", "me_", "leb", "rip", "uli", "_bo", "ect", "efi", "gi_", "rat", "mil", "up_", "ank", "rgu", "_is", "lak", "_as", "rea", "rna", "sec", "fin", "ip_", "ci_", "sub", "_ab", "con", "st_", "che", "dah", "hil", "aat", "aft", "sif", "tab", "eda", "erd", "_ji", "ebi", "ign", "rba", "tal", "uta", "ose", "par", "ros", "ngi", "der", "ake", "epe", "min", "sem", "gum", "_du", "em_", "pos", "abu", "sh_", "rup", "_gr", "are", "fta", "daf", "ibu", "_ru", "ahk", "bur", "rge", "sia", "hat", "ch_", "kin", "ktu", "mak", "oho", "sed", "osi", "all", "mbe", "bin", "ive", "_ol", "bil", "itu", "ola", "ser", "iab", "ibe", "dil", "ru_", "uh_", "pka", "ebe", "iri", "ngs", "bih", "rem", "bas", "ine", "ort", "ul_", "apk", "omo", "jik", "ne_", "ram", "dug", "umb", "ore", "_ne", "ahu", "tek", "tuh", "ipa", "mun", "nge", "_so", "gin", "mul", "_tr", "_of", "hea", "sil", "gra", "iki", "pun", "mar", "nom", "oco", "one", "bis", "gar", "rse", "_ra", "ge_", "ic_", "wal", "ba_", "aua", "mbi", "tag", "tom", "coc", "dih", "ops", "ksp", "les", "ndu", "ntr", "ce_", "ega", "nfi", "eka", "nar", "loc", "mal", "tap", "_sy", "pas", "rlu", "sum", "sun", "und", "arc", "cok", "_ju", "erp", "fig", "_uk", "erg", "met", "cat", "kau", "las", "non", "_lu", "ito", "dul", "rt_", "to_", "ag_", "son", "ade", "nul", "des", "hel", "lem", "out", "lar", "ksa", "ami", "ark", "oba", "ons", "_n_", "_ul", "ase", "ona", "pi_", "tim", "odu", "ise", "_id", "api", "sen", "npa", "ot_", "op_", "kte", "rta", "th_", "sat", "sua", "anp", "oto", "_th", "tus", "ult", "rub", "get", "omb", "sud", "_im", "rch", "erh", "est", "war", "_ur", "mot", "ry_", "uda", "_ot", "tec", "_je", "ble", "iny", "iti", "oma", "tia", "epa", "oso", "mpl", "ben", "com", "gki", "tun", "dua", "ide", "kem", "poh", "_by", "_ge", "log", "ash", "kut", "ial", "_hu", "ira", "mua", "rd_", "cha", "eni", "kes", "pac", "_ki", "enj", "mon", "sio", "rai", "_r_", "anc", "dim", "ku_", "_nu", "das", "fo_", "hin", "ord", "ro_", "sep", "yte", "ner", "_ce", "adi", "kum", "rit", "_a_", "ff_", "kos", "lt_", "mpo", "wak", "_br", "byt", "nye", "_it", "nat", "eca", "rim", "ubl", "usi", "epo", "lit", "ds_", "ked", "tes", "uri", "ewa", "spa", "apl", "lac", "mis", "nor", "spr", "bug", "exp", "gam", "luk", "nca", "nju", "olo", "om_", "_el", "fse", "ilu", "rec", "_am", "ct_", "tre", "mpe", "cal", "ug_", "_wi", "sek", "_s_", "erm", "oni", "ple", "uhk", "_au", "_sk", "abi", "lon", "pol", "rol", "hua", "bli", "mes", "rli", "sai", "bes", "_qu", "pec", "upa", "ab_", "dif", "ese", "cti", "hui", "ora", "ove", "tet", "bmo", "not", "red", "ubm", "var", "yak", "_aw", "_fu", "apo", "deb", "jum", "usa", "_fr", "age", "naa", "ral", "sig", "ias", "isp", "nen", "oro", "put", "bak", "wat", "sym", "_c_", "art", "cor", "iku", "pub", "utk", "lay", "tai", "_d_", "epu", "nem", "ica", "num", "pal", "wa_", "fka", "ifk", "ngo", "alt", "mai", "ow_", "ps_", "ell", "off", "_us", "eli", "flo", "iba", "len", "kah", "lia", "hi_", "hiv", "uju", "yal", "rde", "shi", "bra", "del", "ota", "she", "aut", "kse", "lew", "_bl", "kri", "lti", "nin", "sti", "uni", "_or", "ach", "iko", "mla", "_ci", "fun", "imu", "mi_", "rsa", "nia", "oku", "run", "ss_", "_ut", "kai", "ls_", "ts_", "do_", "ond", "rbo", "low"},
+	"it": {"to_", "le_", "_di", "re_", "_co", "ion", "_no", "on_", "di_", "_de", "ne_", "_in", "one", "ent", "ile", "zio", "non", "ta_", "la_", "del", "_ri", "con", "ato", "il_", "ti_", "te_", "_fi", "_il", "nte", "per", "ell", "sta", "_un", "pos", "er_", "are", "ica", "_se", "fil", "_pe", "men", "bil", "mpo", "ssi", "_es", "_im", "azi", "un_", "ali", "_la", "el_", "ess", "imp", "_re", "chi", "com", "_è_", "_st", "ibi", "_pr", "_al", "no_", "lo_", "ett", "est", "_ne", "lla", "_da", "_ma", "oss", "_so", "_ch", "ere", "_pa", "ore", "in_", "_l_", "che", "na_", "ll_", "ni_", "tat", "sib", "ati", "nti", "_su", "ra_", "so_", "ter", "ver", "do_", "all", "me_", "ro_", "ese", "ten", "fic", "ome", "_si", "se_", "ifi", "val", "li_", "_va", "ata", "io_", "_le", "ale", "and", "ca_", "ina", "oni", "_ca", "it_", "_mo", "nto", "seg", "att", "tto", "ire", "ma_", "err", "tor", "eri", "_i_", "tte", "ita", "_me", "sci", "cor", "ia_", "tro", "cat", "ura", "pre", "ran", "sio", "da_", "ont", "_tr", "nel", "_sc", "rat", "ggi", "ono", "ame", "str", "ost", "_us", "_ar", "ric", "ri_", "man", "_a_", "int", "_qu", "he_", "izz", "rma", "_op", "_er", "ndi", "ve_", "tra", "ist", "co_", "for", "ito", "agg", "nom", "car", "zza", "_li", "_e_", "ari", "_gi", "mod", "za_", "rim", "pro", "ser", "rro", "_ve", "ara", "mer", "ing", "_po", "_sp", "_ta", "llo", "po_", "acc", "ce_", "_nu", "por", "dir", "ei_", "ori", "ndo", "rec", "lid", "sa_", "ind", "una", "egu", "tti", "usc", "ror", "hia", "cit", "usa", "_el", "res", "era", "nta", "anc", "tes", "ort", "ini", "ius", "ero", "ich", "rea", "ant", "enz", "uto", "gio", "min", "olo", "ste", "que", "_te", "sto", "_lo", "liz", "_fo", "ili", "si_", "_vi", "dei", "ass", "lit", "_cr", "ora", "ry_", "al_", "ga_", "nal", "sti", "_ba", "_at", "an_", "git", "lic", "lle", "_o_", "_sa", "tri", "iav", "ele", "opz", "ntr", "ris", "ime", "spe", "orm", "sse", "pzi", "gui", "ppo", "tal", "ave", "ine", "nde", "ut_", "pac", "ers", "sso", "ice", "gge", "nes", "ene", "loc", "lin", "ume", "_pu", "mat", "pri", "rsi", "de_", "ng_", "ico", "ect", "dal", "riu", "_ap", "pec", "eci", "mit", "den", "_an", "rit", "ory", "ona", "_ag", "cri", "odi", "gli", "spo", "dif", "sol", "ede", "rta", "omp", "nat", "cch", "oma", "rig", "son", "cre", "sen", "ien", "cif", "ch_", "fin", "_ha", "ual", "_pi", "tar", "dat", "vis", "tur", "tic", "ivi", "ssa", "_do", "_ut", "izi", "scr", "par", "ate", "ues", "ido", "rio", "tiv", "put", "ezi", "tà_", "upp", "ces", "col", "nit", "ova", "orr", "ang", "sim", "pon", "omm", "_du", "ond", "ria", "oll", "isp", "ung", "_au", "fer", "ors", "ott", "uti", "dic", "nor", "num", "sco", "orn", "ute", "ive", "dis", "ana", "mmi", "rd_", "lor", "nza", "ert", "abi", "cto", "ità", "oca", "_og", "ian", "_ti", "ge_", "st_", "alt", "mbo", "ide", "alo", "get", "ttu", "het", "ior", "vo_", "uov", "_ce", "_ge", "arc", "_bi", "tan", "rif", "arg", "raz", "tam", "ano", "sun", "gra", "onf", "bol", "sis", "sup", "end", "sar", "tem", "erc", "rov", "iut", "_mi", "et_", "_mu", "efi", "_gr", "nar", "itt", "leg", "len", "ord", "app", "hie", "ren", "let", "rch", "taz", "nzi", "ssu", "_ka", "nch", "out", "_or", "nam", "ha_", "bas", "ima", "nga", "erm", "ase", "rin", "rge", "amp", "aut", "oli", "_tu", "des", "egn", "reg", "cam", "erv", "mes", "ack", "def", "imb", "sul", "gin", "sh_", "inf", "pli", "rso", "ema", "mi_", "_br", "tre", "cer", "ue_", "der", "imi", "osi", "tta", "enc", "pat", "emo", "esi", "nsi", "rna", "ger", "eco", "nca", "ogg", "_na", "mpa", "ola", "ram", "nos", "ult", "iat", "iso", "rep", "gno", "ida", "vi_", "ber", "ins", "ign", "nfo", "ove", "tip", "irm", "uir", "bra", "_he", "nco", "lim", "tin", "cia", "gue", "gen", "mo_", "til", "isu", "_fa", "caz", "id_", "va_", "rgo", "set", "ci_", "iga", "nda", "osc", "fir", "ghe", "ull", "amb", "es_", "omi", "ast", "imo", "fig", "occ", "sua", "ui_", "ern", "sez", "rti", "unt", "ai_", "ala", "igu", "ngu", "vat", "_sh", "ck_", "eli", "rol", "ici", "lat", "_ci", "ad_", "_to", "ciu", "tas", "mar", "oro", "oto", "dur", "egg", "sec", "_bu", "_gl", "rre", "giu", "ipo", "tag", "lar", "can", "var", "cal", "mal", "ens", "mbi", "uni", "sca", "vio", "eme", "maz", "_ou", "cod", "ies", "esp", "rop", "su_", "tit", "rri", "_fu", "sot", "nt_", "ote", "riz", "hiv", "_ac", "ama", "iun", "rem", "ite", "rie", "_id", "cce", "isc", "_n_", "met", "nfi", "ons", "sat", "mma", "ivo", "zia", "gur", "ner", "am_", "erg", "red", "_ra", "eta", "lan", "rar", "_bl", "_ig", "agi", "inc", "opp", "pa_", "gom", "rip", "uzi", "ard", "iri", "lem", "_lu", "blo", "dev", "gua", "tpu", "esc", "tab", "utp", "_as", "art", "cci", "ece", "ecu", "_av", "lti", "emp", "ret", "ani", "qua", "ead", "rev", "gna", "rco", "ash", "ngo", "tif", "ino", "nuo", "ole", "ope", "ega", "ong", "lta", "ras", "sit", "oce", "ota", "pas", "at_", "ed_", "ial", "utt", "_vo", "zar", "reb", "uan", "hel", "sin", "ach", "tim", "eve", "ife", "riv", "spa", "opo", "les", "gni", "rid", "tec", "tut", "esa", "ami", "egi", "din", "zo_", "ann", "tom", "nse", "iva", "ode", "ane", "cen", "voc", "lli", "bli", "sag", "san", "inp", "qui", "tch", "isa", "nic", "niz", "ec_", "mag", "rmi", "ea_", "imm", "ar_", "gol", "iti", "npu", "rra", "isi", "nne", "sia", "_am", "_wa", "uso", "_x_", "alc", "dim", "ltr", "mot", "lun", "roc", "ppl", "ref", "vor", "edi", "not", "_cu", "alb", "mai", "sor", "ag_", "nut", "sce", "war", "mpl", "pen", "eso", "pub", "bbl", "eno", "può", "ua_", "uò_", "fra", "nst", "odo", "ogr", "rve", "aba", "idi", "iet", "lav", "mos", "_d_", "_ad", "_bo", "or_", "_fr", "avv", "imu", "han", "nd_", "dio", "go_", "dar", "pot", "ron", "uri", "mon", "ubb", "via", "rl_", "tie", "enu", "zzo", "uta", "rac", "iar", "_is", "mpr", "sal", "_ko", "iù_", "muo", "più", "rni", "vie", "avo", "rt_", "uit", "lis", "ela", "mun", "ezz", "ges", "ngh", "upe", "bia", "ze_", "en_", "tru", "zat", "hi_", "pe_", "_ab", "_ke", "hin", "pt_", "dia", "inv", "siz", "tua", "ul_", "lib", "rca", "_gu", "asc", "odu", "_vu", "itu", "nen", "ral", "sel", "lbe", "lme", "nk_", "vuo", "amm", "_ho", "gam", "naz", "omo", "ple", "rno", "_by", "div", "yte", "cun", "evi", "_ur", "cur", "hea", "cco", "uen", "aga", "dul", "byt", "ug_", "epo", "mor", "rog", "rom", "_be", "abo", "eam", "ena", "avi", "_hu", "mul", "nge", "be_", "bin", "vvi", "_ga", "_oc", "ba_", "hua", "lia", "pal", "raf", "_c_", "_ob", "deb", "inu", "uot", "_s_", "ear", "eng", "rva", "cha", "ipe", "rup", "sha", "bug", "fo_", "bo_", "_ro", "gi_", "las", "olt", "atc", "ril", "spr", "log", "pia", "dop", "rme", "sem", "aro", "nis", "_en", "ble", "clu", "eo_", "iff", "onn", "alm", "lte", "emb", "mem", "sic", "vec", "ain", "doc", "igh", "mpi", "wor", "_za", "nul", "rir", "_ai", "ka_", "oda", "ure", "gru", "ied", "mas", "cop", "pi_", "apo", "gia", "lcu", "dan", "ovo", "tio", "ecc", "evo", "mme", "nve", "vel", "ade", "_cl", "cid", "sab", "ff_", "lon", "off", "rto", "sch", "apr", "bel", "ie_", "nce", "_ex", "ete", "gis", "ink", "rot", "bre", "wa_", "_ku", "dec", "epu", "ipa", "um_", "ego", "ada", "ban", "bbe", "cum", "elp", "ak_"},
+	"ja": {"ません", "せん_", "ます_", "ファイ", "ァイル", "です_", "した_", "する_", "ました", "ション", "できま", "ていま", "きませ", "ありま", "りませ", "しまし", "います", "します", "れてい", "エラー", "_ファ", "してい", "があり", "されて", "ョン_", "le_", "イル_", "クショ", "オプシ", "プショ", "シンボ", "セクシ", "ile", "されま", "_fi", "いませ", "fil", "ポート", "失敗し", "敗しま", "ります", "ラー_", "に失敗", "レクト", "_co", "ンボル", "無効な", "ィレク", "ディレ", "を表示", "on_", "クトリ", "ケージ", "ッケー", "パッケ", "された", "er_", "ion", "ユーザ", "でした", "せんで", "んでし", "_de", "さい_", "_x_", "として", "データ", "_警告", "_n_", "_無効", "くださ", "ださい", "ない_", "警告_", "_re", "ジョン", "_この", "ージョ", "_オプ", "バージ", "しない", "_セク", "てくだ", "示する", "表示す", "_です", "_は_", "id_", "_st", "または", "サポー", "_in", "定され", "イルを", "ている", "マンド", "読み込", "はあり", "コマン", "れまし", "ドレス", "を使用", "してく", "コード", "アドレ", "me_", "リンク", "れます", "tio", "ループ", "再配置", "ード_", "定する", "_se", "ame", "が必要", "グルー", "要です", "_ma", "フォル", "必要で", "nt_", "ラーが", "_シン", "システ", "ステム", "_pa", "ット_", "nam", "ォルト", "デフォ", "ント_", "_から", "ではあ", "メント", "_di", "_ディ", "指定さ", "が発生", "ヘッダ", "発生し", "トされ", "_を使", "lin", "_li", "ること", "インス", "見つか", "ンスト", "サイズ", "_また", "st_", "es_", "str", "れませ", "ストー", "_lo", "ll_", "かりま", "_pr", "イルの", "トール", "ver", "証明書", "_ex", "se_", "ーが発", "_op", "を作成", "ッシュ", "ートさ", "_パッ", "生しま", "et_", "_エラ", "ce_", "_ar", "つかり", "ng_", "ing", "ョンを", "たは_", "バイト", "使用し", "を指定", "_fo", "_ユー", "com", "にエラ", "定でき", "_si", "at_", "_s_", "dir", "のファ", "ーショ", "in_", "しませ", "_デフ", "ent", "con", "場合_", "が見つ", "セット", "_al", "_不明", "_no", "ect", "を削除", "不明な", "_イン", "for", "を取得", "対する", "_nu", "文字列", "loc", "te_", "ていな", "に対す", "モード", "int", "み込み", "トリ_", "sta", "ージ_", "用する", "pe_", "ter", "いない", "指定し", "_プロ", "al_", "を設定", "_バイ", "サーバ", "ョンが", "ってい", "書き込", "_バー", "_アー", "nd_", "ア語_", "存在し", "ぎます", "_us", "res", "される", "なりま", "アーカ", "tri", "ーカイ", "すぎま", "カイブ", "ワード", "使用す", "場合は", "ype", "_na", "を読み", "typ", "ェクト", "ジェク", "ことが", "イル名", "ソース", "_ch", "_使用", "ができ", "新しい", "_r_", "_sh", "_の_", "_入力", "_文字", "_su", "rea", "にする", "には_", "ート_", "_id", "arg", "ロック", "表示し", "_に対", "rt_", "けませ", "_出力", "pre", "キュメ", "ドキュ", "ブジェ", "ュメン", "共和国", "ck_", "re_", "ボル_", "和国_", "_sy", "de_", "ve_", "man", "が指定", "るには", "セージ", "ロード", "文字_", "_c_", "opt", "th_", "ッセー", "メッセ", "and", "設定す", "ate", "_ro", "_があ", "オブジ", "スワー", "パスワ", "_キー", "exp", "タイプ", "フィー", "不正な", "用され", "_f_", "_t_", "_ta", "_un", "pti", "ry_", "情報を", "_ap", "_d_", "ic_", "から_", "ンド_", "中にエ", "用でき", "_コマ", "ine", "omm", "pt_", "rec", "ィール", "ールド", "_he", "_ty", "deb", "ed_", "num", "war", "_と同", "ser", "用して", "_a_", "_デー", "ければ", "ために", "はサポ", "プロセ", "it_", "のため", "_再配", "mat", "nte", "するこ", "なけれ", "エント", "ントリ", "ール_", "_wi", "するに", "ケット", "オフセ", "ンポー", "ートし", "sio", "とがで", "イルが", "に設定", "証が必", "認証が", "ati", "dat", "_el", "_ドキ", "all", "ive", "い場合", "は認証", "キスト", "テキス", "フセッ", "プログ", "ベース", "ログラ", "_これ", "_グル", "_署名", "mma", "rin", "トリを", "削除し", "取得で", "得でき", "_が_", "ist", "no_", "ock", "グラム", "ケーシ", "_i_", "_me", "ip_", "om_", "して_", "には認", "フラグ", "ードを", "_アド", "ch_", "ign", "out", "use", "シェル", "リスト", "_p_", "_名前", "ar_", "ers", "ess", "ビット", "ロセス", "_en", "pro", "および", "き込み", "できな", "ステー", "リック", "_e_", "_l_", "_フラ", "きます", "るため", "ョンの", "_so", "_パス", "ne_", "orm", "ではな", "インタ", "成でき", "_と_", "sh_", "きない", "アップ", "ウェア", "ーバー", "表示_", "_pi", "ータス", "_ke", "_o_", "_標準", "ack", "ode", "tor", "た場合", "の場合", "テータ", "_ca", "_v_", "_を_", "_フィ", "_終了", "ge_", "ut_", "すか_", "使用法", "age", "ix_", "られま", "キャッ", "グナル", "シグナ", "バック", "ージを", "合は_", "指定す", "用法_", "_to", "使用さ", "_mi", "_新し", "elf", "ize", "lf_", "rma", "unt", "us_", "ストリ", "ャッシ", "ライブ", "リティ", "cha", "off", "or_", "rd_", "ss_", "ゲット", "ターゲ", "ーゲッ", "_m_", "_wa", "ead", "ire", "tar", "めませ", "トリー", "ボリッ", "レス_", "ンボリ", "_指定", "rom", "ザid", "トして", "ーザi", "作成で", "_ビッ", "key", "クリン", "グイン", "_サー", "hel", "を入力", "ダウン", "ックリ", "ルされ", "ープ_", "_b_", "_mo", "_ve", "_鍵_", "cti", "get", "ref", "コンテ", "文字を", "set", "ze_", "すが_", "ない場", "を実行", "ードで", "_ba", "_オー", "ord", "トファ", "メモリ", "ランド", "_タイ", "ff_", "siz", "tab", "ース_", "示しま", "終了し", "_da", "_y_", "ase", "ort", "のパッ", "ョンで", "込めま", "_に失", "ert", "log", "up_", "に対し", "_cr", "_tr", "_u_", "_ur", "_コン", "_証明", "bug", "を変更", "el_", "har", "イルに", "力ファ", "実行し", "設定で", "_po", "_ソー", "ls_", "umb", "_シス", "ebu", "ext", "ty_", "の読み", "ージの", "ble", "rl_", "ますか", "スポー", "タイム", "対して", "指定で", "_ra", "_アル", "ore", "ot_", "pen", "アプリ", "タベー", "ータベ", "力する", "_te", "_th", "che", "rou", "イブラ", "ウント", "ブラリ", "ログイ", "ーディ", "ー語_", "成する", "_sp", "_不正", "mod", "rg_", "ことは", "はでき", "れる_", "ッダー", "プリケ", "リケー", "ーザ_", "入力し", "_では", "_サポ", "ct_", "mac", "ope", "pat", "ps_", "sig", "効です", "期しな", "_fr", "_デバ", "_ロー", "_設定", "ir_", "ont", "tat", "ug_", "を無視", "イズ_", "キップ", "コピー", "シュ_", "スト_", "フレー", "ンロー", "予期し", "画像_", "_ne", "lis", "rsi", "ts_", "url", "wor", "エクス", "スキッ", "ライン", "暗号化", "番号_", "apt", "def", "lt_", "pin", "sym", "できる", "ウンロ", "クスポ", "スタン", "ストを", "ョンは", "了ステ", "終了ス", "_la", "_を削", "ber", "cal", "fix", "ind", "からの", "するた", "イト_", "イブ_", "ルト_", "ローカ", "一致し", "区切り", "含まれ", "無視さ", "視され", "除する", "_h_", "_デッ", "_現在", "ass", "sec", "tex", "すべて", "を返し", "デッド", "ホスト", "作成し", "使用で", "設定さ", "返しま", "除しま", "_ad", "_には", "add", "ink", "の名前", "ように", "可能な", "在しま", "読み取", "_gn", "_gp", "_k_", "_への", "_個の", "ec_", "gnu", "rce", "る場合", "インポ", "スクリ", "デバッ", "ドキー", "メージ", "ーカル", "_gr", "_le", "_sc", "alt", "eli", "elp", "end", "ime", "イメー", "スファ", "ネット", "フォー", "以下の", "開けま", "_ge", "_オフ", "_画像", "ef_", "fd_", "len", "lp_", "tls", "が存在", "と同じ", "セキュ", "ボード", "無効で", "現在の", "配置_", "_g_", "_引数", "えられ", "ますが", "み込め", "よって", "わりに", "ェック", "キーボ", "チェッ", "ッドキ", "トする", "トウェ", "ーボー", "_エン", "_カー", "ata", "ern", "un_", "win", "とはで", "のバー", "の設定", "ディス", "バッグ", "ブロッ", "ンテキ", "ーブル", "了しま", "_gi", "_およ", "_命令", "ds_", "ema", "xpr", "イプ_", "イルで", "クリプ", "コンパ", "ータを", "代わり", "定した", "定して", "形式で", "_z_", "_ヘッ", "_一時", "ake", "der", "mak", "ory", "が使用", "ため_", "にあり", "アクセ", "キュリ", "ソケッ", "テーブ", "ュリテ", "不当な", "_ター", "_内部", "_行_", "arc", "ex_", "met", "sun", "xt_", "と同様", "によっ", "クチャ", "メンバ", "上書き", "出力を", "報を表", "要があ", "_形式", "ak_", "inu", "nat", "nul", "put", "tiv", "um_", "uni", "のユー", "は無効", "べての", "を生成", "リーム", "付き_", "必要が", "_を開", "abl", "am_", "rel", "tes", "tra", "が失敗", "これは", "の後に", "れない", "アルゴ", "アーキ", "オープ", "キテク", "クセス", "ットを", "テクチ", "ンコー", "ーキテ", "ープン", "出力す", "正です", "認識で", "識でき", "_as", "_cd", "_ou", "_が指", "_リン", "ad_", "bas", "cto", "mbe", "rc_", "の一覧", "はなく", "りまし", "カード", "ゴリズ", "スフレ", "リズム", "ルゴリ", "ンパイ", "ーン_", "不正で", "力して", "名前_", "_が見", "_以下", "_実行", "an_", "ang", "ite", "lat", "nk_", "nor", "sel", "uid", "ux_", "すでに", "みエラ", "よび_", "ればな", "を参照", "ソフト", "パスフ", "レーズ", "ンドを", "削除す", "込みエ", "_ss", "_コー", "_スキ", "_バッ", "crl", "efi", "en_", "pos", "に使用", "ック_", "ックス", "ディア", "トリガ", "ンディ", "標準入", "正しく", "_dw", "des", "ere", "gro", "net", "par", "ram", "tim", "いけま", "が無効", "ことを", "したが", "でない", "の文字", "る必要", "を追加", "ィスク", "タス_", "テムの", "ディオ", "パイプ", "パイル", "リング", "ンター", "全ての", "準入力", "複数の", "選択し", "_ht", "_表示", "ach", "ft_", "でなけ", "では_", "なく_", "に変更", "のパス", "み込む", "れた_"},
+	"ko": {"니다_", "습니다", "합니다", "_파일", "_수_", "_없습", "없습니", "_옵션", "_사용", "파일_", "에서_", "하지_", "_이름", "_지정", "으로_", "_문자", "_출력", "_실패", "_이_", "it_", "않습니", "_않습", "_설정", "_오류", "_명령", "_을_", "_gi", "있습니", "_있습", "일을_", "파일을", "입니다", "git", "_를_", "_디렉", "디렉터", "렉터리", "_표시", "_re", "시오_", "십시오", "했습니", "_에_", "하는_", "오류_", "_없음", "없음_", "_잘못", "_프로", "또는_", "_또는", "_실행", "_커밋", "_의_", "_co", "_입력", "_형식", "하십시", "_패키", "_중_", "패키지", "_다음", "_상태", "실패_", "_필요", "시합니", "려면_", "이름_", "션을_", "문자_", "_작업", "옵션을", "못된_", "잘못된", "_합니", "_정보", "_기본", "없는_", "_없는", "_인자", "적절한", "절한_", "출력_", "_제거", "거나_", "사용하", "옵션_", "_부적", "부적절", "었습니", "용합니", "_로_", "er_", "실패했", "_st", "_브랜", "_no", "_않았", "브랜치", "_인증", "패했습", "_현재", "는데_", "사용자", "_목록", "_추가", "표시합", "현재_", "션은_", "_he", "_버전", "옵션은", "_지원", "_저장", "_시스", "_하위", "작업_", "id_", "았습니", "시스템", "_pa", "_n_", "_항목", "_바이", "설정_", "_경로", "_처리", "하위_", "_만들", "_가져", "하려면", "_병합", "_모든", "모든_", "le_", "_표준", "se_", "요합니", "이트_", "필요합", "es_", "자가_", "자를_", "있는_", "트를_", "_di", "_링크", "다음_", "데이터", "_있는", "_때_", "_무시", "_는_", "바이트", "표준_", "_메시", "_위치", "메시지", "_가_", "_동작", "되었습", "터리_", "_in", "용할_", "_키_", "력합니", "름을_", "이름을", "출력합", "_오브", "브젝트", "오브젝", "일이_", "_fi", "_내용", "_에서", "_은_", "ead", "않았습", "_시작", "ck_", "_de", "리를_", "지만_", "_대한", "_알_", "대한_", "프로세", "ile", "_c_", "_그룹", "파일이", "_변수", "con", "_업데", "데이트", "업데이", "_대해", "ce_", "프로그", "로세스", "지정하", "_sh", "_부분", "_않음", "지원하", "_데이", "않음_", "지를_", "_변경", "명령_", "_모드", "사용_", "_d_", "상태_", "_각_", "하고_", "_하나", "로그램", "보를_", "정보를", "_문서", "sta", "값이_", "대해_", "정합니", "_값_", "_경우", "_아닙", "_일치", "ver", "닙니다", "아닙니", "아어_", "치를_", "_pr", "_들어", "_시간", "용자_", "으면_", "_s_", "번째_", "파일에", "_f_", "_인덱", "fil", "인덱스", "_a_", "et_", "ll_", "원하지", "_동일", "te_", "_al", "되지_", "일의_", "형식_", "_반환", "스템_", "해야_", "파일의", "_se", "_t_", "_값이", "하면_", "값을_", "_id", "_및_", "입력_", "저장소", "문서_", "_레퍼", "_설치", "_쓸_", "레퍼런", "키지_", "퍼런스", "_기록", "_다시", "dir", "ect", "st_", "_압축", "_연결", "문자열", "_이미", "rea", "공화국", "수를_", "화국_", "_숫자", "_심볼", "_않은", "_허용", "다시_", "않은_", "_l_", "_너무", "me_", "on_", "너무_", "스를_", "_p_", "_나타", "_다른", "_시그", "스트_", "_공화", "_대신", "nt_", "다른_", "_값을", "기본_", "커밋_", "_lo", "_크기", "_아카", "_해당", "_si", "아카이", "hea", "터리를", "_서버", "_선택", "용하십", "지정_", "카이브", "_경고", "_암호", "_않는", "일치하", "환합니", "_종료", "lin", "_삭제", "_필드", "res", "_사항", "_올바", "_li", "_적용", "령을_", "명령을", "_대상", "기본값", "시그널", "표시_", "_pi", "_번호", "사용합", "않는_", "정하지", "_태그", "_폴더", "no_", "str", "심볼릭", "볼릭_", "이름이", "_로컬", "_읽을", "loc", "읽을_", "ame", "_fo", "_구분", "ack", "all", "ref", "_따라", "ch_", "ed_", "버전_", "변경_", "_nu", "_x_", "가져오", "경고_", "일에_", "종료_", "름이_", "_ur", "com", "rt_", "사용할", "텍스트", "_트리", "_패치", "ock", "ont", "re_", "_m_", "_r_", "th_", "ve_", "경우_", "다면_", "됩니다", "_열_", "ion", "ter", "냅니다", "코드_", "_me", "_번째", "_요소", "_읽기", "_활용", "che", "ing", "인자_", "_리모", "_모듈", "_으_", "ty_", "리모트", "압축_", "에는_", "_ma", "_ta", "_새_", "ate", "기를_", "인증이", "정한_", "증이_", "_e_", "pid", "대신_", "로컬_", "정할_", "_u_", "_주소", "_포함", "_헤더", "동일_", "만들_", "_바꾸", "_정렬", "for", "추가_", "_ca", "_최대", "ase", "hel", "그룹_", "_속성", "ng_", "듭니다", "시간_", "_만듭", "만듭니", "정보_", "_ap", "_i_", "_검사", "_과_", "_범위", "_않으", "ent", "들어_", "반환합", "세스_", "_빈_", "_확장", "ad_", "al_", "스트림", "이미_", "_가능", "_변환", "_셸_", "베이스", "실행_", "옵션과", "_b_", "_ba", "_wa", "설정하", "실행하", "해당_", "_po", "_q_", "_할_", "ert", "rl_", "같이_", "션과_", "_같이", "_입니", "_추적", "록을_", "류가_", "_su", "_아닌", "아닌_", "최대_", "_ch", "_로그", "_메모", "_일반", "ne_", "메모리", "보다_", "서는_", "_묶음", "ip_", "오류가", "지정한", "치하는", "_un", "_방식", "or_", "이터_", "자로_", "정해야", "크를_", "하거나", "하는데", "_v_", "_발생", "_번_", "_제한", "_해제", "tar", "드를_", "지정해", "_확인", "ix_", "mer", "목록_", "밋을_", "본값_", "용하지", "읽기_", "커밋을", "호를_", "_참조", "_함수", "_해석", "int", "ps_", "tes", "경로_", "_ex", "ist", "pat", "ry_", "목을_", "잘못되", "_코드", "ct_", "ote", "ren", "값은_", "못되었", "용을_", "적으로", "처리_", "항목을", "_o_", "_to", "_와_", "par", "_수정", "_요청", "elp", "nam", "pre", "tat", "tre", "력을_", "_길이", "_찾을", "at_", "ge_", "rec", "ser", "시지_", "위치_", "찾을_", "_mi", "_tr", "_개_", "_복사", "_시각", "_여러", "_파이", "bas", "sh_", "목록을", "하며_", "_te", "_구성", "_소스", "_표현", "ive", "링크를", "않으면", "올바른", "필드_", "행합니", "_공백", "ign", "in_", "ort", "set", "대상_", "숫자_", "_기능", "_행_", "tin", "url", "바른_", "병합_", "소스_", "옵션에", "지정했", "하게_", "하지만", "_덮어", "_컨텍", "ss_", "사용법", "컨텍스", "_패턴", "부터_", "_진수", "ere", "nd_", "랜치를", "로그인", "파이프", "_g_", "_건너", "_자동", "_전환", "ime", "ore", "rge", "그램_", "_ve", "_하지", "pac", "rc_", "sha", "tor", "모듈_", "모리_", "무시합", "문자를", "용법_", "키지를", "트가_", "포함_", "_us", "_강제", "_아니", "_줄_", "ts_", "문자가", "설정합", "식을_", "제거하", "태를_", "_할당", "_활성", "nul", "링크_", "상태를", "소를_", "일반_", "젝트를", "하기_", "_성공", "am_", "de_", "lp_", "lt_", "tio", "번호_", "행할_", "_단계", "_데드", "_주어", "데드키", "드키_", "소프트", "에서는", "_h_", "_op", "_시도", "arc", "ut_", "내용을", "니까_", "시지를", "이스_", "항목_", "_k_", "_으로", "_접근", "ath", "ff_", "inu", "mat", "war", "가능한", "여러_", "정을_", "젝트_", "표시하", "_sy", "_단위", "_한_", "_호스", "ers", "get", "nce", "omm", "out", "ow_", "pos", "tch", "그널_", "리가_", "모트_", "부분_", "수가_", "호스트", "_값은", "_그림", "_타입", "ext", "그림_", "디스크", "력에_", "모드_", "씁니다", "키를_", "하여_", "_다운", "_디스", "_마지", "_메타", "_여부", "_충돌", "_후_", "and", "dat", "nte", "tim", "us_", "드어_", "마지막", "정렬_", "치가_", "행을_", "_na", "_스트", "갑니다", "거합니", "그인_", "꿉니다", "바꿉니", "사항을", "이브_", "자열_", "지는_", "진수_", "터를_", "항을_", "_bi", "_so", "_깃_", "_바꿉", "_어떤", "_영어", "_초기", "_편집", "d를_", "mit", "mod", "tri", "use", "개의_", "기록_", "능한_", "디오_", "문자로", "변수_", "식으로", "어떤_", "이지_", "_y_", "_문제", "_바꿀", "_장치", "_전체", "_제외", "erg", "묶음_", "실행합", "영어_", "지정합", "_z_", "_모두", "_서명", "_쓰기", "_안에", "_예상", "_진행", "rem", "다운로", "동작_", "바꿀_", "시작하", "오기_", "운로드", "페이지", "_ne", "_개의", "_도움", "_블록", "_페이", "enc", "ine", "lis", "pro", "tra", "wor", "음을_", "전을_", "중입니", "_ht", "_wi", "_검색", "_기존", "_내장", "_노트", "_보안", "_인코", "_작성", "_키를", "ire", "tp_", "up_", "wer", "구성_", "대로_", "모두_", "션이_", "스에_", "전체_", "_권한", "_오디", "_정규", "app", "ib_", "not", "ns_", "pen", "tex", "내용_", "스크립", "실행할", "오디오", "인증서", "제거합", "크기_", "_ti", "_새로", "_소유", "_순서", "_않고", "_이상", "_자세", "_중입", "ar_", "emo", "ha_", "ind", "les", "rs_", "기존_", "보안_", "쓰기_", "입력에", "적인_", "_ar", "_gr", "_on", "_ss", "_같습", "_더_", "_백업", "_비트", "ess", "pt_", "같습니", "선택_", "스어_", "않고_", "어야_", "_gs", "_qu", "_w_", "_개행", "_리베", "_벗어", "_보여", "_인터", "_중지", "_환경", "alt", "der", "fer", "ize", "따라_", "리베이", "발생했", "스가_", "자는_", "작을_", "지정할", "_ad", "_ge", "_ki", "_ra", "_sp", "_소프", "_프랑", "ir_", "ran", "sl_", "ull", "설정을", "인코딩", "하나_", "_생성", "_설명", "_윈도", "dif", "eam", "iff", "ml_", "to_", "깁니다", "문에_", "변수를", "션에_", "시작_", "암호_", "어진_", "졌습니", "초기화", "타입_", "_결과", "_복제", "_읽는", "_초과", "_표기", "_호환", "ash", "end", "log", "per", "ssl", "tty", "도움말", "방식_", "생했습", "윈도우", "이프_", "장소_", "트리거", "트웨어", "프트웨", "합을_", "헤더_", "활성화", "_내부", "are", "ese", "ex_", "nor", "top", "가져올", "납니다", "더를_", "서버_", "시를_", "옵션이", "위를_", "제한_", "져올_", "치하지", "표현식", "허용하", "확장_"},
+	"nb": {"er_", "kke", "en_", "ke_", "et_", "ikk", "il_", "for", "ing", "_ik", "te_", "_fo", "_er", "ter", "ler", "til", "fil", "_ti", "or_", "_fi", "_av", "ng_", "_in", "_en", "re_", "_st", "_me", "ver", "_de", "lle", "ent", "bru", "de_", "_br", "ruk", "_ko", "_ut", "es_", "ed_", "_i_", "tte", "av_", "om_", "rte", "_va", "ig_", "_ve", "alg", "ste", "ere", "val", "_sk", "opp", "ett", "_å_", "all", "sta", "and", "ell", "_so", "ert", "_op", "nde", "end", "inn", "dig", "ne_", "nge", "art", "der", "ker", "nne", "tt_", "_si", "men", "som", "lin", "med", "og_", "nte", "rt_", "lar", "ldi", "skr", "_og", "_kl", "kla", "_ma", "ll_", "_re", "_på", "eil", "dat", "fei", "nt_", "ser", "den", "rin", "se_", "_fe", "på_", "_li", "vis", "det", "rer", "el_", "kri", "_el", "_se", "avn", "tal", "nav", "mme", "uke", "yld", "gyl", "kel", "sjo", "_et", "_le", "gen", "jon", "le_", "ata", "nøk", "_pa", "is_", "ppe", "var", "tet", "_pr", "len", "kom", "ger", "_nø", "man", "_ka", "_ug", "an_", "vn_", "ugy", "økk", "_hv", "lde", "ign", "ren", "res", "riv", "kan", "ge_", "_vi", "on_", "dre", "pe_", "ist", "ar_", "ner", "_du", "jen", "_ar", "egn", "at_", "ers", "utt", "eks", "_un", "gt_", "str", "iv_", "pro", "app", "_fr", "_la", "nda", "eri", "und", "are", "_mi", "mer", "_te", "teg", "uk_", "ene", "lgt", "omm", "ta_", "lg_", "lag", "ern", "ndr", "ten", "ile", "lig", "ngs", "du_", "lge", "_an", "sig", "fra", "_al", "inj", "kon", "ang", "al_", "ede", "id_", "ant", "ele", "mma", "st_", "jer", "nje", "orm", "ont", "_ta", "_he", "eng", "ndo", "ill", "map", "_na", "els", "atu", "gn_", "rma", "tre", "_be", "før", "ra_", "ret", "ort", "ut_", "rd_", "_sa", "hvi", "ord", "ska", "ume", "kal", "lse", "_sl", "ove", "tat", "nta", "met", "_bl", "ess", "enn", "ive", "bli", "_ha", "les", "lut", "fik", "lik", "isk", "ved", "arg", "ass", "sk_", "ate", "gna", "nin", "rdi", "_n_", "tes", "_ad", "set", "sti", "sel", "slu", "ven", "nst", "_gr", "tan", "del", "_da", "ens", "rti", "erd", "sse", "gje", "_ov", "_to", "ore", "_fø", "tid", "age", "ard", "_sy", "us_", "mel", "ram", "kst", "lis", "ske", "asj", "kk_", "sen", "per", "stø", "vel", "ode", "gra", "lt_", "_ba", "amm", "sam", "tar", "old", "het", "elt", "_ny", "eli", "ild", "mat", "nn_", "ifi", "let", "pre", "att", "one", "rse", "ble", "ika", "fin", "hol", "akk", "dar", "tor", "esi", "pak", "_gj", "ør_", "eld", "nes", "net", "kat", "jør", "sor", "avs", "gru", "nen", "ide", "ull", "min", "get", "ken", "kje", "_kj", "ige", "itt", "me_", "pas", "ses", "est", "har", "_di", "sis", "sva", "ykk", "_må", "_no", "_ek", "bar", "tur", "ier", "red", "oll", "eve", "_by", "ytt", "_ne", "_om", "nat", "tab", "vsl", "tin", "ogr", "fje", "nke", "rgu", "nfo", "_fj", "enk", "gum", "eme", "las", "ttr", "la_", "lat", "år_", "_fu", "_sp", "rog", "byt", "ils", "kes", "kte", "tiv", "_id", "ars", "_nu", "di_", "ete", "_mo", "ark", "kt_", "lyk", "ref", "ese", "kjø", "tro", "hen", "ski", "_po", "ila", "lem", "nal", "_at", "ute", "ntr", "tem", "øre", "kre", "din", "gre", "rup", "ype", "ket", "typ", "yte", "unn", "upp", "_bi", "kil", "rne", "rol", "rst", "_fl", "sso", "era", "ise", "ks_", "tif", "_fa", "pps", "tek", "mis", "ve_", "ast", "elg", "tis", "ets", "ins", "je_", "num", "pos", "try", "bel", "ind", "rel", "tus", "ted", "pes", "log", "dva", "nnd", "rek", "abe", "ase", "isl", "kod", "adv", "jel", "rep", "ift", "kun", "han", "spe", "ati", "doe", "nor", "føl", "nse", "ølg", "_ig", "nsk", "rre", "_kr", "ppr", "må_", "sly", "umm", "ans", "ir_", "tel", "do_", "esk", "mod", "reg", "vid", "tør", "_co", "_do", "ari", "rki", "rsj", "sin", "sto", "unk", "_ho", "nti", "por", "vil", "øri", "_lo", "mas", "ryk", "sik", "inf", "ndl", "par", "nd_", "erk", "gge", "rit", "ørr", "_gi", "gne", "lla", "ran", "vær", "lok", "lan", "eho", "in_", "omp", "slå", "tig", "dir", "hel", "ine", "ose", "to_", "_uk", "_e_", "alt", "egg", "mal", "ons", "ori", "_f_", "_su", "odu", "fun", "tda", "_nå", "_tr", "ato", "int", "ns_", "sko", "gno", "sys", "utd", "llo", "obl", "øtt", "dle", "ære", "_væ", "ros", "tøt", "yst", "hve", "kiv", "rve", "rsk", "ss_", "orv", "ubl", "_ku", "_ty", "_s_", "dus", "leg", "tri", "adr", "aks", "fer", "pp_", "rn_", "gg_", "git", "ykt", "gle", "oen", "ria", "tsk", "_ki", "ag_", "ksj", "ids", "nul", "pri", "pub", "sst", "tom", "des", "ekt", "fan", "neh", "nli", "kor", "pen", "bin", "epu", "lli", "lna", "ukj", "ame", "ful", "pte", "tje", "dli", "kti", "rev", "dri", "lls", "osi", "sym", "søk", "vet", "lsv", "ørs", "akt", "ale", "die", "ppg", "_ga", "efi", "lsk", "ned", "ngl", "beh", "emm", "ime", "ndi", "okk", "tti", "fel", "fle", "ina", "kop", "ekk", "gss", "ppf", "em_", "nyt", "_c_", "bol", "efe", "_åp", "dde", "rs_", "ali", "iln", "mbo", "_au", "ft_", "lom", "pt_", "pfø", "rea", "ite", "sek", "_t_", "sle", "tni", "ur_", "bas", "ema", "fte", "igh", "kle", "opi", "ymb", "åpn", "_pe", "ara", "enh", "ike", "ngi", "_p_", "ce_", "erm", "ia_", "iab", "sni", "tra", "gan", "nks", "elp", "lit", "pgi", "tak", "_jo", "eta", "ilg", "ilk", "it_", "syn", "ure", "ypt", "_gy", "agr", "dok", "oer", "ors", "pse", "lst", "når", "aut", "eha", "ela", "lir", "oku", "_hu", "kum", "ld_", "rke", "vin", "ått", "ffe", "kry", "ny_", "ake", "am_", "erf", "eti", "ghe", "iks", "ion", "ksi", "ryp", "_x_", "mål", "tvi", "vne", "_ra", "ope", "pet", "rhe", "iti", "lte", "ols", "uts", "øns", "_d_", "_l_", "sif", "_r_", "erh", "gel", "pph", "urt", "_ro", "_sø", "gi_", "ost", "rif", "run", "økl", "_ge", "con", "edi", "kin", "obb", "rat", "rk_", "uff", "_hj", "dek", "ffi", "hje", "kif", "ras", "_pi", "blo", "job", "mpo", "pli", "rip", "_bo", "bil", "idi", "sit", "tas", "_cr", "jek", "rde", "urs", "utl", "ann", "hev", "pa_", "pda", "pie", "pne", "ppa", "rg_", "utn", "bes", "ien", "imp", "rom", "kas", "mak", "phe", "lp_", "lta", "_a_", "nhe", "nye", "rib", "sid", "sum", "_im", "top", "bak", "gst", "ima", "led", "dag", "dis", "gde", "ppd", "rig", "sim", "så_", "ink", "kob", "utf", "ibu", "møn", "sty", "tlø", "use", "pst", "sfi", "suf", "ukt", "utg", "_pl", "ber", "mpl", "nkt", "_ap", "olk", "van", "_m_", "_u_", "ap_", "ave", "hop", "pla", "rl_", "tol", "ts_", "um_", "utv", "_or", "enf", "gla", "lfi", "mar", "olo", "pun", "rna", "_us", "gin", "hem", "hur", "ial", "ilb", "ipt", "lå_", "mul", "ndt", "rdf", "edl", "kol", "mti", "tme", "åde", "ade", "ect", "eku", "ivi", "lba", "no_", "tst", "_mø", "bet", "ege", "etr", "kev", "nho", "rik", "rim", "stå", "tyr", "avb", "eg_", "ena", "itm", "llf", "ngd", "off", "sky", "ukk", "uli", "_mu", "alo", "def", "ix_", "kni", "lko", "ams", "but", "ekr", "ff_", "igl", "lke", "lon", "ogg", "pin", "ri_", "tad", "tru", "_ex", "inu", "ire", "lås", "løs", "mon", "omr", "rem", "rsi", "tli", "_lu", "_tj", "amt", "elv", "erv", "evi", "fre", "gte", "jem", "kap", "nnl", "onv", "spo", "tta", "ust", "_b_", "_ei", "eko", "loc", "pon", "skj", "ip_", "noe", "nve", "ple", "sfe", "ti_", "ye_", "_bu", "eie", "nns", "rts", "tod", "_of", "beg", "che", "eto", "kse", "oka", "spu", "ssu", "vbr", "_ak", "_wa", "gis", "lgj", "lyt", "ot_", "røm"},
+	"nl": {"en_", "et_", "de_", "an_", "_ge", "_de", "sta", "and", "ver", "_be", "_va", "een", "_in", "van", "est", "er_", "nde", "_ve", "_op", "tan", "nie", "_ni", "bes", "_he", "ing", "iet", "aar", "is_", "ken", "_is", "ie_", "oor", "tie", "ere", "nd_", "te_", "sch", "den", "_on", "ege", "aan", "der", "_ee", "_vo", "_al", "het", "_ma", "_te", "gel", "ren", "ng_", "ord", "ste", "rde", "nge", "gen", "in_", "ten", "or_", "ers", "uit", "erd", "_to", "rd_", "_re", "_me", "ent", "eld", "eer", "naa", "geb", "voo", "men", "eke", "es_", "_ka", "ls_", "_st", "ar_", "cht", "_pa", "el_", "al_", "_co", "gev", "ven", "len", "ter", "eve", "_wo", "_na", "lle", "rui", "ati", "wor", "_en", "ebr", "dig", "met", "_ui", "bru", "kan", "_aa", "st_", "uik", "_wa", "gee", "voe", "ard", "eli", "ond", "nt_", "ach", "_bi", "_ar", "ige", "tal", "_di", "ele", "ge_", "end", "le_", "_do", "als", "lij", "at_", "opt", "_pr", "ns_", "tek", "waa", "kt_", "nen", "it_", "oer", "all", "ong", "ens", "chi", "ind", "pro", "pti", "am_", "isc", "ont", "_of", "con", "ldi", "erw", "kke", "of_", "reg", "_ko", "lin", "on_", "ang", "ijd", "op_", "_da", "pak", "toe", "taa", "one", "out", "wij", "tel", "dt_", "aam", "aat", "nte", "re_", "_fo", "geg", "nst", "akk", "_mo", "ch_", "ijk", "_le", "ket", "rdt", "slu", "_ta", "ove", "ijn", "_mi", "bij", "map", "ree", "fou", "ist", "che", "_om", "aal", "ut_", "nta", "ell", "ike", "maa", "_zi", "lee", "pen", "id_", "_no", "ap_", "ges", "wer", "tte", "eze", "sie", "ij_", "ake", "gro", "_gr", "_af", "_sy", "ale", "del", "ert", "se_", "_we", "ig_", "erk", "ies", "zij", "om_", "_ba", "ts_", "ins", "_la", "ite", "ld_", "rei", "_ov", "ume", "jn_", "din", "dat", "ker", "gin", "ht_", "jde", "nda", "_li", "rij", "ame", "ode", "ton", "ara", "eri", "oet", "ngs", "daa", "kop", "rs_", "ppe", "_sa", "eel", "rwi", "tro", "laa", "hte", "_se", "ke_", "tee", "sen", "com", "ant", "nds", "ron", "tij", "int", "res", "oeg", "arg", "esc", "wac", "ik_", "mis", "_sc", "arc", "rt_", "erv", "uid", "_er", "ica", "cha", "ran", "ans", "rsi", "ze_", "mer", "eid", "itv", "_br", "vol", "tvo", "cti", "lan", "die", "roo", "ett", "_zo", "evo", "_si", "eme", "ede", "bel", "oep", "ukt", "ief", "ne_", "are", "us_", "isl", "rch", "rst", "ber", "mak", "aak", "ort", "rac", "luk", "_el", "dra", "str", "_so", "ect", "_sl", "_wi", "na_", "pel", "_ho", "rin", "chr", "ene", "ern", "roe", "for", "he_", "ser", "_ti", "bre", "doo", "ndi", "ari", "dit", "pre", "orm", "man", "rec", "erg", "ets", "euw", "_au", "ess", "hee", "oon", "era", "gum", "ieu", "rgu", "ssi", "_lo", "bro", "iek", "_po", "mme", "rte", "mat", "ef_", "nti", "_ex", "_sh", "app", "ft_", "ger", "cat", "mma", "_an", "get", "han", "rsc", "_ca", "hie", "jk_", "kel", "eks", "ide", "nvo", "ll_", "oud", "ate", "ek_", "erb", "ina", "typ", "ats", "em_", "ete", "_bu", "_ha", "_ac", "_s_", "hel", "lui", "omm", "per", "eis", "opd", "dez", "vin", "cod", "els", "her", "ijz", "ope", "ype", "_bo", "sys", "_ch", "ech", "idi", "_vi", "bar", "ein", "opp", "ute", "tar", "eta", "ine", "ntr", "rma", "zen", "eek", "_nu", "age", "pe_", "_su", "sse", "aut", "rge", "tra", "ot_", "pdr", "_hu", "num", "yst", "mee", "ien", "gra", "ak_", "bin", "lie", "par", "ali", "dan", "_ei", "eem", "epa", "eng", "tat", "nbe", "egi", "_n_", "ces", "pat", "rep", "ma_", "sla", "abe", "ad_", "woo", "_ne", "ion", "eva", "bev", "inv", "ndo", "oot", "nne", "ole", "opg", "ars", "ria", "hri", "ijv", "jzi", "sel", "unt", "ks_", "omp", "pge", "ve_", "eni", "rol", "_u_", "nam", "tse", "min", "kom", "its", "_ap", "ce_", "rat", "roc", "tor", "atu", "onb", "eft", "ign", "lis", "jke", "lat", "nfo", "olg", "ram", "alt", "sle", "vel", "ia_", "pt_", "bli", "eef", "inf", "zig", "_tr", "eco", "pla", "raa", "rke", "ag_", "ikt", "yte", "tis", "ijs", "ive", "byt", "tes", "_by", "ema", "elk", "ifi", "ra_", "mbo", "var", "ass", "ext", "oce", "doe", "rwa", "tem", "_sp", "tge", "umm", "onf", "the", "tri", "uwe", "itg", "sti", "sna", "jst", "_fi", "enk", "lge", "win", "let", "lez", "ore", "ori", "hei", "vat", "iti", "eed", "afs", "dee", "nse", "_pl", "_ro", "ade", "ile", "ep_", "tus", "ana", "_ty", "sig", "uw_", "sym", "zon", "tre", "ast", "exp", "mod", "moe", "wee", "_pe", "ese", "lem", "bek", "nin", "ome", "anm", "ds_", "lei", "mel", "onv", "she", "sin", "ata", "bol", "lde", "neg", "ude", "und", "ire", "rve", "ymb", "ubl", "_pi", "ged", "odu", "akt", "ili", "fsl", "gew", "kon", "ogr", "_ga", "act", "leu", "ost", "lt_", "_ze", "ees", "teu", "art", "fil", "me_", "ol_", "twa", "um_", "_du", "ri_", "beh", "gaa", "gem", "hal", "rea", "ehe", "ero", "no_", "tic", "deb", "do_", "tbr", "fic", "rek", "ijf", "rig", "pub", "hen", "iab", "nma", "chu", "dui", "gna", "sam", "uth", "_ou", "epu", "eut", "inc", "nke", "ok_", "tec", "tio", "tot", "beg", "eun", "ich", "igu", "jve", "fer", "kin", "ur_", "_p_", "doc", "ena", "la_", "ta_", "eci", "epe", "iev", "igi", "pas", "val", "_za", "io_", "noo", "ela", "tst", "edi", "erp", "gge", "gur", "hak", "rog", "_fr", "ala", "hoo", "las", "ote", "spe", "fig", "gre", "ler", "nat", "oli", "pri", "ric", "_ke", "ani", "nfi", "von", "_fu", "ack", "ini", "zel", "_oo", "elf", "loc", "mar", "_v_", "baa", "eil", "hui", "oek", "pos", "_ad", "dus", "erl", "gd_", "lic", "nga", "og_", "rna", "two", "geh", "htw", "ima", "ië_", "nve", "tin", "as_", "bee", "ed_", "enr", "ier", "tab", "unc", "_i_", "ct_", "pli", "vor", "war", "blo", "ill", "nco", "sto", "uur", "ure", "_bl", "gst", "cie", "dsn", "log", "oel", "we_", "hou", "lok", "nre", "ura", "amm", "erm", "gan", "tex", "_e_", "_id", "_t_", "dir", "ewe", "geï", "mag", "_c_", "_un", "fun", "ott", "_gi", "lec", "les", "oge", "ook", "rag", "ck_", "hik", "ice", "kba", "nct", "rva", "uni", "des", "nee", "ock", "ebe", "lig", "top", "_l_", "rov", "ble", "eba", "eik", "mal", "uwi", "eïn", "ntb", "opm", "stu", "xt_", "fde", "kte", "nul", "pec", "ank", "huw", "lte", "ouw", "rbe", "rip", "_a_", "rne", "_ce", "lag", "uri", "ban", "bas", "too", "_zu", "dow", "rit", "san", "att", "err", "har", "ian", "nci", "opi", "_d_", "abi", "bou", "igd", "ikb", "kst", "rob", "_ki", "au_", "kri", "_ku", "por", "rou", "scr", "eig", "je_", "oll", "ovi", "_ra", "max", "oma", "sh_", "_or", "cum", "eau", "jd_", "pma", "tuu", "xpr", "_kl", "cer", "nis", "nor", "slo", "th_", "bui", "ec_", "ga_", "ink", "kun", "mac", "ner", "tur", "_ru", "ase", "onc", "ora", "osi", "rom", "én_", "gep", "lke", "mog", "uto", "ir_", "itw", "rie", "ijg", "nli", "ocu", "to_", "zet", "_f_", "elp", "och", "ref", "bis", "cen", "net", "set", "spa", "twe", "ama", "atr", "cri", "ila", "un_", "_r_", "led", "niv", "ud_", "_x_", "nal", "ona", "pad", "vra", "zoe", "aba", "bla", "ca_", "ega", "lli", "rab", "rti", "_hi", "ash", "nko", "oni", "ïns", "cte", "eko", "hre", "loo", "mon", "rbi", "ipt", "leg", "lfd", "obl", "sis", "eg_", "hin", "ofd", "vea", "zui", "af_", "ain", "air", "fra", "oof", "ool", "rwe", "syn", "ul_", "vee", "ato", "ben", "eha", "esl", "shi", "sit", "uss", "één", "_tu", "ni_", "_ja", "_éé", "jge", "nes", "oun", "ry_", "cho", "dss"},
+	"pl": {"nie", "ie_", "_ni", "_po", "ani", "na_", "_pr", "ia_", "_wy", "_za", "_na", "nia", "wan", "_do", "eni", "sta", "owa", "lik", "ki_", "ch_", "ny_", "pli", "_pl", "_je", "rze", "ne_", "go_", "_mo", "prz", "ego", "ów_", "st_", "est", "_w_", "moż", "ści", "pod", "_ko", "pis", "ych", "jes", "wie", "any", "awi", "ski", "ji_", "żna", "ożn", "zna", "do_", "ej_", "ku_", "_li", "ać_", "_st", "rzy", "_od", "_pa", "ost", "raw", "_si", "_z_", "cze", "_re", "uży", "ane", "_op", "dan", "ika", "owy", "czy", "ka_", "cji", "ien", "cza", "la_", "je_", "_uż", "ent", "pra", "ier", "no_", "_ma", "wy_", "nyc", "cie", "_us", "_in", "_bł", "pro", "_ka", "kat", "wa_", "kie", "tu_", "_i_", "ię_", "_ro", "iku", "się", "ja_", "zen", "kon", "ik_", "nik", "owe", "_se", "em_", "naz", "azw", "kow", "czn", "yć_", "neg", "oda", "za_", "cja", "acj", "zmi", "ami", "owi", "_zn", "_ty", "bra", "ci_", "zy_", "pow", "era", "mie", "ale", "_ar", "_kl", "_ob", "dzi", "tal", "mia", "_wi", "pcj", "opc", "war", "ym_", "ywa", "_al", "tan", "for", "su_", "icz", "zyt", "dło", "ko_", "men", "ak_", "le_", "dni", "ty_", "ucz", "_cz", "alo", "luc", "iet", "ist", "bie", "ini", "klu", "zas", "ole", "_te", "_sy", "ion", "pol", "yst", "_we", "aln", "orz", "str", "ony", "ust", "jąc", "ra_", "_zm", "dow", "ło_", "ran", "roz", "dla", "_dl", "log", "taw", "zon", "tor", "_sk", "api", "ków", "ata", "lic", "zap", "row", "orm", "łow", "_lu", "_no", "ian", "_ta", "it_", "art", "ume", "rma", "_gi", "ana", "ano", "ośc", "ers", "ez_", "jśc", "_wa", "one", "to_", "ić_", "li_", "two", "ąd_", "_sp", "res", "aki", "wor", "zan", "błą", "ocz", "łąd", "rto", "szy", "ść_", "lub", "rak", "cen", "ub_", "ako", "git", "acz", "_co", "_ja", "_br", "lin", "_ws", "kcj", "_to", "gra", "ach", "tów", "_ba", "isa", "lec", "wym", "_da", "nak", "poz", "nal", "fik", "ter", "ącz", "mi_", "łąc", "odc", "wyk", "wid", "ast", "ość", "wer", "by_", "cho", "ta_", "iep", "ńsk", "yfi", "now", "dcz", "ece", "pak", "_de", "ze_", "sek", "uni", "ram", "ste", "toś", "_mi", "trz", "obi", "aga", "ona", "tow", "nej", "iel", "sze", "_be", "jak", "ce_", "ięc", "we_", "wej", "_zo", "wni", "ące", "iej", "ono", "yma", "er_", "uje", "ają", "iwa", "wyp", "zos", "ali", "zie", "idł", "and", "zys", "ogr", "iem", "_bi", "_tr", "iow", "błę", "łęd", "zek", "_fo", "eks", "zwa", "mac", "san", "ług", "że_", "bez", "nię", "nt_", "nym", "ont", "stę", "ktu", "odp", "ikó", "nan", "at_", "omi", "_o_", "lne", "ma_", "mat", "ędn", "usu", "zyć", "eśl", "tar", "wać", "tyl", "arg", "ypi", "ież", "żyt", "oka", "ii_", "odn", "rac", "ekt", "es_", "tni", "zer", "an_", "tko", "lny", "kom", "epr", "et_", "own", "ara", "pie", "arc", "ni_", "um_", "as_", "dom", "cje", "ęci", "_by", "ekc", "tęp", "nic", "ska", "wsz", "oże", "ry_", "wyj", "zwy", "lon", "ind", "_ze", "iu_", "nio", "_lo", "tem", "cia", "jsk", "lko", "dpi", "ga_", "zak", "zaw", "_pi", "iek", "adn", "edn", "od_", "ują", "ład", "sun", "zez", "isu", "lok", "weg", "_sa", "sow", "_ur", "ero", "_n_", "czo", "yjś", "lni", "ało", "inf", "ato", "chi", "ek_", "nfo", "ylk", "_gr", "akt", "wio", "te_", "lis", "nac", "rog", "zac", "_ch", "pom", "yta", "ący", "_sz", "_ab", "tyf", "sz_", "_os", "on_", "zam", "rep", "min", "ycz", "kła", "nte", "tyc", "zne", "łów", "cz_", "uch", "tał", "opr", "_ad", "en_", "mag", "eń_", "ros", "nde", "tki", "enc", "gum", "koń", "rgu", "tat", "ońc", "try", "erz", "nty", "sto", "wys", "jed", "ją_", "ele", "czb", "iez", "tra", "zny", "gu_", "żen", "iec", "sty", "mu_", "_pu", "mer", "ba_", "erw", "is_", "noś", "re_", "stk", "_ga", "skr", "świ", "wia", "wią", "ytk", "tro", "pre", "tek", "typ", "sym", "aby", "zes", "_ce", "den", "iąz", "kre", "któ", "owo", "uda", "_nu", "ada", "awd", "ła_", "_uw", "ar_", "mod", "ala", "rsj", "_ut", "kod", "ref", "słu", "_ud", "aj_", "atu", "zio", "być", "yko", "_an", "rów", "kiw", "odz", "onf", "ual", "bli", "oni", "raz", "yci", "_is", "nor", "lan", "rch", "dek", "_ak", "lem", "sza", "epo", "isz", "ora", "ori", "odu", "tua", "_me", "ard", "eki", "nda", "yśl", "eże", "uwa", "int", "roc", "niu", "etl", "cy_", "ejś", "sys", "wyc", "życ", "_fi", "_a_", "myś", "omy", "_zw", "awa", "wyś", "ert", "mię", "dy_", "ogu", "śln", "_he", "am_", "ces", "iki", "oli", "ren", "aso", "pas", "_id", "_sc", "ną_", "yśw", "nii", "ort", "_su", "dał", "śli", "ewa", "id_", "oce", "par", "rob", "dod", "zni", "cio", "ere", "low", "eli", "drz", "isy", "tór", "dna", "esz", "obs", "or_", "spr", "ide", "liz", "ncj", "odł", "sów", "są_", "_ca", "_są", "głó", "len", "ame", "pac", "rty", "yto", "ies", "por", "ryb", "dos", "ozw", "_ła", "_wł", "aty", "po_", "poł", "yte", "teg", "_śc", "ał_", "bol", "ca_", "_mu", "kac", "mbo", "ser", "_dz", "_el", "gno", "ina", "ich", "ign", "każ", "nag", "ugi", "wis", "blo", "jeś", "ąza", "fil", "omo", "omp", "adr", "iew", "dar", "dny", "bsł", "ied", "ang", "utw", "al_", "man", "oro", "ecz", "ile", "elo", "ięt", "ńcz", "arz", "iod", "ała", "dat", "odo", "zaj", "_dr", "asu", "uj_", "_ha", "oto", "sji", "mus", "num", "_di", "gał", "in_", "stn", "_ot", "ezn", "met", "kry", "pop", "waż", "iał", "ten", "okr", "_oc", "_ok", "se_", "pot", "wię", "ymb", "con", "dne", "ias", "obr", "opi", "zew", "ozy", "skł", "awn", "naj", "rod", "tla", "ed_", "ins", "leż", "da_", "_un", "ain", "pus", "suj", "zwi", "łan", "efe", "ryt", "_wp", "ng_", "zyk", "hiw", "cal", "cki", "zeg", "żni", "ańs", "iar", "ówn", "pon", "dre", "kaz", "osz", "wo_", "gan", "fer", "oku", "wyb", "zed", "zcz", "ack", "lez", "nst", "tyw", "zym", "ły_", "che", "enn", "ozm", "usz", "gru", "oko", "aci", "aku", "ezi", "zec", "_ra", "kla", "sie", "szc", "_ró", "esu", "syw", "_kt", "dza", "nfi", "sko", "nu_", "ope", "_wz", "ate", "hod", "fig", "uru", "wol", "cha", "ozn", "zia", "_kr", "agł", "dłu", "rup", "ntr", "per", "rsz", "eme", "eżk", "ron", "rza", "sca", "yjs", "żyw", "ad_", "az_", "wdz", "ura", "_pe", "mow", "pam", "pob", "pok", "dno", "eje", "kan", "reg", "twa", "kol", "ite", "tab", "ąć_", "atn", "tać", "cer", "ory", "amo", "rdo", "baj", "cej", "etu", "iwu", "lsk", "ruc", "ceg", "edz", "nad", "unk", "_so", "dze", "gow", "gur", "jęz", "wum", "wyr", "ęzy", "nar", "pos", "ver", "_au", "win", "_że", "kal", "ntu", "og_", "_bl", "alb", "igu", "kra", "sch", "wal", "ck_", "ymi", "ajt", "zi_", "_ję", "inn", "bo_", "si_", "co_", "ec_", "nąć", "yjn", "_zd", "_s_", "mal", "sam", "zyn", "ędz", "żyć", "tos", "olo", "ubl", "du_", "oln", "om_", "gi_", "nas", "yj_", "_bu", "tac", "tym", "wła", "pub", "dal", "dzo", "obo", "rat", "rzo", "wny", "żyj", "zba", "zet", "dok", "ety", "_ju", "nd_", "_ki", "eta", "ępn", "odr", "otw", "rt_", "ysk", "asz", "ery", "etw", "pu_", "spo", "_sh", "emo", "ję_", "tel", "_t_", "ród", "_dł", "dop", "rl_", "how", "lu_", "reś", "stą", "_tw", "ejs", "ium", "riu", "yłą", "law", "set", "szu", "usi", "wne", "wył", "kuj", "noc", "rea", "sło", "śni", "cyj", "kty", "ówk", "_up", "aut", "rsk", "zow", "_pó", "ażd", "gna", "lbo", "nta", "eln", "ru_", "wpi", "ewn", "ore", "ępu", "ażn", "ing", "ll_", "omu", "_zł", "atr", "ri_", "rol", "zal", "_e_", "_źr"},
+	"pt": {"_de", "de_", "ão_", "do_", "_co", "os_", "_pa", "ar_", "ra_", "da_", "_se", "ado", "_in", "ent", "_re", "as_", "ção", "es_", "_a_", "par", "com", "ara", "_o_", "_es", "em_", "te_", "ro_", "nte", "não", "_nã", "to_", "fic", "con", "er_", "_no", "or_", "_po", "_um", "_fi", "_do", "ada", "_fo", "ica", "men", "_ar", "_pr", "ta_", "tra", "_li", "ter", "sta", "_ca", "açã", "eir", "um_", "est", "ido", "iro", "che", "_ex", "ma_", "dos", "ont", "qui", "ndo", "_em", "rad", "ivo", "pos", "for", "res", "el_", "ich", "que", "ist", "ver", "_da", "vel", "_en", "vo_", "hei", "and", "des", "al_", "_di", "no_", "por", "io_", "ou_", "_é_", "esp", "_fa", "rqu", "ia_", "_te", "_ma", "arq", "nto", "_qu", "ome", "íve", "_e_", "_us", "eci", "uiv", "se_", "ntr", "ess", "om_", "me_", "rio", "_me", "_su", "ões", "ida", "_op", "alh", "_ou", "mo_", "man", "spe", "lin", "_ta", "nom", "ser", "pro", "era", "iza", "so_", "esc", "mpo", "ir_", "oss", "ha_", "ina", "ifi", "cad", "_si", "_im", "pre", "_mo", "ste", "_ve", "lid", "_al", "são", "ria", "uma", "çõe", "liz", "err", "sív", "per", "rma", "car", "str", "_ao", "ssí", "ali", "ura", "ue_", "tar", "orm", "ini", "fal", "ao_", "tes", "fin", "is_", "po_", "_er", "imp", "_va", "efi", "int", "loc", "ho_", "tad", "rro", "rec", "na_", "dad", "_pe", "_ne", "lo_", "áli", "_ap", "inh", "ion", "def", "omp", "nha", "vál", "tem", "cia", "inv", "ere", "re_", "opç", "inc", "óri", "ort", "usa", "lho", "ve_", "ame", "ces", "ári", "_as", "ten", "_lo", "cri", "_sa", "ca_", "tiv", "nvá", "end", "dor", "nta", "rem", "dir", "ode", "oca", "alt", "das", "lic", "nde", "val", "pac", "pec", "ote", "rar", "tam", "ade", "ual", "ran", "ros", "pri", "ume", "alo", "_at", "ant", "lha", "ora", "oi_", "foi", "ers", "oma", "_tr", "co_", "act", "ati", "_na", "_st", "ama", "tos", "arg", "_so", "_os", "ema", "ita", "enc", "emo", "ito", "lis", "aco", "nci", "ect", "nal", "upo", "_ch", "cio", "min", "nho", "ire", "ais", "cor", "ret", "ili", "ona", "scr", "_ob", "ico", "ecu", "tua", "roc", "ero", "til", "pon", "tro", "mer", "sem", "atu", "enh", "_le", "it_", "mit", "cha", "cot", "erm", "eve", "omo", "ore", "_gr", "sa_", "cid", "apa", "sso", "age", "ici", "_to", "le_", "pod", "cif", "rta", "_cr", "ref", "nco", "tór", "cap", "nen", "_b_", "mat", "tip", "rgu", "_ba", "nti", "ece", "lor", "sco", "_ti", "mas", "ext", "cal", "_an", "ram", "nor", "eta", "eri", "la_", "pas", "reg", "tor", "ída", "ass", "rmi", "_ac", "açõ", "tri", "caç", "sec", "ost", "aíd", "pçã", "tal", "ime", "rim", "fer", "hec", "am_", "rep", "nca", "_nú", "raç", "saí", "az_", "ine", "mes", "exi", "nfo", "orr", "pen", "núm", "sin", "inf", "der", "pad", "seg", "ign", "ind", "qua", "egu", "cam", "ins", "ite", "tur", "adr", "gem", "stá", "_gi", "dis", "spo", "mov", "sup", "git", "ce_", "ena", "anh", "exp", "onf", "mod", "olo", "fil", "exe", "iva", "mbo", "tic", "rre", "rão", "dic", "zad", "abe", "elo", "eto", "içã", "mos", "sti", "tá_", "_ig", "mai", "gra", "hum", "gur", "ndi", "id_", "mpr", "úme", "nec", "mem", "iar", "ipo", "nhu", "gum", "nar", "ata", "oce", "_bi", "_ab", "vis", "ens", "ula", "paz", "ço_", "odo", "ela", "ove", "nhe", "_n_", "imi", "on_", "va_", "ile", "onh", "_au", "dif", "ori", "drã", "tec", "_ad", "zaç", "_av", "bol", "rac", "ala", "ato", "rea", "uti", "xec", "rte", "sar", "rel", "rev", "lte", "nic", "lta", "_s_", "cte", "_ut", "cre", "ima", "ert", "erv", "rsã", "_id", "hou", "ênc", "ave", "vos", "gno", "ast", "rup", "_nu", "ing", "pli", "_x_", "den", "ll_", "ne_", "red", "ele", "osi", "tas", "sen", "emp", "nid", "pçõ", "cçã", "uto", "ecç", "nst", "sub", "dev", "lar", "ape", "nas", "aut", "tid", "áve", "iso", "ren", "var", "iti", "avi", "ede", "las", "mpa", "cur", "iga", "bre", "ern", "_ge", "tex", "lti", "ias", "_he", "_sí", "has", "eit", "yte", "art", "byt", "are", "nov", "_by", "ons", "tab", "_mu", "ari", "edi", "sis", "et_", "dei", "lig", "eça", "uer", "ase", "mpl", "sím", "cen", "_un", "ond", "_sh", "ímb", "gru", "in_", "zer", "xis", "hav", "ns_", "uan", "obr", "equ", "nfi", "ssa", "_or", "cut", "one", "tod", "isp", "spa", "maç", "_ra", "nad", "ese", "lim", "rit", "tat", "cti", "ch_", "go_", "sad", "orn", "tin", "vid", "ssi", "igu", "sob", "ém_", "efe", "vor", "ios", "emó", "mór", "aço", "dem", "ead", "lit", "sol", "bas", "ota", "out", "sto", "uta", "ras", "lem", "sim", "eno", "_sã", "egi", "etó", "rti", "tim", "epo", "ssã", "imo", "_la", "los", "ocu", "ng_", "ace", "col", "num", "ope", "sit", "obj", "tan", "atr", "ler", "bje", "nt_", "clu", "adi", "let", "_fu", "dep", "iad", "ilh", "us_", "eme", "cla", "hel", "nes", "nça", "isa", "cas", "ger", "xo_", "esm", "tre", "smo", "amp", "dia", "met", "_pi", "ira", "ça_", "_el", "bli", "_ho", "faz", "arc", "ino", "lad", "obt", "rig", "sse", "últ", "fun", "xto", "fix", "fig", "ian", "dat", "ink", "itu", "ami", "ed_", "unt", "nív", "ial", "tio", "ple", "ck_", "mon", "fon", "bal", "ord", "gis", "pat", "_ce", "aba", "cab", "zar", "_má", "rir", "ctu", "nat", "ult", "st_", "pel", "ano", "mal", "nam", "nir", "use", "arr", "igo", "aze", "uit", "sca", "apl", "exc", "nos", "ogr", "uni", "unç", "vez", "jun", "ulo", "rib", "ate", "squ", "anç", "eco", "ble", "uso", "_d_", "bri", "ez_", "rin", "rna", "amb", "ecl", "nçã", "eli", "rra", "_c_", "eja", "not", "tir", "ês_", "beç", "ixo", "odi", "_vi", "vei", "ega", "eis", "ide", "rne", "esa", "mag", "_bl", "ibu", "sel", "mad", "rab", "ssá", "at_", "blo", "_t_", "_bu", "jet", "abr", "ive", "del", "gar", "ova", "mar", "tru", "abi", "reç", "sár", "çal", "can", "nár", "all", "ack", "pal", "epa", "gui", "rno", "rva", "xpr", "_mi", "taç", "anc", "gin", "sag", "_ha", "bin", "nsa", "pe_", "iáv", "cum", "_l_", "ric", "rom", "_sy", "cat", "uin", "ute", "amo", "nda", "niç", "_có", "_fl", "ete", "ior", "eço", "pla", "_fe", "ice", "lat", "dig", "_fr", "pid", "_is", "bte", "fo_", "urs", "cos", "riá", "_só", "eda", "tém", "ava", "esq", "laç", "oco", "só_", "rif", "bil", "cer", "lme", "ts_", "gaç", "les", "_am", "_du", "azi", "rog", "usu", "war", "ge_", "cul", "ad_", "pil", "vaz", "_r_", "mpi", "rat", "ana", "ock", "did", "suá", "_vo", "cta", "ja_", "uár", "doc", "im_", "vio", "cod", "din", "gen", "_f_", "imb", "uda", "_ro", "_ár", "rên", "deb", "paç", "rol", "rt_", "eia", "he_", "ls_", "nce", "riz", "ham", "ped", "sep", "und", "_p_", "hor", "ós_", "eti", "bel", "iss", "nk_", "pt_", "_ju", "bit", "eu_", "pós", "dio", "ixa", "nit", "rei", "rip", "sh_", "hos", "lav", "nté", "ain", "rsi", "lon", "esv", "tif", "ut_", "nd_", "rá_", "had", "set", "log", "oto", "uçã", "det", "etr", "olu", "ize", "lec", "oni", "uir", "lt_", "mot", "rid", "via", "an_", "apó", "xim", "ovo", "tag", "mei", "erê", "avr", "rso", "svi", "unc", "erd", "ps_", "ell", "nve", "_br", "rda", "vra", "_m_", "emi", "ncl", "il_", "ola", "ong", "sio", "alm", "gua", "inu", "óli", "alv", "but", "_th", "mbi", "ole", "rvi", "th_", "_vá", "_ze", "púb", "úbl", "_v_", "ff_", "olv", "ból", "iqu", "mbó", "_úl", "ila", "cód", "mor", "ui_", "ois", "rde", "_i_", "ber", "bra", "she", "ze_", "ódi", "ará"},
+	"ro": {"_de", "de_", "te_", "re_", "are", "_nu", "ul_", "ea_", "_se", "ent", "rea", "le_", "_în", "tă_", "_co", "nu_", "_fi", "_in", "iun", "ntr", "ate", "ste", "est", "_a_", "_re", "_pe", "fiș", "ier", "at_", "tru", "ză_", "se_", "_es", "_di", "rul", "_ne", "une", "iși", "în_", "șie", "ru_", "țiu", "ui_", "_pr", "ie_", "pen", "num", "oar", "ază", "men", "_po", "la_", "car", "_ca", "eaz", "lui", "_la", "ele", "nea", "ile", "ume", "ulu", "nte", "ter", "_un", "_cu", "int", "ere", "ire", "ist", "val", "ne_", "or_", "nt_", "_li", "ali", "tat", "sta", "_ar", "ați", "con", "ect", "_ex", "_su", "tor", "ica", "che", "_ac", "cți", "ată", "com", "un_", "_ma", "că_", "ver", "ii_", "liz", "er_", "_op", "ră_", "ri_", "_fo", "cu_", "_st", "_si", "ili", "fic", "iza", "ero", "loc", "ces", "ște", "rec", "_o_", "_da", "sec", "eru", "ifi", "să_", "tul", "uni", "oat", "_al", "pre", "al_", "_pa", "it_", "uri", "_er", "_și", "ia_", "til", "ți_", "pro", "alo", "_va", "imb", "uti", "ut_", "str", "ta_", "_ut", "_sa", "in_", "ini", "roa", "poa", "me_", "lic", "tar", "au_", "ecț", "ori", "ecu", "și_", "id_", "ar_", "oca", "bil", "_ti", "pți", "nă_", "tre", "tur", "ara", "for", "act", "rma", "ca_", "din", "_s_", "tra", "_ve", "opț", "siu", "lor", "orm", "rar", "lă_", "imp", "ei_", "res", "_mo", "eri", "ace", "cat", "lid", "st_", "ici", "des", "ine", "cit", "nec", "_me", "ce_", "ina", "sim", "lul", "dat", "_b_", "per", "_no", "ers", "sau", "pri", "_să", "_pu", "_ch", "_sc", "bol", "ato", "mbo", "_tr", "ime", "_af", "zat", "_ta", "chi", "par", "rat", "abi", "_sp", "ite", "cte", "omp", "ept", "tri", "_im", "eșt", "pta", "lin", "ril", "șir", "_ad", "_ci", "ări", "tiv", "ion", "cut", "and", "înc", "_au", "por", "dir", "ică", "țin", "_do", "tip", "ive", "oru", "mul", "mat", "ert", "put", "hei", "tab", "ită", "cri", "min", "ale", "ție", "utu", "ții", "scu", "esa", "esc", "ort", "rsi", "eva", "_lo", "dă_", "ast", "olu", "rie", "_ni", "ins", "reg", "eci", "nal", "cun", "scr", "pli", "rel", "imi", "cre", "tea", "eși", "stă", "cep", "afi", "iți", "spe", "nev", "ra_", "erm", "het", "mpl", "_lu", "_ie", "et_", "ind", "mai", "uno", "_ce", "lim", "ai_", "nd_", "ebu", "mod", "rti", "inf", "iti", "nfo", "man", "ni_", "eal", "pec", "_an", "eta", "_ap", "ita", "cal", "bui", "ach", "tel", "cor", "mel", "cif", "ieș", "loa", "ant", "ide", "măr", "nic", "pul", "_el", "ost", "sch", "tim", "cce", "pac", "_bi", "pe_", "ten", "nos", "rim", "on_", "cto", "nde", "unt", "lis", "ont", "ult", "era", "ona", "rmi", "_cr", "inc", "tal", "umă", "pot", "_ur", "ctu", "roc", "sun", "one", "cti", "_te", "mit", "sem", "ext", "unc", "osc", "sup", "ute", "sit", "_fu", "ens", "egi", "ice", "ete", "nți", "ătu", "fos", "nta", "ip_", "_le", "înt", "_câ", "ati", "fie", "mar", "arh", "nsi", "rhi", "exp", "tif", "_vi", "acc", "nst", "fi_", "fin", "fer", "ece", "rit", "ima", "_ob", "tut", "ct_", "edi", "bli", "olo", "ția", "dar", "rup", "toa", "șea", "_gr", "el_", "dec", "etu", "ișe", "reb", "sar", "arg", "emn", "nar", "_at", "efi", "rac", "ic_", "vă_", "nti", "ară", "uie", "ura", "căr", "ern", "lat", "dep", "elo", "art", "es_", "_ul", "ol_", "rgu", "eme", "erv", "oce", "_fa", "_id", "ave", "def", "_eș", "atu", "așt", "odu", "sti", "dim", "nat", "nce", "exi", "ală", "_av", "_mu", "rii", "tic", "acă", "elu", "rta", "aut", "cur", "caț", "nii", "nit", "gis", "leg", "ută", "eză", "hiv", "nda", "_ge", "ll_", "maț", "_fă", "dre", "ner", "oma", "rca", "cer", "ot_", "eți", "ope", "cod", "_to", "ran", "ize", "iec", "onț", "_gă", "_so", "_x_", "nil", "ță_", "găs", "ram", "esi", "ură", "uat", "il_", "ilo", "tet", "_ba", "tis", "dac", "_n_", "mpo", "ând", "ame", "tep", "dul", "_du", "na_", "ără", "ăsi", "arc", "dis", "rin", "mic", "ric", "exe", "făr", "_sh", "zar", "_as", "_că", "ref", "ari", "tin", "ese", "tec", "fol", "tem", "lur", "_mi", "ivă", "lar", "iva", "adr", "ină", "ore", "ora", "idă", "los", "ria", "cul", "der", "iil", "ede", "ism", "onf", "ual", "ala", "cea", "gra", "sme", "ană", "urn", "efe", "gin", "ple", "gum", "mem", "ome", "rol", "țio", "tan", "eas", "ibi", "xis", "dif", "mpr", "lem", "rep", "obi", "xec", "ana", "tua", "ian", "mp_", "riv", "mă_", "atr", "_oc", "osi", "alt", "an_", "red", "va_", "den", "zea", "șit", "mpu", "ula", "urs", "eșu", "ung", "ăru", "cta", "oct", "rez", "sa_", "ubl", "eli", "nor", "_en", "hel", "ndi", "sel", "esp", "rte", "_or", "alu", "esu", "inu", "lea", "lun", "ocu", "rt_", "pon", "met", "tas", "enț", "ci_", "egă", "emo", "șua", "gur", "bel", "pun", "ser", "abe", "agi", "găt", "eca", "ene", "fun", "rib", "ţi_", "epa", "epu", "uta", "lte", "var", "fil", "ogr", "poz", "dup", "nam", "ntu", "ani", "io_", "eia", "hid", "tro", "ule", "dia", "da_", "ibu", "uma", "_pi", "dez", "tio", "ote", "teț", "gen", "igu", "ret", "rni", "pub", "nia", "ozi", "cum", "iu_", "mer", "sul", "ata", "nie", "ech", "mor", "ntă", "nzi", "oc_", "ăr_", "_aș", "mag", "mes", "pt_", "rd_", "war", "tir", "vir", "eie", "lti", "bie", "spa", "ard", "iei", "lit", "ami", "ilă", "ips", "rog", "seș", "end", "eti", "od_", "sur", "eco", "nco", "otr", "ren", "sin", "zer", "mis", "am_", "ma_", "him", "lt_", "enz", "ge_", "lip", "nță", "ruc", "rva", "urm", "blo", "ncț", "rip", "tex", "det", "iat", "nul", "_go", "ell", "eze", "is_", "sis", "ec_", "gru", "mna", "tră", "us_", "năt", "ro_", "răr", "tr_", "cân", "vat", "set", "ir_", "oni", "aju", "cop", "inv", "lan", "ign", "_ru", "asc", "ndă", "za_", "_d_", "uto", "ng_", "ons", "ial", "ipt", "med", "mnă", "pan", "rnă", "_he", "pă_", "_r_", "âmp", "_fr", "sub", "ât_", "ărc", "_ab", "nut", "ode", "doa", "nou", "rge", "_e_", "ble", "câm", "let", "odi", "she", "_ra", "dic", "rne", "ing", "lia", "sc_", "upă", "lel", "reș", "_bl", "deb", "ex_", "gul", "ns_", "aj_", "xpr", "_am", "bi_", "iab", "ipu", "rio", "ciu", "gre", "eza", "mpa", "ptă", "ls_", "pse", "spr", "ti_", "fra", "opr", "apl", "but", "erg", "ain", "col", "en_", "ila", "niz", "pat", "_l_", "cio", "iot", "lec", "apt", "fur", "nfi", "upo", "asa", "ega", "ngi", "um_", "ure", "ve_", "vin", "cla", "eac", "soc", "uă_", "enu", "ger", "spo", "dex", "eaș", "ncă", "nez", "nve", "upr", "upt", "upu", "_bu", "ade", "far", "ivi", "opi", "fig", "isp", "saj", "ăto", "cel", "vel", "afa", "bin", "gă_", "rif", "del", "gno", "nch", "nre", "ord", "ree", "tit", "ziț", "ane", "non", "paț", "pid", "sto", "înr", "cât", "mpi", "not", "rmă", "vec", "bți", "etă", "und", "vea", "_pl", "eni", "_c_", "_et", "bă_", "ema", "epe", "mal", "ouă", "_sy", "_ze", "mon", "xt_", "ze_", "_ig", "ncr", "no_", "ose", "rem", "sie", "zi_", "mac", "mbi", "ock", "pil", "rsă", "sor", "ase", "ela", "ich", "mba", "oli", "rna", "rve", "scă", "ucț", "up_", "amb", "aro", "ucr", "uți", "_t_", "ada", "biț", "gim", "ibl", "im_", "ps_", "rc_", "_gn", "_șt", "doc", "iru", "ix_", "mea", "op_", "rev", "_sf", "clu", "obț", "unu", "em_", "iv_", "nen", "raț", "gnu", "păr", "leș", "nui", "rop", "sig", "bib", "ed_", "exc", "fac", "ioa", "nsu", "apa", "erc", "niv", "taț", "_p_", "_v_", "baz", "bug", "imă", "rl_", "sam", "ctă", "ead"},
+	"ru": {"_не", "ть_", "ени", "_по", "_пр", "не_", "ие_", "ние", "ия_", "пол", "_в_", "ать", "_за", "ый_", "_ко", "ова", "оль", "ся_", "_ра", "ля_", "мен", "стр", "ка_", "но_", "айл", "_фа", "фай", "ет_", "ния", "_дл", "_вы", "ный", "тся", "пер", "ая_", "_со", "ить", "_на", "про", "ани", "для", "раз", "го_", "ват", "ров", "етс", "на_", "пре", "вер", "нны", "ой_", "_па", "льз", "_ис", "_об", "ало", "_пе", "ов_", "ере", "спо", "_до", "уда", "_от", "дал", "ии_", "_си", "_уд", "льн", "ста", "ого", "ий_", "анн", "ки_", "дел", "ред", "ост", "тро", "ест", "ом_", "_ка", "ком", "_ре", "ств", "ое_", "сь_", "ван", "ые_", "_ст", "ли_", "исп", "ает", "нов", "ла_", "зов", "ент", "чен", "_с_", "уст", "под", "лен", "сти", "_из", "при", "пис", "ска", "_ин", "сим", "ых_", "ует", "еме", "дан", "мет", "иро", "ель", "тел", "та_", "_им", "клю", "люч", "ист", "лов", "нач", "енн", "ера", "зна", "_и_", "ось", "ьзо", "лос", "ект", "нев", "ите", "кат", "вол", "рам", "жен", "каз", "тор", "пар", "те_", "рав", "имв", "ные", "ива", "дер", "мво", "оши", "_оп", "мож", "зап", "анд", "шиб", "_ош", "аме", "щен", "тан", "нен", "ерж", "рем", "ран", "ибк", "аци", "ных", "ара", "или", "ден", "пус", "ное", "ног", "ти_", "йл_", "бра", "_ве", "аза", "ен_", "зме", "нно", "ика", "ата", "рок", "жно", "име", "аче", "_то", "сли", "бка", "_но", "ции", "_сл", "ход", "мер", "ра_", "ате", "кая", "ная", "ока", "_ар", "ано", "ию_", "сто", "ок_", "_ил", "ржи", "зде", "обр", "пра", "тны", "етр", "воз", "_кл", "ей_", "то_", "азд", "ски", "ной", "реж", "ави", "_се", "фор", "вае", "орм", "олн", "_ус", "мещ", "ожн", "аль", "_зн", "_ук", "вле", "кон", "фик", "ука", "ьны", "_мо", "_да", "оди", "ерн", "тно", "_бы", "ево", "рма", "сле", "чит", "кци", "ри_", "еще", "_сп", "опу", "ми_", "рек", "ер_", "да_", "йла", "одн", "пос", "ьно", "тал", "вод", "ене", "нст", "оло", "ле_", "змо", "озм", "по_", "тов", "_b_", "оже", "_эт", "тек", "ыть", "ко_", "тву", "ома", "мя_", "ман", "рег", "_чт", "ада", "лог", "рес", "инс", "иче", "еги", "од_", "пак", "из_", "чес", "ори", "доп", "опе", "ифи", "тр_", "ны_", "гис", "еде", "ак_", "кет", "еск", "выв", "ном", "_ди", "ото", "ем_", "едо", "имо", "ово", "льк", "неп", "_ме", "раб", "_ба", "тру", "ьзу", "это", "зан", "ина", "ым_", "дол", "еве", "кор", "рас", "рук", "ты_", "апи", "ько", "ры_", "ыва", "або", "авл", "_та", "аке", "жив", "одд", "оде", "дде", "код", "ежд", "сте", "рир", "вит", "ена", "вес", "екс", "тим", "кий", "ожи", "зда", "нит", "ове", "нт_", "олж", "тат", "ан_", "ено", "его", "быт", "яет", "овк", "лок", "ва_", "мат", "it_", "ль_", "вре", "изв", "отк", "еко", "аст", "озд", "зад", "нос", "еду", "еле", "ида", "соз", "_re", "вля", "_ти", "емы", "тол", "ую_", "азо", "изм", "оме", "осл", "тиф", "тре", "_вн", "айт", "имя", "уме", "_во", "дат", "_ма", "ний", "упр", "_де", "лит", "укц", "тра", "дно", "тип", "жид", "ку_", "нео", "ерс", "_ес", "ит_", "сло", "вет", "бли", "тст", "_фо", "ке_", "оки", "как", "епо", "нед", "ция", "ным", "ско", "ита", "ктн", "что", "али", "рен", "рси", "нии", "_вс", "заг", "лы_", "вуе", "ами", "уще", "ато", "азм", "рат", "стн", "_x_", "опр", "игн", "ела", "_те", "дит", "зат", "тве", "вып", "ющи", "_co", "жим", "_gi", "отс", "иск", "сод", "йло", "иси", "гра", "дос", "обн", "ыво", "дин", "_би", "_бе", "бло", "гру", "ери", "нек", "сыл", "ссы", "йст", "чан", "чис", "арх", "_тр", "есл", "лем", "орр", "мес", "рре", "ерв", "_сс", "ыпо", "зве", "им_", "рхи", "сть", "_ад", "неи", "абл", "нию", "_су", "нер", "_см", "адр", "их_", "тен", "_st", "аже", "сер", "зав", "аем", "има", "git", "нта", "объ", "жде", "общ", "ляе", "овы", "луч", "_de", "кры", "выр", "_пу", "дре", "еиз", "бот", "рны", "спи", "бъе", "раж", "ода", "бай", "_бу", "ат_", "очн", "пок", "три", "во_", "ежи", "же_", "нде", "нти", "сов", "нда", "соо", "етк", "рос", "ол_", "зуе", "поз", "_no", "исл", "_эл", "иру", "_ум", "без", "_вр", "инд", "ним", "дек", "ели", "ати", "дуп", "най", "_ож", "ни_", "_од", "огр", "_ос", "утс", "le_", "нет", "тит", "_чи", "ови", "арг", "уже", "лед", "олу", "сут", "нор", "си_", "ять", "ел_", "нск", "са_", "еля", "лин", "иль", "точ", "жит", "_гр", "еоб", "чны", "ённ", "лож", "овл", "чно", "эле", "цию", "ана", "дае", "мол", "бит", "за_", "инф", "вто", "ыра", "ип_", "рти", "тсу", "лиш", "вил", "вой", "оне", "так", "нфо", "умо", "олч", "_in", "лча", "оро", "вне", "обы", "кол", "мый", "ующ", "жет", "ло_", "нал", "рой", "гно", "омп", "ор_", "on_", "мац", "все", "том", "рыт", "еди", "пор", "er_", "аве", "рно", "тем", "бол", "ргу", "гум", "ись", "оба", "ъек", "он_", "реб", "ейс", "имы", "тер", "одп", "епр", "сме", "оле", "але", "вен", "от_", "пом", "сту", "апа", "лас", "сис", "спе", "шко", "_n_", "ез_", "ишк", "ета", "ылк", "пов", "тив", "тры", "_иг", "шен", "диа", "_бо", "ог_", "ее_", "явл", "иде", "очи", "она", "оце", "_це", "юче", "дпи", "мпо", "му_", "рез", "рац", "тви", "ма_", "ичн", "руе", "дли", "нна", "_яв", "ора", "туп", "апр", "_a_", "роц", "_ma", "ков", "авн", "вых", "есс", "зон", "ную", "мы_", "ава", "нар", "рог", "ебу", "лик", "es_", "йте", "_сб", "юча", "ючи", "бще", "вно", "рол", "ающ", "нут", "тир", "паз", "яни", "бно", "тво", "тки", "_бл", "иап", "кот", "ола", "тоб", "_к_", "шир", "вы_", "лжн", "сок", "тка", "ире", "рит", "омм", "таб", "нд_", "_lo", "нес", "пон", "иса", "ён_", "вый", "лич", "щий", "нте", "уск", "чат", "айд", "_se", "мит", "роб", "тур", "цел", "зыв", "рин", "цес", "лне", "ром", "ерш", "орт", "ion", "еча", "йде", "лав", "опи", "доб", "юч_", "аго", "се_", "дов", "хив", "еку", "амм", "гол", "ооб", "тар", "ито", "юще", "кла", "кси", "лиц", "sta", "ако", "буе", "бы_", "дей", "кра", "вме", "мми", "ерт", "ето", "лни", "мог", "_дв", "ини", "_кр", "ах_", "зам", "асп", "no_", "_ва", "вую", "кс_", "мое", "па_", "тьс", "ься", "_fi", "id_", "ютс", "_pr", "аро", "ll_", "буд", "реп", "озн", "ник", "руп", "se_", "йти", "ген", "nt_", "бав", "онт", "оры", "лже", "_li", "тич", "ток", "ены", "кту", "ткр", "_he", "_вв", "дны", "_вх", "вхо", "упп", "ile", "наз", "тав", "_ло", "арт", "асс", "вка", "дир", "уйт", "_r_", "кти", "сущ", "_s_", "_ни", "той", "щес", "ача", "ваю", "роп", "лон", "азы", "вки", "кал", "лад", "обл", "руж", "чте", "ver", "_pa", "йт_", "тна", "исо", "рим", "рои", "ды_", "_ге", "_ск", "_уп", "et_", "вну", "сбо", "итн", "ыхо", "_о_", "fil", "дст", "нас", "тоя", "они", "loc", "йлы", "емо", "кой", "няе", "_че", "опо", "реш", "ут_", "_ли", "ота", "азр", "жат", "роч", "сно", "in_", "пец", "рна", "con", "час", "агр", "lin", "анс", "ча_", "руг", "зак", "ца_", "_вк", "_св", "ce_", "вкл", "изо", "йск", "щие", "ck_", "мод", "печ", "сор", "te_", "ари", "рев", "утр", "льш", "выб", "инт", "уля", "ело", "кие", "оли", "_ид", "me_", "едс", "зит", "_di", "_фу", "ect", "re_", "га_", "дим", "мно", "сос", "вни", "льт", "ант", "ают", "зре", "ием", "лён", "унк", "_ну", "де_", "сии", "отв", "уче", "нто", "фун", "бой", "ме_", "мо_", "пут", "_d_", "азн", "асш", "лня", "оку", "син", "спр", "_al", "_др", "ица", "озв", "оно", "тог", "_ча", "акс", "ала", "_ви", "ame", "кст", "мал", "щей", "хра", "ch_", "нел"},
+	"sk": {"_pr", "ie_", "_po", "_ne", "je_", "nie", "_na", "ova", "_sú", "ný_", "né_", "_je", "na_", "pre", "_sa", "súb", "bor", "úbo", "sa_", "van", "ov_", "iť_", "ať_", "_vy", "_ni", "ia_", "eni", "rov", "_ch", "ba_", "pri", "or_", "men", "sta", "lo_", "uje", "_za", "_re", "_v_", "nep", "re_", "ka_", "_ná", "pod", "ná_", "kon", "_do", "_od", "ver", "ani", "zna", "ho_", "_al", "te_", "ch_", "chy", "_ak", "hyb", "ost", "pou", "ouž", "_in", "ky_", "res", "ent", "ožn", "_ko", "_ba", "stu", "_sp", "_ve", "bol", "_mo", "áci", "_zo", "om_", "ne_", "str", "iad", "_st", "ru_", "mož", "aný", "oru", "ale", "ebo", "ast", "_sy", "prí", "zov", "_ob", "_se", "sti", "lat", "_a_", "kaz", "to_", "atn", "pla", "ina", "tor", "nam", "cie", "náz", "vať", "pro", "ri_", "tav", "_ad", "ého", "_vo", "yba", "ázo", "den", "ko_", "bal", "_sk", "ený", "_s_", "tov", "ní_", "adr", "žné", "ané", "odp", "_zá", "tup", "íka", "hod", "epo", "alo", "áva", "_ar", "ist", "alí", "dre", "_ho", "lík", "teľ", "ta_", "nen", "_ma", "_vý", "for", "ako", "oro", "uži", "bo_", "ých", "tvo", "ate", "leb", "slo", "nov", "_to", "orm", "raz", "odn", "_pa", "_čí", "ove", "por", "dno", "dar", "nas", "kci", "ny_", "ria", "nia", "obr", "epl", "čas", "ari", "cia", "ti_", "_ro", "_de", "ozn", "voľ", "lov", "šta", "rmá", "vor", "ick", "prá", "er_", "aní", "ené", "kov", "len", "ou_", "íva", "_zl", "_zn", "čin", "dpo", "_no", "_me", "olo", "ový", "ku_", "ok_", "bra", "not", "vý_", "čít", "žív", "nt_", "oľb", "uží", "íta", "nos", "tu_", "pis", "red", "est", "sť_", "ej_", "la_", "ilo", "ká_", "kľú", "ľúč", "_ri", "oda", "am_", "az_", "_kľ", "by_", "rzi", "vat", "tal", "ada", "tan", "spr", "ali", "arc", "kto", "rík", "ak_", "erz", "tný", "le_", "ril", "typ", "sym", "ume", "ame", "nak", "rep", "oča", "sko", "esá", "et_", "ned", "ori", "_he", "_ty", "sah", "sár", "no_", "ren", "_bo", "avi", "žia", "lož", "ráv", "lik", "zly", "ce_", "lyh", "néh", "pís", "vyp", "_so", "hal", "yha", "inf", "ite", "sek", "ké_", "mie", "poz", "_te", "nfo", "ore", "pra", "ra_", "ové", "ram", "va_", "áln", "čen", "mi_", "rch", "ry_", "tre", "dok", "pos", "mu_", "odk", "ods", "_fo", "roz", "tie", "_ča", "žit", "_op", "aká", "nšt", "dka", "mbo", "_ex", "do_", "iu_", "ymb", "adn", "ten", "tuj", "kom", "nač", "sku", "ami", "dá_", "iká", "met", "vyt", "obs", "ter", "nez", "dst", "akt", "inš", "_o_", "riť", "tro", "ven", "_ži", "júc", "nýc", "čak", "že_", "oku", "_kt", "azy", "nem", "ytv", "ajú", "oli", "čís", "_be", "dia", "ies", "ísa", "ol_", "_z_", "vol", "_di", "_ta", "dov", "iac", "roj", "_ce", "aná", "ekc", "pol", "upn", "al_", "veľ", "_by", "_zd", "dro", "tri", "_zm", "pov", "ska", "ujú", "zob", "ľa_", "bsa", "ran", "ave", "daj", "ec_", "orn", "ár_", "and", "ede", "ký_", "ty_", "edá", "jú_", "nut", "_si", "rán", "zor", "led", "trá", "ísl", "_ur", "rne", "zoz", "ete", "ifi", "oto", "sle", "áto", "_co", "lad", "ota", "eno", "kup", "osť", "ovn", "rip", "dan", "exi", "eme", "li_", "me_", "stn", "vst", "_ka", "en_", "ráz", "ika", "zad", "_mi", "_li", "mát", "nsk", "on_", "ská", "tar", "ená", "ktu", "poč", "káv", "ont", "ová", "sú_", "_vi", "ara", "niť", "ste", "ahu", "chí", "hív", "yp_", "ím_", "duj", "sys", "žno", "ign", "lic", "onč", "upi", "_lo", "de_", "neb", "omo", "_vs", "ii_", "mác", "edn", "eľa", "id_", "xis", "hla", "pin", "zdr", "bli", "ekt", "yst", "ere", "fik", "mov", "výs", "tra", "_št", "ciu", "gra", "bez", "hel", "zná", "_up", "cov", "my_", "nej", "ské", "kum", "nám", "pub", "sté", "ubl", "vá_", "pom", "tab", "vé_", "eľk", "nu_", "vu_", "esl", "jov", "sov", "tém", "ýst", "_pl", "ade", "dne", "eho", "liz", "rac", "zme", "_ja", "_ot", "adi", "ľby", "jed", "kác", "neo", "žad", "ato", "ext", "ovo", "poj", "ík_", "byť", "ces", "epu", "hes", "ope", "ory", "rie", "ved", "vo_", "yť_", "ča_", "_ap", "arg", "per", "za_", "_tr", "ero", "náv", "obn", "ole", "up_", "ve_", "ypí", "ára", "ajt", "dos", "ern", "kla", "lav", "_hl", "_sh", "_už", "es_", "lok", "môž", "náj", "onf", "rem", "su_", "tom", "yko", "_kó", "_sl", "nči", "sla", "úča", "_mu", "ení", "má_", "ogr", "se_", "výr", "_bi", "_bu", "_da", "cii", "nte", "ser", "vyk", "_fi", "_n_", "nic", "nto", "otv", "rob", "rom", "viť", "ázv", "dat", "kód", "mus", "oje", "upo", "ati", "moc", "pot", "_id", "adk", "eťa", "iba", "iti", "lu_", "pli", "reť", "rát", "_ib", "adu", "ain", "huj", "lne", "loc", "ona", "omp", "sky", "át_", "_ov", "baj", "ed_", "ena", "tat", "ťaz", "žiť", "_zv", "azi", "ion", "rat", "ind", "is_", "par", "ros", "sať", "rsk", "tné", "zy_", "las", "tic", "tif", "vyž", "aze", "ačn", "ll_", "ola", "ort", "pu_", "tex", "vy_", "ado", "edo", "nom", "pec", "rav", "rog", "_x_", "ard", "ca_", "chý", "ene", "ezn", "ly_", "ozo", "ust", "áni", "ata", "ci_", "hov", "aco", "azu", "ým_", "_má", "dát", "sto", "ľba", "_dá", "eľn", "fun", "lin", "vič", "yža", "ziť", "ace", "emo", "nai", "spo", "ôže", "ľko", "cké", "ek_", "int", "ičk", "kos", "vše", "úč_", "_fu", "_vš", "ačí", "kát", "reb", "ský", "tná", "tož", "_sc", "dis", "ell", "fil", "hýb", "in_", "ma_", "ože", "ret", "tiť", "ual", "esk", "eľ_", "nan", "tať", "tot", "_k_", "ke_", "ode", "urč", "ide", "ile", "liš", "orý", "rt_", "tin", "vac", "aci", "jen", "pný", "pok", "tua", "árn", "ýra", "amu", "brá", "nor", "pus", "_ti", "ber", "nda", "ojo", "oče", "ožk", "rea", "ríl", "ybn", "úda", "čné", "_au", "dy_", "ele", "ez_", "ník", "už_", "nee", "nes", "ock", "oko", "rol", "vár", "zia", "íli", "ýba", "_an", "_uk", "as_", "deb", "ert", "fig", "pam", "rad", "rev", "vne", "_úd", "iš_", "nfi", "nú_", "rek", "ruj", "zvu", "zís", "ísk", "_va", "_zí", "bin", "erá", "jaz", "tok", "uko", "unk", "usí", "zu_", "zyk", "čov", "bud", "con", "edi", "erv", "eto", "nap", "uto", "via", "čet", "čiť", "ala", "ing", "otr", "tvá", "zok", "úci", "eex", "ií_", "oži", "rid", "roc", "tia", "véh", "čia", "šet", "def", "dny", "eda", "osl", "rý_", "sch", "set", "she", "zie", "ava", "dať", "dný", "ebu", "etk", "ine", "tno", "_la", "_vl", "_či", "_šp", "azo", "eoč", "gur", "ice", "igu", "jto", "med", "ner", "ntu", "reg", "sig", "spu", "vis", "ach", "eci", "isl", "it_", "nci", "nkc", "ním", "nút", "tív", "ers", "ino", "pt_", "rve", "zap", "del", "dné", "era", "esu", "jde", "sí_", "tý_", "us_", "ív_", "čný", "_vz", "apí", "gno", "ným", "och", "rel", "ájd", "ína", "úce", "bný", "cou", "em_", "iek", "ito", "los", "zác", "záp", "íky", "ľov", "aho", "aut", "exp", "fo_", "hra", "lan", "ntr", "tky", "ému", "_ig", "ect", "ial", "izá", "nd_", "odu", "st_", "tne", "val", "vov", "žka", "_c_", "_kr", "asn", "nti", "zo_", "íko", "_ke", "_os", "_oč", "amä", "cký", "enc", "ečn", "gum", "imo", "iná", "ipo", "rač", "rgu", "ré_", "sob", "xt_", "záv", "úto", "_nu", "cer", "dpi", "etr", "eži", "lny", "mer", "nde", "nál", "oni", "ávi", "špe", "_as", "_mô", "alt", "asl", "du_", "efi", "oco", "od_", "áve", "ázd", "_zi", "edz", "elo", "eľo", "fin", "hu_", "ich", "iet", "zdn", "_dĺ", "_um", "dvo", "dĺž", "eri", "imp", "kyt", "oft", "púš", "sof", "úšť", "še_", "žen", "_of", "báz", "ng_", "nta", "oce", "prv", "skr", "té_", "uál"},
+	"sl": {"_pr", "na_", "ka_", "_na", "_po", "ni_", "je_", "_za", "_iz", "pre", "dat", "_da", "_ni", "ato", "tek", "ote", "tot", "anj", "ne_", "no_", "ti_", "_ne", "nje", "_je", "men", "sta", "pri", "_do", "ja_", "_mo", "_ko", "ke_", "ki_", "če_", "ost", "tev", "za_", "red", "_se", "ska", "sti", "_v_", "zna", "ime", "pod", "por", "ogo", "en_", "ran", "oče", "_st", "nos", "ina", "raz", "ga_", "ora", "lja", "eka", "_im", "mog", "goč", "jen", "_vr", "lo_", "nik", "pak", "_ra", "ov_", "pis", "ika", "_in", "se_", "eni", "ih_", "ega", "kov", "ta_", "in_", "lik", "va_", "_ma", "vel", "_al", "ko_", "eve", "li_", "_pa", "_z_", "_od", "ira", "oda", "ite", "jav", "_ob", "ali", "_sp", "em_", "_up", "ena", "nak", "_ar", "van", "to_", "upo", "čin", "ilo", "te_", "_ve", "avn", "vil", "rab", "ave", "šte", "nap", "elj", "_si", "ri_", "ent", "iti", "aka", "apa", "nja", "_re", "la_", "ve_", "ra_", "nam", "eke", "avi", "izb", "šči", "edn", "oči", "eno", "_vs", "rav", "bir", "evi", "dol", "zbi", "_zn", "me_", "st_", "ova", "_me", "_št", "ot_", "sto", "an_", "ati", "_br", "str", "izp", "_de", "pro", "tav", "lje", "_us", "_s_", "kaz", "nas", "nev", "ani", "neg", "ake", "rst", "jem", "loč", "est", "nt_", "isa", "vrs", "ame", "ove", "_sk", "ist", "zpi", "ume", "_ti", "eva", "jan", "pos", "čen", "aj_", "ako", "_op", "hod", "ast", "var", "ek_", "lju", "pra", "ava", "ev_", "tan", "da_", "iko", "mo_", "_bi", "_uk", "ma_", "ik_", "kot", "nih", "vna", "dno", "ed_", "ezn", "olo", "uka", "rem", "ana", "er_", "tra", "_če", "ene", "izv", "_en", "_ka", "jo_", "gra", "ica", "ija", "bli", "vez", "_sa", "ede", "_ta", "ca_", "klj", "nsk", "ven", "tre", "juč", "kon", "odp", "olj", "tip", "_ba", "and", "ak_", "vni", "_no", "od_", "uje", "enj", "pov", "arg", "zap", "bra", "_so", "ce_", "den", "om_", "del", "_ki", "med", "ter", "piš", "_te", "eme", "rat", "spr", "rep", "re_", "lni", "ovn", "rez", "ski", "ved", "_la", "_lo", "tic", "_tr", "ust", "am_", "eli", "led", "ema", "man", "_pi", "bit", "eto", "le_", "_sl", "nav", "ano", "eza", "ajt", "vse", "ram", "pol", "az_", "vre", "mes", "et_", "nov", "vno", "eti", "ine", "ste", "tov", "ice", "ovo", "več", "ila", "ket", "zor", "ren", "mi_", "čil", "rej", "elo", "ar_", "rit", "vi_", "amo", "dan", "jsk", "naj", "kra", "vor", "api", "bre", "_is", "raj", "spe", "ari", "dar", "met", "nem", "_kl", "vit", "lov", "pin", "rek", "so_", "ret", "spo", "de_", "ipk", "san", "pon", "ver", "_n_", "aja", "tve", "upi", "ši_", "nal", "res", "nda", "ore", "seb", "či_", "on_", "zav", "ogr", "tor", "iz_", "odn", "rog", "stn", "_be", "dnj", "ete", "nic", "tem", "al_", "_to", "eri", "lji", "tva", "dni", "eje", "ičn", "_le", "ba_", "ez_", "sle", "baj", "vlj", "edi", "izr", "ara", "iši", "iča", "_vi", "ate", "gum", "rhi", "rip", "arh", "int", "one", "oro", "sa_", "pom", "rgu", "_bo", "nep", "ori", "stv", "tar", "is_", "mer", "sez", "sku", "ard", "išk", "ška", "hiv", "itv", "ji_", "ode", "usp", "vo_", "bil", "slo", "avl", "nte", "iri", "ju_", "nad", "abi", "di_", "emo", "eta", "iva", "jiv", "dob", "riv", "arn", "mor", "rev", "asl", "il_", "rno", "čak", "dov", "tni", "ubl", "dok", "niz", "odo", "oku", "pa_", "čna", "nim", "or_", "pot", "rne", "_va", "ela", "zra", "zvo", "atk", "cij", "el_", "iln", "obs", "oln", "roč", "sam", "vze", "_di", "kum", "_co", "ire", "_ča", "do_", "not", "obi", "nit", "zho", "kan", "kod", "odi", "zve", "žno", "bi_", "kup", "obl", "pub", "tne", "vaj", "zak", "adn", "eko", "epu", "ese", "iki", "log", "dpr", "opo", "nan", "zet", "aln", "im_", "ivz", "skl", "_sh", "nju", "rič", "_dr", "ic_", "izh", "rja", "tri", "ere", "ril", "zah", "_vh", "ber", "ec_", "epr", "lav", "mož", "čas", "akl", "dru", "ign", "vho", "ajo", "ans", "ožn", "čni", "_ok", "ama", "ami", "poz", "rin", "kla", "_lu", "aza", "gna", "_dv", "_kr", "_li", "opi", "rsk", "_ce", "dst", "isn", "ome", "poš", "vol", "rik", "_gl", "ro_", "čan", "ini", "sig", "bni", "ods", "ala", "eč_", "lka", "pel", "ča_", "es_", "lad", "map", "opr", "azl", "gle", "hte", "nji", "zli", "šir", "_ur", "jev", "kom", "_fi", "bo_", "lin", "tel", "tok", "aht", "vsa", "ino", "lan", "sis", "_om", "aba", "ači", "ont", "orn", "reb", "_su", "ane", "iso", "lic", "ose", "aci", "azn", "lat", "onč", "rje", "ros", "vod", "abn", "bol", "ezi", "ico", "lič", "ona", "prt", "čit", "_mi", "oto", "per", "sli", "šče", "ada", "ile", "tna", "ški", "dme", "oka", "sav", "bst", "sko", "tič", "vne", "_ca", "be_", "edm", "sim", "vir", "at_", "nič", "sak", "sla", "_fo", "era", "isk", "nač", "dod", "ern", "mat", "apo", "ilk", "len", "bin", "dir", "enu", "gla", "id_", "ijs", "kat", "omo", "ovi", "rdn", "aki", "ata", "kli", "mbo", "oso", "pir", "rni", "_an", "_oz", "dpi", "eki", "for", "ili", "ip_", "zad", "let", "lup", "omn", "otr", "žni", "dil", "dne", "ežn", "imi", "kos", "mni", "ošt", "_bl", "_ci", "ilj", "loc", "ozn", "tu_", "čne", "ele", "ing", "nča", "še_", "as_", "aze", "dvo", "iše", "nar", "par", "_ro", "iči", "nez", "nti", "taj", "_fr", "_gr", "abl", "ku_", "nat", "rea", "tiv", "dna", "imb", "oko", "_o_", "ang", "arj", "ije", "kol", "ozo", "po_", "rec", "zač", "_os", "are", "ll_", "msk", "ng_", "ima", "ono", "očn", "rt_", "sov", "tis", "_že", "blj", "eji", "ešč", "rij", "sni", "utn", "žen", "_vz", "cev", "imo", "ivn", "nd_", "net", "pe_", "atn", "lne", "min", "nil", "nut", "ock", "okr", "ort", "rim", "_he", "aje", "jka", "osk", "eja", "ešk", "iro", "jez", "lok", "niš", "njk", "rad", "tno", "_sm", "_ši", "co_", "dej", "emb", "ins", "las", "ope", "rug", "sed", "tit", "_vo", "fil", "jšč", "lah", "mej", "nšč", "rg_", "tko", "čic", "ahk", "ck_", "ide", "rež", "zno", "alo", "atu", "dle", "kle", "lno", "orj", "sme", "_zv", "alt", "ces", "des", "jih", "lit", "odr", "oč_", "th_", "tin", "zik", "_mr", "ače", "blo", "dpo", "eks", "hem", "hko", "jto", "mu_", "tro", "ško", "_nu", "cil", "dvi", "nto", "ok_", "rib", "alj", "ipo", "mrt", "reg", "rtv", "she", "si_", "tat", "_c_", "_t_", "daj", "ebu", "epi", "gal", "mem", "osi", "tab", "zen", "_tu", "_wi", "ben", "edo", "end", "nij", "ord", "tvo", "val", "_at", "bno", "esl", "pke", "top", "_ga", "alk", "ars", "epo", "ita", "obr", "odl", "zan", "že_", "_ap", "_ke", "_un", "bes", "buj", "evo", "jti", "meš", "nta", "odv", "rak", "rel", "rid", "tal", "zi_", "_fu", "dal", "dis", "ebi", "ike", "mar", "oli", "čno", "žin", "ix_", "ris", "roc", "seg", "_f_", "_ja", "ade", "go_", "ir_", "joč", "pan", "shi", "skr", "sne", "sre", "uni", "_d_", "_ha", "ad_", "emi", "enl", "erj", "lsk", "mac", "mal", "nis", "oce", "osl", "ral", "rd_", "sil", "sno", "sod", "us_", "zme", "adi", "ago", "ah_", "atr", "bri", "ive", "kam", "lep", "os_", "prv", "rov", "ale", "fra", "ind", "kin", "kst", "nlj", "rna", "ula", "unt", "uri", "vid", "vrn", "_ub", "azi", "ci_", "ers", "lži", "obe", "rma", "sel", "ack", "anc", "but", "esn", "ibu", "jno", "kar", "orm", "rmi", "ršč", "ži_", "_l_", "_zg", "apr", "con", "dos", "dsk", "ici", "lom", "mod", "obn", "oje", "olž", "uč_", "ze_", "_ch", "azp", "cel", "du_", "ft_", "ijo", "iv_", "kaž", "klo", "ota", "čet", "_a_", "_bu", "_pe"},
+	"sr": {"је_", "_пр", "_по", "ка_", "_не", "_да", "_на", "на_", "_за", "не_", "дат", "да_", "_из", "_је", "тек", "ње_", "ато", "_ни", "пре", "оте", "_са", "_ко", "ња_", "ста", "_од", "тот", "за_", "но_", "ва_", "ори", "ке_", "ије", "ред", "ост", "_у_", "ава", "ти_", "та_", "_мо", "ни_", "под", "про", "ма_", "пра", "ања", "ист", "ање", "оде", "ује", "им_", "_ре", "рав", "_до", "са_", "_оп", "мен", "ан_", "пис", "ом_", "те_", "исп", "ја_", "_ст", "при", "ли_", "_си", "циј", "ра_", "ниј", "_вр", "ива", "_гр", "_ис", "сти", "ки_", "зна", "зив", "кор", "рем", "ија", "дељ", "ак_", "ази", "нос", "_ве", "ам_", "спр", "или", "ска", "_ка", "_се", "ван", "ова", "ако", "ика", "мог", "огу", "_и_", "_би", "лаз", "поз", "ку_", "ека", "еме", "реш", "вањ", "гу_", "едн", "рис", "иса", "ла_", "ве_", "гре", "сим", "наз", "се_", "ешк", "ода", "има", "_уп", "_та", "_бр", "одр", "пос", "ављ", "раз", "нис", "ење", "тањ", "држ", "ина", "ог_", "_ра", "ко_", "сам", "еке", "ара", "вре", "_b_", "ели", "дно", "тав", "_ар", "нов", "ем_", "лик", "ски", "ент", "ено", "адр", "шка", "ема", "шта", "риј", "ове", "уме", "бол", "имб", "_ил", "мбо", "бро", "рој", "_ме", "ена", "_ди", "рај", "ени", "сте", "ата", "опц", "гра", "ера", "ну_", "ора", "_ос", "пци", "сто", "_об", "ој_", "тор", "спи", "неи", "ран", "ита", "еис", "рек", "тра", "ај_", "лич", "ект", "нак", "иск", "их_", "оме", "дре", "вел", "ао_", "ити", "чит", "изв", "_ус", "ржа", "авн", "озн", "спе", "неп", "_ма", "оре", "азн", "_св", "кљу", "ључ", "епо", "ака", "ави", "то_", "од_", "аре", "чин", "ани", "вез", "ив_", "ник", "вар", "стр", "ису", "усп", "изл", "нем", "зла", "упо", "еку", "ију", "_су", "ст_", "ју_", "ви_", "дир", "ичи", "су_", "ула", "нск", "_сп", "ци_", "ешт", "ите", "ире", "вља", "рењ", "ен_", "еља", "ком", "_ба", "вор", "сно", "бит", "пот", "меш", "_ак", "нат", "_де", "рад", "рам", "сад", "вер", "као", "так", "нар", "тре", "ног", "_ин", "ља_", "љак", "ира", "нав", "рст", "_ун", "аје", "кра", "ово", "ана", "врс", "ано", "_ви", "ају", "тај", "огр", "љен", "едб", "мер", "пом", "аци", "ене", "_ов", "ним", "едо", "осн", "рен", "_x_", "али", "аст", "ише", "тва", "_сл", "ељк", "кто", "ати", "пок", "сни", "шав", "ама", "амо", "ичк", "вно", "вна", "ств", "_чи", "рес", "_бе", "зор", "врш", "кој", "азу", "мор", "рик", "озо", "јед", "реб", "_зн", "во_", "ета", "ји_", "_ук", "окр", "мо_", "ео_", "аз_", "еде", "ола", "зап", "јум", "ест", "ини", "пор", "суј", "ће_", "оје", "рим", "ења", "арг", "аја", "ме_", "каз", "ниц", "зво", "ше_", "аве", "овн", "оче", "гла", "дни", "кон", "ба_", "ден", "ајт", "тан", "ле_", "еки", "апи", "_кр", "екс", "ане", "_па", "зуј", "_ул", "иве", "нт_", "вед", "_ад", "анд", "дос", "бли", "рет", "нут", "рат", "ређ", "ат_", "ишћ", "бај", "_ло", "ћен", "жан", "еће", "них", "агл", "ри_", "пон", "рук", "_ал", "ном", "сту", "изд", "оји", "мож", "пов", "таб", "_ду", "виш", "_re", "_им", "бел", "зда", "ови", "рањ", "еда", "отр", "без", "ло_", "зан", "_тр", "ико", "оже", "еди", "из_", "алн", "кс_", "он_", "арх", "га_", "осл", "дањ", "дов", "аза", "вим", "инс", "кла", "риш", "сло", "тар", "ште", "мет", "ром", "ре_", "рхи", "ков", "ута", "ик_", "очи", "пут", "исн", "_те", "ргу", "дод", "лед", "же_", "гум", "чек", "_no", "лов", "љив", "љка", "бра", "ту_", "дин", "тво", "тер", "ине", "вље", "пео", "рог", "ца_", "абе", "ада", "ћи_", "_st", "рев", "_n_", "кци", "мес", "ока", "шће", "лав", "све", "име", "_de", "ди_", "ез_", "ену", "еша", "сле", "_a_", "ала", "апа", "аче", "код", "_in", "ар_", "еци", "сан", "икс", "ези", "еле", "кив", "оку", "лас", "мар", "нед", "ум_", "ђен", "_co", "_ок", "оја", "реп", "тем", "нап", "већ", "жај", "ис_", "деш", "заг", "рез", "ров", "нда", "_ва", "_ск", "анс", "ичн", "ере", "уно", "le_", "ача", "еба", "сва", "_пи", "рег", "ела", "_а_", "on_", "ађе", "ева", "оди", "оли", "емо", "зни", "_пу", "нал", "шир", "ади", "иво", "_но", "_то", "езе", "ете", "ско", "ело", "кре", "она", "аке", "еве", "звр", "там", "фик", "тал", "ума", "рск", "дво", "нам", "нич", "роч", "_ум", "вај", "тру", "хив", "чен", "вак", "дар", "_от", "жи_", "изр", "ард", "тој", "ion", "обр", "оби", "тим", "мал", "нти", "вој", "бло", "_ли", "_ув", "ер_", "инд", "ишт", "сис", "ље_", "ину", "оце", "це_", "њу_", "роц", "лок", "јем", "ефи", "оба", "ву_", "вач", "ол_", "рил", "мењ", "нађ", "нст", "пак", "атк", "вод", "дба", "лан", "еса", "_см", "уче", "ице", "отв", "њен", "нео", "_s_", "сег", "упу", "зме", "ици", "_оз", "ица", "дај", "ози", "до_", "езу", "три", "nt_", "гов", "еђу", "скр", "ча_", "_r_", "псе", "тов", "гру", "пољ", "руп", "тач", "тит", "доб", "_t_", "кад", "_фу", "еза", "_кљ", "ами", "ужи", "ед_", "жим", "оно", "поч", "чки", "нде", "бе_", "нта", "тке", "док", "ега", "кри", "обј", "пел", "уну", "зра", "нај", "спо", "аме", "ржи", "одн", "јек", "ате", "лат", "тно", "учи", "ћав", "бје", "еко", "оне", "чењ", "чуј", "јућ", "ежи", "рни", "цес", "_ну", "изм", "мат", "нте", "фун", "_pr", "ачи", "сиг", "туп", "фин", "асн", "гис", "дек", "опс", "рша", "сли", "_se", "еги", "лон", "рив", "тећ", "ћењ", "_fi", "атр", "ачк", "жав", "зно", "нач", "опш", "тни", "учу", "es_", "ари", "иб_", "куј", "дуж", "тив", "цим", "ећи", "шко", "де_", "ебн", "ери", "нкц", "ор_", "унк", "зав", "укл", "зе_", "раш", "уре", "чка", "шен", "er_", "бин", "реж", "no_", "иви", "ког", "рдн", "_li", "ику", "скљ", "тас", "уве", "мич", "чно", "дац", "еђа", "еви", "жин", "бав", "ок_", "тур", "дан", "ући", "_др", "_со", "асп", "иде", "јав", "ile", "ос_", "пер", "апо", "мањ", "сак", "јен", "et_", "мак", "ошт", "руг", "ver", "дел", "мем", "ске", "ску", "шки", "_ша", "se_", "енљ", "зи_", "нљи", "љке", "_ру", "_шк", "би_", "вни", "оле", "тск", "ll_", "лно", "нас", "ору", "рит", "убл", "дру", "ег_", "кољ", "оса", "_ла", "_о_", "вла", "обл", "тец", "_l_", "_дв", "ашњ", "игн", "пар", "пшт", "ад_", "арн", "деф", "ибл", "нул", "биб", "еси", "лањ", "чи_", "_ур", "пуб", "рађ", "ток", "зу_", "иза", "утр", "fil", "lt_", "ању", "осе", "sta", "епу", "ин_", "кум", "они", "оси", "сме", "_sy", "ниш", "одг", "рош", "_lo", "_z_", "_ог", "дбе", "лош", "фор", "_ел", "еск", "кал", "_di", "дго", "онт", "_оч", "апр", "гна", "дра", "прв", "уће", "ољк", "рас", "укљ", "тат", "_al", "ed_", "опе", "јез", "јск", "око", "пој", "чни", "едњ", "енс", "еље", "лем", "уко", "_ex", "ал_", "иот", "лин", "љањ", "_бл", "ајв", "гле", "низ", "ош_", "па_", "реч", "улт", "упа", "lin", "дње", "ила", "лио", "му_", "орм", "руј", "уча", "чет", "_c_", "_уч", "есе", "међ", "озв", "пе_", "ату", "бно", "орн", "шћа", "_ид", "кам", "опи", "сре", "ect", "tio", "јто", "абл", "аво", "еђе", "опу", "сла", "ic_", "адњ", "део", "кст", "шем", "_ta", "_бу", "sym", "еоч", "ири", "лим", "рач", "_e_", "_p_", "_ош", "азл", "аки", "етн", "ифи", "оши", "оље", "чав", "чиш", "њи_", "_f_", "вид", "омо", "рма", "рос", "тич", "том", "int", "loc", "авр", "ети", "нив", "шња", "_d_", "_ши", "аша", "ожа", "упр", "ару", "бир", "ећа", "_v_", "дес", "дом", "зар", "збе", "кту", "вен", "отп", "раж", "чке", "_ле", "ent", "rel", "ват", "иси", "риб", "_he", "љку", "te_", "зах"},
+	"sv": {"_in", "en_", "er_", "ing", "nte", "för", "te_", "_fö", "int", "era", "ter", "ör_", "et_", "ar_", "de_", "ng_", "ra_", "_an", "an_", "_st", "nde", "_de", "tt_", "ion", "ll_", "nin", "änd", "ill", "ta_", "fil", "and", "_ti", "_ko", "_me", "ler", "_en", "_fi", "til", "ver", "vän", "sta", "ade", "om_", "_ka", "_i_", "är_", "_av", "tio", "_re", "kti", "on_", "ste", "lle", "med", "att", "nda", "_är", "_sk", "_ut", "rin", "gen", "_at", "rad", "anv", "ed_", "nvä", "_so", "ent", "eri", "kan", "nd_", "tig", "ell", "ata", "nge", "ad_", "es_", "ska", "den", "var", "yck", "av_", "nt_", "fel", "ist", "tal", "tan", "_vi", "_va", "el_", "na_", "_fe", "at_", "nam", "ekt", "_ma", "_ta", "ara", "_se", "ang", "kom", "_om", "_ar", "as_", "som", "ort", "der", "ch_", "ka_", "_mi", "ati", "ga_", "str", "des", "und", "men", "_lä", "ig_", "ile", "_sa", "ett", "ser", "nga", "_på", "_pa", "nst", "all", "mma", "cke", "det", "amn", "lag", "på_", "ngs", "re_", "lti", "mat", "dat", "la_", "st_", "ers", "_oc", "nta", "_pr", "_el", "_fl", "ilt", "och", "gt_", "il_", "tta", "man", "_sy", "al_", "rt_", "ela", "id_", "isk", "lis", "agg", "akt", "igt", "ins", "tar", "for", "kat", "ren", "_ha", "eck", "gil", "ern", "skr", "sa_", "one", "_fr", "cka", "_et", "ant", "kri", "upp", "kon", "_si", "omm", "tor", "gar", "_na", "are", "dar", "mer", "pro", "mn_", "_al", "ran", "inn", "log", "len", "or_", "fla", "stä", "orm", "ner", "ind", "_no", "_vä", "_gi", "ns_", "riv", "_be", "ogi", "äll", "rma", "ärd", "rat", "lla", "end", "ons", "reg", "_ku", "_og", "lig", "änt", "in_", "rde", "it_", "kad", "kal", "_ny", "ign", "iv_", "tad", "kun", "ut_", "_li", "_ve", "ess", "öve", "mis", "ken", "tet", "ive", "del", "_bi", "ge_", "rer", "_bo", "_te", "har", "uta", "tec", "lut", "tiv", "sk_", "lan", "rar", "ens", "sym", "vär", "_gr", "ket", "sto", "che", "frå", "ali", "mbo", "_ba", "sig", "alo", "ast", "sek", "da_", "_ra", "bol", "_di", "vis", "tat", "ck_", "ån_", "_up", "_öv", "slu", "mme", "res", "ssl", "iss", "ark", "kt_", "lyc", "äng", "per", "rd_", "_po", "_än", "_to", "fin", "ndr", "rån", "nor", "ymb", "sio", "isa", "egi", "kni", "gra", "ate", "rna", "sam", "gga", "_må", "sly", "_op", "ere", "gis", "ten", "_du", "bor", "bar", "ts_", "_ex", "rsi", "git", "_ok", "vid", "ras", "amm", "typ", "kän", "lt_", "par", "_lo", "kod", "_fo", "ma_", "_un", "let", "lok", "täl", "nen", "_co", "ätt", "val", "nne", "ans", "ard", "ise", "stö", "ram", "ume", "han", "sen", "ger", "sök", "_mo", "le_", "tra", "erv", "län", "arn", "dra", "tru", "läg", "ake", "ont", "pos", "_sp", "apa", "atu", "rki", "_ge", "se_", "_tr", "gor", "ndo", "nna", "oll", "_da", "_x_", "avs", "_by", "tur", "_hi", "inf", "oka", "ari", "lak", "_he", "itt", "kap", "das", "hål", "läs", "ope", "nfo", "ord", "arg", "okä", "åll", "töd", "_ch", "nyc", "ruk", "rn_", "ukt", "nat", "pak", "byt", "kel", "ite", "ds_", "_sl", "art", "pa_", "ier", "omp", "_la", "ref", "min", "dni", "rän", "kna", "tag", "kar", "du_", "iga", "örs", "ifi", "red", "lat", "ina", "_kr", "ker", "tni", "mal", "ore", "_ob", "ala", "ete", "ost", "est", "ntr", "ets", "gre", "yte", "_sä", "ika", "dan", "sät", "fte", "rva", "ehå", "hec", "_ef", "num", "od_", "tis", "åst", "ggo", "nti", "ttr", "rle", "ss_", "_n_", "nar", "tab", "trä", "orl", "örv", "het", "obj", "og_", "opp", "ert", "lin", "bel", "_ad", "kiv", "sak", "ckn", "rst", "mås", "_ty", "bje", "efi", "ot_", "rni", "lek", "alt", "hit", "sse", "por", "gna", "gum", "nsk", "rvä", "eft", "jek", "spe", "dex", "met", "rgu", "ick", "bas", "dre", "ski", "ini", "tid", "rte", "tem", "tch", "neh", "när", "ek_", "lik", "yp_", "ita", "nch", "dig", "ec_", "ack", "am_", "bit", "no_", "ext", "ogr", "ble", "_hä", "akn", "onf", "try", "ars", "umm", "ång", "pec", "mlo", "krä", "inc", "mar", "ven", "fer", "låt", "oml", "_nä", "ene", "_ig", "ne_", "ote", "äge", "änk", "tro", "rog", "dir", "els", "pre", "cer", "gan", "ute", "giv", "pat", "rek", "ppa", "get", "gru", "nns", "ol_", "rol", "sin", "ian", "sti", "_nu", "eci", "ngi", "_åt", "ige", "abe", "def", "_ak", "tom", "eme", "mod", "oge", "hop", "kor", "ur_", "tre", "llå", "us_", "_ca", "utt", "_bl", "åte", "roc", "_su", "loc", "iti", "adr", "eda", "ri_", "sty", "tin", "lös", "ktu", "rs_", "cha", "ase", "ria", "_bu", "sh_", "va_", "enn", "vs_", "_au", "ats", "ide", "iva", "ex_", "_kö", "lem", "_is", "atc", "väg", "pen", "_ne", "ale", "ass", "öds", "lte", "ber", "_kä", "äns", "bet", "ock", "efe", "_sö", "bli", "räv", "app", "ndi", "vet", "_ho", "aut", "gno", "fäl", "hel", "pp_", "ia_", "tri", "exe", "ryc", "_do", "ire", "ke_", "yst", "tus", "sys", "em_", "her", "sla", "lar", "ld_", "tsk", "_fu", "_s_", "ame", "fik", "_hu", "lna", "år_", "age", "lls", "ine", "rup", "ena", "_pi", "net", "rel", "ori", "gni", "ivn", "unk", "out", "stå", "anf", "pla", "sni", "kör", "_fä", "ndn", "vil", "nfi", "nkt", "ägg", "ytt", "nal", "oli", "öre", "_id", "ong", "_or", "do_", "pri", "ces", "edd", "bin", "oce", "ole", "chi", "nse", "ält", "tek", "mpo", "mot", "pac", "ic_", "san", "ämt", "_sh", "lic", "nsl", "dri", "beh", "op_", "ice", "ana", "nka", "nas", "agn", "häm", "ild", "ime", "ölj", "ce_", "pda", "rit", "vsl", "öra", "ode", "_pe", "_a_", "_r_", "fun", "gg_", "lit", "nit", "eno", "ink", "lko", "tas", "fig", "rep", "_br", "con", "kvä", "än_", "eta", "föl", "ret", "ect", "rsk", "dde", "run", "gur", "igu", "nan", "_t_", "erm", "_lå", "kte", "käl", "ike", "tes", "ökv", "eln", "fix", "nfö", "lja", "odu", "uel", "_as", "abi", "me_", "ull", "_mu", "mta", "ix_", "lta", "mon", "ha_", "huv", "uvu", "_nå", "_ga", "ede", "pli", "vud", "ato", "mpl", "the", "ag_", "aga", "gst", "rbe", "_ap", "sko", "je_", "ude", "din", "llk", "nds", "_wa", "ure", "ärr", "fra", "rve", "ele", "tex", "_ce", "ppd", "_e_", "mne", "sar", "sfi", "_lö", "ral", "ult", "erk", "rea", "jär", "ngl", "nsa", "ppn", "_ro", "ann", "lba", "rec", "äsa", "arb", "bil", "aka", "ani", "ash", "skt", "ung", "äve", "ero", "_d_", "spr", "_ab", "eng", "jan", "_pl", "nad", "sis", "tda", "grä", "igh", "rig", "th_", "ånd", "rti", "_ur", "hua", "_er", "dul", "rta", "tån", "don", "rre", "utd", "set", "ule", "ura", "kop", "sou", "tte", "ama", "to_", "nie", "_im", "_v_", "asi", "iln", "spa", "kla", "pps", "iff", "lse", "sor", "tif", "ead", "hin", "ima", "ngd", "mul", "ype", "_gå", "någ", "ora", "pu_", "pan", "_ov", "edi", "ges", "ls_", "um_", "_pu", "lni", "utf", "_fa", "ikt", "imp", "lln", "pad", "tst", "öpp", "ris", "urs", "ust", "ami", "gör", "llt", "rim", "tol", "un_", "_c_", "aba", "gli", "_m_", "elt", "syn", "dif", "erh", "tue", "ej_", "olk", "vst", "_öp", "cif", "ökn", "nes", "sst", "exp", "uth", "ekv", "ese", "ppe", "ya_", "mas", "_le", "uts", "pna", "_f_", "deb", "kol", "pas", "ach", "mål", "_ic", "_ni", "ema", "ff_", "als", "ome", "ve_", "ln_", "nol", "ro_", "sid", "bla", "kve", "arj", "emb", "li_", "ps_", "_ej", "_qu", "nli", "nu_", "ple", "lka", "ngu", "rib", "rnt", "ry_", "lp_", "gsf", "ni_", "inl", "ppl", "ubl", "_få", "_tv", "emo", "ssa", "mpa", "rje", "iab", "_l_", "ak_", "dis", "ja_", "llo"},
+	"tr": {"_bi", "lan", "eri", "in_", "ir_", "_de", "en_", "lar", "an_", "ama", "_do", "ler", "_ya", "bir", "anı", "_ba", "_ge", "_iç", "ile", "er_", "_ve", "_ka", "arı", "ara", "yor", "içi", "sya", "ya_", "dos", "osy", "or_", "_ol", "ası", "len", "_ku", "lam", "_sa", "çin", "ri_", "ili", "ak_", "_se", "le_", "ar_", "eçe", "sı_", "değ", "ini", "dı_", "_di", "eği", "kle", "lla", "ma_", "ıla", "lem", "ste", "ull", "ene", "de_", "alı", "kul", "_ha", "ala", "nde", "_pa", "ekl", "_ta", "nda", "li_", "ni_", "_be", "çer", "bil", "eme", "ind", "_ye", "adı", "_ko", "da_", "si_", "_al", "şle", "_ar", "eti", "esi", "ını", "ır_", "_gi", "_bu", "rı_", "_iş", "geç", "eni", "ayı", "rin", "ana", "_il", "lı_", "rak", "iz_", "iyo", "ata", "den", "lir", "işl", "ın_", "ola", "tir", "dır", "mad", "tır", "ne_", "nı_", "eli", "_ad", "ik_", "_so", "ter", "yen", "iri", "me_", "ek_", "di_", "aya", "baş", "la_", "ve_", "and", "tan", "yaz", "_ay", "izi", "uru", "ist", "siz", "_da", "rsi", "_yo", "ers", "tar", "hat", "sin", "it_", "_gö", "ki_", "ere", "ınd", "bel", "diz", "ine", "ver", "sın", "lma", "ıyo", "_ma", "_an", "ırı", "seç", "man", "_he", "say", "_si", "atı", "ta_", "yal", "ok_", "edi", "ril", "ğiş", "lik", "dan", "yar", "nam", "et_", "yas", "ele", "şti", "rın", "al_", "ılı", "son", "kar", "rma", "_bo", "_in", "isi", "ket", "leş", "çık", "mi_", "ısı", "na_", "rla", "ula", "dil", "emi", "zin", "rıl", "_ki", "lle", "nın", "_ça", "nım", "amı", "rle", "yer", "dir", "çen", "bu_", "_ön", "ürü", "ca_", "_re", "yok", "ldı", "_çı", "_te", "erl", "ış_", "eye", "olu", "mey", "ger", "ğer", "_li", "lin", "rul", "eya", "yan", "mas", "ken", "_is", "vey", "nme", "eğe", "_sı", "ce_", "yap", "par", "ndi", "ndı", "nin", "il_", "kte", "_sü", "onu", "unu", "ği_", "rme", "git", "enm", "mak", "end", "_uy", "nce", "num", "nek", "el_", "_ne", "ake", "bağ", "abi", "ulu", "_ek", "ilm", "re_", "_tü", "iği", "_gü", "on_", "ang", "pak", "ıml", "im_", "sat", "nıl", "azı", "şar", "çal", "mal", "miy", "alt", "ell", "ız_", "lış", "_no", "tek", "iml", "olm", "iş_", "tur", "kay", "se_", "arl", "ird", "may", "una", "kal", "aşa", "lis", "ti_", "ağl", "sür", "ut_", "ştı", "nah", "tem", "nla", "cı_", "gir", "ede", "eks", "med", "_et", "un_", "_co", "mış", "_st", "sta", "_me", "ada", "aht", "mbo", "am_", "des", "tal", "tı_", "imi", "irt", "üze", "aki", "rek", "hta", "te_", "apı", "mıy", "üm_", "rti", "nes", "est", "işi", "ğil", "ına", "eki", "sız", "akt", "bol", "miş", "içe", "ra_", "ırm", "irl", "nız", "ğla", "irm", "ıcı", "dek", "em_", "ölü", "emb", "_bö", "luş", "ışt", "kom", "nu_", "rdi", "ikl", "mut", "gün", "res", "bul", "ari", "gör", "_va", "du_", "mle", "rli", "til", "dur", "kla", "tik", "omu", "kon", "ılm", "ng_", "ştu", "uşt", "ayn", "sem", "ktı", "az_", "rum", "tür", "mar", "_fa", "ıkt", "böl", "ol_", "ığı", "işt", "pıl", "var", "ran", "tla", "lun", "mel", "mla", "ci_", "und", "_du", "eşt", "yı_", "rde", "lme", "ral", "ide", "ağı", "mes", "rke", "anm", "doğ", "nle", "ilg", "lgi", "_en", "_uz", "tle", "_za", "aca", "ard", "mlı", "_mi", "eyi", "oku", "ık_", "kli", "_at", "bek", "ald", "uma", "_dü", "biç", "arg", "lüm", "riy", "ğin", "es_", "ras", "ece", "öst", "esn", "dal", "_im", "kil", "art", "gös", "yi_", "rıs", "_ok", "ey_", "ade", "mli", "_su", "cak", "nma", "rel", "ım_", "aşl", "şim", "sne", "bit", "ksi", "mek", "_aç", "ges", "niz", "um_", "ıld", "ışı", "der", "ten", "min", "tam", "umu", "zma", "lık", "_nu", "_öz", "kış", "maz", "ici", "_iz", "_tu", "nen", "rt_", "_n_", "ati", "sa_", "ümü", "anl", "arş", "_pr", "etl", "ayr", "_eş", "zıl", "azm", "let", "lu_", "nta", "şma", "inm", "tin", "yük", "ük_", "lay", "rgü", "_ed", "ur_", "at_", "şla", "_ke", "uya", "_ço", "tıl", "düz", "her", "liğ", "ort", "iye", "are", "bay", "boş", "dak", "yıl", "ip_", "kim", "lab", "neğ", "iki", "nmi", "rüm", "_ch", "aşı", "ğı_", "_hi", "anc", "ild", "zca", "nca", "_e_", "ekt", "açı", "ka_", "mam", "nak", "oğr", "ret", "aln", "çim", "çok", "ıra", "lnı", "ye_", "yol", "uk_", "ızc", "_na", "zam", "nır", "rir", "san", "erd", "kod", "lın", "ad_", "dış", "acı", "gi_", "nid", "all", "eşl", "izl", "nem", "tas", "zer", "ğru", "_if", "güm", "_gr", "ırl", "üma", "ali", "önt", "enl", "tel", "_dı", "ldi", "ğu_", "_ik", "cel", "sun", "mı_", "nel", "ram", "yna", "st_", "old", "oru", "akı", "ame", "ıkl", "net", "ldu", "_fi", "lıy", "ge_", "tab", "yrı", "ate", "dla", "gul", "kip", "gen", "apa", "ez_", "uri", "_to", "rün", "def", "tme", "ing", "_mo", "_la", "onl", "_po", "fad", "no_", "üre", "uyg", "_bü", "ant", "ifa", "kur", "ğın", "utu", "üst", "blo", "zle", "öne", "tim", "dre", "eçi", "adr", "ygu", "ıkı", "_dö", "_or", "_lo", "_x_", "kin", "kta", "lır", "niy", "nıc", "nra", "lmı", "met", "kun", "tif", "boy", "aç_", "onr", "tes", "önc", "ışm", "ley", "sis", "gel", "_on", "rça", "_a_", "arç", "ha_", "uğu", "üne", "dar", "sim", "_ca", "run", "ren", "rsa", "zla", "ayt", "ike", "ani", "sil", "_yö", "yön", "zey", "sik", "mod", "rda", "nan", "_kı", "lt_", "_sh", "ça_", "ney", "nun", "_üz", "kap", "mza", "vur", "_yü", "imz", "oyu", "rşi", "ün_", "zel", "_es", "_çe", "ımı", "lmi", "örü", "şiv", "azl", "aşv", "inl", "ser", "şvu", "_ti", "fil", "ite", "rec", "ck_", "dön", "mer", "ru_", "zdı", "lü_", "pla", "pro", "_üs", "unl", "ika", "mez", "raf", "öre", "bi_", "con", "ec_", "har", "uzu", "adl", "ipi", "azd", "ian", "ünc", "han", "mu_", "cek", "ert", "faz", "int", "sh_", "ünü", "ars", "ağa", "ll_", "su_", "bas", "ef_", "tki", "zun", "_fo", "_le", "gil", "rim", "yin", "din", "mat", "ong", "yle", "oş_", "etk", "_i_", "yet", "liy", "aka", "nt_", "rge", "şı_", "ent", "epo", "uza", "üyü", "ark", "men", "rdı", "ski", "_ağ", "abl", "atl", "rol", "tüm", "şik", "lat", "_şu", "aha", "büy", "evi", "kti", "mü_", "nya", "sor", "şın", "_aş", "rta", "sır", "tu_", "gra", "aba", "dis", "mac", "ay_", "ina", "luk", "one", "_wa", "ion", "dep", "pat", "şen", "ban", "idi", "lığ", "lac", "hed", "nga", "inc", "ntı", "ıda", "asa", "ed_", "tec", "ucu", "ltm", "erm", "eşe", "ga_", "izg", "nte", "şıl", "dah", "kan", "maç", "mem", "ngi", "öze", "sel", "gis", "mun", "rit", "rü_", "ura", "ür_", "_ap", "_er", "işa", "kab", "ita", "ynı", "_ra", "esk", "str", "ven", "_ak", "sağ", "zak", "ch_", "che", "era", "_mu", "ord", "_un", "bat", "for", "iç_", "ksa", "tre", "chi", "get", "imd", "_ni", "_c_", "işk", "nor", "abu", "ead", "nlu", "_s_", "nd_", "nli", "_am", "_şe", "erg", "yıs", "diğ", "_kü", "ahi", "ebi", "ect", "laş", "ntü", "_ög", "lge", "oll", "ref", "öge", "ünt", "arm", "tun", "ack", "_op", "ans", "pi_", "hal", "ill", "_pe", "_şi", "ont", "tuş", "ibi", "orm", "_cu", "ami", "ner", "rap", "yla", "çıl", "_pi", "com", "ire", "zi_", "ıfı", "pos", "_d_", "dik", "loc", "mın", "odu", "ore", "_ur", "ber", "eng", "nıy", "yut", "lon", "yt_", "çek", "mde", "bun", "oks", "akl", "aro", "iti", "oli", "_sö", "ia_", "pac", "sal", "up_", "zal", "_çö", "ic_", "irs", "klı", "rar", "rea", "_ab", "nal", "oto", "utl", "hua", "ırk", "dığ", "evr", "ifi", "uze", "önd", "üve", "ima", "ona", "zen", "afı", "ndu", "fır", "kuz"},
+	"uk": {"_не", "ти_", "ня_", "ння", "_по", "_ви", "не_", "ка_", "_за", "ува", "ий_", "енн", "_пр", "но_", "анн", "пер", "ати", "ван", "на_", "ере", "кор", "_ко", "_на", "ів_", "_до", "_ро", "ся_", "від", "ори", "_у_", "роз", "зна", "ля_", "ний", "ист", "ого", "_пе", "ано", "ста", "го_", "про", "_фа", "айл", "фай", "ні_", "вик", "рис", "_пі", "ськ", "ька", "чен", "ити", "для", "_дл", "тан", "ало", "ико", "их_", "іст", "_ма", "_па", "аче", "ено", "нач", "оми", "пом", "ват", "_си", "_ст", "_ві", "пов", "стр", "ть_", "_мо", "ови", "мил", "пис", "_ре", "илк", "три", "_з_", "них", "оре", "до_", "під", "рам", "ки_", "ект", "ара", "при", "ми_", "_бу", "вда", "ани", "дал", "ент", "дан", "_об", "_да", "тов", "каз", "льн", "_як", "анд", "сти", "пар", "_зн", "рек", "діл", "_та", "вол", "сим", "ком", "ред", "лос", "им_", "ає_", "ося", "рес", "ова", "_ка", "ост", "_вд", "зді", "озд", "опе", "_вк", "сто", "нов", "_ін", "вка", "имв", "вер", "мво", "ом_", "ії_", "мож", "лен", "ног", "ва_", "ктн", "ла_", "кат", "змі", "аза", "ку_", "ден", "_сп", "зап", "мет", "аме", "жен", "ові", "еко", "_ти", "рим", "мен", "лка", "зан", "ову", "ід_", "тьс", "ься", "ути", "аль", "роб", "ідн", "нек", "ок_", "ою_", "наз", "ман", "_ар", "азв", "ряд", "або", "що_", "вор", "ра_", "ков", "тип", "ера", "вив", "етр", "_аб", "_є_", "лів", "апи", "кон", "бо_", "_кл", "тор", "ри_", "_ба", "ані", "рит", "тни", "сту", "та_", "_се", "сув", "іль", "має", "бут", "_що", "йл_", "ома", "ті_", "тво", "час", "есу", "рів", "ово", "за_", "_чи", "дом", "ідо", "ції", "мін", "клю", "люч", "ран", "_оп", "му_", "ія_", "іка", "_мі", "код", "изн", "ним", "міс", "фік", "_ря", "_ча", "_вс", "пор", "лу_", "зав", "еві", "рег", "нев", "_ве", "ами", "мат", "_ді", "ожн", "ств", "тув", "кці", "су_", "_бі", "сть", "дже", "хід", "чит", "трі", "_і_", "ої_", "нен", "тал", "ло_", "ідп", "_b_", "оро", "ядк", "вий", "вув", "егі", "івн", "дов", "фор", "ій_", "ном", "ата", "але", "вст", "гіс", "тру", "єть", "ому", "орм", "аці", "ава", "айт", "йла", "пра", "отр", "рук", "ифі", "рен", "вле", "тів", "пот", "иво", "иве", "ато", "інс", "_но", "_зм", "ас_", "лі_", "ше_", "ну_", "сер", "_бе", "тек", "обр", "нта", "рма", "тр_", "поп", "ідт", "нсь", "ерш", "пос", "мал", "нем", "нст", "_фо", "ви_", "оло", "ону", "_сл", "кри", "кщо", "якщ", "нал", "ічн", "озм", "сті", "дтр", "_са", "ест", "оди", "виз", "то_", "йсь", "тат", "_ад", "_ме", "мір", "укц", "аст", "ика", "уме", "оду", "адр", "без", "лан", "над", "нос", "раз", "ага", "ана", "тра", "гра", "пів", "ьни", "юва", "дно", "док", "олі", "неп", "нт_", "во_", "лов", "кла", "овн", "вил", "лас", "нан", "лог", "ли_", "_кі", "ени", "екс", "_те", "лиш", "жна", "нда", "дре", "нут", "ідк", "аве", "іл_", "ту_", "ує_", "_ва", "_ли", "има", "_де", "діа", "поз", "едж", "дат", "сте", "нь_", "ча_", "рав", "ока", "ими", "заг", "ача", "кан", "ві_", "тиф", "ина", "слі", "огр", "вед", "имк", "ита", "ма_", "ала", "азо", "_ос", "ише", "ан_", "кий", "мо_", "ле_", "ву_", "мов", "йлі", "ить", "ам_", "ема", "_кр", "лив", "лок", "вні", "_re", "бай", "овл", "апа", "_ал", "арг", "ерс", "она", "рог", "вир", "нти", "ду_", "ант", "кув", "ису", "дна", "рев", "буд", "одо", "арх", "вач", "льк", "об_", "тро", "_co", "пус", "бра", "_от", "ила", "ол_", "кіл", "ір_", "рсі", "те_", "ове", "вим", "абл", "рхі", "ежи", "так", "гно", "ис_", "най", "ці_", "тим", "туп", "ипо", "орі", "_ск", "ійс", "_x_", "_ла", "ків", "амі", "сло", "щен", "гум", "вод", "_st", "ної", "анг", "еде", "жли", "ль_", "ргу", "_ди", "ска", "ник", "_гр", "мер", "иму", "оме", "лід", "арі", "ото", "ичн", "ілу", "чис", "алі", "ожл", "тив", "ез_", "реж", "ють", "_no", "зва", "спр", "же_", "нув", "вит", "вір", "ньо", "ира", "ємо", "ілі", "дпо", "лик", "од_", "ор_", "рі_", "шен", "вес", "сил", "_од", "дни", "нні", "рат", "тис", "бро", "ип_", "_су", "ат_", "мпо", "біт", "он_", "сі_", "_de", "анс", "ипу", "оль", "ьки", "кі_", "оси", "кра", "омо", "ве_", "бло", "лко", "зви", "лік", "тер", "le_", "on_", "исл", "ода", "ров", "ізн", "гол", "дин", "_ке", "ьно", "чни", "зон", "обо", "омп", "да_", "меж", "_n_", "таб", "роц", "дек", "_це", "обл", "ькі", "ер_", "риз", "_то", "id_", "блі", "пон", "_зб", "тит", "оце", "рип", "як_", "ній", "ру_", "спи", "_із", "дос", "паз", "іап", "чна", "із_", "пу_", "уєт", "чно", "де_", "йти", "ням", "аго", "ець", "нор", "іде", "_ці", "_in", "щод", "ніс", "пок", "цес", "бер", "дит", "бач", "озп", "уль", "оби", "рац", "лон", "ора", "ром", "ьог", "гал", "азу", "ди_", "орт", "икл", "очі", "вих", "вни", "унк", "дод", "іку", "ію_", "нд_", "пол", "дба", "емо", "аєт", "шир", "едб", "оку", "сно", "гру", "_лі", "er_", "нг_", "атн", "ро_", "руп", "ень", "зат", "ядо", "_зв", "мі_", "оли", "ире", "ція", "луч", "_ід", "атк", "дпи", "інд", "_a_", "ері", "точ", "ісл", "жим", "опо", "тич", "еви", "рол", "ерт", "_ра", "оже", "ям_", "онт", "га_", "ору", "існ", "арт", "_r_", "кал", "лад", "рез", "ада", "бли", "рап", "аку", "тна", "_se", "илу", "роп", "сис", "_lo", "вог", "ни_", "ніч", "тур", "епр", "оно", "опу", "тко", "ігн", "nt_", "ion", "олу", "_ні", "ама", "_вх", "ида", "цій", "чік", "іто", "аро", "нат", "_s_", "иль", "піз", "сов", "ішн", "_ma", "юч_", "апо", "леж", "чат", "пак", "аві", "лиц", "тем", "_сх", "дні", "ей_", "іте", "очи", "_ну", "аже", "иск", "мкн", "одн", "дто", "иці", "мий", "ені", "вно", "ерв", "ави", "адт", "поч", "_ло", "очн", "зпі", "вто", "сок", "цьк", "мог", "нак", "тар", "ця_", "кти", "ах_", "зво", "оне", "_рі", "лом", "гор", "кін", "no_", "доп", "кту", "оча", "дка", "_li", "бул", "си_", "тно", "хів", "_вв", "аз_", "нте", "біл", "зі_", "исо", "сля", "зах", "нди", "піс", "lin", "кіс", "мки", "заб", "кун", "озн", "_яз", "ай_", "es_", "вал", "ток", "кар", "іна", "_pr", "пош", "рув", "івд", "нам", "_ел", "вид", "нде", "ату", "аті", "вде", "ско", "уст", "чин", "_іс", "том", "нім", "_зі", "нул", "ак_", "рти", "loc", "цьо", "рем", "тне", "_ць", "ба_", "ді_", "лав", "пам", "ll_", "ако", "мак", "нга", "дкр", "сів", "окр", "осн", "_дж", "вхі", "оза", "рив", "имі", "оря", "рше", "вия", "ияв", "_ку", "зас", "рос", "сам", "таж", "цію", "мод", "нна", "еле", "уде", "дко", "сан", "дав", "еро", "зам", "_ан", "нав", "_бл", "кли", "сум", "sta", "акс", "ал_", "ме_", "уск", "_ув", "айд", "вує", "ог_", "спе", "са_", "юча", "явл", "мув", "ім_", "ини", "_ят", "ст_", "_fi", "ань", "вну", "ен_", "рот", "ьна", "єкт", "_вн", "кси", "омл", "спо", "інн", "_ус", "ахі", "лем", "убл", "_фу", "ивн", "мле", "іни", "льт", "джа", "рсь", "_єк", "et_", "вел", "вищ", "едн", "нео", "овж", "езп", "ною", "онг", "_ав", "ане", "ися", "есо", "зув", "йті", "нка", "оні", "еми", "ива", "нтр", "пущ", "уще", "ают", "кст", "_di", "іть", "_t_", "жин", "рту", "ріш", "ile", "se_", "зву", "ску", "ена", "льо", "чні", "ежа", "ем_", "рши", "озш", "зши", "кол", "льс", "реб", "ect", "йде", "йте", "пец", "інк", "me_", "зсу", "оте", "рет", "сії", "_sh", "ель", "кс_", "мар", "_зс", "дар", "збе", "циф", "вне", "рай", "_ar", "апу", "виб", "нді", "ікс", "_га", "вар", "віш", "ик_", "_в_", "би_", "нер", "роч", "ent", "ака", "зак"},
+	"vi": {"ng_", "_th", "_kh", "_ch", "ông", "hôn", "nh_", "_tr", "khô", "_ti", "_ph", "_nh", "in_", "ên_", "ập_", "tin", "_gi", "ác_", "_cá", "_tậ", "tập", "_đư", "các", "hi_", "ch_", "_ng", "ược", "ần_", "ỗi_", "ợc_", "hể_", "thể", "ho_", "đượ", "_hi", "_có", "có_", "_đị", "_và", "ục_", "ới_", "_là", "ùng", "_số", "số_", "_lỗ", "ết_", "lỗi", "iến", "ối_", "ong", "cho", "ột_", "_qu", "ại_", "ron", "tro", "tiế", "chu", "ủa_", "ịnh", "_củ", "của", "địn", "ển_", "_mộ", "một", "khi", "_lệ", "_li", "_sa", "tha", "_dù", "hiệ", "dùn", "là_", "chỉ", "ra_", "hỉ_", "ay_", "mục", "_mụ", "iên", "iệu", "ệu_", "thư", "_tê", "tên", "_tạ", "hư_", "_đã", "đã_", "ký_", "ọn_", "ầu_", "_ký", "ếng", "_ra", "với", "_vớ", "họn", "chọ", "_bả", "ào_", "phầ", "ặp_", "hần", "hay", "ải_", "_ki", "ất_", "_vi", "_đầ", "ặc_", "_kế", "_bi", "ến_", "và_", "it_", "_gặ", "gặp", "bản", "kết", "_ho", "_co", "nhậ", "_đặ", "đầu", "_đố", "ạng", "iểu", "ểu_", "ang", "ếu_", "đối", "_tù", "tùy", "ùy_", "ao_", "_lạ", "_bỏ", "bỏ_", "ình", "ài_", "ích", "ai_", "ản_", "_hợ", "hợp", "ợp_", "_nà", "lại", "ườn", "ện_", "ờng", "iện", "ời_", "ghi", "_để", "để_", "_gh", "_độ", "ặt_", "huy", "uyể", "yển", "hiể", "_từ", "_bị", "bị_", "ưa_", "_đa", "vào", "òng", "_tư", "_cả", "đặt", "git", "kho", "ạn_", "_tự", "tự_", "_re", "ách", "_đổ", "on_", "an_", "từ_", "ệnh", "lện", "gia", "chi", "_ha", "ày_", "_đi", "ộng", "chư", "_dò", "ổi_", "kiể", "hiế", "đổi", "_bộ", "dòn", "phả", "hàn", "hải", "bộ_", "ành", "ấu_", "lệ_", "ọc_", "liệ", "_in", "au_", "iển", "_cầ", "hị_", "ống", "ung", "ảnh", "ân_", "việ", "ượn", "ấy_", "ợng", "anh", "_xu", "ật_", "ạo_", "ều_", "tạo", "ánh", "iều", "này", "đan", "hưa", "con", "àm_", "thô", "thứ", "thị", "hân", "am_", "óa_", "ẫn_", "_dạ", "dạn", "ây_", "_sử", "_đọ", "đọc", "qua", "trư", "áo_", "_cấ", "ái_", "ơng", "ươn", "_dụ", "thi", "sai", "như", "ảng", "the", "trì", "eo_", "oặc", "_ma", "te_", "_tí", "ệc_", "iệc", "_bạ", "rìn", "ức_", "ụng", "_tì", "dụn", "ói_", "heo", "_lư", "iếu", "_x_", "thà", "hoặ", "rợ_", "ua_", "trợ", "_di", "_gó", "gói", "_lầ", "giá", "lần", "cần", "trị", "bạn", "rị_", "tìm", "ìm_", "_bá", "_vị", "phi", "ận_", "_st", "vị_", "úc_", "hiê", "iao", "iá_", "tượ", "_mã", "ắt_", "_ba", "mã_", "iết", "le_", "ước", "ớc_", "ngư", "báo", "ực_", "dẫn", "_dẫ", "phâ", "liê", "hế_", "hỗ_", "làm", "rộn", "át_", "uất", "_to", "cản", "ngu", "_pa", "_da", "xuấ", "hận", "ham", "tại", "sau", "_lo", "_hỗ", "_đế", "chứ", "er_", "_nế", "_mi", "nếu", "ằng", "_cu", "_a_", "hệ_", "_gỡ", "gỡ_", "_de", "_hệ", "thự", "hực", "êu_", "_ca", "_dữ", "dữ_", "ính", "hời", "thờ", "_ở_", "êm_", "trê", "ép_", "_dấ", "es_", "rên", "_về", "dấu", "về_", "_he", "hán", "tra", "_n_", "ưu_", "hiề", "àn_", "ền_", "chạ", "hức", "se_", "độn", "_ta", "ệt_", "_no", "hập", "ội_", "quy", "_mô", "thê", "hêm", "hạy", "ạy_", "đườ", "ộc_", "_bằ", "_nó", "bằn", "ười", "_tá", "thu", "khá", "_mà", "tín", "uộc", "hác", "gườ", "_na", "đến", "_câ", "_mớ", "_mở", "mới", "mở_", "ởi_", "_hà", "độ_", "thấ", "thá", "áy_", "sử_", "_má", "_nộ", "nội", "_se", "hép", "tiê", "địa", "ơn_", "dan", "giả", "nhá", "nó_", "máy", "ịa_", "_đồ", "ồn_", "óm_", "ạm_", "biế", "hóa", "_sá", "hoá", "uy_", "ck_", "áp_", "ấp_", "nhi", "sta", "lưu", "_tố", "id_", "_lấ", "phí", "_mặ", "nhó", "un_", "_dà", "et_", "hóm", "ứng", "_cậ", "lấy", "ếp_", "tho", "húc", "phá", "ồng", "_lý", "lý_", "ĩa_", "chú", "chế", "ll_", "hứ_", "mà_", "_si", "quá", "_đó", "cả_", "_du", "iệt", "_hạ", "hìn", "re_", "_hì", "_tả", "ngh", "_by", "uá_", "yte", "ọi_", "cấu", "byt", "cập", "_la", "hấy", "iểm", "mặc", "ẩn_", "ểm_", "_pr", "mô_", "rướ", "ững", "hủ_", "ăng", "hím", "hữn", "nhữ", "ím_", "úng", "ửa_", "ead", "hứa", "uỗi", "ứa_", "huỗ", "nha", "_al", "hật", "ile", "nt_", "_cỡ", "_fi", "tác", "ưng", "tươ", "ộn_", "han", "_su", "hai", "_cô", "lin", "_so", "cỡ_", "hạn", "uồn", "_kí", "ver", "hoả", "hốn", "oản", "cuố", "_xó", "kíc", "rườ", "uối", "dài", "ian", "thố", "ừng", "fil", "xóa", "ect", "án_", "ớp_", "_sh", "chữ", "nào", "uẩn", "trộ", "ãy_", "_ri", "oát", "_kê", "điề", "_bu", "côn", "rạn", "trạ", "huẩ", "hái", "ran", "_cũ", "sh_", "thì", "hì_", "ăn_", "chủ", "ịch", "sác", "_tà", "hưn", "ia_", "ngo", "tài", "_lụ", "_sẽ", "hườ", "lục", "sẽ_", "kê_", "che", "iêu", "sửa", "_bắ", "gữ_", "hoà", "ngữ", "_c_", "ad_", "guy", "_cộ", "_hò", "ack", "hòa", "yên", "òa_", "al_", "ion", "_s_", "khó", "uyê", "_bở", "_mo", "_tu", "_d_", "oại", "thế", "bởi", "ảo_", "_dị", "ce_", "hươ", "hớp", "khớ", "phé", "trí", "ri_", "_cà", "oàn", "phụ", "hau", "hữ_", "all", "óng", "cài", "ghĩ", "hĩa", "int", "nd_", "no_", "_đu", "iải", "ne_", "_hơ", "vi_", "_bấ", "hơn", "ter", "_nê", "_pi", "ed_", "ẫu_", "_hã", "_xá", "cấp", "huộ", "đi_", "_sự", "sự_", "xác", "_tắ", "hãy", "_cù", "cùn", "dịc", "_đí", "ref", "ta_", "cây", "em_", "chí", "st_", "ing", "òn_", "đưa", "_me", "_mẫ", "_mậ", "mẫu", "_tổ", "_xử", "ent", "mật", "xử_", "chấ", "lượ", "_an", "loc", "ôi_", "đíc", "_t_", "nam", "én_", "at_", "rt_", "đồn", "_rõ", "_ản", "rõ_", "_ni", "bất", "hea", "_v_", "iền", "thú", "đun", "hel", "me_", "nên", "_cò", "_đâ", "còn", "_mu", "_rộ", "hối", "la_", "_mỗ", "biể", "hun", "mỗi", "đa_", "_né", "tru", "_r_", "nén", "bắt", "guồ", "hất", "na_", "ắc_", "_f_", "nhớ", "tíc", "_ve", "dun", "hớ_", "or_", "th_", "_lê", "cái", "de_", "oán", "âu_", "đây", "_mọ", "mọi", "đặc", "en_", "rea", "_lu", "hín", "_fo", "_ứn", "hú_", "sao", "ẩu_", "_xe", "mon", "for", "ge_", "_l_", "ase", "set", "ve_", "_sy", "cộn", "tả_", "ame", "ma_", "_un", "ff_", "pac", "res", "ẵn_", "ốc_", "_lớ", "ate", "ha_", "hạm", "sở_", "ấm_", "ữa_", "_sở", "lên", "_do", "_tớ", "hết", "loạ", "ore", "tới", "_i_", "_vì", "and", "iếp", "khẩ", "ut_", "vì_", "tắt", "uyế", "_yê", "giữ", "háp", "ind", "ềm_", "hẩu", "ắn_", "_nă", "_po", "cơ_", "ers", "_cơ", "par", "ếm_", "_nằ", "bas", "ct_", "nằm", "thí", "đón", "ằm_", "_ex", "cầu", "hẻ_", "mềm", "tạm", "yêu", "_cụ", "_mề", "_sẵ", "híc", "quả", "sẵn", "phạ", "thẻ", "dir", "nhấ", "ry_", "so_", "tar", "điể", "kiế", "tor", "_đá", "elp", "nte", "str", "lan", "ga_", "hàm", "nga", "rốn", "trố", "_u_", "out", "san", "com", "rí_", "tab", "ín_", "ni_", "xem", "õi_", "_xế", "ort", "oà_", "to_", "tre", "xếp", "_tồ", "toá", "tồn", "ồi_", "_e_", "_p_", "_te", "âm_", "hún", "uyề", "yền", "do_", "tio", "tối", "ệm_", "_m_", "_nố", "_rỗ", "_đú", "ls_", "man", "nối", "rỗn", "sec", "đún", "ỗng", "_hỏ", "_tử", "_đệ", "ca_", "lớn", "pre", "tử_", "đó_", "ớn_", "_nu", "_vá", "di_", "_tấ", "_áp", "oài", "tất", "xun", "đồ_", "cha", "goà", "hát", "hụ_", "rl_", "tố_", "đán", "_o_", "hướ", "ỏng", "biệ", "hỏn", "nde", "uốn", "ine", "_dõ", "_âm", "_đè", "cục", "dõi", "ock", "ont", "_gì", "gì_", "toà", "vá_", "ôn_", "đè_", "_vă", "ex_", "inh", "tes", "văn", "ara", "hử_", "ist", "thử", "ba_", "ché", "cũ_", "ic_", "àng", "_ad", "_bù", "_sp", "_vù", "bù_", "dat", "tat", "vùn", "_ga", "ip_", "rd_", "trả", "_gọ", "_đừ", "gắn", "ote", "đột", "đừn", "_ar", "cũn", "mer", "ũng", "buộ", "end", "lp_", "ngắ", "rả_", "ser", "ẩy_", "ổng", "_bê", "ar_", "hấm", "iốn", "log"},
+	"zh": {"_无法", "_無法", "文件_", "it_", "_gi", "使用_", "git", "檔案_", "_使用", "_re", "_co", "_n_", "le_", "ll_", "_不能", "_文件", "_如果", "id_", "选项_", "er_", "_正在", "_sh", "ile", "hel", "錯誤_", "fil", "_pa", "_de", "_st", "失败_", "_选项", "错误_", "選項_", "无效的", "_或_", "_无效", "_fi", "警告_", "失敗_", "se_", "_檔案", "_in", "on_", "es_", "_選項", "_he", "con", "_和_", "_no", "_警告", "_無效", "無效的", "_se", "软件包", "目录_", "sta", "_pr", "_di", "me_", "提交_", "et_", "_ma", "ck_", "rl_", "ver", "名稱_", "lin", "参数_", "_c_", "ion", "目錄_", "ell", "in_", "_x_", "te_", "she", "_id", "_al", "ce_", "使用者", "_s_", "_d_", "模式_", "ame", "分支_", "ect", "ead", "re_", "_沒有", "_需要", "nt_", "che", "_在_", "_a_", "的文件", "_ex", "_si", "信息_", "字符_", "_li", "all", "ack", "dir", "_没有", "_f_", "sh_", "rea", "rt_", "st_", "ed_", "格式_", "版本_", "_fo", "_不是", "or_", "ent", "res", "_显示", "at_", "no_", "_t_", "_ur", "命令_", "ter", "的檔案", "_字符", "_lo", "ch_", "ad_", "str", "_不支", "_un", "_未知", "指定_", "for", "nam", "_p_", "_pi", "_忽略", "_顯示", "ase", "ate", "com", "存在_", "_ta", "_設定", "ve_", "指定的", "ref", "loc", "列表_", "_me", "名称_", "_l_", "不支持", "al_", "用法_", "_命令", "pac", "_nu", "_y_", "_ch", "int", "tat", "_格式", "_用法", "ry_", "_指定", "_设置", "ock", "大小_", "_ap", "状态_", "_cr", "設定_", "ge_", "ng_", "pe_", "_su", "引用_", "_e_", "_r_", "ont", "ser", "出错_", "_m_", "nd_", "de_", "pat", "ing", "指令_", "bas", "elp", "hea", "tor", "_i_", "类型_", "_ba", "_失敗", "執行_", "未知的", "_v_", "tio", "对象_", "_u_", "密钥_", "ct_", "lp_", "ore", "_po", "_失败", "ne_", "th_", "tre", "url", "ign", "ix_", "生錯誤", "發生錯", "_ca", "pre", "_的_", "_错误", "ff_", "物件_", "_na", "ype", "ip_", "_tr", "typ", "tar", "字符串", "_ve", "_默认", "件包_", "_指令", "_版本", "文件名", "_q_", "參數_", "_中的", "_ad", "_或者", "一个_", "時發生", "狀態_", "输出_", "ers", "ort", "不存在", "效的_", "_b_", "标准输", "ema", "dat", "ert", "無效_", "有效的", "ine", "_列出", "pt_", "pro", "ty_", "找不到", "_参数", "ist", "字元_", "是一个", "ut_", "共和國", "ind", "omm", "rec", "和國_", "_so", "set", "设置_", "_wi", "_op", "不支援", "_預設", "inu", "位元組", "tes", "更新_", "_o_", "索引_", "資訊_", "_us", "mer", "ss_", "忽略_", "_ty", "_不要", "out", "ow_", "rge", "ts_", "金鑰_", "use", "服务器", "_sy", "lt_", "不是一", "_h_", "add", "deb", "ime", "par", "的提交", "類型_", "tch", "版本庫", "_sc", "文件的", "表达式", "路径_", "配置_", "_mi", "onf", "用者_", "emo", "ess", "ive", "_ar", "get", "无效_", "eli", "標準輸", "签名_", "am_", "pen", "sio", "_en", "_sp", "exp", "mat", "us_", "ls_", "ar_", "_bi", "age", "ext", "_au", "_k_", "tp_", "的版本", "_ke", "nte", "操作_", "and", "end", "执行_", "_wa", "_建立", "_錯誤", "les", "删除_", "_da", "ir_", "om_", "tra", "链接_", "_g_", "wor", "时出错", "man", "rsi", "tri", "up_", "_to", "ec_", "tin", "一個_", "_ht", "_创建", "tim", "_ne", "app", "arc", "ath", "rce", "程序_", "_te", "_位元", "訊息_", "ere", "mod", "rs_", "程式_", "路徑_", "ote", "子模組", "工作區", "檔案的", "輸出_", "_退出", "ue_", "伺服器", "子模组", "_ge", "pos", "sig", "位置_", "_配置", "nor", "ope", "使用了", "_找不", "an_", "cti", "fo_", "ize", "nul", "_密钥", "_讀取", "ode", "ri_", "war", "工作区", "_读取", "ak_", "ble", "to_", "uid", "_ro", "_提交", "_软件", "sel", "cal", "ns_", "ord", "地址_", "时出现", "_gp", "nit", "sha", "err", "uni", "aut", "ink", "ntr", "rem", "ren", "支持_", "数据_", "的引用", "_重新", "def", "inf", "共和国", "的格式", "_输出", "nfo", "和国_", "如果_", "支援_", "退出_", "ly_", "pin", "_as", "_qu", "_ui", "_輸出", "key", "rc_", "rd_", "連結_", "_模式", "ail", "gno", "num", "sec", "_fs", "enc", "ex_", "iff", "lis", "mit", "ug_", "ux_", "ze_", "指定了", "資料_", "_第_", "bug", "dif", "ef_", "ire", "log", "ot_", "上下文", "字串_", "错误的", "_例如", "_更新", "erg", "引數_", "的所有", "符串_", "_ig", "arg", "crl", "llo", "rom", "uri", "手語_", "_用户", "dd_", "eam", "_字节", "_缺少", "_金鑰", "_除非", "ffi", "ig_", "nce", "tab", "win", "_可以", "法解析", "_bu", "qui", "符號_", "_pu", "_删除", "_目录", "ass", "orm", "ash", "gnu", "htt", "nti", "siz", "ttp", "使用的", "现错误", "用户_", "_z_", "mon", "off", "ree", "std", "tex", "时间_", "认证_", "ati", "ib_", "出现错", "移除_", "_gn", "_gr", "ps_", "ran", "rma", "sch", "成功_", "mac", "rin", "nk_", "xt_", "文档_", "无法打", "的字符", "_on", "ach", "ele", "nde", "ol_", "出状态", "法打开", "無法取", "解析_", "读取_", "需要一", "_mo", "_以_", "_数据", "ove", "unt", "區塊_", "_w_", "gid", "中的_", "准输入", "的软件", "退出状", "_執行", "_文档", "ull", "注意_", "算法_", "變更_", "ma_", "mma", "号链接", "符号链", "要认证", "需要认", "_ss", "_目錄", "der", "nux", "件名_", "分隔符", "变量_", "的选项", "重命名", "_字元", "_結束", "el_", "nfi", "ta_", "内容_", "_归档", "fer", "ml_", "per", "例如_", "修補檔", "出錯_", "刪除_", "已存在", "_wo", "_刪除", "_输入", "apt", "col", "ee_", "表示式", "_但是", "alt", "ap_", "hem", "wer", "_bl", "_打印", "cke", "low", "mak", "pid", "por", "rac", "ult", "是一個", "時出錯", "_您的", "abl", "ali", "del", "gpg", "met", "op_", "top", "匹配_", "字节_", "無法開", "的值_", "符号_", "讀取_", "項目_", "默认_", "_fr", "_up", "亞語_", "位址_", "法取得", "的分支", "_获取", "cat", "est", "fix", "og_", "未指定", "des", "ite", "pg_", "新命名", "法開啟", "符號連", "號連結", "重新命", "驗證_", "ese", "fig", "men", "sho", "支持的", "_ab", "_您可", "_时出", "fd_", "找到_", "時間_", "_dv", "cre", "cur", "erv", "初始化", "準輸入", "的選項", "而不是", "范围_", "_ti", "_而不", "ebu", "eck", "套件_", "法建立", "相同_", "_行_", "en_", "ern", "hec", "il_", "ste", "无法获", "时使用", "输入_", "are", "not", "oca", "rse", "soc", "数据库", "档文件", "eba", "ft_", "偏移量", "元組_", "归档文", "文件中", "运行_", "_ra", "cor", "ic_", "orc", "tho", "ude", "不是_", "您可以", "达式_", "需要_", "cs_", "ds_", "opt", "roo", "ush", "信号_", "修改_", "內容_", "定的_", "變數_", "_dp", "_參數", "_而非", "cha", "nu_", "oot", "rip", "uto", "建立_", "条目_", "無法建", "_中_", "_将_", "jec", "ora", "的命令", "_la", "_名稱", "ake", "ica", "rve", "亚语_", "户标识", "数字_", "用户标", "的对象", "_ha", "_不存", "_可能", "_返回", "art", "clu", "ose", "sys", "可用的", "_ip", "_pe", "_到_", "_輸入", "ang", "din", "eta", "pkg", "put", "spe", "tf_", "仓库_", "可以是", "标识_", "的参数", "範圍_", "_sk", "_为_", "_类型", "ito", "pas", "reb", "定檔案", "描述符", "有指定", "的物件", "置文件", "_br", "_ct", "_gs", "_取得", "_名称", "_封存", "ber", "lud", "spa", "sub", "存在的", "无法创", "法创建", "法找到", "用程序", "进程_", "_不允", "_表示", "ace", "ata", "efe", "kg_", "one", "osi", "pst", "命名_", "_即使", "_已经", "_目前", "_签名", "act", "atc", "dpk", "pri", "rak", "rm_", "tro", "文字_", "無法將", "_fa", "_fl", "blo", "gs_", "ook", "rn_", "ul_", "ule", "vor", "取得_", "应用程", "_do", "_从_", "_服务", "chi", "efi", "eve", "nch", "non", "onl", "ork", "red", "rou", "准输出", "合併_", "束狀態", "标签_", "結束_", "結束狀", "_值_", "_是_", "_注意", "dvo", "rep", "um_", "_不可", "_作为", "_包含", "em_", "lob", "zip", "檔案中", "识别的", "_字串", "_期望", "_添加", "anc", "ars", "att", "dow", "gp_", "mes", "sp_", "無法解", "限制_", "_ki", "_le", "_允许", "_提供", "_選擇", "etc", "io_", "ory", "个文件", "创建_", "如果您", "密码_", "所有者", "數字_", "用程式", "_ou", "nly", "tal", "tem", "ys_", "匹配的", "打开_", "无法解", "的名稱", "的工作", "_由_", "_解析", "bus", "mmi", "un_", "下文_", "可用_", "標籤_", "簽章_", "_cl", "_子模", "_字段", "dul", "ey_", "har", "len", "lib", "os_", "reg", "scr", "tty", "或者_", "_em", "_va", "_写入", "_因为", "_套件", "cap", "gro", "mai", "mot", "mp_", "ndo", "oni", "rev", "ssl", "tdi", "動作_", "檔案名", "配置文", "開啟_", "_同时", "_引用", "_類型", "cto", "omp", "sym", "不允许", "同时使", "安装_", "應用程", "无法将", "模組_", "移量_", "記憶體", "_ac", "_el", "_or", "_ut", "_只能", "_所有", "_的值", "_相同", "_路徑", "atu", "ecu"},
+}
@@ -0,0 +1,205 @@
+//go:build ignore
+// +build ignore
+
+// language_profiles_generate.go writes language_profiles.go, with the trigram
+// profiles used to detect the language of epubs.
+// The corpus is made of the translations found in gettext catalogs, for
+// instance those installed with the software of any Linux distribution.
+// English profiles are built from the original strings of these catalogs.
+//
+//	go run language_profiles_generate.go /usr/share/locale
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// number of ranked trigrams kept in a profile, as in language_detection.go
+	languageProfileSize = 1000
+	// languages with a smaller corpus are left out
+	minCorpusTrigrams = 200000
+	english           = "en"
+)
+
+var (
+	// locale directories: language, then optional territory, encoding or variant
+	localeRegexp = regexp.MustCompile(`^([a-z]{2,3})(?:_[A-Z]{2})?(?:\.[Uu][Tt][Ff]-?8)?$`)
+	// printf directives, markup and placeholders are not part of the language
+	noiseRegexp = regexp.MustCompile(`%[-+ #0-9.*$lhqjzt]*[a-zA-Z]|<[^>]*>|\$\{[^}]*\}|\{[^}]*\}|https?://\S+`)
+)
+
+// readCatalog returns the original strings and translations of a gettext
+// catalog.
+func readCatalog(filename string) (originals, translations []string, err error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) < 20 {
+		return nil, nil, errors.New("not a gettext catalog: " + filename)
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(data) {
+	case 0x950412de:
+		order = binary.LittleEndian
+	case 0xde120495:
+		order = binary.BigEndian
+	default:
+		return nil, nil, errors.New("not a gettext catalog: " + filename)
+	}
+	number := int(order.Uint32(data[8:]))
+	read := func(table, index int) (string, error) {
+		entry := table + 8*index
+		if entry+8 > len(data) {
+			return "", errors.New("truncated catalog: " + filename)
+		}
+		length, offset := int(order.Uint32(data[entry:])), int(order.Uint32(data[entry+4:]))
+		if offset+length > len(data) {
+			return "", errors.New("truncated catalog: " + filename)
+		}
+		return string(data[offset : offset+length]), nil
+	}
+	originalsTable, translationsTable := int(order.Uint32(data[12:])), int(order.Uint32(data[16:]))
+	for j := 0; j < number; j++ {
+		original, err := read(originalsTable, j)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the header has an empty original string
+		if original == "" {
+			continue
+		}
+		translation, err := read(translationsTable, j)
+		if err != nil {
+			return nil, nil, err
+		}
+		// plural forms are separated by NUL characters
+		originals = append(originals, strings.Split(original, "\x00")...)
+		translations = append(translations, strings.Split(translation, "\x00")...)
+	}
+	return originals, translations, nil
+}
+
+// countTrigrams of words padded with underscores, as in language_detection.go.
+func countTrigrams(text string, counts map[string]int) (total int) {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, word := range words {
+		runes := []rune("_" + word + "_")
+		for j := 0; j+3 <= len(runes); j++ {
+			counts[string(runes[j:j+3])]++
+			total++
+		}
+	}
+	return
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println("usage: go run language_profiles_generate.go LOCALE_DIRECTORY...")
+		os.Exit(1)
+	}
+	// deduplicated strings, by language
+	corpus := make(map[string]map[string]bool)
+	add := func(language string, texts []string) {
+		if _, ok := corpus[language]; !ok {
+			corpus[language] = make(map[string]bool)
+		}
+		for _, text := range texts {
+			if text = strings.TrimSpace(noiseRegexp.ReplaceAllString(text, " ")); text != "" && utf8.ValidString(text) {
+				corpus[language][text] = true
+			}
+		}
+	}
+	for _, root := range os.Args[1:] {
+		catalogs, err := filepath.Glob(filepath.Join(root, "*", "LC_MESSAGES", "*.mo"))
+		if err != nil {
+			panic(err)
+		}
+		for _, catalog := range catalogs {
+			locale := filepath.Base(filepath.Dir(filepath.Dir(catalog)))
+			match := localeRegexp.FindStringSubmatch(locale)
+			if match == nil {
+				continue
+			}
+			originals, translations, err := readCatalog(catalog)
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+			add(english, originals)
+			// English catalogs only adapt the spelling of the originals
+			if match[1] != english {
+				add(match[1], translations)
+			}
+		}
+	}
+
+	profiles := make(map[string][]string)
+	for language, texts := range corpus {
+		counts := make(map[string]int)
+		total := 0
+		for text := range texts {
+			total += countTrigrams(text, counts)
+		}
+		if total < minCorpusTrigrams {
+			continue
+		}
+		trigrams := make([]string, 0, len(counts))
+		for trigram := range counts {
+			trigrams = append(trigrams, trigram)
+		}
+		sort.Slice(trigrams, func(j, k int) bool {
+			if counts[trigrams[j]] == counts[trigrams[k]] {
+				return trigrams[j] < trigrams[k]
+			}
+			return counts[trigrams[j]] > counts[trigrams[k]]
+		})
+		if len(trigrams) > languageProfileSize {
+			trigrams = trigrams[:languageProfileSize]
+		}
+		profiles[language] = trigrams
+	}
+	languages := []string{}
+	for language := range profiles {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by language_profiles_generate.go; DO NOT EDIT.\n\n")
+	b.WriteString("package book\n\n")
+	b.WriteString("// languageProfileTrigrams are the most frequent trigrams of each language,\n")
+	b.WriteString("// by rank.\n")
+	b.WriteString("var languageProfileTrigrams = map[string][]string{\n")
+	for _, language := range languages {
+		fmt.Fprintf(&b, "\t%q: {", language)
+		for j, trigram := range profiles[language] {
+			if j != 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%q", trigram)
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile("language_profiles.go", out, 0644); err != nil {
+		panic(err)
+	}
+}
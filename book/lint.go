@@ -0,0 +1,381 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+	"gopkg.in/yaml.v2"
+)
+
+// Sections of the configuration file where aliases can be suggested.
+const (
+	authorAliasesSection    = "author_aliases"
+	publisherAliasesSection = "publisher_aliases"
+)
+
+// LintIssue is an inconsistency found in the collection.
+type LintIssue struct {
+	Check   string
+	Books   []*Book
+	Message string
+	// Main name and the Aliases that should be replaced by it, in the
+	// AliasSection of the configuration file.
+	AliasSection string
+	Main         string
+	Aliases      []string
+	// Fix the issue automatically, if possible.
+	Fix            func() error
+	FixDescription string
+	// Field to edit to fix the issue manually.
+	Field string
+}
+
+// IDs of the Books concerned by the LintIssue.
+func (li LintIssue) IDs() string {
+	ids := []string{}
+	for _, b := range li.Books {
+		ids = append(ids, strconv.Itoa(b.ID()))
+	}
+	return strings.Join(ids, ", ")
+}
+
+// LintCheck looks for one kind of inconsistency in a collection.
+type LintCheck struct {
+	Name        string
+	Description string
+	Run         func(books []*Book, cfg e.Config) []LintIssue
+}
+
+// LintChecks available, in the order they are run.
+var LintChecks = []LintCheck{
+	{"authors", "Near-duplicate author names", lintAuthors},
+	{"publishers", "Near-duplicate publisher names", lintPublishers},
+	{"series", "Near-duplicate series names", lintSeriesNames},
+	{"series-indexes", "Gaps and duplicate indexes in series", lintSeriesIndexes},
+	{"years", "Edition year before original year", lintYears},
+	{"type", "Types missing from the configured vocabulary", lintTypes},
+	{"genre", "Unknown genres", lintGenres},
+	{"isbn", "Retail books without ISBN", lintISBN},
+}
+
+// RegisterLintCheck adds a LintCheck, or replaces the one with the same name.
+func RegisterLintCheck(check LintCheck) {
+	for j, c := range LintChecks {
+		if c.Name == check.Name {
+			LintChecks[j] = check
+			return
+		}
+	}
+	LintChecks = append(LintChecks, check)
+}
+
+// Lint a collection, with all checks or only the ones given by name.
+func Lint(collection e.Collection, cfg e.Config, names ...string) ([]LintIssue, error) {
+	checks := []LintCheck{}
+	for _, name := range names {
+		found := false
+		for _, c := range LintChecks {
+			if c.Name == name {
+				checks = append(checks, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("Unknown check " + name + ", expected one of: " + strings.Join(lintCheckNames(), ", "))
+		}
+	}
+	if len(names) == 0 {
+		checks = LintChecks
+	}
+	books := []*Book{}
+	for _, book := range collection.Books() {
+		books = append(books, book.(*Book))
+	}
+	issues := []LintIssue{}
+	for _, c := range checks {
+		for _, issue := range c.Run(books, cfg) {
+			issue.Check = c.Name
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+func lintCheckNames() []string {
+	names := []string{}
+	for _, c := range LintChecks {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// AliasSnippet for the configuration file, from the aliases suggested by issues.
+func AliasSnippet(issues []LintIssue) (string, error) {
+	sections := make(map[string]map[string][]string)
+	for _, issue := range issues {
+		if issue.AliasSection == "" {
+			continue
+		}
+		if _, ok := sections[issue.AliasSection]; !ok {
+			sections[issue.AliasSection] = make(map[string][]string)
+		}
+		sections[issue.AliasSection][issue.Main] = append(sections[issue.AliasSection][issue.Main], issue.Aliases...)
+	}
+	if len(sections) == 0 {
+		return "", nil
+	}
+	snippet, err := yaml.Marshal(sections)
+	return string(snippet), err
+}
+
+// nearDuplicates groups names that are probably the same, with the books using them.
+// In each group, the name used by the most books comes first.
+func nearDuplicates(names map[string][]*Book) [][]string {
+	sorted := []string{}
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	grouped := make(map[string]bool)
+	groups := [][]string{}
+	for j, name := range sorted {
+		if grouped[name] {
+			continue
+		}
+		group := []string{name}
+		for _, other := range sorted[j+1:] {
+			if !grouped[other] && e.SimilarNames(name, other) {
+				group = append(group, other)
+				grouped[other] = true
+			}
+		}
+		if len(group) > 1 {
+			sort.SliceStable(group, func(x, y int) bool {
+				return len(names[group[x]]) > len(names[group[y]])
+			})
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// lintNames finds near-duplicate names, and renames aliases to the main name.
+func lintNames(names map[string][]*Book, kind, section string, rename func(b *Book, from, to string)) []LintIssue {
+	issues := []LintIssue{}
+	for _, group := range nearDuplicates(names) {
+		main, aliases := group[0], group[1:]
+		issue := LintIssue{
+			Message:        fmt.Sprintf("%s %q could also be: %s", kind, main, strings.Join(aliases, ", ")),
+			AliasSection:   section,
+			Main:           main,
+			Aliases:        aliases,
+			FixDescription: fmt.Sprintf("Rename %s to %q", strings.Join(aliases, ", "), main),
+		}
+		for _, alias := range aliases {
+			issue.Books = append(issue.Books, names[alias]...)
+		}
+		aliasBooks := issue.Books
+		issue.Fix = func() error {
+			for _, b := range aliasBooks {
+				for _, alias := range aliases {
+					rename(b, alias, main)
+				}
+			}
+			return nil
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+func lintAuthors(books []*Book, cfg e.Config) []LintIssue {
+	names := make(map[string][]*Book)
+	for _, b := range books {
+		for _, author := range b.Metadata.Authors {
+			names[author] = append(names[author], b)
+		}
+	}
+	return lintNames(names, "Author", authorAliasesSection, func(b *Book, from, to string) {
+		for j, author := range b.Metadata.Authors {
+			if author == from {
+				b.Metadata.Authors[j] = to
+			}
+		}
+	})
+}
+
+func lintPublishers(books []*Book, cfg e.Config) []LintIssue {
+	names := make(map[string][]*Book)
+	for _, b := range books {
+		if b.Metadata.Publisher != "" && b.Metadata.Publisher != unknown {
+			names[b.Metadata.Publisher] = append(names[b.Metadata.Publisher], b)
+		}
+	}
+	return lintNames(names, "Publisher", publisherAliasesSection, func(b *Book, from, to string) {
+		if b.Metadata.Publisher == from {
+			b.Metadata.Publisher = to
+		}
+	})
+}
+
+func lintSeriesNames(books []*Book, cfg e.Config) []LintIssue {
+	names := make(map[string][]*Book)
+	for _, b := range books {
+		for _, s := range b.Metadata.Series {
+			names[s.Name] = append(names[s.Name], b)
+		}
+	}
+	// there are no series aliases in the configuration file
+	return lintNames(names, "Series", "", func(b *Book, from, to string) {
+		for j, s := range b.Metadata.Series {
+			if s.Name == from {
+				b.Metadata.Series[j].Name = to
+			}
+		}
+	})
+}
+
+// seriesIndexes of a series position: "2", "1,2", or "1-3".
+func seriesIndexes(position string) []float64 {
+	indexes := []float64{}
+	for _, part := range strings.Split(position, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+		first, err := strconv.ParseFloat(bounds[0], 64)
+		if err != nil {
+			continue
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.ParseFloat(bounds[1], 64); err != nil {
+				last = first
+			}
+		}
+		for index := first; index <= last; index++ {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+func lintSeriesIndexes(books []*Book, cfg e.Config) []LintIssue {
+	series := make(map[string][]SeriesBook)
+	for _, b := range books {
+		for _, s := range b.Metadata.Series {
+			series[s.Name] = append(series[s.Name], SeriesBook{Book: b, Name: s.Name, Position: s.Position})
+		}
+	}
+	names := []string{}
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	issues := []LintIssue{}
+	for _, name := range names {
+		if missing := MissingPositions(series[name], nil); len(missing) != 0 {
+			positions := []string{}
+			for _, entry := range missing {
+				positions = append(positions, entry.Position)
+			}
+			issue := LintIssue{Message: fmt.Sprintf("Series %q is missing: %s", name, strings.Join(positions, ", "))}
+			for j, sb := range series[name] {
+				// a book can be listed several times in a series
+				if j == 0 || sb.Book != series[name][j-1].Book {
+					issue.Books = append(issue.Books, sb.Book)
+				}
+			}
+			issues = append(issues, issue)
+		}
+		// omnibus editions overlap with single books
+		single := make(map[float64][]*Book)
+		for _, sb := range series[name] {
+			if indexes := seriesIndexes(sb.Position); len(indexes) == 1 {
+				single[indexes[0]] = append(single[indexes[0]], sb.Book)
+			}
+		}
+		indexes := []float64{}
+		for index := range single {
+			indexes = append(indexes, index)
+		}
+		sort.Float64s(indexes)
+		for _, index := range indexes {
+			if len(single[index]) > 1 {
+				issues = append(issues, LintIssue{
+					Books:   single[index],
+					Message: fmt.Sprintf("Series %q has several books #%s", name, strconv.FormatFloat(index, 'f', -1, 64)),
+					Field:   seriesField,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+func lintYears(books []*Book, cfg e.Config) []LintIssue {
+	issues := []LintIssue{}
+	for _, b := range books {
+		original, err := strconv.Atoi(b.Metadata.OriginalYear)
+		if err != nil {
+			continue
+		}
+		edition, err := strconv.Atoi(b.Metadata.EditionYear)
+		if err != nil {
+			continue
+		}
+		if edition < original {
+			issues = append(issues, LintIssue{
+				Books:   []*Book{b},
+				Message: fmt.Sprintf("Edition year %d is before original year %d", edition, original),
+				Field:   editionYearField,
+			})
+		}
+	}
+	return issues
+}
+
+func lintTypes(books []*Book, cfg e.Config) []LintIssue {
+	issues := []LintIssue{}
+	for _, b := range books {
+		if !cfg.TypeVocabulary().Has(b.Metadata.Type) {
+			issues = append(issues, LintIssue{
+				Books:   []*Book{b},
+				Message: fmt.Sprintf("Unknown type %q", b.Metadata.Type),
+				Field:   typeField,
+			})
+		}
+	}
+	return issues
+}
+
+func lintGenres(books []*Book, cfg e.Config) []LintIssue {
+	issues := []LintIssue{}
+	for _, b := range books {
+		if b.Metadata.Genre == "" || b.Metadata.Genre == unknown {
+			issues = append(issues, LintIssue{
+				Books:   []*Book{b},
+				Message: "Unknown genre",
+				Field:   genreField,
+			})
+		}
+	}
+	return issues
+}
+
+func lintISBN(books []*Book, cfg e.Config) []LintIssue {
+	issues := []LintIssue{}
+	for _, b := range books {
+		if b.HasRetail() && b.Metadata.ISBN == "" {
+			issues = append(issues, LintIssue{
+				Books:   []*Book{b},
+				Message: "Retail book without ISBN",
+				Field:   isbnField,
+			})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,75 @@
+package book
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	en "github.com/barsanuphe/endive/endive"
+)
+
+func TestLint(t *testing.T) {
+	fmt.Println("+ Testing Lint()...")
+	assert := assert.New(t)
+	metadata := []Metadata{
+		{Authors: []string{"Iain M. Banks"}, Publisher: "Tor Books", Series: Series{{Name: "Culture", Position: "1"}}, OriginalYear: "1987", EditionYear: "1987", Type: "novel", Genre: "science-fiction"},
+		{Authors: []string{"Iain M. Banks"}, Publisher: "Tor Books", Series: Series{{Name: "Culture", Position: "2"}}, OriginalYear: "1988", EditionYear: "1988", Type: "novel", Genre: "science-fiction"},
+		{Authors: []string{"Iain Banks"}, Publisher: "Tor", Series: Series{{Name: "The Culture", Position: "2"}}, OriginalYear: "1990", EditionYear: "1985", Type: "novel", Genre: "science-fiction", ISBN: "9780316005388"},
+		{Authors: []string{"Ken MacLeod"}, Publisher: "Orbit", Series: Series{{Name: "Fall Revolution", Position: "1-2"}, {Name: "Fall Revolution", Position: "4"}, {Name: "Culture", Position: "2"}}, OriginalYear: "1995", Type: "comic", Genre: unknown},
+	}
+	collection := &Books{}
+	for j, m := range metadata {
+		collection.Add(NewBookWithMetadata(ui, j+1, epubs[0].filename, standardTestConfig, j != 2, m))
+	}
+
+	_, err := Lint(collection, standardTestConfig, "unknown")
+	assert.NotNil(err)
+
+	issues, err := Lint(collection, standardTestConfig)
+	assert.Nil(err)
+	found := make(map[string][]string)
+	for _, issue := range issues {
+		found[issue.Check] = append(found[issue.Check], issue.Message+" ["+issue.IDs()+"]")
+	}
+	assert.Equal([]string{`Author "Iain M. Banks" could also be: Iain Banks [3]`}, found["authors"])
+	assert.Equal([]string{`Publisher "Tor Books" could also be: Tor [3]`}, found["publishers"])
+	assert.Equal([]string{`Series "Culture" could also be: The Culture [3]`}, found["series"])
+	assert.Equal([]string{`Series "Culture" has several books #2 [2, 4]`, `Series "Fall Revolution" is missing: 3 [4]`, `Series "The Culture" is missing: 1 [3]`}, found["series-indexes"])
+	assert.Equal([]string{"Edition year 1985 is before original year 1990 [3]"}, found["years"])
+	assert.Equal([]string{`Unknown type "comic" [4]`}, found["type"])
+	assert.Equal([]string{"Unknown genre [4]"}, found["genre"])
+	assert.Equal([]string{"Retail book without ISBN [1]", "Retail book without ISBN [2]", "Retail book without ISBN [4]"}, found["isbn"])
+
+	snippet, err := AliasSnippet(issues)
+	assert.Nil(err)
+	assert.Equal("author_aliases:\n  Iain M. Banks:\n  - Iain Banks\npublisher_aliases:\n  Tor Books:\n  - Tor\n", snippet)
+
+	// fixing
+	issues, err = Lint(collection, standardTestConfig, "authors", "series")
+	assert.Nil(err)
+	assert.Equal(2, len(issues))
+	for _, issue := range issues {
+		assert.NotNil(issue.Fix)
+		assert.Nil(issue.Fix())
+	}
+	third, err := collection.FindByID(3)
+	assert.Nil(err)
+	assert.Equal("Iain M. Banks", third.(*Book).Metadata.Author())
+	assert.Equal("Culture", third.(*Book).Metadata.Series[0].Name)
+	issues, err = Lint(collection, standardTestConfig, "authors", "series")
+	assert.Nil(err)
+	assert.Equal(0, len(issues))
+
+	// registering a new check
+	RegisterLintCheck(LintCheck{"title", "Missing title", func(books []*Book, cfg en.Config) []LintIssue {
+		return []LintIssue{{Books: books, Message: "Missing title"}}
+	}})
+	defer func() { LintChecks = LintChecks[:len(LintChecks)-1] }()
+	issues, err = Lint(collection, standardTestConfig, "title")
+	assert.Nil(err)
+	assert.Equal(1, len(issues))
+	assert.Equal("1, 2, 3, 4", issues[0].IDs())
+	assert.True(strings.Contains(strings.Join(lintCheckNames(), " "), "title"))
+}
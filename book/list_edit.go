@@ -0,0 +1,187 @@
+package book
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+)
+
+const (
+	listAdd    = "+"
+	listRemove = "-"
+)
+
+// operations are separated by commas, or by spaces before + and -
+var listOperationRegexp = regexp.MustCompile(`\s+([+-])`)
+
+// listFields hold several values, which can be added or removed individually.
+var listFields = []string{authorField, translatorField, editorField, tagsField, seriesField, identifiersField}
+
+// IsListEdit checks if a value is made of operations on a list field,
+// such as "+space-opera, -scifi" or "+space-opera -scifi".
+func IsListEdit(value string) bool {
+	operations := splitListEdit(value)
+	if len(operations) == 0 {
+		return false
+	}
+	for _, op := range operations {
+		if !strings.HasPrefix(op, listAdd) && !strings.HasPrefix(op, listRemove) {
+			return false
+		}
+	}
+	return true
+}
+
+func splitListEdit(value string) []string {
+	operations := []string{}
+	value = listOperationRegexp.ReplaceAllString(value, ",$1")
+	for _, op := range strings.Split(value, ",") {
+		if op = strings.TrimSpace(op); op != "" {
+			operations = append(operations, op)
+		}
+	}
+	return operations
+}
+
+// isListField checks if a field can be edited with list operations.
+func (b *Book) isListField(field string) bool {
+	if customField, ok := b.Config.CustomFields[field]; ok {
+		return customField.Type == e.FieldList
+	}
+	_, isIn := h.StringInSlice(field, listFields)
+	return isIn
+}
+
+// listValues of a list field.
+func (b *Book) listValues(field string) []string {
+	switch field {
+	case authorField:
+		return b.Metadata.Authors
+	case translatorField:
+		return b.Metadata.Contributors[translatorRole]
+	case editorField:
+		return b.Metadata.Contributors[editorRole]
+	case tagsField:
+		return strings.Split(b.Metadata.Tags.String(), ", ")
+	case seriesField:
+		return strings.Split(b.Metadata.Series.rawString(), ", ")
+	case identifiersField:
+		return strings.Split(b.Metadata.Identifiers.String(), ", ")
+	}
+	return strings.Split(b.Custom[field], ", ")
+}
+
+// listKey identifies a value in a list field: series are identified by name,
+// identifiers by kind, so that adding them again updates their index or value.
+func listKey(field, value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch field {
+	case seriesField:
+		if idx := strings.LastIndex(value, ":"); idx != -1 {
+			return strings.TrimSpace(value[:idx])
+		}
+	case identifiersField:
+		if idx := strings.Index(value, ":"); idx != -1 {
+			return strings.TrimSpace(value[:idx])
+		}
+	}
+	return value
+}
+
+// EditList adds (+value) and removes (-value) values of a list field.
+func (b *Book) EditList(field, operations string) error {
+	if !b.isListField(field) {
+		return errors.New("Field " + field + " is not a list")
+	}
+	if !IsListEdit(operations) {
+		return errors.New("Invalid list operations " + operations + ", expected +value or -value")
+	}
+	values := []string{}
+	for _, v := range b.listValues(field) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	for _, op := range splitListEdit(operations) {
+		value := strings.TrimSpace(op[1:])
+		if value == "" {
+			continue
+		}
+		key := listKey(field, value)
+		// removing the existing value, or the previous version of an added value
+		kept := []string{}
+		replaced := -1
+		for j, v := range values {
+			if listKey(field, v) == key {
+				replaced = j
+			} else {
+				kept = append(kept, v)
+			}
+		}
+		if op[:1] == listAdd {
+			if replaced != -1 {
+				// keeping the position of updated values
+				kept = append(kept[:replaced], append([]string{value}, kept[replaced:]...)...)
+			} else {
+				kept = append(kept, value)
+			}
+		}
+		values = kept
+	}
+	return b.Set(field, strings.Join(values, ", "))
+}
+
+// Copy of a Book, which can be modified without affecting the original.
+func (b *Book) Copy() *Book {
+	c := *b
+	c.Editions = append([]Edition(nil), b.Editions...)
+	c.Readings = append(ReadingLog(nil), b.Readings...)
+	c.Metadata = b.Metadata.copy()
+	if b.Custom != nil {
+		c.Custom = make(map[string]string)
+		for k, v := range b.Custom {
+			c.Custom[k] = v
+		}
+	}
+	return &c
+}
+
+// copy of Metadata, including its slices and maps.
+func (i Metadata) copy() Metadata {
+	c := i
+	if i.Authors != nil {
+		c.Authors = append([]string{}, i.Authors...)
+	}
+	if i.Series != nil {
+		c.Series = append(Series{}, i.Series...)
+	}
+	if i.Tags != nil {
+		c.Tags = append(Tags{}, i.Tags...)
+	}
+	if i.Contributors != nil {
+		c.Contributors = Contributors{}
+		for role, names := range i.Contributors {
+			c.Contributors[role] = append([]string{}, names...)
+		}
+	}
+	if i.SortNames != nil {
+		c.SortNames = make(map[string]string)
+		for k, v := range i.SortNames {
+			c.SortNames[k] = v
+		}
+	}
+	if i.Identifiers != nil {
+		c.Identifiers = Identifiers{}
+		for k, v := range i.Identifiers {
+			c.Identifiers[k] = v
+		}
+	}
+	if i.Work != nil {
+		work := *i.Work
+		c.Work = &work
+	}
+	return c
+}
@@ -0,0 +1,70 @@
+package book
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	en "github.com/barsanuphe/endive/endive"
+)
+
+func TestBookEditList(t *testing.T) {
+	fmt.Println("+ Testing Book.EditList()...")
+	assert := assert.New(t)
+	cfg := standardTestConfig
+	cfg.CustomFields = en.CustomFields{
+		"owners": {Name: "owners", Type: en.FieldList},
+		"price":  {Name: "price", Type: en.FieldFloat},
+	}
+	b := NewBook(ui, 1, epubs[0].filename, cfg, isRetail)
+
+	assert.True(IsListEdit("+space-opera -scifi"))
+	assert.True(IsListEdit(" -scifi, +space-opera"))
+	assert.False(IsListEdit("space-opera, +scifi"))
+	assert.False(IsListEdit(""))
+
+	// tags
+	assert.Nil(b.Set(tagsField, "scifi, classic"))
+	assert.Nil(b.EditList(tagsField, "+space-opera, -scifi"))
+	assert.Equal("classic, space-opera", b.Metadata.Tags.String())
+	assert.Nil(b.EditList(tagsField, "-CLASSIC"))
+	assert.Equal("space-opera", b.Metadata.Tags.String())
+	assert.Nil(b.EditList(tagsField, "+hard-sf -space-opera +scifi"))
+	assert.Equal("hard-sf, scifi", b.Metadata.Tags.String())
+	assert.Nil(b.EditList(tagsField, "+space-opera  -hard-sf, -scifi"))
+	assert.Equal("space-opera", b.Metadata.Tags.String())
+
+	// series: adding an existing series updates its index
+	assert.Nil(b.Set(seriesField, "Culture:1, Other:2"))
+	assert.Nil(b.EditList(seriesField, "+Culture:3"))
+	assert.Equal("Culture:3, Other:2", b.Metadata.Series.rawString())
+	assert.Nil(b.EditList(seriesField, "-Other, +Third:1"))
+	assert.Equal("Culture:3, Third:1", b.Metadata.Series.rawString())
+
+	// authors
+	assert.Nil(b.Set(authorField, "Iain M. Banks"))
+	assert.Nil(b.EditList(authorField, "+Ken MacLeod"))
+	assert.Equal([]string{"Iain M. Banks", "Ken MacLeod"}, b.Metadata.Authors)
+	assert.Nil(b.EditList(authorField, "-Ken MacLeod +Ken Liu"))
+	assert.Equal([]string{"Iain M. Banks", "Ken Liu"}, b.Metadata.Authors)
+	assert.Nil(b.EditList(authorField, "-Ken Liu +Ken MacLeod"))
+
+	// custom list fields
+	assert.Nil(b.EditList("owners", "+Alice, +Bob"))
+	assert.Nil(b.EditList("owners", "-alice"))
+	assert.Equal("Bob", b.Custom["owners"])
+	assert.NotNil(b.EditList("price", "+12"))
+	assert.NotNil(b.EditList(titleField, "+thing"))
+	assert.NotNil(b.EditList(tagsField, "thing"))
+
+	// editing a copy does not change the original
+	c := b.Copy()
+	assert.Nil(c.EditList(tagsField, "+classic"))
+	assert.Nil(c.EditList("owners", "+Carol"))
+	assert.Nil(c.EditList(authorField, "-Ken MacLeod"))
+	assert.Equal("space-opera", b.Metadata.Tags.String())
+	assert.Equal("Bob", b.Custom["owners"])
+	assert.Equal(2, len(b.Metadata.Authors))
+	assert.Equal(3, len(b.OutputDiffTable(c, true)), "authors, tags and owners changed")
+}
@@ -0,0 +1,137 @@
+package book
+
+import (
+	"errors"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// MergeOrder of two Books describing the same book: the one with a retail
+// epub is kept, or else the oldest one.
+func MergeOrder(a, o *Book) (kept, merged *Book) {
+	if o.HasRetail() && !a.HasRetail() {
+		return o, a
+	}
+	if a.HasRetail() == o.HasRetail() && o.ID() < a.ID() {
+		return o, a
+	}
+	return a, o
+}
+
+// Merge another Book describing the same book into this one.
+// The editions of the other Book are added, retail versions trumping
+// non-retail ones of the same edition: the retail metadata are used as
+// reference, and trumped non-retail epubs are removed. User data (progress,
+// rating, review...) are kept, and completed by the other Book's.
+func (b *Book) Merge(o *Book) error {
+	for _, ed := range o.Editions {
+		if k := b.findEdition(ed); ed.Retail && k != -1 && b.Editions[k].Retail {
+			return errors.New("Cannot merge two books with retail epubs of the same edition")
+		}
+	}
+	// metadata, with the retail version as reference
+	if o.HasRetail() && !b.HasRetail() {
+		reference := o.Metadata.copy()
+		if err := reference.Merge(&b.Metadata, b.Config, b.UI, true); err != nil {
+			return err
+		}
+		reference.keepOnlineData(o.Metadata)
+		b.Metadata = reference
+	} else {
+		known := b.Metadata.copy()
+		if err := b.Metadata.Merge(&o.Metadata, b.Config, b.UI, true); err != nil {
+			return err
+		}
+		b.Metadata.keepOnlineData(known)
+	}
+	b.mergeUserData(o)
+	return b.mergeEpubs(o)
+}
+
+// keepOnlineData known before a merge, if it was not found in the merged Metadata.
+func (i *Metadata) keepOnlineData(known Metadata) {
+	if i.ImageURL == "" {
+		i.ImageURL = known.ImageURL
+	}
+	if i.NumPages == "" {
+		i.NumPages = known.NumPages
+	}
+	if i.AverageRating == "" {
+		i.AverageRating = known.AverageRating
+	}
+}
+
+// mergeUserData of another Book, keeping known values.
+func (b *Book) mergeUserData(o *Book) {
+	if o.Progress == e.ProgressRead || ((b.Progress == "" || b.Progress == e.ProgressUnread) && o.Progress != "") {
+		b.Progress = o.Progress
+	}
+	if b.ReadDate == "" || o.ReadDate > b.ReadDate {
+		b.ReadDate = o.ReadDate
+	}
+	if b.Readings = b.Readings.merge(o.Readings); len(b.Readings) != 0 {
+		b.Progress = b.Readings[b.Readings.latest()].progress()
+	}
+	if b.Rating == "" {
+		b.Rating = o.Rating
+	}
+	if b.Review == "" {
+		b.Review = o.Review
+	}
+	for name, value := range o.Custom {
+		if b.Custom == nil {
+			b.Custom = make(map[string]string)
+		}
+		if b.Custom[name] == "" {
+			b.Custom[name] = value
+		}
+	}
+}
+
+// mergeEpubs of another Book, removing the trumped ones.
+func (b *Book) mergeEpubs(o *Book) error {
+	superseded := []int{}
+	for j, ed := range o.Editions {
+		k := b.findEdition(ed)
+		switch {
+		case k == -1:
+		case ed.Retail && !b.Editions[k].Retail:
+			if err := b.removeEdition(k); err != nil {
+				return err
+			}
+		// keeping the non-retail epub that does not need replacement
+		case !ed.Retail && !b.Editions[k].Retail && b.Editions[k].Epub.NeedsReplacement == e.True && ed.Epub.NeedsReplacement != e.True:
+			if err := b.removeEdition(k); err != nil {
+				return err
+			}
+		default:
+			superseded = append(superseded, j)
+			continue
+		}
+		b.Editions = append(b.Editions, ed)
+	}
+	if len(superseded) != 0 {
+		if err := o.trash(TrashReasonSuperseded, superseded...); err != nil {
+			return err
+		}
+	}
+	// the other epubs now belong to this Book
+	o.Editions = nil
+	return nil
+}
+
+// Split the epub of an edition of a Book into a new Book with the given ID.
+// The new Book starts with a copy of the metadata, but without user data.
+func (b *Book) Split(id int, k int) (*Book, error) {
+	if len(b.Editions) < 2 {
+		return nil, errors.New("Only books with several epubs can be split")
+	}
+	if k < 0 || k >= len(b.Editions) {
+		return nil, errors.New("Edition not found")
+	}
+	ed := b.Editions[k]
+	b.Editions = append(b.Editions[:k], b.Editions[k+1:]...)
+	n := NewBookWithMetadata(b.UI, id, ed.Epub.Filename, b.Config, ed.Retail, b.Metadata.copy())
+	n.Editions = []Edition{ed}
+	return n, nil
+}
@@ -0,0 +1,41 @@
+package book
+
+import (
+	"fmt"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookSplit(t *testing.T) {
+	fmt.Println("+ Testing Book.Split()...")
+	assert := assert.New(t)
+
+	b := NewBookWithMetadata(ui, 1, epubs[0].filename, standardTestConfig, true, Metadata{BookTitle: "Excession", Authors: []string{"Iain M. Banks"}})
+	_, err := b.Split(2, 0)
+	assert.NotNil(err, "a single epub cannot be split")
+
+	b.Editions = append(b.Editions, Edition{Epub: Epub{Filename: epubs[1].filename, Hash: "hash", Fingerprint: "fingerprint", Config: standardTestConfig, UI: ui}, Language: "fr"})
+	b.Progress = e.ProgressRead
+	b.Metadata.Tags.AddFromNames("sf")
+	_, err = b.Split(2, 2)
+	assert.NotNil(err, "unknown edition")
+	n, err := b.Split(2, b.EditionIndex(false))
+	assert.Nil(err)
+	assert.Equal(2, n.ID())
+	assert.True(b.HasRetail())
+	assert.False(b.HasNonRetail())
+	assert.False(n.HasRetail())
+	if assert.Equal(1, len(n.Editions)) {
+		assert.Equal(epubs[1].filename, n.MainEpub().Filename)
+		assert.Equal("hash", n.MainEpub().Hash)
+		assert.Equal("fingerprint", n.MainEpub().Fingerprint)
+		assert.Equal("fr", n.Editions[0].Language)
+	}
+	assert.Equal("Excession", n.Metadata.BookTitle)
+	assert.Equal(e.ProgressUnread, n.Progress)
+	// metadata are copied
+	n.Metadata.Tags.AddFromNames("culture")
+	assert.Equal(1, len(b.Metadata.Tags))
+}
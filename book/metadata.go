@@ -13,11 +13,14 @@ import (
 
 const (
 	titleField         = "title"
+	subtitleField      = "subtitle"
 	descriptionField   = "description"
 	isbnField          = "isbn"
 	yearField          = "year"
 	editionYearField   = "edition_year"
 	authorField        = "author"
+	translatorField    = "translator"
+	editorField        = "editor"
 	publisherField     = "publisher"
 	tagsField          = "tags"
 	seriesField        = "series"
@@ -27,10 +30,13 @@ const (
 	genreField         = "genre"
 	numPagesField      = "numpages"
 	averageRatingField = "averagerating"
+	identifiersField   = "identifiers"
 
 	authorUsage      = "Authors can be edited as a comma-separated list of strings."
-	categoryUsage    = "A book can be either fiction or nonfiction."
-	typeUsage        = "The nature of this book."
+	translatorUsage  = "Translators can be edited as a comma-separated list of strings."
+	editorUsage      = "Editors can be edited as a comma-separated list of strings."
+	categoryUsage    = "The category of this book, as defined in the configuration file."
+	typeUsage        = "The nature of this book, as defined in the configuration file."
 	tagsUsage        = "Tags can be edited as a comma-separated list of strings."
 	seriesUsage      = "Series can be edited as a comma-separated list of 'series name:index' strings. Index can be empty, or a range."
 	yearUsage        = "The year in which the book was written."
@@ -40,7 +46,9 @@ const (
 	genreUsage       = "Main genre of this book."
 	isbnUsage        = "ISBN13 for this edition."
 	titleUsage       = "Title, without series information."
+	subtitleUsage    = "Subtitle, if any."
 	descriptionUsage = "Description for this edition."
+	identifiersUsage = "Identifiers can be edited as a comma-separated list of 'kind:value' strings (goodreads, asin, openlibrary, uuid...)."
 
 	unknownYear = "XXXX"
 	unknown     = "Unknown"
@@ -53,10 +61,13 @@ const (
 )
 
 // MetadataFieldNames is a list of valid field names
-var MetadataFieldNames = []string{authorField, titleField, yearField, editionYearField, publisherField, descriptionField, languageField, categoryField, typeField, genreField, tagsField, seriesField, isbnField}
+var MetadataFieldNames = []string{authorField, translatorField, editorField, titleField, subtitleField, yearField, editionYearField, publisherField, descriptionField, languageField, categoryField, typeField, genreField, tagsField, seriesField, isbnField}
 var metadataFieldMap = map[string]string{
 	authorField:      "Authors",
+	translatorField:  "Contributors",
+	editorField:      "Contributors",
 	titleField:       "BookTitle",
+	subtitleField:    "Subtitle",
 	yearField:        "OriginalYear",
 	editionYearField: "EditionYear",
 	publisherField:   "Publisher",
@@ -68,14 +79,16 @@ var metadataFieldMap = map[string]string{
 	tagsField:        "Tags",
 	seriesField:      "Series",
 	isbnField:        "ISBN",
+	identifiersField: "Identifiers",
 }
 
 // Metadata contains all of the known book metadata.
 type Metadata struct {
 	BookTitle     string   `json:"title" xml:"title"`
+	Subtitle      string   `json:"subtitle"`
 	ImageURL      string   `json:"image_url" xml:"image_url"`
 	NumPages      string   `json:"num_pages" xml:"num_pages"`
-	Authors       []string `json:"authors" xml:"authors>author>name"`
+	Authors       []string `json:"authors" xml:"-"`
 	ISBN          string   `json:"isbn" xml:"isbn13"`
 	OriginalYear  string   `json:"year" xml:"work>original_publication_year"`
 	EditionYear   string   `json:"edition_year" xml:"publication_year"`
@@ -88,6 +101,13 @@ type Metadata struct {
 	Genre         string   `json:"genre"`
 	Language      string   `json:"language" xml:"language_code"`
 	Publisher     string   `json:"publisher" xml:"publisher"`
+	// other contributors, and sort names for everyone
+	Contributors Contributors      `json:"contributors" xml:"-"`
+	SortNames    map[string]string `json:"sort_names" xml:"-"`
+	// identifiers on external sites
+	Identifiers Identifiers `json:"identifiers" xml:"-"`
+	// work of which this is an edition or a translation
+	Work *Work `json:"work,omitempty" xml:"-"`
 }
 
 // String returns a representation of Metadata
@@ -130,6 +150,8 @@ func (i *Metadata) Title() string {
 
 // Clean cleans up the Metadata
 func (i *Metadata) Clean(cfg e.Config) {
+	// the original year is the work's
+	i.cleanWork()
 	// default year
 	if i.OriginalYear == "" {
 		if i.EditionYear != "" {
@@ -148,33 +170,42 @@ func (i *Metadata) Clean(cfg e.Config) {
 	// clean description
 	i.Description = cleanHTML(i.Description)
 	// clean language
-	i.Language = cleanLanguage(i.Language)
+	i.Language = cleanLanguage(i.Language, cfg.LanguageAliases)
+	if i.Work != nil {
+		i.Work.Language = cleanLanguage(i.Work.Language, cfg.LanguageAliases)
+	}
 	// use config aliases
 	i.useAliases(cfg)
 	// clean tags
-	i.Tags.Clean()
+	i.Tags.Clean(cfg.TagRules, "")
+	i.Tags.UseHierarchy(cfg.TagHierarchy)
 	// autofill category
 	if i.Category == "" {
-		for _, possibleCategory := range validCategories {
+		for _, possibleCategory := range cfg.CategoryVocabulary().Terms() {
 			if isIn, _ := i.Tags.Has(Tag{Name: possibleCategory}); isIn {
 				i.Category = possibleCategory
 				i.Tags.RemoveFromNames(possibleCategory)
 				break
 			}
+			// hierarchical tags are kept
+			if i.Tags.HasDescendant(possibleCategory) {
+				i.Category = possibleCategory
+				break
+			}
 		}
 	}
 	// if nothing valid found...
 	if i.Category == "" {
 		i.Category = unknown
 	}
-	if cat, err := cleanCategory(i.Category); err == nil {
+	if cat, err := cleanCategory(i.Category, cfg); err == nil {
 		i.Category = cat
 		i.Tags.RemoveFromNames(cat)
 	}
 
 	// autofill type
 	if i.Type == "" {
-		for _, possibleType := range validTypes {
+		for _, possibleType := range cfg.TypeVocabulary().Terms() {
 			if isIn, _ := i.Tags.Has(Tag{Name: possibleType}); isIn {
 				i.Type = possibleType
 				i.Tags.RemoveFromNames(possibleType)
@@ -186,15 +217,17 @@ func (i *Metadata) Clean(cfg e.Config) {
 	if i.Type == "" {
 		i.Type = unknown
 	}
-	if tp, err := cleanType(i.Type); err == nil {
+	if tp, err := cleanType(i.Type, cfg); err == nil {
 		i.Type = tp
 		i.Tags.RemoveFromNames(tp)
 	}
 
 	// MainGenre
 	if i.Genre == "" && len(i.Tags) != 0 {
-		if cleanName, err := cleanTagName(i.Tags[0].Name); err == nil {
-			i.Genre = cleanName
+		if cleanName, kept := cfg.TagRules.Apply(i.Tags[0].Name); kept {
+			// the most specific level of hierarchical tags, which are kept
+			levels := strings.Split(cleanName, e.TagSeparator)
+			i.Genre = levels[len(levels)-1]
 			i.Tags.RemoveFromNames(i.Genre)
 		}
 	}
@@ -215,32 +248,27 @@ func (i *Metadata) Clean(cfg e.Config) {
 
 // useAliases updates Metadata fields, using the configuration file.
 func (i *Metadata) useAliases(cfg e.Config) {
-	// author aliases
+	// author aliases, for all contributors
 	for j, author := range i.Authors {
-		for mainAlias, aliases := range cfg.AuthorAliases {
-			_, isIn := h.StringInSlice(author, aliases)
-			if isIn {
-				i.Authors[j] = mainAlias
-				break
+		i.Authors[j] = authorAlias(cfg, author)
+	}
+	for role, names := range i.Contributors {
+		for j, name := range names {
+			i.Contributors[role][j] = authorAlias(cfg, name)
+		}
+	}
+	for name, sortName := range i.SortNames {
+		if alias := authorAlias(cfg, name); alias != name {
+			delete(i.SortNames, name)
+			if _, known := i.SortNames[alias]; !known {
+				i.SortNames[alias] = sortName
 			}
 		}
 	}
 	// tag aliases
 	cleanTags := Tags{}
 	for _, tag := range i.Tags {
-		added := false
-		for mainAlias, aliases := range cfg.TagAliases {
-			_, isIn := h.StringInSlice(tag.Name, aliases)
-			if isIn {
-				cleanTags.AddFromNames(mainAlias)
-				added = true
-				break
-			}
-		}
-		// if no alias found, add directly
-		if !added {
-			cleanTags.AddFromNames(tag.Name)
-		}
+		cleanTags.Add(Tag{Name: tagAlias(cfg, tag.Name), Count: tag.Count})
 	}
 	i.Tags = cleanTags
 	// genre aliases (same as tags)
@@ -269,6 +297,57 @@ func (i *Metadata) useAliases(cfg e.Config) {
 	}
 }
 
+// SubjectCodes for the tags, according to the configuration.
+func (i *Metadata) SubjectCodes(cfg e.Config) []e.SubjectCode {
+	codes := []e.SubjectCode{}
+	for _, tag := range i.Tags {
+		code, ok := cfg.TagSubjects.Code(tag.Name)
+		if !ok {
+			continue
+		}
+		known := false
+		for _, c := range codes {
+			known = known || c == code
+		}
+		if !known {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// tagAlias returns the main alias of a tag, according to the configuration.
+func tagAlias(cfg e.Config, name string) string {
+	for mainAlias, aliases := range cfg.TagAliases {
+		if _, isIn := h.StringInSlice(name, aliases); isIn {
+			return mainAlias
+		}
+	}
+	return name
+}
+
+// CleanTags returns the current tags, after applying the tag aliases, rules
+// and hierarchy from the configuration.
+func (i *Metadata) CleanTags(cfg e.Config) Tags {
+	cleanTags := Tags{}
+	for _, tag := range i.Tags {
+		cleanTags.AddFromNames(tagAlias(cfg, tag.Name))
+	}
+	cleanTags.Clean(cfg.TagRules, "")
+	cleanTags.UseHierarchy(cfg.TagHierarchy)
+	return cleanTags
+}
+
+// authorAlias returns the main alias of a person, according to the configuration.
+func authorAlias(cfg e.Config, name string) string {
+	for mainAlias, aliases := range cfg.AuthorAliases {
+		if _, isIn := h.StringInSlice(name, aliases); isIn {
+			return mainAlias
+		}
+	}
+	return name
+}
+
 // Author returns Metadata's main author.
 func (i *Metadata) Author() string {
 	if len(i.Authors) != 0 {
@@ -277,6 +356,51 @@ func (i *Metadata) Author() string {
 	return unknown
 }
 
+// AuthorSortName returns the name used to sort by author.
+func (i *Metadata) AuthorSortName() string {
+	if len(i.Authors) == 0 {
+		return unknown
+	}
+	sortNames := []string{}
+	for _, author := range i.Authors {
+		if sortName, ok := i.SortNames[author]; ok && sortName != "" {
+			sortNames = append(sortNames, sortName)
+		} else {
+			sortNames = append(sortNames, author)
+		}
+	}
+	return strings.Join(sortNames, ", ")
+}
+
+// SetSortName for a contributor.
+func (i *Metadata) SetSortName(name, sortName string) {
+	if name == "" || sortName == "" || sortName == name {
+		return
+	}
+	if i.SortNames == nil {
+		i.SortNames = make(map[string]string)
+	}
+	i.SortNames[name] = sortName
+}
+
+// detectSeries in the title and subtitle, removing it from them unless the
+// title is only series information.
+func (i *Metadata) detectSeries() {
+	for _, title := range []*string{&i.BookTitle, &i.Subtitle} {
+		cleanTitle, series, found := parseSeriesFromTitle(*title)
+		if !found {
+			continue
+		}
+		// a title cannot be only series information, a subtitle can
+		if cleanTitle != "" || title != &i.BookTitle {
+			*title = cleanTitle
+		}
+		if hasSeries, _, _ := i.Series.Has(series[:strings.LastIndex(series, ":")]); !hasSeries {
+			i.Series.AddFromString(series)
+		}
+	}
+}
+
 // MainSeries return the main Series of Metadata.
 func (i *Metadata) MainSeries() SingleSeries {
 	if len(i.Series) != 0 {
@@ -287,8 +411,8 @@ func (i *Metadata) MainSeries() SingleSeries {
 
 // IsSimilar checks if metadata is similar to known Metadata.
 func (i *Metadata) IsSimilar(o Metadata) bool {
-	// check isbn
-	if i.ISBN != "" && o.ISBN != "" && i.ISBN == o.ISBN {
+	// check isbn and other identifiers
+	if i.HasSameIdentifier(o) {
 		return true
 	}
 	// similar == same author/title, for now
@@ -337,6 +461,8 @@ func (i *Metadata) Merge(o *Metadata, cfg e.Config, ui i.UserInterface, diffOnly
 	i.ImageURL = getLargeGRUrl(o.ImageURL)
 	i.NumPages = o.NumPages
 	i.AverageRating = o.AverageRating
+	i.mergeIdentifiers(o)
+	i.mergeWork(o)
 	i.Clean(cfg)
 	return
 }
@@ -354,13 +480,19 @@ func (i *Metadata) Get(field string) (string, error) {
 		return i.Series.rawString(), nil
 	case authorField:
 		return i.Author(), nil
+	case translatorField:
+		return i.Contributors.Get(translatorRole), nil
+	case editorField:
+		return i.Contributors.Get(editorRole), nil
+	case identifiersField:
+		return i.Identifiers.String(), nil
 	default:
 		return structField.String(), nil
 	}
 }
 
 // Set Metadata field with a string value
-func (i *Metadata) Set(field, value string) error {
+func (i *Metadata) Set(field, value string, cfg e.Config) error {
 	publicFieldName, structField, canBeSet, err := getField(i, metadataFieldMap, field)
 	if err != nil {
 		return err
@@ -388,6 +520,26 @@ func (i *Metadata) Set(field, value string) error {
 		for j := range i.Authors {
 			i.Authors[j] = strings.TrimSpace(i.Authors[j])
 		}
+	case translatorField:
+		i.Contributors.Set(translatorRole, strings.Split(value, ",")...)
+	case editorField:
+		i.Contributors.Set(editorRole, strings.Split(value, ",")...)
+	case identifiersField:
+		identifiers := []string{}
+		for _, identifier := range strings.Split(value, ",") {
+			identifier = strings.TrimSpace(identifier)
+			if identifier == "" {
+				continue
+			}
+			if !strings.Contains(identifier, ":") {
+				return errors.New("Identifiers must be in kind:value form, got: " + identifier)
+			}
+			identifiers = append(identifiers, identifier)
+		}
+		i.Identifiers = Identifiers{}
+		for _, identifier := range identifiers {
+			i.addIdentifierFromString(identifier)
+		}
 	case yearField, editionYearField:
 		// check it's a correct year
 		_, err := strconv.Atoi(value)
@@ -403,13 +555,13 @@ func (i *Metadata) Set(field, value string) error {
 		}
 		structField.SetString(isbn)
 	case categoryField:
-		cleanCategory, err := cleanCategory(value)
+		cleanCategory, err := cleanCategory(value, cfg)
 		if err != nil {
 			return err
 		}
 		structField.SetString(cleanCategory)
 	case typeField:
-		cleanType, err := cleanType(value)
+		cleanType, err := cleanType(value, cfg)
 		if err != nil {
 			return err
 		}
@@ -417,7 +569,7 @@ func (i *Metadata) Set(field, value string) error {
 	case descriptionField:
 		structField.SetString(cleanHTML(value))
 	case languageField:
-		structField.SetString(cleanLanguage(value))
+		structField.SetString(cleanLanguage(value, cfg.LanguageAliases))
 	default:
 		structField.SetString(value)
 	}
@@ -459,14 +611,34 @@ func (i *Metadata) MergeField(o *Metadata, field string, cfg e.Config, ui i.User
 		}
 		userInput, err = ui.SelectOption(title, usage, options, false)
 	case languageField:
-		listLocalAndRemoteOnly(ui, cleanLanguage(i.Language), cleanLanguage(o.Language), &options, unknown)
+		listLocalAndRemoteOnly(ui, cleanLanguage(i.Language, cfg.LanguageAliases), cleanLanguage(o.Language, cfg.LanguageAliases), &options, unknown)
 		userInput, err = ui.SelectOption(strings.Title(field), usage, options, false)
 	case categoryField:
-		options = append(options, validCategories...)
+		options = append(options, cfg.CategoryVocabulary().Terms()...)
 		CleanSliceAndTagEntries(ui, currentValue, otherValue, &options, unknown)
 		userInput, err = ui.SelectOption(strings.Title(field), usage, options, false)
 	case typeField:
-		options = append(options, validTypes...)
+		options = append(options, cfg.TypeVocabulary().Terms()...)
+		CleanSliceAndTagEntries(ui, currentValue, otherValue, &options, unknown)
+		userInput, err = ui.SelectOption(strings.Title(field), usage, options, false)
+	case titleField:
+		// also proposing titles without series information
+		options = append(options, currentValue, otherValue)
+		for _, title := range []string{currentValue, otherValue} {
+			if cleanTitle, _, found := parseSeriesFromTitle(title); found && cleanTitle != "" {
+				options = append(options, cleanTitle)
+			}
+		}
+		CleanSliceAndTagEntries(ui, currentValue, otherValue, &options, unknown)
+		userInput, err = ui.SelectOption(strings.Title(field), usage, options, false)
+	case seriesField:
+		// also proposing series found in titles
+		options = append(options, currentValue, otherValue)
+		for _, title := range []string{i.BookTitle, i.Subtitle, o.BookTitle, o.Subtitle} {
+			if _, series, found := parseSeriesFromTitle(title); found {
+				options = append(options, series)
+			}
+		}
 		CleanSliceAndTagEntries(ui, currentValue, otherValue, &options, unknown)
 		userInput, err = ui.SelectOption(strings.Title(field), usage, options, false)
 	case descriptionField:
@@ -482,7 +654,7 @@ func (i *Metadata) MergeField(o *Metadata, field string, cfg e.Config, ui i.User
 		return
 	}
 	// set the field
-	err = i.Set(field, userInput)
+	err = i.Set(field, userInput, cfg)
 	if err != nil {
 		return
 	}
@@ -500,8 +672,10 @@ func (i *Metadata) getOnlineMetadata(ui i.UserInterface, cfg e.Config) (*Metadat
 	g = GoodReads{}
 	id := ""
 
+	// use the Goodreads ID if it is already known
+	id = i.Identifiers[goodReadsIdentifier]
 	// If not ISBN is found, ask for input
-	if i.ISBN == "" {
+	if id == "" && i.ISBN == "" {
 		ui.Warning("Could not find ISBN.")
 		isbn, err := e.AskForISBN(ui)
 		if err == nil {
@@ -509,7 +683,7 @@ func (i *Metadata) getOnlineMetadata(ui i.UserInterface, cfg e.Config) (*Metadat
 		}
 	}
 	// search by ISBN preferably
-	if i.ISBN != "" {
+	if id == "" && i.ISBN != "" {
 		id, err = g.GetBookIDByISBN(i.ISBN, cfg.GoodReadsAPIKey)
 		if err != nil {
 			return nil, err
@@ -530,6 +704,7 @@ func (i *Metadata) getOnlineMetadata(ui i.UserInterface, cfg e.Config) (*Metadat
 	// get book info
 	onlineInfo, err := g.GetBook(id, cfg.GoodReadsAPIKey)
 	if err == nil {
+		onlineInfo.Tags.Clean(cfg.TagRules, e.TagSourceGoodReads)
 		onlineInfo.Clean(cfg)
 	}
 	return &onlineInfo, nil
@@ -577,6 +752,8 @@ func (i *Metadata) SearchOnline(ui i.UserInterface, cfg e.Config, fields ...stri
 				i.ImageURL = getLargeGRUrl(onlineInfo.ImageURL)
 				i.NumPages = onlineInfo.NumPages
 				i.AverageRating = onlineInfo.AverageRating
+				i.mergeIdentifiers(onlineInfo)
+				i.mergeWork(onlineInfo)
 				i.Clean(cfg)
 			}
 			validChoice = true
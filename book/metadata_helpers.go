@@ -5,32 +5,18 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
-	"strings"
+	"sort"
 
 	"github.com/kennygrant/sanitize"
 
+	e "github.com/barsanuphe/endive/endive"
 	h "github.com/barsanuphe/helpers"
 	i "github.com/barsanuphe/helpers/ui"
 )
 
-// tagAliases defines redundant tags and a main alias for them.
-var languageAliases = map[string][]string{
-	"en": []string{"en-US", "en-GB", "eng", "en-CA"},
-	"fr": []string{"fr-FR", "fre"},
-	"es": []string{"spa"},
-}
-
-func cleanLanguage(language string) (clean string) {
-	clean = strings.TrimSpace(strings.ToLower(language))
-	// reducing to main alias
-	for mainalias, aliasList := range languageAliases {
-		_, isIn := h.StringInSlice(language, aliasList)
-		if isIn {
-			clean = mainalias
-			break
-		}
-	}
-	return
+// cleanLanguage to its ISO 639 code, or to its configured main alias.
+func cleanLanguage(language string, overrides map[string][]string) string {
+	return e.NormalizeLanguage(language, overrides)
 }
 
 // GR information return the "medium" version of the cover url. This generates the "large" URL.
@@ -43,101 +29,39 @@ func getLargeGRUrl(url string) string {
 	return url
 }
 
-// remove shelf names that are obviously not genres
-var forbiddenTags = []string{
-	"own", "school", "favorite", "favourite", "book", "adult",
-	"read", "kindle", "borrowed", "classic", "buy",
-	"star", "release", "wait", "soon", "wish", "published", "want",
-	"tbr", "series", "finish", "to-", "not-", "library", "audible",
-	"coming", "anticipated", "default", "recommended", "-list", "sequel",
-	"general", "have", "bundle", "maybe", "audio", "podcast", "calibre", "bks",
-	"moved-on", "record", "arc", "z-", "livre", "unsorted", "gave-up", "abandoned",
-	"retelling", "middle-grade", "collection", "english", "netgalley", "available",
-	"list", "stand-alone", "meh", "amazon", "paperback", "giveaways", "review-copy",
-	"check", "queue", "dnf",
-}
-
-func cleanTags(tags Tags) (cleanTags Tags) {
+// cleanTags using the tag rules. If a source is given, only its most popular tags are kept.
+func cleanTags(tags Tags, rules e.TagRules, source string) (cleanTags Tags) {
 	cleanTags = Tags{}
 	for _, tag := range tags {
-		cleanName, err := cleanTagName(tag.Name)
-		if err == nil {
-			cleanTags.Add(Tag{Name: cleanName})
+		if cleanName, kept := rules.Apply(tag.Name); kept {
+			cleanTags.Add(Tag{Name: cleanName, Count: tag.Count})
 		}
 	}
-	// NOTE: this limit is completely arbitrary
-	// only keep top10 tags, since they are ordered by popularity and will be increasingly wrong.
-	if len(cleanTags) > 10 {
-		cleanTags = cleanTags[:10]
+	if source == "" {
+		return
 	}
-	return
-}
-
-func cleanTagName(tagName string) (cleanTagName string, err error) {
-	tagName = strings.TrimSpace(tagName)
-	tagName = strings.ToLower(tagName)
-	// checking if not forbidden
-	for _, ft := range forbiddenTags {
-		if strings.Contains(tagName, ft) {
-			err = errors.New("Forbidden tag " + tagName)
-			break
-		}
-	}
-	// returning if not forbidden
-	if err == nil {
-		cleanTagName = tagName
-	}
-	if cleanTagName == "" {
-		err = errors.New("Tag cannot be empty string")
+	// Goodreads shelves are ordered by popularity, and will be increasingly wrong.
+	sort.SliceStable(cleanTags, func(j, k int) bool { return cleanTags[j].Count > cleanTags[k].Count })
+	if limit := rules.Limit(source); limit != 0 && len(cleanTags) > limit {
+		cleanTags = cleanTags[:limit]
 	}
 	return
 }
 
-// categoryAliases replaces category tags with the canonical version
-var categoryAliases = map[string][]string{
-	fiction:    []string{"fiction", "fic"},
-	nonfiction: []string{"non fiction", "non-fiction", "nonfiction", "nonfic"},
-}
-
-func cleanCategory(category string) (string, error) {
-	clean := strings.TrimSpace(strings.ToLower(category))
-	// reducing to main alias
-	for mainalias, aliasList := range categoryAliases {
-		if _, isIn := h.StringInSlice(clean, aliasList); isIn {
-			clean = mainalias
-			break
-		}
-	}
-	// testing if valid
-	if _, isIn := h.StringInSlice(clean, validCategories); !isIn {
-		return "", errors.New("Invalid category " + category)
+// cleanCategory using the categories defined in the configuration file.
+func cleanCategory(category string, cfg e.Config) (string, error) {
+	clean, err := cfg.CategoryVocabulary().Clean(category)
+	if err != nil {
+		return "", errors.New("Invalid category: " + err.Error())
 	}
 	return clean, nil
 }
 
-// typeAliases replaces category tags with the canonical version
-var typeAliases = map[string][]string{
-	essay:         []string{"essay"},
-	biography:     []string{"biography"},
-	autobiography: []string{"autobiography"},
-	novel:         []string{"novel"},
-	shortstory:    []string{"shortstory", "short story", "short-story", "novella", "short-stories", "shortstories"},
-	anthology:     []string{"anthology", "anthologies"},
-	poetry:        []string{"poetry", "poems"},
-}
-
-func cleanType(typ string) (string, error) {
-	clean := strings.TrimSpace(strings.ToLower(typ))
-	// reducing to main alias
-	for mainalias, aliasList := range typeAliases {
-		if _, isIn := h.StringInSlice(clean, aliasList); isIn {
-			clean = mainalias
-			break
-		}
-	}
-	// testing if valid
-	if _, isIn := h.StringInSlice(clean, validTypes); !isIn {
-		return "", errors.New("Invalid type " + typ)
+// cleanType using the types defined in the configuration file.
+func cleanType(typ string, cfg e.Config) (string, error) {
+	clean, err := cfg.TypeVocabulary().Clean(typ)
+	if err != nil {
+		return "", errors.New("Invalid type: " + err.Error())
 	}
 	return clean, nil
 }
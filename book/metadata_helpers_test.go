@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	en "github.com/barsanuphe/endive/endive"
 	u "github.com/barsanuphe/helpers/ui"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,13 +17,16 @@ var languages = []struct {
 	{"engg", "engg"},
 	{"fre", "fr"},
 	{"fr", "fr"},
+	{"de-DE", "de"},
+	{"German", "de"},
+	{"fr-CA", "fr-ca"},
 }
 
 func TestEpubCleanLanguages(t *testing.T) {
 	fmt.Println("+ Testing Info/CleanLanguages()...")
 	assert := assert.New(t)
 	for _, c := range languages {
-		lg := cleanLanguage(c.candidate)
+		lg := cleanLanguage(c.candidate, standardTestConfig.LanguageAliases)
 		assert.Equal(c.expected, lg, "Error cleaning language")
 	}
 }
@@ -34,37 +38,44 @@ const (
 func TestCleanCategory(t *testing.T) {
 	fmt.Println("+ Testing Info/TestCleanCategory()...")
 	assert := assert.New(t)
-	clean, err := cleanCategory(fiction)
+	clean, err := cleanCategory(fiction, standardTestConfig)
 	assert.Nil(err, validCategory)
 	assert.Equal(fiction, clean)
 	for _, nf := range []string{nonfiction, " " + nonfiction + "    ", "non fiction", "non-Fiction"} {
-		clean, err = cleanCategory(nf)
+		clean, err = cleanCategory(nf, standardTestConfig)
 		assert.Nil(err, validCategory)
 		assert.Equal(nonfiction, clean)
 	}
-	_, err = cleanCategory("invalid category")
+	_, err = cleanCategory("invalid category", standardTestConfig)
 	assert.NotNil(err, "invalid category entered")
 }
 
 func TestCleanType(t *testing.T) {
 	fmt.Println("+ Testing Info/TestCleanType()...")
 	assert := assert.New(t)
-	for _, v := range validTypes {
-		clean, err := cleanType(v)
+	for _, v := range standardTestConfig.TypeVocabulary().Terms() {
+		clean, err := cleanType(v, standardTestConfig)
 		assert.Nil(err, "valid type entered")
 		assert.Equal(v, clean)
 	}
-	clean, err := cleanType(" " + essay + "    ")
+	clean, err := cleanType(" "+essay+"    ", standardTestConfig)
 	assert.Nil(err, "valid category entered")
 	assert.Equal(essay, clean)
-	_, err = cleanType("invalid type")
+	_, err = cleanType("invalid type", standardTestConfig)
 	assert.NotNil(err, "invalid category entered")
-	clean, err = cleanType("short-story")
+	clean, err = cleanType("short-story", standardTestConfig)
 	assert.Nil(err, "valid type entered")
 	assert.Equal(shortstory, clean)
-	clean, err = cleanType("novella")
+	clean, err = cleanType("novella", standardTestConfig)
 	assert.Nil(err, "valid type entered")
 	assert.Equal(shortstory, clean)
+	// configured types
+	cfg := en.Config{Types: en.Vocabulary{"graphic novel": {"comics", "bd"}, novel: nil}}
+	clean, err = cleanType("BD", cfg)
+	assert.Nil(err, "valid type entered")
+	assert.Equal("graphic novel", clean)
+	_, err = cleanType(essay, cfg)
+	assert.NotNil(err, "essay is not a configured type")
 }
 
 func TestGetLargeImgURL(t *testing.T) {
@@ -63,22 +63,22 @@ func TestMetadataSetGet(t *testing.T) {
 	e := NewBook(ui, 0, epubs[0].filename, standardTestConfig, isRetail)
 
 	// set unknown field
-	err := e.Metadata.Set("rating", "a")
+	err := e.Metadata.Set("rating", "a", standardTestConfig)
 	assert.NotNil(err, invalidValue)
 	// get unknown field
 	_, err = e.Metadata.Get("ratingg")
 	assert.NotNil(err, invalidFieldT)
 
 	// Set ISBN
-	err = e.Metadata.Set("ISBN", "hihi")
+	err = e.Metadata.Set("ISBN", "hihi", standardTestConfig)
 	assert.NotNil(err, invalidValue)
-	err = e.Metadata.Set("isbn", "9780340839935")
+	err = e.Metadata.Set("isbn", "9780340839935", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("9780340839935", e.Metadata.ISBN)
-	err = e.Metadata.Set("isbn", "0340839937")
+	err = e.Metadata.Set("isbn", "0340839937", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("9780340839935", e.Metadata.ISBN)
-	err = e.Metadata.Set("ISBN", "9-78-0-4--410-13-593")
+	err = e.Metadata.Set("ISBN", "9-78-0-4--410-13-593", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("9780441013593", e.Metadata.ISBN)
 	// get isbn
@@ -86,15 +86,23 @@ func TestMetadataSetGet(t *testing.T) {
 	assert.Nil(err, validField)
 	assert.Equal("9780441013593", value)
 
+	// set language, with the configured aliases
+	err = e.Metadata.Set(languageField, "fr-CA", standardTestConfig)
+	assert.Nil(err)
+	assert.Equal("fr-ca", e.Metadata.Language)
+	err = e.Metadata.Set(languageField, "German", standardTestConfig)
+	assert.Nil(err)
+	assert.Equal("de", e.Metadata.Language)
+
 	// Set Tags
-	err = e.Metadata.Set(tagsField, sampleTags)
+	err = e.Metadata.Set(tagsField, sampleTags, standardTestConfig)
 	assert.Nil(err)
 	assert.Equal(2, len(e.Metadata.Tags), "Metadata should have 2 tags")
 	assert.Equal(strings.ToLower(sampleTags), e.Metadata.Tags.String())
-	err = e.Metadata.Set(tagsField, "")
+	err = e.Metadata.Set(tagsField, "", standardTestConfig)
 	assert.Nil(err)
 	assert.Equal("", e.Metadata.Tags.String())
-	err = e.Metadata.Set(tagsField, sampleTags)
+	err = e.Metadata.Set(tagsField, sampleTags, standardTestConfig)
 	assert.Nil(err)
 	// get tags
 	value, err = e.Metadata.Get(tagsField)
@@ -102,26 +110,26 @@ func TestMetadataSetGet(t *testing.T) {
 	assert.Equal(strings.ToLower(sampleTags), value)
 
 	// Set Series
-	err = e.Metadata.Set(seriesField, "hihi, HOHO")
+	err = e.Metadata.Set(seriesField, "hihi, HOHO", standardTestConfig)
 	assert.Nil(err)
 	assert.Equal(2, len(e.Metadata.Series), "Metadata should have 2 series")
 	assert.Equal("hihi #0, HOHO #0", e.Metadata.Series.String())
-	err = e.Metadata.Set(seriesField, "hihi:0.5, HOHO:7-9")
+	err = e.Metadata.Set(seriesField, "hihi:0.5, HOHO:7-9", standardTestConfig)
 	assert.Nil(err)
 	assert.Equal(2, len(e.Metadata.Series), "Metadata should have 2 series")
 	assert.Equal("hihi #0.5, HOHO #7,8,9", e.Metadata.Series.String())
-	err = e.Metadata.Set(seriesField, "")
+	err = e.Metadata.Set(seriesField, "", standardTestConfig)
 	assert.Nil(err)
 	assert.Equal("", e.Metadata.Series.String())
 	// get Series
-	err = e.Metadata.Set(seriesField, "hihi:0.5, HOHO:7-9")
+	err = e.Metadata.Set(seriesField, "hihi:0.5, HOHO:7-9", standardTestConfig)
 	assert.Nil(err)
 	value, err = e.Metadata.Get(seriesField)
 	assert.Nil(err, validField)
 	assert.Equal("hihi:0.5, HOHO:7,8,9", value)
 
 	// Set authors
-	err = e.Metadata.Set(authorField, "  hihi , HOHO  ")
+	err = e.Metadata.Set(authorField, "  hihi , HOHO  ", standardTestConfig)
 	assert.Nil(err)
 	assert.Equal(2, len(e.Metadata.Authors), "Metadata should have 2 authors")
 	assert.Equal("hihi, HOHO", e.Metadata.Author())
@@ -131,9 +139,9 @@ func TestMetadataSetGet(t *testing.T) {
 	assert.Equal("hihi, HOHO", value)
 
 	// Set years
-	err = e.Metadata.Set(yearField, "hihi")
+	err = e.Metadata.Set(yearField, "hihi", standardTestConfig)
 	assert.NotNil(err, invalidValue)
-	err = e.Metadata.Set(editionYearField, "2013")
+	err = e.Metadata.Set(editionYearField, "2013", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("2013", e.Metadata.EditionYear)
 	// get years
@@ -142,40 +150,40 @@ func TestMetadataSetGet(t *testing.T) {
 	assert.Equal("2013", value)
 
 	// Set category
-	err = e.Metadata.Set("category", "hihi")
+	err = e.Metadata.Set("category", "hihi", standardTestConfig)
 	assert.NotNil(err, invalidValue)
-	err = e.Metadata.Set("category", "NonFiction")
+	err = e.Metadata.Set("category", "NonFiction", standardTestConfig)
 	assert.Nil(err, validValue)
-	for _, vc := range validCategories {
-		err = e.Metadata.Set("category", vc)
+	for _, vc := range standardTestConfig.CategoryVocabulary().Terms() {
+		err = e.Metadata.Set("category", vc, standardTestConfig)
 		assert.Nil(err, validValue)
 		assert.Equal(vc, e.Metadata.Category)
 	}
 
 	// Set type
-	err = e.Metadata.Set("type", "hihi")
+	err = e.Metadata.Set("type", "hihi", standardTestConfig)
 	assert.NotNil(err, invalidValue)
-	for _, vt := range validTypes {
-		err = e.Metadata.Set("type", vt)
+	for _, vt := range standardTestConfig.TypeVocabulary().Terms() {
+		err = e.Metadata.Set("type", vt, standardTestConfig)
 		assert.Nil(err, validValue)
 		assert.Equal(vt, e.Metadata.Type)
 	}
 
 	// Set description
-	err = e.Metadata.Set("description", "simple description")
+	err = e.Metadata.Set("description", "simple description", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("simple description", e.Metadata.Description)
-	err = e.Metadata.Set("description", `simple <a href="link">description</a>`)
+	err = e.Metadata.Set("description", `simple <a href="link">description</a>`, standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("simple description", e.Metadata.Description)
 
 	// Set language
-	err = e.Metadata.Set("language", "eng")
+	err = e.Metadata.Set("language", "eng", standardTestConfig)
 	assert.Nil(err, validValue)
 	assert.Equal("en", e.Metadata.Language)
 
 	// set simple field
-	err = e.Metadata.Set("publisher", "m. publisher")
+	err = e.Metadata.Set("publisher", "m. publisher", standardTestConfig)
 	assert.Nil(err, validValue)
 
 }
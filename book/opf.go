@@ -0,0 +1,578 @@
+package book
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+const (
+	containerPath = "META-INF/container.xml"
+	mimetypePath  = "mimetype"
+
+	dcNamespace  = "http://purl.org/dc/elements/1.1/"
+	opfNamespace = "http://www.idpf.org/2007/opf"
+
+	calibreSeries      = "calibre:series"
+	calibreSeriesIndex = "calibre:series_index"
+	dctermsModified    = "dcterms:modified"
+)
+
+// dcManagedElements are the dc: elements rewritten from Metadata.
+var dcManagedElements = []string{"title", "creator", "language", "publisher", "description", "subject"}
+
+// container is the META-INF/container.xml file, pointing to the OPF.
+type container struct {
+	Rootfiles []struct {
+		FullPath  string `xml:"full-path,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// opfPackage holds the few root attributes of the OPF package document needed to edit it.
+type opfPackage struct {
+	Version          string `xml:"version,attr"`
+	UniqueIdentifier string `xml:"unique-identifier,attr"`
+}
+
+// opfDocument is the part of the OPF package document describing its contents.
+type opfDocument struct {
+	opfPackage
+	Metas []opfMeta `xml:"metadata>meta"`
+	Items []opfItem `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+// opfMeta is a meta element, in EPUB2 (name/content) or EPUB3 (property/refines) form.
+type opfMeta struct {
+	Name     string `xml:"name,attr"`
+	Content  string `xml:"content,attr"`
+	Property string `xml:"property,attr"`
+	Refines  string `xml:"refines,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// opfItem is a file declared in the OPF manifest.
+type opfItem struct {
+	ID         string `xml:"id,attr"`
+	Href       string `xml:"href,attr"`
+	MediaType  string `xml:"media-type,attr"`
+	Properties string `xml:"properties,attr"`
+}
+
+// hasProperty checks if a manifest item has an EPUB3 property.
+func (o opfItem) hasProperty(property string) bool {
+	for _, p := range strings.Fields(o.Properties) {
+		if p == property {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOPFDocument from a raw OPF file.
+func parseOPFDocument(opf []byte) (doc opfDocument, err error) {
+	err = newOPFDecoder(opf).Decode(&doc)
+	return
+}
+
+// itemPath returns the path of a manifest item inside the epub.
+func itemPath(opfPath, href string) string {
+	if unescaped, err := url.QueryUnescape(href); err == nil {
+		href = unescaped
+	}
+	return path.Join(path.Dir(opfPath), href)
+}
+
+// isEpub3 checks the package version.
+func (p opfPackage) isEpub3() bool {
+	return strings.HasPrefix(p.Version, "3")
+}
+
+// readZipFile returns the contents of a file inside an epub.
+func readZipFile(r *zip.Reader, name string) ([]byte, error) {
+	for _, f := range r.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			return ioutil.ReadAll(rc)
+		}
+	}
+	return nil, errors.New("Could not find " + name + " in epub")
+}
+
+// findOPFPath from the epub container.
+func findOPFPath(r *zip.Reader) (string, error) {
+	data, err := readZipFile(r, containerPath)
+	if err != nil {
+		return "", err
+	}
+	var c container
+	if err := xml.Unmarshal(data, &c); err != nil {
+		return "", err
+	}
+	for _, rf := range c.Rootfiles {
+		if rf.MediaType == "" || rf.MediaType == "application/oebps-package+xml" {
+			return rf.FullPath, nil
+		}
+	}
+	return "", errors.New("Could not find OPF file in epub container")
+}
+
+// readOPF returns the path and contents of the OPF file of an epub.
+func readOPF(path string) (opfPath string, opf []byte, err error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	opfPath, err = findOPFPath(&r.Reader)
+	if err != nil {
+		return
+	}
+	opf, err = readZipFile(&r.Reader, opfPath)
+	return
+}
+
+// writeEpubWithOPF copies the epub src to dst, replacing the OPF file contents.
+func writeEpubWithOPF(src, dst, opfPath string, opf []byte) error {
+	return rewriteEpub(src, dst, map[string][]byte{opfPath: opf})
+}
+
+// rewriteEpub copies the epub src to dst, replacing or adding the given files.
+// The mimetype file is kept first and uncompressed, as required by the spec.
+func rewriteEpub(src, dst string, replacements map[string][]byte) (err error) {
+	r, err := zip.OpenReader(src)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	w := zip.NewWriter(out)
+
+	written := make(map[string]bool)
+	// mimetype first
+	files := []*zip.File{}
+	for _, f := range r.File {
+		if f.Name == mimetypePath {
+			files = append([]*zip.File{f}, files...)
+		} else {
+			files = append(files, f)
+		}
+	}
+	for _, f := range files {
+		header := f.FileHeader
+		if f.Name == mimetypePath {
+			header.Method = zip.Store
+		}
+		fw, err := w.CreateHeader(&header)
+		if err != nil {
+			return err
+		}
+		if contents, ok := replacements[f.Name]; ok {
+			if _, err := fw.Write(contents); err != nil {
+				return err
+			}
+			written[f.Name] = true
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(fw, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	// new files
+	for name, contents := range replacements {
+		if written[name] {
+			continue
+		}
+		fw, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(contents); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// opfElement is a direct child of the OPF metadata element, with its position in the raw file.
+type opfElement struct {
+	name       xml.Name
+	attributes map[string]string
+	text       string
+	start      int64
+	end        int64
+}
+
+// attr returns an attribute value, ignoring its namespace.
+func (o opfElement) attr(name string) string {
+	return o.attributes[name]
+}
+
+// opfMetadataBlock locates the metadata element and its children in a raw OPF file.
+type opfMetadataBlock struct {
+	pkg       opfPackage
+	tagStart  int64
+	tagEnd    int64
+	children  []opfElement
+	hasDCNS   bool
+	hasOPFNS  bool
+	selfClose bool
+}
+
+func newOPFDecoder(opf []byte) *xml.Decoder {
+	d := xml.NewDecoder(bytes.NewReader(opf))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	return d
+}
+
+// parseOPFMetadataBlock finds where the metadata and its children are in the OPF.
+func parseOPFMetadataBlock(opf []byte) (block opfMetadataBlock, err error) {
+	if err = xml.Unmarshal(opf, &block.pkg); err != nil {
+		return
+	}
+	d := newOPFDecoder(opf)
+	depth := 0
+	inMetadata := false
+	found := false
+	var current opfElement
+	for {
+		offset := d.InputOffset()
+		tok, tokErr := d.Token()
+		if tokErr == io.EOF {
+			break
+		}
+		if tokErr != nil {
+			return block, tokErr
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && t.Name.Local == "metadata" && !found {
+				inMetadata = true
+				found = true
+				block.tagStart = offset
+				block.tagEnd = d.InputOffset()
+				block.selfClose = bytes.HasSuffix(bytes.TrimSpace(opf[offset:block.tagEnd]), []byte("/>"))
+				for _, a := range t.Attr {
+					if a.Name.Space == "xmlns" && a.Value == dcNamespace {
+						block.hasDCNS = true
+					}
+					if a.Name.Space == "xmlns" && a.Value == opfNamespace {
+						block.hasOPFNS = true
+					}
+				}
+			} else if inMetadata && depth == 3 {
+				current = opfElement{name: t.Name, attributes: make(map[string]string), start: offset}
+				for _, a := range t.Attr {
+					current.attributes[a.Name.Local] = a.Value
+				}
+			}
+		case xml.CharData:
+			if inMetadata && depth == 3 {
+				current.text += string(t)
+			}
+		case xml.EndElement:
+			if inMetadata && depth == 3 {
+				current.end = d.InputOffset()
+				block.children = append(block.children, current)
+			}
+			if inMetadata && depth == 2 {
+				inMetadata = false
+			}
+			depth--
+		}
+	}
+	if !found {
+		return block, errors.New("Could not find metadata in OPF")
+	}
+	// namespaces can also be declared on the package element.
+	header := opf[:block.tagStart]
+	block.hasDCNS = block.hasDCNS || bytes.Contains(header, []byte(`"`+dcNamespace+`"`)) || bytes.Contains(header, []byte(`'`+dcNamespace+`'`))
+	block.hasOPFNS = block.hasOPFNS || bytes.Contains(header, []byte(`xmlns:opf=`))
+	return
+}
+
+// isManaged checks if a metadata element is regenerated from Metadata.
+func (block opfMetadataBlock) isManaged(el opfElement) bool {
+	if el.name.Space == dcNamespace || el.name.Space == "" {
+		for _, name := range dcManagedElements {
+			if el.name.Local == name {
+				return true
+			}
+		}
+		switch el.name.Local {
+		case "date":
+			// keep dates associated with other events (conversion, modification...)
+			event := el.attr("event")
+			return event == "" || event == "publication"
+		case "identifier":
+			// the unique identifier must stay untouched
+			if block.pkg.UniqueIdentifier != "" && el.attr("id") == block.pkg.UniqueIdentifier {
+				return false
+			}
+			// only ISBNs are managed
+			_, err := e.CleanISBN(el.text)
+			return strings.ToLower(el.attr("scheme")) == "isbn" || err == nil
+		}
+	}
+	if el.name.Local == "meta" {
+		switch el.attr("name") {
+		case calibreSeries, calibreSeriesIndex:
+			return true
+		}
+		switch el.attr("property") {
+		case dctermsModified:
+			return true
+		case belongsToCollection:
+			// only top-level collections, their refinements are dropped with them
+			return el.attr("refines") == ""
+		}
+	}
+	return false
+}
+
+// writeOPFElement writes a single element, escaping its text.
+func writeOPFElement(buf *bytes.Buffer, name, attributes, text string) {
+	buf.WriteString("\n    <" + name)
+	if attributes != "" {
+		buf.WriteString(" " + attributes)
+	}
+	buf.WriteString(">")
+	xml.EscapeText(buf, []byte(text))
+	buf.WriteString("</" + name + ">")
+}
+
+// escapeAttr for an attribute value.
+func escapeAttr(value string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
+
+// updateOPFMetadata replaces the metadata managed by endive in a raw OPF file.
+// Other elements, and the rest of the file, are left untouched.
+func updateOPFMetadata(opf []byte, info Metadata) ([]byte, error) {
+	block, err := parseOPFMetadataBlock(opf)
+	if err != nil {
+		return opf, err
+	}
+	if block.selfClose {
+		return opf, errors.New("Empty metadata element in OPF, cannot update")
+	}
+	epub3 := block.pkg.isEpub3()
+
+	// first pass: find which elements are dropped, and their ids
+	dropped := make(map[int]bool)
+	droppedIDs := make(map[string]bool)
+	keptISBN := false
+	for j, el := range block.children {
+		// contributors are only replaced if some are known, to avoid losing them
+		if block.isManaged(el) || (isDC(el, "contributor") && len(info.Contributors) != 0) {
+			dropped[j] = true
+			if id := el.attr("id"); id != "" {
+				droppedIDs["#"+id] = true
+			}
+		} else if el.name.Local == "identifier" && info.ISBN != "" && strings.Contains(el.text, info.ISBN) {
+			keptISBN = true
+		}
+	}
+	// EPUB3 refinements of dropped elements are dropped too
+	for j, el := range block.children {
+		if el.name.Local == "meta" && droppedIDs[el.attr("refines")] {
+			dropped[j] = true
+		}
+	}
+
+	var buf bytes.Buffer
+	// start tag, adding missing namespaces
+	startTag := string(opf[block.tagStart:block.tagEnd])
+	extraNS := ""
+	if !block.hasDCNS {
+		extraNS += ` xmlns:dc="` + dcNamespace + `"`
+	}
+	if !block.hasOPFNS && !epub3 {
+		extraNS += ` xmlns:opf="` + opfNamespace + `"`
+	}
+	if extraNS != "" {
+		startTag = strings.TrimSuffix(startTag, ">") + extraNS + ">"
+	}
+	buf.Write(opf[:block.tagStart])
+	buf.WriteString(startTag)
+
+	// kept elements
+	previousEnd := block.tagEnd
+	for j, el := range block.children {
+		if !dropped[j] {
+			buf.Write(opf[previousEnd:el.end])
+		}
+		previousEnd = el.end
+	}
+
+	// new elements
+	if epub3 && info.Subtitle != "" {
+		writeOPFElement(&buf, "dc:title", `id="endive-title"`, info.Title())
+		writeOPFElement(&buf, "meta", `refines="#endive-title" property="title-type"`, titleTypeMain)
+		writeOPFElement(&buf, "dc:title", `id="endive-subtitle"`, info.Subtitle)
+		writeOPFElement(&buf, "meta", `refines="#endive-subtitle" property="title-type"`, titleTypeSubtitle)
+	} else {
+		writeOPFElement(&buf, "dc:title", "", info.Title())
+	}
+	creatorID := 0
+	writeCreator := func(element, role, name string) {
+		sortName := info.SortNames[name]
+		if epub3 {
+			creatorID++
+			id := fmt.Sprintf("endive-creator%d", creatorID)
+			writeOPFElement(&buf, element, `id="`+id+`"`, name)
+			writeOPFElement(&buf, "meta", `refines="#`+id+`" property="role" scheme="marc:relators"`, role)
+			if sortName != "" {
+				writeOPFElement(&buf, "meta", `refines="#`+id+`" property="file-as"`, sortName)
+			}
+		} else {
+			attributes := `opf:role="` + role + `"`
+			if sortName != "" {
+				attributes += ` opf:file-as="` + escapeAttr(sortName) + `"`
+			}
+			writeOPFElement(&buf, element, attributes, name)
+		}
+	}
+	for _, author := range info.Authors {
+		writeCreator("dc:creator", authorRole, author)
+	}
+	roles := []string{}
+	for role := range info.Contributors {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		for _, name := range info.Contributors[role] {
+			writeCreator("dc:contributor", role, name)
+		}
+	}
+	if info.Language != "" {
+		writeOPFElement(&buf, "dc:language", "", info.Language)
+	}
+	if info.Publisher != "" {
+		writeOPFElement(&buf, "dc:publisher", "", info.Publisher)
+	}
+	if info.Description != "" {
+		writeOPFElement(&buf, "dc:description", "", info.Description)
+	}
+	if info.EditionYear != "" && info.EditionYear != unknownYear {
+		if epub3 {
+			writeOPFElement(&buf, "dc:date", "", info.EditionYear)
+		} else {
+			writeOPFElement(&buf, "dc:date", `opf:event="publication"`, info.EditionYear)
+		}
+	}
+	if info.Genre != "" && info.Genre != unknown {
+		writeOPFElement(&buf, "dc:subject", "", info.Genre)
+	}
+	for _, tag := range info.Tags {
+		writeOPFElement(&buf, "dc:subject", "", tag.Name)
+	}
+	if info.ISBN != "" && !keptISBN {
+		if epub3 {
+			writeOPFElement(&buf, "dc:identifier", "", "urn:isbn:"+info.ISBN)
+		} else {
+			writeOPFElement(&buf, "dc:identifier", `opf:scheme="ISBN"`, info.ISBN)
+		}
+	}
+	if epub3 {
+		for j, series := range info.Series {
+			id := fmt.Sprintf("endive-series%d", j+1)
+			writeOPFElement(&buf, "meta", `property="`+belongsToCollection+`" id="`+id+`"`, series.Name)
+			writeOPFElement(&buf, "meta", `refines="#`+id+`" property="collection-type"`, "series")
+			if series.Position != "" {
+				writeOPFElement(&buf, "meta", `refines="#`+id+`" property="group-position"`, strings.Split(series.Position, ",")[0])
+			}
+		}
+	}
+	if info.Series.HasAny() {
+		main := info.MainSeries()
+		buf.WriteString("\n    <meta name=\"" + calibreSeries + "\" content=\"" + escapeAttr(main.Name) + "\"/>")
+		if main.Position != "" {
+			// only the first index is relevant for a single book
+			position := strings.Split(main.Position, ",")[0]
+			buf.WriteString("\n    <meta name=\"" + calibreSeriesIndex + "\" content=\"" + escapeAttr(position) + "\"/>")
+		}
+	}
+	if epub3 {
+		writeOPFElement(&buf, "meta", `property="`+dctermsModified+`"`, time.Now().UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	// rest of the file, from the last child
+	buf.Write(opf[previousEnd:])
+	return buf.Bytes(), nil
+}
+
+// addOPFSubjects adds standard subject codes to the metadata of a raw OPF file.
+// With EPUB3, refinements give the authority of each code.
+func addOPFSubjects(opf []byte, codes []e.SubjectCode) ([]byte, error) {
+	block, err := parseOPFMetadataBlock(opf)
+	if err != nil {
+		return opf, err
+	}
+	if block.selfClose {
+		return opf, errors.New("Empty metadata element in OPF, cannot update")
+	}
+	insertAt := block.tagEnd
+	if len(block.children) != 0 {
+		insertAt = block.children[len(block.children)-1].end
+	}
+
+	var buf bytes.Buffer
+	buf.Write(opf[:block.tagStart])
+	startTag := string(opf[block.tagStart:block.tagEnd])
+	if !block.hasDCNS {
+		startTag = strings.TrimSuffix(startTag, ">") + ` xmlns:dc="` + dcNamespace + `">`
+	}
+	buf.WriteString(startTag)
+	buf.Write(opf[block.tagEnd:insertAt])
+	for j, code := range codes {
+		if block.pkg.isEpub3() {
+			id := fmt.Sprintf("endive-subject%d", j+1)
+			writeOPFElement(&buf, "dc:subject", `id="`+id+`"`, code.Code)
+			writeOPFElement(&buf, "meta", `refines="#`+id+`" property="authority"`, code.Authority)
+			writeOPFElement(&buf, "meta", `refines="#`+id+`" property="term"`, code.Code)
+		} else {
+			writeOPFElement(&buf, "dc:subject", "", code.Code)
+		}
+	}
+	buf.Write(opf[insertAt:])
+	return buf.Bytes(), nil
+}
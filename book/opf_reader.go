@@ -0,0 +1,279 @@
+package book
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+const (
+	titleTypeMain     = "main"
+	titleTypeSubtitle = "subtitle"
+
+	belongsToCollection = "belongs-to-collection"
+	collectionTypeSet   = "set"
+)
+
+// yearRegexp finds the first standalone 4-digit number in a date.
+var yearRegexp = regexp.MustCompile(`(?:^|[^0-9])([0-9]{4})(?:[^0-9]|$)`)
+
+// opfCreator is a dc:creator or dc:contributor, with its role and sort name.
+type opfCreator struct {
+	Name   string
+	FileAs string
+	Role   string
+}
+
+// opfDate is a dc:date and the event it is associated with.
+type opfDate struct {
+	Value string
+	Event string
+}
+
+// opfIdentifier is a dc:identifier and its scheme, if known.
+type opfIdentifier struct {
+	Value  string
+	Scheme string
+}
+
+// opfMetadata is what can be read from the metadata of an EPUB2 or EPUB3 OPF.
+type opfMetadata struct {
+	Title       string
+	Subtitle    string
+	Creators    []opfCreator
+	Language    string
+	Description string
+	Publisher   string
+	Subjects    []string
+	Dates       []opfDate
+	Modified    string
+	Identifiers []opfIdentifier
+	Sources     []string
+	Series      Series
+}
+
+// isDC checks if an element is in the Dublin Core namespace.
+// Broken epubs may use the dc prefix without declaring it.
+func isDC(el opfElement, name string) bool {
+	return el.name.Local == name && (el.name.Space == dcNamespace || el.name.Space == "dc" || el.name.Space == "")
+}
+
+// cleanOPFText collapses whitespace in element values.
+func cleanOPFText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// parseYear returns the year of a date, in any of the usual formats.
+func parseYear(date string) (string, error) {
+	for _, match := range yearRegexp.FindAllStringSubmatch(date, -1) {
+		// calibre uses 0101 for unknown dates
+		if year, err := strconv.Atoi(match[1]); err == nil && year >= 1000 {
+			return match[1], nil
+		}
+	}
+	return "", errors.New("No year found in date " + date)
+}
+
+// parseOPFMetadata reads the metadata of a raw OPF file, taking EPUB3 refinements into account.
+func parseOPFMetadata(opf []byte) (info opfMetadata, err error) {
+	block, err := parseOPFMetadataBlock(opf)
+	if err != nil {
+		return
+	}
+	// EPUB3: <meta refines="#id" property="...">, indexed by id
+	refinements := make(map[string]map[string]string)
+	for _, el := range block.children {
+		if el.name.Local == "meta" && strings.HasPrefix(el.attr("refines"), "#") {
+			id := strings.TrimPrefix(el.attr("refines"), "#")
+			if _, ok := refinements[id]; !ok {
+				refinements[id] = make(map[string]string)
+			}
+			// only keeping the first value of a property
+			if _, ok := refinements[id][el.attr("property")]; !ok {
+				refinements[id][el.attr("property")] = cleanOPFText(el.text)
+			}
+		}
+	}
+	// refined returns an EPUB2 attribute, or its EPUB3 equivalent.
+	refined := func(el opfElement, attribute, property string) string {
+		if value := el.attr(attribute); value != "" {
+			return strings.TrimSpace(value)
+		}
+		if id := el.attr("id"); id != "" {
+			return refinements[id][property]
+		}
+		return ""
+	}
+
+	var untypedTitles []string
+	collections := make(map[string]bool)
+	calibreSeriesName, calibreSeriesPosition := "", ""
+	for _, el := range block.children {
+		text := cleanOPFText(el.text)
+		switch {
+		case isDC(el, "title"):
+			switch strings.ToLower(refined(el, "title-type", "title-type")) {
+			case titleTypeMain:
+				if info.Title == "" {
+					info.Title = text
+				}
+			case titleTypeSubtitle:
+				if info.Subtitle == "" {
+					info.Subtitle = text
+				}
+			case "":
+				untypedTitles = append(untypedTitles, text)
+			}
+		case isDC(el, "creator"), isDC(el, "contributor"):
+			creator := opfCreator{Name: text, FileAs: refined(el, "file-as", "file-as"), Role: strings.ToLower(refined(el, "role", "role"))}
+			// creators are authors unless stated otherwise
+			if creator.Role == "" && el.name.Local == "creator" {
+				creator.Role = authorRole
+			}
+			if creator.Name != "" {
+				info.Creators = append(info.Creators, creator)
+			}
+		case isDC(el, "language"):
+			if info.Language == "" {
+				info.Language = text
+			}
+		case isDC(el, "description"):
+			if info.Description == "" {
+				info.Description = strings.TrimSpace(el.text)
+			}
+		case isDC(el, "publisher"):
+			if info.Publisher == "" {
+				info.Publisher = text
+			}
+		case isDC(el, "subject"):
+			if text != "" {
+				info.Subjects = append(info.Subjects, text)
+			}
+		case isDC(el, "date"):
+			date := opfDate{Value: text, Event: strings.ToLower(el.attr("event"))}
+			if date.Event == "modification" && info.Modified == "" {
+				info.Modified = text
+			}
+			info.Dates = append(info.Dates, date)
+		case isDC(el, "identifier"):
+			scheme := refined(el, "scheme", "identifier-type")
+			info.Identifiers = append(info.Identifiers, opfIdentifier{Value: text, Scheme: scheme})
+		case isDC(el, "source"):
+			info.Sources = append(info.Sources, text)
+		case el.name.Local == "meta":
+			switch {
+			case el.attr("name") == calibreSeries:
+				calibreSeriesName = strings.TrimSpace(el.attr("content"))
+			case el.attr("name") == calibreSeriesIndex:
+				calibreSeriesPosition = strings.TrimSpace(el.attr("content"))
+			case el.attr("property") == dctermsModified:
+				info.Modified = text
+			case el.attr("property") == belongsToCollection && el.attr("refines") == "":
+				id := el.attr("id")
+				if strings.ToLower(refinements[id]["collection-type"]) == collectionTypeSet {
+					continue
+				}
+				collections[text] = true
+				info.addSeries(text, refinements[id]["group-position"])
+			}
+		}
+	}
+	// EPUB2, or EPUB3 without title types
+	if len(untypedTitles) != 0 {
+		if info.Title == "" {
+			info.Title = untypedTitles[0]
+			untypedTitles = untypedTitles[1:]
+		}
+		if info.Subtitle == "" && len(untypedTitles) != 0 && block.pkg.isEpub3() {
+			info.Subtitle = untypedTitles[0]
+		}
+	}
+	// calibre series, if not already declared as a collection
+	if !collections[calibreSeriesName] {
+		info.addSeries(calibreSeriesName, calibreSeriesPosition)
+	}
+	return
+}
+
+// addSeries with an index that may not be a valid float.
+func (o *opfMetadata) addSeries(name, position string) {
+	if name == "" {
+		return
+	}
+	if _, err := o.Series.AddFromString(name + ":" + position); err != nil {
+		// invalid index, keeping the series anyway
+		o.Series.add(name, 0)
+	}
+}
+
+// Authors returns the names of the creators with the author role.
+func (o opfMetadata) Authors() []string {
+	authors := []string{}
+	for _, c := range o.Creators {
+		if c.Role == authorRole {
+			authors = append(authors, c.Name)
+		}
+	}
+	return authors
+}
+
+// PublicationYear returns the year of the publication date.
+func (o opfMetadata) PublicationYear() (string, error) {
+	// explicit publication date first
+	for _, d := range o.Dates {
+		if d.Event == "publication" || d.Event == "issued" {
+			if year, err := parseYear(d.Value); err == nil {
+				return year, nil
+			}
+		}
+	}
+	// EPUB3 only has the publication date, EPUB2 dates without event usually are too
+	for _, d := range o.Dates {
+		if d.Event == "" {
+			if year, err := parseYear(d.Value); err == nil {
+				return year, nil
+			}
+		}
+	}
+	// reverting to the first valid date, except those describing the file itself
+	for _, d := range o.Dates {
+		switch d.Event {
+		case "modification", "conversion", "file-creation":
+			continue
+		}
+		if year, err := parseYear(d.Value); err == nil {
+			return year, nil
+		}
+	}
+	return "", errors.New("No publication date found")
+}
+
+// ISBN returns the first valid ISBN found in identifiers or sources.
+func (o opfMetadata) ISBN() (string, error) {
+	// identifiers declared as ISBN first
+	for _, id := range o.Identifiers {
+		if strings.ToLower(id.Scheme) == "isbn" || strings.HasPrefix(strings.ToLower(id.Value), "urn:isbn:") {
+			if isbn, err := e.CleanISBN(id.Value); err == nil {
+				return isbn, nil
+			}
+		}
+	}
+	for _, id := range o.Identifiers {
+		if isbn, err := e.CleanISBN(id.Value); err == nil {
+			return isbn, nil
+		}
+		// it shouldn't be there, but retail epubs have awful metadata
+		if isbn, err := e.CleanISBN(id.Scheme); err == nil {
+			return isbn, nil
+		}
+	}
+	for _, source := range o.Sources {
+		if isbn, err := e.CleanISBN(source); err == nil {
+			return isbn, nil
+		}
+	}
+	return "", errors.New("ISBN not found in epub")
+}
@@ -0,0 +1,190 @@
+package book
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var opfFixtures = []struct {
+	filename         string
+	expectedTitle    string
+	expectedSubtitle string
+	expectedCreators []opfCreator
+	expectedAuthors  []string
+	expectedYear     string
+	expectedModified string
+	expectedISBN     string
+	expectedLanguage string
+	expectedSeries   Series
+}{
+	{
+		"epub2_calibre.opf",
+		"Use of Weapons",
+		"",
+		[]opfCreator{
+			{Name: "Iain M. Banks", FileAs: "Banks, Iain M.", Role: "aut"},
+			{Name: "Jane Doe", FileAs: "Doe, Jane", Role: "trl"},
+			{Name: "calibre (2.57.1) [http://calibre-ebook.com]", FileAs: "calibre", Role: "bkp"},
+		},
+		[]string{"Iain M. Banks"},
+		"2008",
+		"",
+		"9780748110513",
+		"eng",
+		Series{SingleSeries{Name: "Culture", Position: "3"}},
+	},
+	{
+		"epub3_refines.opf",
+		"The Last Wish",
+		"A Novel",
+		[]opfCreator{
+			{Name: "Andrzej Sapkowski", FileAs: "Sapkowski, Andrzej", Role: "aut"},
+			{Name: "Danusia Stok", FileAs: "Stok, Danusia", Role: "trl"},
+			{Name: "Some Editor", FileAs: "", Role: "edt"},
+		},
+		[]string{"Andrzej Sapkowski"},
+		"2008",
+		"2016-01-01T00:00:00Z",
+		"9780316029186",
+		"en-US",
+		Series{SingleSeries{Name: "The Witcher", Position: "0.5"}},
+	},
+	{
+		"epub2_broken.opf",
+		"Untitled & Broken",
+		"",
+		[]opfCreator{{Name: "Anonymous", Role: "aut"}},
+		[]string{"Anonymous"},
+		"1999",
+		"2015",
+		"9780306406157",
+		"fr",
+		nil,
+	},
+	{
+		"epub3_minimal.opf",
+		"Short",
+		"",
+		nil,
+		[]string{},
+		"",
+		"2016-01-01T00:00:00Z",
+		"",
+		"de",
+		nil,
+	},
+}
+
+// testEpubFile is a file to include in a test epub.
+type testEpubFile struct {
+	name     string
+	contents string
+}
+
+// writeTestEpub with a given OPF file, and the given documents only.
+func writeTestEpub(path string, opf []byte, documents ...testEpubFile) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	w := zip.NewWriter(out)
+	files := []testEpubFile{
+		{mimetypePath, "application/epub+zip"},
+		{containerPath, `<?xml version="1.0"?><container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container"><rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles></container>`},
+		{"OEBPS/content.opf", string(opf)},
+	}
+	files = append(files, documents...)
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write([]byte(f.contents)); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func TestParseYear(t *testing.T) {
+	fmt.Println("+ Testing parseYear()...")
+	assert := assert.New(t)
+	for date, expected := range map[string]string{
+		"2005":                      "2005",
+		"2005-03-15":                "2005",
+		"2008-09-04T23:00:00+00:00": "2008",
+		"12/03/1999":                "1999",
+		"0101-01-01T00:00:00+00:00": "",
+		"98":                        "",
+		"":                          "",
+	} {
+		year, err := parseYear(date)
+		assert.Equal(expected, year, "Wrong year for "+date)
+		assert.Equal(expected == "", err != nil)
+	}
+}
+
+func TestParseOPFMetadata(t *testing.T) {
+	fmt.Println("+ Testing parseOPFMetadata()...")
+	assert := assert.New(t)
+	for _, fixture := range opfFixtures {
+		opf, err := ioutil.ReadFile(filepath.Join(parentDir, "test", "opf", fixture.filename))
+		assert.Nil(err)
+		info, err := parseOPFMetadata(opf)
+		assert.Nil(err, "Error parsing "+fixture.filename)
+		assert.Equal(fixture.expectedTitle, info.Title, fixture.filename)
+		assert.Equal(fixture.expectedSubtitle, info.Subtitle, fixture.filename)
+		assert.Equal(fixture.expectedCreators, info.Creators, fixture.filename)
+		assert.Equal(fixture.expectedAuthors, info.Authors(), fixture.filename)
+		assert.Equal(fixture.expectedModified, info.Modified, fixture.filename)
+		assert.Equal(fixture.expectedLanguage, info.Language, fixture.filename)
+		assert.Equal(fixture.expectedSeries, info.Series, fixture.filename)
+		year, err := info.PublicationYear()
+		assert.Equal(fixture.expectedYear, year, fixture.filename)
+		assert.Equal(fixture.expectedYear == "", err != nil, fixture.filename)
+		isbn, err := info.ISBN()
+		assert.Equal(fixture.expectedISBN, isbn, fixture.filename)
+		assert.Equal(fixture.expectedISBN == "", err != nil, fixture.filename)
+	}
+}
+
+func TestEpubReadMetadataEPUB3(t *testing.T) {
+	fmt.Println("+ Testing Epub.ReadMetadata() with EPUB3...")
+	assert := assert.New(t)
+	opf, err := ioutil.ReadFile(filepath.Join(parentDir, "test", "opf", "epub3_refines.opf"))
+	assert.Nil(err)
+	path := filepath.Join(parentDir, "test", "epub3_test.epub")
+	assert.Nil(writeTestEpub(path, opf))
+	defer os.Remove(path)
+
+	e := Epub{Filename: path, UI: ui, Config: standardTestConfig}
+	info, err := e.ReadMetadata()
+	assert.Nil(err, "ISBN should have been found")
+	assert.Equal("The Last Wish", info.BookTitle)
+	assert.Equal("A Novel", info.Subtitle)
+	assert.Equal([]string{"Andrzej Sapkowski"}, info.Authors)
+	assert.Equal("2008", info.EditionYear)
+	assert.Equal("2008", info.OriginalYear)
+	assert.Equal("9780316029186", info.ISBN)
+	assert.Equal("en", info.Language)
+	assert.Equal("Orbit", info.Publisher)
+	assert.Equal("The Witcher #0.5", info.Series.String())
+
+	// writing back to an EPUB3 and reading again
+	info.Subtitle = "Another Subtitle"
+	info.Series = Series{SingleSeries{Name: "Witcher", Position: "1"}}
+	newOPF, err := updateOPFMetadata(opf, info)
+	assert.Nil(err)
+	readInfo, err := parseOPFMetadata(newOPF)
+	assert.Nil(err)
+	assert.Equal("The Last Wish", readInfo.Title)
+	assert.Equal("Another Subtitle", readInfo.Subtitle)
+	assert.Equal(info.Series, readInfo.Series)
+}
@@ -0,0 +1,176 @@
+package book
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	en "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testOPF2 = `<?xml version='1.0' encoding='UTF-8'?>
+<package xmlns="http://www.idpf.org/2007/opf" version="2.0" unique-identifier="id">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:opf="http://www.idpf.org/2007/opf">
+    <dc:identifier id="id" opf:scheme="URI">http://www.gutenberg.org/ebooks/16328</dc:identifier>
+    <dc:identifier opf:scheme="ISBN">0575083654</dc:identifier>
+    <dc:title>Old Title</dc:title>
+    <dc:creator opf:role="aut">Old Author</dc:creator>
+    <dc:contributor opf:role="trl">Translator</dc:contributor>
+    <dc:date opf:event="publication">1999</dc:date>
+    <dc:date opf:event="conversion">2016-03-09</dc:date>
+    <meta name="cover" content="cover-image"/>
+    <meta name="calibre:series" content="Old Series"/>
+  </metadata>
+  <manifest/>
+</package>`
+	testOPF3 = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" xmlns:dc="http://purl.org/dc/elements/1.1/" version="3.0" unique-identifier="uid">
+  <metadata>
+    <dc:identifier id="uid">urn:uuid:0adf2006-7812-4675-9c27-47699d21c4a2</dc:identifier>
+    <dc:title id="t1">Old Title</dc:title>
+    <meta refines="#t1" property="title-type">main</meta>
+    <dc:creator id="c1">Old Author</dc:creator>
+    <meta refines="#c1" property="role" scheme="marc:relators">aut</meta>
+    <meta property="dcterms:modified">2016-01-01T00:00:00Z</meta>
+  </metadata>
+  <manifest/>
+</package>`
+)
+
+func testEmbeddedMetadata() Metadata {
+	return Metadata{
+		BookTitle:   "New & Improved Title",
+		Authors:     []string{"First Author", "Second Author"},
+		EditionYear: "2012",
+		Language:    "en",
+		Publisher:   "Tor",
+		ISBN:        "9780575083653",
+		Tags:        Tags{Tag{Name: "science-fiction"}},
+		Series:      Series{SingleSeries{Name: "Culture", Position: "2"}},
+	}
+}
+
+func TestUpdateOPFMetadata(t *testing.T) {
+	fmt.Println("+ Testing updateOPFMetadata()...")
+	assert := assert.New(t)
+	info := testEmbeddedMetadata()
+
+	// EPUB2
+	opf, err := updateOPFMetadata([]byte(testOPF2), info)
+	assert.Nil(err)
+	updated := string(opf)
+	assert.Contains(updated, "<dc:title>New &amp; Improved Title</dc:title>")
+	assert.Contains(updated, `<dc:creator opf:role="aut">First Author</dc:creator>`)
+	assert.Contains(updated, `<dc:creator opf:role="aut">Second Author</dc:creator>`)
+	assert.Contains(updated, `<dc:date opf:event="publication">2012</dc:date>`)
+	assert.Contains(updated, `<dc:identifier opf:scheme="ISBN">9780575083653</dc:identifier>`)
+	assert.Contains(updated, `<meta name="calibre:series" content="Culture"/>`)
+	assert.Contains(updated, `<meta name="calibre:series_index" content="2"/>`)
+	// untouched elements
+	assert.Contains(updated, `<dc:identifier id="id" opf:scheme="URI">http://www.gutenberg.org/ebooks/16328</dc:identifier>`)
+	assert.Contains(updated, `<dc:contributor opf:role="trl">Translator</dc:contributor>`)
+	assert.Contains(updated, `<dc:date opf:event="conversion">2016-03-09</dc:date>`)
+	assert.Contains(updated, `<meta name="cover" content="cover-image"/>`)
+	assert.Contains(updated, "<manifest/>")
+	// removed elements
+	assert.NotContains(updated, "Old Title")
+	assert.NotContains(updated, "Old Author")
+	assert.NotContains(updated, "Old Series")
+	assert.NotContains(updated, "0575083654")
+	assert.NotContains(updated, "dcterms:modified")
+
+	// EPUB3
+	opf, err = updateOPFMetadata([]byte(testOPF3), info)
+	assert.Nil(err)
+	updated = string(opf)
+	assert.Contains(updated, `<dc:identifier id="uid">urn:uuid:0adf2006-7812-4675-9c27-47699d21c4a2</dc:identifier>`)
+	assert.Contains(updated, `<dc:creator id="endive-creator1">First Author</dc:creator>`)
+	assert.Contains(updated, `<meta refines="#endive-creator2" property="role" scheme="marc:relators">aut</meta>`)
+	assert.Contains(updated, `<dc:identifier>urn:isbn:9780575083653</dc:identifier>`)
+	assert.Contains(updated, `<meta property="dcterms:modified">`)
+	assert.NotContains(updated, "opf:")
+	assert.NotContains(updated, "Old Title")
+	assert.NotContains(updated, `refines="#t1"`)
+	assert.NotContains(updated, `refines="#c1"`)
+	assert.NotContains(updated, "2016-01-01T00:00:00Z")
+
+	// known contributors replace those in the epub
+	info.Contributors.Add(translatorRole, "New Translator")
+	info.SetSortName("First Author", "Author, First")
+	opf, err = updateOPFMetadata([]byte(testOPF2), info)
+	assert.Nil(err)
+	updated = string(opf)
+	assert.Contains(updated, `<dc:creator opf:role="aut" opf:file-as="Author, First">First Author</dc:creator>`)
+	assert.Contains(updated, `<dc:contributor opf:role="trl">New Translator</dc:contributor>`)
+	assert.NotContains(updated, ">Translator</dc:contributor>")
+	opf, err = updateOPFMetadata([]byte(testOPF3), info)
+	assert.Nil(err)
+	updated = string(opf)
+	assert.Contains(updated, `<meta refines="#endive-creator1" property="file-as">Author, First</meta>`)
+	assert.Contains(updated, `<dc:contributor id="endive-creator3">New Translator</dc:contributor>`)
+	assert.Contains(updated, `<meta refines="#endive-creator3" property="role" scheme="marc:relators">trl</meta>`)
+
+	// invalid OPF
+	_, err = updateOPFMetadata([]byte("<package><manifest/></package>"), info)
+	assert.NotNil(err, "no metadata element, should have failed")
+}
+
+func TestWriteEpubWithOPF(t *testing.T) {
+	fmt.Println("+ Testing writeEpubWithOPF()...")
+	assert := assert.New(t)
+	src := filepath.Join(parentDir, epubs[0].filename)
+	dst := filepath.Join(parentDir, "test", "embed_test.epub")
+	defer os.Remove(dst)
+
+	opfPath, opf, err := readOPF(src)
+	assert.Nil(err)
+	assert.Equal("OEBPS/content.opf", opfPath)
+	assert.True(strings.Contains(string(opf), epubs[0].expectedTitle))
+
+	info := testEmbeddedMetadata()
+	newOPF, err := updateOPFMetadata(opf, info)
+	assert.Nil(err)
+	assert.Nil(writeEpubWithOPF(src, dst, opfPath, newOPF))
+
+	// reading the new epub
+	e := Epub{Filename: dst, UI: ui, Config: standardTestConfig}
+	readInfo, err := e.ReadMetadata()
+	assert.Nil(err, "ISBN should have been found")
+	assert.Equal(info.BookTitle, readInfo.BookTitle)
+	assert.Equal(info.Authors, readInfo.Authors)
+	assert.Equal(info.ISBN, readInfo.ISBN)
+	assert.Equal(info.EditionYear, readInfo.EditionYear)
+	// original is untouched
+	hash, err := h.CalculateSHA256(src)
+	assert.Nil(err)
+	assert.Equal(epubs[0].expectedSha256, hash)
+}
+
+func TestAddOPFSubjects(t *testing.T) {
+	fmt.Println("+ Testing addOPFSubjects()...")
+	assert := assert.New(t)
+	codes := []en.SubjectCode{{Authority: en.AuthorityBISAC, Code: "FIC028130"}, {Authority: en.AuthorityThema, Code: "FM"}}
+
+	opf, err := addOPFSubjects([]byte(testOPF2), codes)
+	assert.Nil(err)
+	updated := string(opf)
+	assert.Contains(updated, "<dc:subject>FIC028130</dc:subject>")
+	assert.Contains(updated, "<dc:subject>FM</dc:subject>")
+	// everything else is untouched
+	assert.Contains(updated, "<dc:title>Old Title</dc:title>")
+	assert.Equal(len(testOPF2)+len("\n    <dc:subject>FIC028130</dc:subject>\n    <dc:subject>FM</dc:subject>"), len(updated))
+
+	opf, err = addOPFSubjects([]byte(testOPF3), codes)
+	assert.Nil(err)
+	updated = string(opf)
+	assert.Contains(updated, `<dc:subject id="endive-subject1">FIC028130</dc:subject>`)
+	assert.Contains(updated, `<meta refines="#endive-subject1" property="authority">BISAC</meta>`)
+	assert.Contains(updated, `<meta refines="#endive-subject2" property="authority">THEMA</meta>`)
+	assert.Contains(updated, `<meta refines="#endive-subject2" property="term">FM</meta>`)
+	assert.Contains(updated, "2016-01-01T00:00:00Z")
+}
@@ -0,0 +1,204 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+const dateFormat = "2006-01-02"
+
+// Reading of a Book: one attempt at reading it, in progress, finished or abandoned.
+type Reading struct {
+	Started   string `json:"started,omitempty"`
+	Finished  string `json:"finished,omitempty"`
+	Abandoned string `json:"abandoned,omitempty"`
+	// format or device used, for example paper or kobo
+	Format string `json:"format,omitempty"`
+	Notes  string `json:"notes,omitempty"`
+}
+
+// inProgress checks if a Reading was started, but not finished or abandoned yet.
+func (r Reading) inProgress() bool {
+	return r.Finished == "" && r.Abandoned == ""
+}
+
+// date of the last event of a Reading.
+func (r Reading) date() string {
+	for _, date := range []string{r.Finished, r.Abandoned} {
+		if date != "" {
+			return date
+		}
+	}
+	return r.Started
+}
+
+// progress of a Book after this Reading.
+func (r Reading) progress() string {
+	switch {
+	case r.Finished != "":
+		return e.ProgressRead
+	case r.Abandoned != "":
+		return e.ProgressAbandoned
+	default:
+		return e.ProgressReading
+	}
+}
+
+// String representation of a Reading.
+func (r Reading) String() string {
+	started := r.Started
+	if started == "" {
+		started = "?"
+	}
+	var description string
+	switch {
+	case r.Finished != "":
+		description = started + " → " + r.Finished
+	case r.Abandoned != "":
+		description = started + " → " + r.Abandoned + " (abandoned)"
+	default:
+		description = "since " + started
+	}
+	if r.Format != "" {
+		description += " [" + r.Format + "]"
+	}
+	if r.Notes != "" {
+		description += ": " + r.Notes
+	}
+	return description
+}
+
+// ReadingLog of a Book, with all its readings and attempts.
+type ReadingLog []Reading
+
+// latest Reading index, or -1 if the log is empty.
+func (l ReadingLog) latest() int {
+	latest := -1
+	for k, r := range l {
+		if latest == -1 || r.date() >= l[latest].date() {
+			latest = k
+		}
+	}
+	return latest
+}
+
+// current Reading index, or -1 if the Book is not being read.
+func (l ReadingLog) current() int {
+	for k := len(l) - 1; k >= 0; k-- {
+		if l[k].inProgress() {
+			return k
+		}
+	}
+	return -1
+}
+
+// Finished dates of a ReadingLog.
+func (l ReadingLog) Finished() []string {
+	dates := []string{}
+	for _, r := range l {
+		if r.Finished != "" {
+			dates = append(dates, r.Finished)
+		}
+	}
+	return dates
+}
+
+// merge another ReadingLog, ignoring the readings already logged.
+func (l ReadingLog) merge(o ReadingLog) ReadingLog {
+	for _, r := range o {
+		known := false
+		for _, lr := range l {
+			if lr == r {
+				known = true
+				break
+			}
+		}
+		if !known {
+			l = append(l, r)
+		}
+	}
+	return l
+}
+
+// CheckReadingDate is a valid date, or today if empty.
+func CheckReadingDate(date string) (string, error) {
+	if date == "" {
+		return time.Now().Local().Format(dateFormat), nil
+	}
+	if _, err := time.Parse(dateFormat, date); err != nil {
+		return "", errors.New("Invalid date " + date + ", expected YYYY-MM-DD")
+	}
+	return date, nil
+}
+
+// StartReading a Book at a given date.
+func (b *Book) StartReading(date, format, notes string) error {
+	if k := b.Readings.current(); k != -1 {
+		return errors.New(b.String() + " is already being read, since " + b.Readings[k].Started)
+	}
+	return b.logReading(Reading{Started: date, Format: format, Notes: notes})
+}
+
+// FinishReading a Book at a given date. Finishing a reading that was not
+// started is logged too.
+func (b *Book) FinishReading(date, format, notes string) error {
+	r := b.currentReading(format, notes)
+	r.Finished = date
+	return b.logReading(r)
+}
+
+// AbandonReading of a Book at a given date.
+func (b *Book) AbandonReading(date, format, notes string) error {
+	r := b.currentReading(format, notes)
+	r.Abandoned = date
+	return b.logReading(r)
+}
+
+// currentReading of a Book, updated with more information, or a new Reading.
+func (b *Book) currentReading(format, notes string) Reading {
+	var r Reading
+	if k := b.Readings.current(); k != -1 {
+		r = b.Readings[k]
+	}
+	if format != "" {
+		r.Format = format
+	}
+	if notes != "" {
+		r.Notes = strings.TrimSpace(strings.Join([]string{r.Notes, notes}, " "))
+	}
+	return r
+}
+
+// logReading, replacing the current one if it was started, and derive the
+// progress of the Book from the latest reading.
+func (b *Book) logReading(r Reading) error {
+	if r.date() > time.Now().Local().Format(dateFormat) {
+		return errors.New("Readings cannot be logged in the future")
+	}
+	if r.Started != "" && r.date() < r.Started {
+		return errors.New("A reading cannot end before it started")
+	}
+	if !b.Config.ProgressVocabulary().Has(r.progress()) {
+		return fmt.Errorf("Progress %s must be defined in the configuration file to log readings", r.progress())
+	}
+	if k := b.Readings.current(); k != -1 && r.Started == b.Readings[k].Started {
+		b.Readings[k] = r
+	} else {
+		b.Readings = append(b.Readings, r)
+	}
+	latest := b.Readings[b.Readings.latest()]
+	b.Progress = latest.progress()
+	if finished := b.Readings.Finished(); len(finished) != 0 {
+		b.ReadDate = ""
+		for _, date := range finished {
+			if date > b.ReadDate {
+				b.ReadDate = date
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package book
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookReadings(t *testing.T) {
+	fmt.Println("+ Testing Book.StartReading()...")
+	assert := assert.New(t)
+	bk := NewBook(ui, 0, epubs[0].filename, standardTestConfig, isRetail)
+
+	// invalid dates
+	_, err := CheckReadingDate("2016-13-01")
+	assert.NotNil(err)
+	date, err := CheckReadingDate("2016-01-02")
+	assert.Nil(err)
+	assert.Equal("2016-01-02", date)
+	assert.NotNil(bk.StartReading("2999-01-01", "", ""))
+	assert.Equal(0, len(bk.Readings))
+
+	// first reading
+	assert.Nil(bk.StartReading("2015-12-20", "paper", ""))
+	assert.Equal(e.ProgressReading, bk.Progress)
+	assert.NotNil(bk.StartReading("2015-12-21", "", ""), "already reading")
+	assert.NotNil(bk.FinishReading("2015-12-01", "", ""), "cannot finish before starting")
+	assert.Nil(bk.FinishReading("2016-01-02", "", "Great."))
+	assert.Equal(e.ProgressRead, bk.Progress)
+	assert.Equal("2016-01-02", bk.ReadDate)
+	if assert.Equal(1, len(bk.Readings)) {
+		assert.Equal(Reading{Started: "2015-12-20", Finished: "2016-01-02", Format: "paper", Notes: "Great."}, bk.Readings[0])
+		assert.Equal("2015-12-20 → 2016-01-02 [paper]: Great.", bk.Readings[0].String())
+	}
+
+	// abandoned re-read
+	assert.Nil(bk.StartReading("2018-03-01", "kobo", ""))
+	assert.Nil(bk.AbandonReading("2018-04-01", "", "Not now."))
+	assert.Equal(e.ProgressAbandoned, bk.Progress)
+	assert.Equal("2016-01-02", bk.ReadDate)
+	assert.Equal("2018-03-01 → 2018-04-01 (abandoned) [kobo]: Not now.", bk.Readings[1].String())
+
+	// finished without logging the start, then logging an older reading
+	assert.Nil(bk.FinishReading("2019-05-05", "", ""))
+	assert.Equal(e.ProgressRead, bk.Progress)
+	assert.Equal("2019-05-05", bk.ReadDate)
+	assert.Nil(bk.StartReading("2010-01-01", "", ""))
+	assert.Nil(bk.FinishReading("2010-02-01", "", ""))
+	assert.Equal(e.ProgressRead, bk.Progress)
+	assert.Equal("2019-05-05", bk.ReadDate)
+	assert.Equal([]string{"2016-01-02", "2019-05-05", "2010-02-01"}, bk.Readings.Finished())
+	assert.Nil(bk.StartReading("2020-01-01", "", ""))
+	assert.Equal(e.ProgressReading, bk.Progress)
+	assert.Equal("since 2020-01-01", bk.Readings[4].String())
+
+	// progress states must be configured
+	c := standardTestConfig
+	c.Progress = e.Vocabulary{e.ProgressUnread: nil, e.ProgressRead: nil}
+	other := NewBook(ui, 1, epubs[0].filename, c, isRetail)
+	assert.NotNil(other.StartReading("2016-01-01", "", ""))
+	assert.Nil(other.FinishReading("2016-01-01", "", ""))
+
+	// merging user data
+	a := &Book{Progress: e.ProgressUnread, Readings: ReadingLog{bk.Readings[0]}}
+	a.mergeUserData(bk)
+	assert.Equal(5, len(a.Readings))
+	assert.Equal(e.ProgressReading, a.Progress)
+	assert.Equal("2019-05-05", a.ReadDate)
+}
+
+func TestBookReadingsMigration(t *testing.T) {
+	fmt.Println("+ Testing Book.UnmarshalJSON() for readings...")
+	assert := assert.New(t)
+	var bk Book
+	assert.Nil(json.Unmarshal([]byte(`{"id": 3, "progress": "read", "readdate": "2016-01-02"}`), &bk))
+	assert.Equal(ReadingLog{{Finished: "2016-01-02"}}, bk.Readings)
+	data, err := json.Marshal(&bk)
+	assert.Nil(err)
+	assert.Contains(string(data), `"readings":[{"finished":"2016-01-02"}]`)
+	var unread Book
+	assert.Nil(json.Unmarshal([]byte(`{"id": 4, "progress": "unread", "readdate": ""}`), &unread))
+	assert.Nil(unread.Readings)
+}
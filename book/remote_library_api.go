@@ -18,6 +18,18 @@ type RemoteLibraryAPI interface {
 	GetBookIDByISBN(isbn, key string) (id string, err error)
 }
 
+// SeriesEntry is a book of a series, according to a remote library.
+type SeriesEntry struct {
+	Position string
+	Title    string
+	Author   string
+}
+
+// SeriesLister is implemented by remote libraries that can list all the books of a series.
+type SeriesLister interface {
+	GetSeries(bookID, series, key string) ([]SeriesEntry, error)
+}
+
 // GetXMLData retrieves XML responses from online APIs.
 func getXMLData(uri string, i interface{}) (err error) {
 	currentPass := 0
@@ -64,10 +76,59 @@ const apiRoot = "https://www.goodreads.com/"
 
 // response is the top xml element in goodreads response.
 type response struct {
-	Book   Metadata      `xml:"book"`
+	Book   goodReadsBook `xml:"book"`
 	Search searchResults `xml:"search"`
 }
 
+// seriesResponse is the top xml element in goodreads responses about series.
+type seriesResponse struct {
+	// series of a book
+	BookSeries []struct {
+		ID    string `xml:"series>id"`
+		Title string `xml:"series>title"`
+	} `xml:"book>series_works>series_work"`
+	// books of a series
+	Works []struct {
+		Position string `xml:"user_position"`
+		Title    string `xml:"work>best_book>title"`
+		Author   string `xml:"work>best_book>author>name"`
+	} `xml:"series>series_works>series_work"`
+}
+
+// goodReadsBook is the book xml element, with authors and their roles.
+type goodReadsBook struct {
+	Metadata
+	ID         string `xml:"id"`
+	KindleASIN string `xml:"kindle_asin"`
+	WorkID     string `xml:"work>id"`
+	WorkTitle  string `xml:"work>original_title"`
+	Authors    []struct {
+		Name string `xml:"name"`
+		Role string `xml:"role"`
+	} `xml:"authors>author"`
+}
+
+// metadata with authors separated from other contributors.
+func (g goodReadsBook) metadata() Metadata {
+	info := g.Metadata
+	for _, author := range g.Authors {
+		role := goodReadsRole(author.Role)
+		if role == authorRole {
+			info.Authors = append(info.Authors, author.Name)
+		} else {
+			info.Contributors.Add(role, author.Name)
+		}
+	}
+	info.Identifiers.Add(goodReadsIdentifier, g.ID)
+	info.Identifiers.Add(asinIdentifier, g.KindleASIN)
+	if g.WorkID != "" {
+		info.Work = &Work{ID: goodReadsIdentifier + ":" + g.WorkID, Title: g.WorkTitle, Year: g.OriginalYear}
+	}
+	// Goodreads titles include series information
+	info.detectSeries()
+	return info
+}
+
 // searchResults is the main xml element in goodreads search.
 type searchResults struct {
 	ResultsNumber string `xml:"total-results"`
@@ -86,7 +147,7 @@ func (g GoodReads) GetBook(id, key string) (Metadata, error) {
 	uri := apiRoot + "book/show/" + id + ".xml?key=" + key
 	r := response{}
 	err := getXMLData(uri, &r)
-	return r.Book, err
+	return r.Book.metadata(), err
 }
 
 func makeSearchQuery(parts ...string) (query string) {
@@ -142,3 +203,30 @@ func (g GoodReads) GetBookIDByISBN(isbn, key string) (id string, err error) {
 	}
 	return
 }
+
+// GetSeries lists the books of a series, from the Goodreads ID of one of them.
+func (g GoodReads) GetSeries(bookID, series, key string) ([]SeriesEntry, error) {
+	r := seriesResponse{}
+	if err := getXMLData(apiRoot+"book/show/"+bookID+".xml?key="+key, &r); err != nil {
+		return nil, err
+	}
+	seriesID := ""
+	for _, s := range r.BookSeries {
+		if strings.EqualFold(strings.TrimSpace(s.Title), series) {
+			seriesID = s.ID
+			break
+		}
+	}
+	if seriesID == "" {
+		return nil, fmt.Errorf("Series %s not found for Goodreads book %s", series, bookID)
+	}
+	r = seriesResponse{}
+	if err := getXMLData(apiRoot+"series/show/"+seriesID+".xml?key="+key, &r); err != nil {
+		return nil, err
+	}
+	entries := []SeriesEntry{}
+	for _, w := range r.Works {
+		entries = append(entries, SeriesEntry{Position: strings.TrimSpace(w.Position), Title: w.Title, Author: w.Author})
+	}
+	return entries, nil
+}
@@ -13,6 +13,7 @@ var grBooks = []struct {
 	title             string
 	expectedID        string
 	expectedYear      string
+	expectedTitle     string
 	expectedFullTitle string
 	isbn              string
 }{
@@ -21,7 +22,8 @@ var grBooks = []struct {
 		"The Republic of Thieves (Gentleman Bastard, #3)",
 		"2890090",
 		"2013",
-		"Scott Lynch (2013) The Republic of Thieves (Gentleman Bastard, #3) [Gentleman Bastard #3]",
+		"The Republic of Thieves",
+		"Scott Lynch (2013) The Republic of Thieves [Gentleman Bastard #3]",
 		"9780553804690",
 	},
 	{
@@ -29,6 +31,7 @@ var grBooks = []struct {
 		"Animal Farm",
 		"7613",
 		"1945",
+		"Animal Farm",
 		"George Orwell (1945) Animal Farm",
 		"9780452284241",
 	},
@@ -52,8 +55,8 @@ func TestGoodReads(t *testing.T) {
 		assert.Nil(err, "Unexpected error")
 		b.Clean(standardTestConfig)
 		assert.Equal(book.author, b.Author(), "Bad author")
-		if b.Title() != book.title {
-			t.Errorf("Bad title, got %s, expected %s.", b.Title(), book.title)
+		if b.Title() != book.expectedTitle {
+			t.Errorf("Bad title, got %s, expected %s.", b.Title(), book.expectedTitle)
 		}
 		assert.Equal(book.expectedYear, b.OriginalYear, "Bad year")
 		assert.Equal(book.expectedFullTitle, b.String(), "Bad title")
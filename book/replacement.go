@@ -0,0 +1,71 @@
+package book
+
+import (
+	"errors"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// FlagForReplacement the preferred retail or non-retail epub of a Book, with an optional reason.
+func (b *Book) FlagForReplacement(isRetail bool, reason string) error {
+	ed := b.edition(isRetail)
+	if ed == nil {
+		if isRetail {
+			return errors.New("Book has no retail epub")
+		}
+		return errors.New("Book has no non-retail epub")
+	}
+	ed.Epub.FlagForReplacement(true)
+	ed.Epub.ReplacementReason = reason
+	return nil
+}
+
+// ClearReplacementFlags of the epubs of a Book.
+func (b *Book) ClearReplacementFlags() {
+	for _, ep := range b.Epubs() {
+		ep.FlagForReplacement(false)
+	}
+}
+
+// NeedsReplacement checks if an epub of the Book is flagged for replacement.
+func (b *Book) NeedsReplacement() bool {
+	for _, ed := range b.Editions {
+		if ed.Epub.NeedsReplacement == e.True {
+			return true
+		}
+	}
+	return false
+}
+
+// CanBeReplacedBy checks if importing a retail or non-retail epub would
+// replace an epub of the Book flagged for replacement: a retail epub replaces
+// any flagged epub, a non-retail epub only a flagged non-retail epub.
+func (b *Book) CanBeReplacedBy(isRetail bool) bool {
+	for _, ed := range b.Editions {
+		if ed.Epub.NeedsReplacement == e.True && (isRetail || !ed.Retail) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceEdition flagged for replacement with an epub described by its
+// Metadata, even if it is not the same edition: the flagged epub is moved to
+// the trash.
+// A retail epub replaces any flagged epub, a non-retail epub only a flagged
+// non-retail epub.
+func (b *Book) ReplaceEdition(path string, isRetail bool, hash string, info Metadata) (imported bool, err error) {
+	k := b.preferredEdition(func(ed Edition) bool {
+		return ed.Epub.NeedsReplacement == e.True && (isRetail || !ed.Retail)
+	})
+	if k == -1 {
+		return false, errors.New("No epub flagged for replacement can be replaced by " + path)
+	}
+	ed := newEdition(Epub{}, isRetail, info)
+	ed.inherit(b.Editions[k])
+	b.UI.Warning("Replacing version flagged for replacement: " + b.Editions[k].String())
+	if err = b.removeEdition(k); err != nil {
+		return
+	}
+	return b.importEdition(path, hash, ed)
+}
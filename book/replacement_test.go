@@ -0,0 +1,96 @@
+package book
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookReplacement(t *testing.T) {
+	fmt.Println("+ Testing Book.FlagForReplacement(), CanBeReplacedBy()...")
+	assert := assert.New(t)
+
+	nonRetail := NewBook(ui, 1, "nonretail.epub", standardTestConfig, !isRetail)
+	assert.NotNil(nonRetail.FlagForReplacement(isRetail, ""))
+	assert.False(nonRetail.CanBeReplacedBy(!isRetail))
+	assert.Nil(nonRetail.FlagForReplacement(!isRetail, "OCR errors"))
+	assert.Equal(e.True, nonRetail.MainEpub().NeedsReplacement)
+	assert.Equal("true (OCR errors)", nonRetail.MainEpub().replacementInfo())
+	assert.True(nonRetail.CanBeReplacedBy(!isRetail))
+	assert.True(nonRetail.CanBeReplacedBy(isRetail))
+
+	// retail original edition, non-retail translation
+	retail := NewBookWithMetadata(ui, 2, "retail.epub", standardTestConfig, isRetail, Metadata{Language: "en"})
+	retail.Editions = append(retail.Editions, Edition{Epub: Epub{Filename: "nonretail.epub", Config: standardTestConfig, UI: ui}, Language: "fr"})
+	assert.Nil(retail.FlagForReplacement(isRetail, "no cover"))
+	// a non-retail epub cannot replace a retail epub
+	assert.False(retail.CanBeReplacedBy(!isRetail))
+	assert.True(retail.CanBeReplacedBy(isRetail))
+	retail.ClearReplacementFlags()
+	assert.False(retail.NeedsReplacement())
+	assert.Nil(retail.FlagForReplacement(!isRetail, ""))
+	assert.True(retail.NeedsReplacement())
+	assert.True(retail.CanBeReplacedBy(!isRetail))
+	assert.True(retail.CanBeReplacedBy(isRetail))
+
+	other := NewBook(ui, 3, "other.epub", standardTestConfig, isRetail)
+	books := Books{*nonRetail, *retail, *other}
+	flagged := books.NeedsReplacement()
+	if assert.Equal(2, len(flagged.Books())) {
+		assert.Equal(1, flagged.Books()[0].ID())
+		assert.Equal(2, flagged.Books()[1].ID())
+	}
+
+	assert.Nil(retail.FlagForReplacement(isRetail, "no cover"))
+	retail.ClearReplacementFlags()
+	for _, ed := range retail.Editions {
+		assert.Equal(e.False, ed.Epub.NeedsReplacement)
+		assert.Equal("", ed.Epub.ReplacementReason)
+	}
+}
+
+func TestBookReplaceEdition(t *testing.T) {
+	fmt.Println("+ Testing Book.ReplaceEdition()...")
+	assert := assert.New(t)
+	libraryDir, err := ioutil.TempDir("", "endive-library")
+	assert.Nil(err)
+	defer os.RemoveAll(libraryDir)
+	c := standardTestConfig
+	c.LibraryRoot = libraryDir
+	c.TrashDir = filepath.Join(libraryDir, "trash")
+	c.EpubFilenameFormat = "$a $t"
+	source := filepath.Join(parentDir, epubs[0].filename)
+
+	assert.Nil(h.CopyFile(source, filepath.Join(libraryDir, "original.epub")))
+	bk := NewBookWithMetadata(ui, 1, "original.epub", c, !isRetail, Metadata{BookTitle: "Dune", Authors: []string{"Frank Herbert"}, Language: "en", Publisher: "Ace"})
+	bk.Editions[0].ISBN = "9780441172719"
+	bk.Editions[0].Epub.Hash = "flagged"
+
+	// nothing flagged
+	_, err = bk.ReplaceEdition(source, !isRetail, "better", Metadata{ISBN: "9780340960196"})
+	assert.NotNil(err)
+	assert.Nil(bk.FlagForReplacement(!isRetail, "OCR errors"))
+	// a better copy of another edition replaces the flagged epub
+	imported, err := bk.ReplaceEdition(source, !isRetail, "better", Metadata{ISBN: "9780340960196"})
+	assert.Nil(err)
+	assert.True(imported)
+	assert.False(bk.NeedsReplacement())
+	if assert.Equal(1, len(bk.Editions)) {
+		assert.Equal("better", bk.Editions[0].Epub.Hash)
+		assert.Equal("9780340960196", bk.Editions[0].ISBN)
+		assert.Equal("Ace", bk.Editions[0].Publisher)
+		_, err = h.FileExists(bk.MainEpub().FullPath())
+		assert.Nil(err)
+	}
+	entries, err := ListTrash(c, ui)
+	assert.Nil(err)
+	if assert.Equal(1, len(entries)) {
+		assert.Equal([]string{"flagged"}, entries[0].Hashes())
+	}
+}
@@ -3,11 +3,55 @@ package book
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 )
 
+// seriesNumberWords are the numbers spelled out in series titles.
+var seriesNumberWords = map[string]string{
+	"one": "1", "two": "2", "three": "3", "four": "4", "five": "5", "six": "6",
+	"seven": "7", "eight": "8", "nine": "9", "ten": "10", "eleven": "11", "twelve": "12",
+	"first": "1", "second": "2", "third": "3", "fourth": "4", "fifth": "5", "sixth": "6",
+	"seventh": "7", "eighth": "8", "ninth": "9", "tenth": "10", "eleventh": "11", "twelfth": "12",
+}
+
+var (
+	// "Title (Series #2)", "Title (Series, #2-3)", "Title (Series, Book 2)"
+	seriesInParenthesesRegexp = regexp.MustCompile(`^(.+?)\s*\((.+?),?\s+(?:#|[Bb]ook\s+|[Vv]ol(?:ume|\.)?\s*|[Tt]ome\s+)\s*([0-9.]+(?:-[0-9.]+)?)\)$`)
+	// "Title: Book Two of the Series", "Title - Volume 3 in Series"
+	seriesInSubtitleRegexp = regexp.MustCompile(`^(?:(.+?)\s*[:\-\x{2013}\x{2014}]\s*)?(?:[Tt]he\s+)?(?:[Bb]ook|[Vv]olume|[Vv]ol\.|[Pp]art|[Tt]ome)\s+(\w+)\s+(?:of|in)\s+(?:[Tt]he\s+)?(.+)$`)
+)
+
+// seriesNumber converts a series index, possibly spelled out, to a number.
+func seriesNumber(index string) (string, bool) {
+	if number, ok := seriesNumberWords[strings.ToLower(index)]; ok {
+		return number, true
+	}
+	if _, err := strconv.ParseFloat(index, 64); err == nil {
+		return index, true
+	}
+	return "", false
+}
+
+// parseSeriesFromTitle detects series information in a title.
+// It returns the title without it, and the series in raw "series:index" form.
+func parseSeriesFromTitle(title string) (cleanTitle, series string, found bool) {
+	title = strings.TrimSpace(title)
+	if m := seriesInParenthesesRegexp.FindStringSubmatch(title); m != nil {
+		return strings.TrimSpace(m[1]), strings.TrimSpace(m[2]) + ":" + m[3], true
+	}
+	if m := seriesInSubtitleRegexp.FindStringSubmatch(title); m != nil {
+		// "Part One of Two" is not a series
+		_, isNumber := seriesNumber(m[3])
+		if number, ok := seriesNumber(m[2]); ok && !isNumber {
+			return strings.TrimSpace(m[1]), strings.TrimSpace(m[3]) + ":" + number, true
+		}
+	}
+	return title, "", false
+}
+
 // SingleSeries holds the name and index of a series a Book is part of.
 type SingleSeries struct {
 	Name     string `json:"name" xml:"series>title"`
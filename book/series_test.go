@@ -101,3 +101,52 @@ func TestSeries(t *testing.T) {
 		}
 	}
 }
+
+func TestParseSeriesFromTitle(t *testing.T) {
+	fmt.Println("+ Testing parseSeriesFromTitle()...")
+	assert := assert.New(t)
+	for _, c := range []struct {
+		title          string
+		expectedTitle  string
+		expectedSeries string
+		expectedFound  bool
+	}{
+		{"Foundation and Empire (Foundation #2)", "Foundation and Empire", "Foundation:2", true},
+		{"Use of Weapons (Culture, #3)", "Use of Weapons", "Culture:3", true},
+		{"Omnibus (The Expanse, #1-3)", "Omnibus", "The Expanse:1-3", true},
+		{"Dune (Dune Chronicles, Book 1)", "Dune", "Dune Chronicles:1", true},
+		{"Caliban's War: Book Two of the Expanse", "Caliban's War", "Expanse:2", true},
+		{"Red Mars - Volume 1 in the Mars Trilogy", "Red Mars", "Mars Trilogy:1", true},
+		{"Book Three of the Broken Empire", "", "Broken Empire:3", true},
+		{"Some Title: Part One of Two", "Some Title: Part One of Two", "", false},
+		{"The Book of Three", "The Book of Three", "", false},
+		{"Catch-22 (Vintage Classics)", "Catch-22 (Vintage Classics)", "", false},
+	} {
+		title, series, found := parseSeriesFromTitle(c.title)
+		assert.Equal(c.expectedTitle, title, c.title)
+		assert.Equal(c.expectedSeries, series, c.title)
+		assert.Equal(c.expectedFound, found, c.title)
+	}
+}
+
+func TestMetadataDetectSeries(t *testing.T) {
+	fmt.Println("+ Testing Metadata.detectSeries()...")
+	assert := assert.New(t)
+	i := Metadata{BookTitle: "Caliban's War", Subtitle: "Book Two of the Expanse"}
+	i.detectSeries()
+	assert.Equal("Caliban's War", i.BookTitle)
+	assert.Equal("", i.Subtitle)
+	assert.Equal("Expanse:2", i.Series.rawString())
+
+	// known series are not added twice
+	i = Metadata{BookTitle: "Use of Weapons (Culture, #3)", Series: Series{SingleSeries{Name: "Culture", Position: "3"}}}
+	i.detectSeries()
+	assert.Equal("Use of Weapons", i.BookTitle)
+	assert.Equal("Culture:3", i.Series.rawString())
+
+	// titles are never emptied, but their series is still detected
+	i = Metadata{BookTitle: "Book Three of the Broken Empire"}
+	i.detectSeries()
+	assert.Equal("Book Three of the Broken Empire", i.BookTitle)
+	assert.Equal("Broken Empire:3", i.Series.rawString())
+}
@@ -0,0 +1,174 @@
+package book
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// maxSeriesGap is the largest number of consecutive missing positions listed
+// between known ones: larger gaps are not numbering, for example years.
+const maxSeriesGap = 10
+
+// SeriesBook is a Book with its position in a series.
+type SeriesBook struct {
+	Book     *Book
+	Name     string
+	Position string
+}
+
+// SeriesIndex used to sort books in reading order.
+// Positions without index come last.
+func SeriesIndex(position string) float64 {
+	indexes := seriesIndexes(position)
+	if len(indexes) == 0 {
+		return math.Inf(1)
+	}
+	return indexes[0]
+}
+
+// FindSeries returns the books of a series, in reading order.
+// The series name is not case-sensitive.
+func FindSeries(collection e.Collection, name string) []SeriesBook {
+	books := []SeriesBook{}
+	for _, book := range collection.Books() {
+		b := book.(*Book)
+		for _, s := range b.Metadata.Series {
+			if strings.EqualFold(s.Name, strings.TrimSpace(name)) {
+				books = append(books, SeriesBook{Book: b, Name: s.Name, Position: s.Position})
+			}
+		}
+	}
+	sort.SliceStable(books, func(j, k int) bool {
+		return SeriesIndex(books[j].Position) < SeriesIndex(books[k].Position)
+	})
+	return books
+}
+
+// MissingPositions in a series: small gaps between known indexes, and books
+// only known by a remote library, if its list of the series is given.
+func MissingPositions(books []SeriesBook, online []SeriesEntry) []SeriesEntry {
+	covered := make(map[float64]bool)
+	for _, sb := range books {
+		for _, index := range seriesIndexes(sb.Position) {
+			covered[index] = true
+		}
+	}
+	missing := make(map[float64]SeriesEntry)
+	for _, entry := range online {
+		indexes := seriesIndexes(entry.Position)
+		// omnibus editions are not missing books
+		if len(indexes) != 1 {
+			continue
+		}
+		if !covered[indexes[0]] {
+			missing[indexes[0]] = entry
+		}
+	}
+	known := []float64{0}
+	for index := range covered {
+		known = append(known, index)
+	}
+	for index := range missing {
+		known = append(known, index)
+	}
+	sort.Float64s(known)
+	for j := 1; j < len(known); j++ {
+		first, last := math.Floor(known[j-1])+1, math.Ceil(known[j])-1
+		if last-first+1 > maxSeriesGap {
+			continue
+		}
+		for index := first; index <= last; index++ {
+			if _, ok := missing[index]; !covered[index] && !ok {
+				missing[index] = SeriesEntry{Position: strconv.FormatFloat(index, 'f', -1, 64)}
+			}
+		}
+	}
+	indexes := []float64{}
+	for index := range missing {
+		indexes = append(indexes, index)
+	}
+	sort.Float64s(indexes)
+	entries := []SeriesEntry{}
+	for _, index := range indexes {
+		entries = append(entries, missing[index])
+	}
+	return entries
+}
+
+// SeriesProgress of a series being read.
+type SeriesProgress struct {
+	Name  string
+	Read  int
+	Total int
+	Next  SeriesBook
+}
+
+// NextInSeries returns the first book that is not read, being read or abandoned
+// in every series that is being read.
+func NextInSeries(collection e.Collection) []SeriesProgress {
+	// series names are not case-sensitive
+	names := make(map[string]string)
+	for _, book := range collection.Books() {
+		for _, s := range book.(*Book).Metadata.Series {
+			names[strings.ToLower(s.Name)] = s.Name
+		}
+	}
+	progress := []SeriesProgress{}
+	for _, name := range names {
+		sp := SeriesProgress{Name: name}
+		started, found := false, false
+		for _, sb := range FindSeries(collection, name) {
+			sp.Total++
+			switch sb.Book.Progress {
+			case e.ProgressRead:
+				sp.Read++
+				started = true
+			case e.ProgressReading:
+				started = true
+			case e.ProgressAbandoned:
+			default:
+				// any other configured progress, such as unread or shortlisted
+				if !found && sb.Book.Config.ProgressVocabulary().Has(sb.Book.Progress) {
+					sp.Next = sb
+					found = true
+				}
+			}
+		}
+		if started && found {
+			progress = append(progress, sp)
+		}
+	}
+	sort.Slice(progress, func(j, k int) bool {
+		return progress[j].Name < progress[k].Name
+	})
+	return progress
+}
+
+// OnlineSeries lists all the books of a series the Book is part of, according to Goodreads.
+func (b *Book) OnlineSeries(series string) ([]SeriesEntry, error) {
+	if b.Config.GoodReadsAPIKey == "" {
+		return nil, e.WarningGoodReadsAPIKeyMissing
+	}
+	var err error
+	g := GoodReads{}
+	id := b.Metadata.Identifiers[goodReadsIdentifier]
+	if id == "" && b.Metadata.ISBN != "" {
+		if id, err = g.GetBookIDByISBN(b.Metadata.ISBN, b.Config.GoodReadsAPIKey); err != nil {
+			return nil, err
+		}
+	}
+	if id == "" {
+		if id, err = g.GetBookIDByQuery(b.Metadata.Author(), b.Metadata.Title(), b.Config.GoodReadsAPIKey); err != nil {
+			return nil, err
+		}
+	}
+	if id == "" {
+		return nil, errors.New("Could not find online data for " + b.String())
+	}
+	return g.GetSeries(id, series, b.Config.GoodReadsAPIKey)
+}
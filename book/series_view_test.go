@@ -0,0 +1,82 @@
+package book
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	e "github.com/barsanuphe/endive/endive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeriesView(t *testing.T) {
+	fmt.Println("+ Testing FindSeries(), MissingPositions() and NextInSeries()...")
+	assert := assert.New(t)
+	books := []struct {
+		series   Series
+		progress string
+	}{
+		{Series{{Name: "Culture", Position: "2"}}, "read"},
+		{Series{{Name: "culture", Position: "1"}}, "read"},
+		{Series{{Name: "Culture", Position: "5"}}, "unread"},
+		{Series{{Name: "Culture", Position: "4"}, {Name: "Other", Position: "1"}}, "shortlisted"},
+		{Series{{Name: "Other", Position: "2"}}, "unread"},
+		{Series{{Name: "Finished", Position: "1"}}, "read"},
+	}
+	collection := &Books{}
+	for j, bk := range books {
+		b := NewBookWithMetadata(ui, j+1, epubs[0].filename, standardTestConfig, true, Metadata{Series: bk.series})
+		b.Progress = bk.progress
+		collection.Add(b)
+	}
+
+	culture := FindSeries(collection, " CULTURE")
+	assert.Equal(4, len(culture))
+	ids := []int{}
+	for _, sb := range culture {
+		ids = append(ids, sb.Book.ID())
+	}
+	assert.Equal([]int{2, 1, 4, 3}, ids)
+	assert.Equal("culture", culture[0].Name)
+	assert.Equal(0, len(FindSeries(collection, "unknown")))
+
+	assert.Equal(2.0, SeriesIndex("2-4"))
+	assert.True(math.IsInf(SeriesIndex(""), 1))
+
+	// gaps, and books only known online
+	assert.Equal([]SeriesEntry{{Position: "3"}}, MissingPositions(culture, nil))
+	online := []SeriesEntry{
+		{Position: "1", Title: "Consider Phlebas"},
+		{Position: "3", Title: "Use of Weapons"},
+		{Position: "6", Title: "Excession"},
+		{Position: "1-3", Title: "Omnibus"},
+	}
+	assert.Equal([]SeriesEntry{{Position: "3", Title: "Use of Weapons"}, {Position: "6", Title: "Excession"}}, MissingPositions(culture, online))
+	// large gaps are not listed, positions can be years
+	years := []SeriesBook{{Position: "1984"}, {Position: "1986"}, {Position: "2001"}}
+	assert.Equal([]SeriesEntry{{Position: "1985"}}, MissingPositions(years, nil))
+
+	// series with read books, and unread ones
+	next := NextInSeries(collection)
+	if assert.Equal(1, len(next)) {
+		assert.Equal(2, next[0].Read)
+		assert.Equal(4, next[0].Total)
+		assert.Equal(4, next[0].Next.Book.ID())
+		assert.Equal("4", next[0].Next.Position)
+	}
+
+	// abandoned books are skipped, progress terms can be configured
+	c := standardTestConfig
+	c.Progress = e.Vocabulary{e.ProgressUnread: nil, e.ProgressRead: nil, e.ProgressAbandoned: nil, "wishlist": nil}
+	for j, progress := range []string{e.ProgressRead, e.ProgressAbandoned, "wishlist"} {
+		b := NewBookWithMetadata(ui, j+7, epubs[0].filename, c, true, Metadata{Series: Series{{Name: "Dune", Position: strconv.Itoa(j + 1)}}})
+		b.Progress = progress
+		collection.Add(b)
+	}
+	next = NextInSeries(collection)
+	if assert.Equal(2, len(next)) {
+		assert.Equal("Dune", next[1].Name)
+		assert.Equal(9, next[1].Next.Book.ID())
+	}
+}
@@ -0,0 +1,232 @@
+package book
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	e "github.com/barsanuphe/endive/endive"
+)
+
+// topCount is the number of authors, tags and publishers in Stats.
+const topCount = 10
+
+// Count of books for a name (author, tag, language...).
+type Count struct {
+	Name  string `json:"name"`
+	Books int    `json:"books"`
+}
+
+// PeriodStats counts the books and pages read during a month or a year.
+type PeriodStats struct {
+	Period string `json:"period"`
+	Books  int    `json:"books"`
+	Pages  int    `json:"pages"`
+}
+
+// Stats about the books read, during a year or since the beginning.
+// Re-reads are counted for each reading, other statistics for each book read.
+type Stats struct {
+	Year       string        `json:"year,omitempty"`
+	Books      int           `json:"books"`
+	BooksRead  int           `json:"books_read"`
+	Readings   int           `json:"readings"`
+	PagesRead  int           `json:"pages_read"`
+	Abandoned  int           `json:"abandoned"`
+	ByPeriod   []PeriodStats `json:"by_period"`
+	RatedBooks int           `json:"rated_books"`
+	// average rating given, and average online rating of the same books
+	AverageRating       float64 `json:"average_rating"`
+	AverageOnlineRating float64 `json:"average_online_rating"`
+	// average number of days between import and the end of a reading, for
+	// the readings of books with a known import date
+	TimedReadings     int     `json:"timed_readings"`
+	AverageDaysToRead float64 `json:"average_days_to_read"`
+	Retail            int     `json:"retail"`
+	NonRetail         int     `json:"nonretail"`
+	Authors           []Count `json:"top_authors"`
+	Tags              []Count `json:"top_tags"`
+	Publishers        []Count `json:"top_publishers"`
+	Languages         []Count `json:"languages"`
+}
+
+// NewStats for Books, during a year if it is not empty.
+func NewStats(books []*Book, year string) Stats {
+	s := Stats{Year: year, Books: len(books)}
+	periods := make(map[string]*PeriodStats)
+	authors, tags, publishers, languages := make(map[string]int), make(map[string]int), make(map[string]int), make(map[string]int)
+	var ratings, onlineRatings, days float64
+	for _, b := range books {
+		pages, _ := strconv.Atoi(b.Metadata.NumPages)
+		isRead := false
+		for _, r := range b.Readings {
+			if r.Abandoned != "" && strings.HasPrefix(r.Abandoned, year) {
+				s.Abandoned++
+			}
+			finished, err := time.Parse(dateFormat, r.Finished)
+			if err != nil || !strings.HasPrefix(r.Finished, year) {
+				continue
+			}
+			isRead = true
+			s.Readings++
+			s.PagesRead += pages
+			// by month during a year, else by year
+			period := finished.Format("2006")
+			if year != "" {
+				period = finished.Format("2006-01")
+			}
+			if _, ok := periods[period]; !ok {
+				periods[period] = &PeriodStats{Period: period}
+			}
+			periods[period].Books++
+			periods[period].Pages += pages
+			if d, ok := daysBetween(b.ImportDate(), r.Finished); ok {
+				days += d
+				s.TimedReadings++
+			}
+		}
+		if !isRead {
+			continue
+		}
+		s.BooksRead++
+		if b.HasRetail() {
+			s.Retail++
+		} else {
+			s.NonRetail++
+		}
+		rating, err := strconv.ParseFloat(b.Rating, 64)
+		onlineRating, onlineErr := strconv.ParseFloat(b.Metadata.AverageRating, 64)
+		if err == nil && onlineErr == nil {
+			s.RatedBooks++
+			ratings += rating
+			onlineRatings += onlineRating
+		}
+		for _, author := range b.Metadata.Authors {
+			authors[author]++
+		}
+		for _, tag := range b.Metadata.Tags {
+			tags[tag.Name]++
+		}
+		if b.Metadata.Publisher != "" {
+			publishers[b.Metadata.Publisher]++
+		}
+		if b.Metadata.Language != "" {
+			languages[b.Metadata.Language]++
+		} else {
+			languages[unknown]++
+		}
+	}
+	for _, p := range periods {
+		s.ByPeriod = append(s.ByPeriod, *p)
+	}
+	sort.Slice(s.ByPeriod, func(i, j int) bool { return s.ByPeriod[i].Period < s.ByPeriod[j].Period })
+	if s.RatedBooks != 0 {
+		s.AverageRating = ratings / float64(s.RatedBooks)
+		s.AverageOnlineRating = onlineRatings / float64(s.RatedBooks)
+	}
+	if s.TimedReadings != 0 {
+		s.AverageDaysToRead = days / float64(s.TimedReadings)
+	}
+	s.Authors = topCounts(authors, topCount)
+	s.Tags = topCounts(tags, topCount)
+	s.Publishers = topCounts(publishers, topCount)
+	s.Languages = topCounts(languages, 0)
+	return s
+}
+
+// daysBetween two dates, if both are known and in order.
+func daysBetween(from, to string) (float64, bool) {
+	start, err := time.Parse(dateFormat, from)
+	if err != nil {
+		return 0, false
+	}
+	end, err := time.Parse(dateFormat, to)
+	if err != nil || end.Before(start) {
+		return 0, false
+	}
+	return end.Sub(start).Hours() / 24, true
+}
+
+// topCounts sorted by decreasing number of books, all of them if limit is 0.
+func topCounts(counts map[string]int, limit int) []Count {
+	top := []Count{}
+	for name, books := range counts {
+		top = append(top, Count{Name: name, Books: books})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Books != top[j].Books {
+			return top[i].Books > top[j].Books
+		}
+		return top[i].Name < top[j].Name
+	})
+	if limit != 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
+
+// Table representation of Stats.
+func (s Stats) Table() string {
+	title := "All time"
+	period := "Year"
+	if s.Year != "" {
+		title = s.Year
+		period = "Month"
+	}
+	rows := [][]string{
+		{"Books in the library", strconv.Itoa(s.Books)},
+		{"Books read", strconv.Itoa(s.BooksRead)},
+		{"Readings finished", strconv.Itoa(s.Readings)},
+		{"Readings abandoned", strconv.Itoa(s.Abandoned)},
+		{"Pages read", strconv.Itoa(s.PagesRead)},
+		{"Books read with a retail version", share(s.Retail, s.BooksRead)},
+		{"Books read with a non-retail version only", share(s.NonRetail, s.BooksRead)},
+	}
+	if s.RatedBooks != 0 {
+		rows = append(rows, []string{"Average rating given", fmt.Sprintf("%.2f (%d books)", s.AverageRating, s.RatedBooks)})
+		rows = append(rows, []string{"Average online rating", fmt.Sprintf("%.2f", s.AverageOnlineRating)})
+	}
+	if s.TimedReadings != 0 {
+		rows = append(rows, []string{"Average days between import and reading", fmt.Sprintf("%.0f (%d readings)", s.AverageDaysToRead, s.TimedReadings)})
+	}
+	tables := []string{e.TabulateRows(rows, "Reading statistics", title)}
+
+	var periodRows [][]string
+	for _, p := range s.ByPeriod {
+		periodRows = append(periodRows, []string{p.Period, strconv.Itoa(p.Books), strconv.Itoa(p.Pages)})
+	}
+	tables = append(tables, e.TabulateRows(periodRows, period, "Books read", "Pages read"))
+	for _, top := range []struct {
+		header string
+		counts []Count
+	}{
+		{"Top authors", s.Authors},
+		{"Top tags", s.Tags},
+		{"Top publishers", s.Publishers},
+		{"Languages", s.Languages},
+	} {
+		var countRows [][]string
+		for _, c := range top.counts {
+			countRows = append(countRows, []string{c.Name, strconv.Itoa(c.Books)})
+		}
+		tables = append(tables, e.TabulateRows(countRows, top.header, "Books read"))
+	}
+	// tables without rows are empty
+	nonEmpty := []string{}
+	for _, t := range tables {
+		if t != "" {
+			nonEmpty = append(nonEmpty, t)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// share of a total, as a number and a percentage.
+func share(n, total int) string {
+	if total == 0 {
+		return strconv.Itoa(n)
+	}
+	return fmt.Sprintf("%d (%.0f%%)", n, 100*float64(n)/float64(total))
+}
@@ -0,0 +1,82 @@
+package book
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	fmt.Println("+ Testing NewStats()...")
+	assert := assert.New(t)
+	c := standardTestConfig
+
+	dune := NewBookWithMetadata(ui, 1, "dune.epub", c, isRetail, Metadata{BookTitle: "Dune", Authors: []string{"Frank Herbert"}, Language: "en", NumPages: "600", AverageRating: "4.2", Publisher: "Ace", Tags: Tags{{Name: "sf"}}})
+	dune.Editions[0].Imported = "2015-01-01"
+	dune.Rating = "5"
+	dune.Readings = ReadingLog{{Started: "2015-01-05", Finished: "2015-01-31"}, {Finished: "2016-03-01"}}
+	fondation := NewBookWithMetadata(ui, 2, "fondation.epub", c, !isRetail, Metadata{BookTitle: "Fondation", Authors: []string{"Isaac Asimov"}, Language: "fr", NumPages: "300", AverageRating: "4", Tags: Tags{{Name: "sf"}}})
+	fondation.Rating = "3"
+	fondation.Readings = ReadingLog{{Finished: "2016-03-10"}, {Started: "2016-05-01", Abandoned: "2016-05-02"}}
+	unread := NewBookWithMetadata(ui, 3, "unread.epub", c, isRetail, Metadata{BookTitle: "Unread", Authors: []string{"Frank Herbert"}})
+
+	all := NewStats([]*Book{dune, fondation, unread}, "")
+	assert.Equal(3, all.Books)
+	assert.Equal(2, all.BooksRead)
+	assert.Equal(3, all.Readings)
+	assert.Equal(1, all.Abandoned)
+	assert.Equal(1500, all.PagesRead)
+	assert.Equal([]PeriodStats{{"2015", 1, 600}, {"2016", 2, 900}}, all.ByPeriod)
+	assert.Equal(1, all.Retail)
+	assert.Equal(1, all.NonRetail)
+	assert.Equal(2, all.RatedBooks)
+	assert.Equal(4.0, all.AverageRating)
+	assert.InDelta(4.1, all.AverageOnlineRating, 0.001)
+	// dune was read 30 then 425 days after import
+	assert.Equal(2, all.TimedReadings)
+	assert.Equal(227.5, all.AverageDaysToRead)
+	assert.Equal([]Count{{"Frank Herbert", 1}, {"Isaac Asimov", 1}}, all.Authors)
+	assert.Equal([]Count{{"sf", 2}}, all.Tags)
+	assert.Equal([]Count{{"Ace", 1}}, all.Publishers)
+	assert.Equal([]Count{{"en", 1}, {"fr", 1}}, all.Languages)
+
+	year := NewStats([]*Book{dune, fondation, unread}, "2015")
+	assert.Equal(1, year.BooksRead)
+	assert.Equal(0, year.Abandoned)
+	assert.Equal([]PeriodStats{{"2015-01", 1, 600}}, year.ByPeriod)
+	assert.Equal(30.0, year.AverageDaysToRead)
+	assert.Contains(year.Table(), "Frank Herbert")
+	assert.Contains(year.Table(), "2015-01")
+	data, err := json.Marshal(year)
+	assert.Nil(err)
+	assert.Contains(string(data), `"by_period":[{"period":"2015-01","books":1,"pages":600}]`)
+
+	none := NewStats([]*Book{unread}, "2020")
+	assert.Equal(0, none.BooksRead)
+	assert.NotContains(none.Table(), "Top authors")
+	assert.NotContains(none.Table(), "Average days")
+
+	// books read the day they were imported
+	quick := NewBookWithMetadata(ui, 4, "quick.epub", c, isRetail, Metadata{BookTitle: "Quick", Authors: []string{"Frank Herbert"}})
+	quick.Editions[0].Imported = "2017-02-01"
+	quick.Readings = ReadingLog{{Finished: "2017-02-01"}}
+	same := NewStats([]*Book{quick}, "2017")
+	assert.Equal(1, same.TimedReadings)
+	assert.Equal(0.0, same.AverageDaysToRead)
+	assert.Contains(same.Table(), "Average days between import and reading")
+
+	// without import date, the epub modification date is saved once
+	old := NewBook(ui, 5, epubs[0].filename, c, isRetail)
+	assert.Equal("", old.ImportDate())
+	info, err := os.Stat(old.Editions[0].Epub.FullPath())
+	assert.Nil(err)
+	migrated := Books{*old}
+	assert.Equal(1, migrated.MigrateImportDates())
+	assert.Equal(info.ModTime().Local().Format(dateFormat), migrated[0].Editions[0].Imported)
+	assert.Equal(info.ModTime().Local().Format(dateFormat), migrated[0].ImportDate())
+	assert.Equal(0, migrated.MigrateImportDates())
+	assert.Equal("", unread.ImportDate())
+}
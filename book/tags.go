@@ -1,10 +1,15 @@
 package book
 
-import "strings"
+import (
+	"strings"
+
+	e "github.com/barsanuphe/endive/endive"
+)
 
 // Tag holds the name of a tag.
 type Tag struct {
-	Name string `json:"name" xml:"name,attr"`
+	Name  string `json:"name" xml:"name,attr"`
+	Count int    `json:"-" xml:"count,attr"` // popularity, for Goodreads shelves
 }
 
 // Tags can track a book's Tags
@@ -72,12 +77,32 @@ func (t *Tags) Has(o Tag) (isIn bool, index int) {
 	return
 }
 
-// Clean a list of tags.
-func (t *Tags) Clean() {
-	*t = cleanTags(*t)
+// Clean a list of tags with the tag rules.
+// If a source is given, its tag limit is applied.
+func (t *Tags) Clean(rules e.TagRules, source string) {
+	*t = cleanTags(*t, rules, source)
 	return
 }
 
+// UseHierarchy replaces tags by their path in the tag hierarchy.
+func (t *Tags) UseHierarchy(hierarchy e.TagHierarchy) {
+	tags := Tags{}
+	for _, tag := range *t {
+		tags.Add(Tag{Name: hierarchy.Path(tag.Name), Count: tag.Count})
+	}
+	*t = tags
+}
+
+// HasDescendant checks if a tag is a hierarchical descendant of another.
+func (t *Tags) HasDescendant(ancestor string) bool {
+	for _, tag := range *t {
+		if strings.HasPrefix(tag.Name, ancestor+e.TagSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
 // HasAny checks if epub is part of any series
 func (t *Tags) HasAny() bool {
 	return len(*t) != 0
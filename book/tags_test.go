@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	en "github.com/barsanuphe/endive/endive"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,3 +57,58 @@ func TestTags(t *testing.T) {
 	isIn, _ = e.Metadata.Tags.Has(tag5)
 	assert.False(isIn, "Error: expected tag5 to have been removed.")
 }
+
+func TestTagsClean(t *testing.T) {
+	fmt.Println("+ Testing Tags.Clean()...")
+	assert := assert.New(t)
+	rules := en.TagRules{Allow: []string{"to-read-later"}, Limits: map[string]int{en.TagSourceGoodReads: 3}}
+	tags := Tags{
+		{Name: "Fantasy", Count: 50},
+		{Name: "to-read", Count: 1000},
+		{Name: "to-read-later", Count: 2},
+		{Name: "science-fiction-classics", Count: 20},
+		{Name: "ownership", Count: 30},
+		{Name: "classics", Count: 1},
+	}
+	// no limit without a source
+	cleaned := make(Tags, len(tags))
+	copy(cleaned, tags)
+	cleaned.Clean(rules, "")
+	assert.Equal("fantasy, to-read-later, science-fiction-classics, ownership, classics", cleaned.String())
+	// most popular tags from Goodreads
+	tags.Clean(rules, en.TagSourceGoodReads)
+	assert.Equal("fantasy, ownership, science-fiction-classics", tags.String())
+
+	// retagging with aliases and rules
+	i := Metadata{Tags: Tags{{Name: "sci-fi"}, {Name: "owned"}, {Name: "space opera"}}}
+	assert.Equal("science-fiction, space opera", i.CleanTags(standardTestConfig).String())
+}
+
+func TestTagsHierarchy(t *testing.T) {
+	fmt.Println("+ Testing Tags.UseHierarchy()...")
+	assert := assert.New(t)
+	c := standardTestConfig
+	c.TagHierarchy = en.TagHierarchy{"fiction/science-fiction/space-opera", "fiction/fantasy"}
+
+	i := Metadata{Tags: Tags{{Name: "space-opera"}, {Name: "sci-fi"}, {Name: "Fantasy"}, {Name: "dragons"}}}
+	i.Clean(c)
+	assert.Equal("fiction/science-fiction/space-opera, fiction/science-fiction, fiction/fantasy, dragons", i.Tags.String())
+	assert.True(i.Tags.HasDescendant("fiction"))
+	assert.True(i.Tags.HasDescendant("fiction/science-fiction"))
+	assert.False(i.Tags.HasDescendant("fiction/fantasy"))
+	// category and genre from hierarchical tags
+	assert.Equal(fiction, i.Category)
+	assert.Equal("space-opera", i.Genre)
+
+	// retagging existing books
+	i = Metadata{Tags: Tags{{Name: "sci-fi"}, {Name: "to-read"}}}
+	assert.Equal("fiction/science-fiction", i.CleanTags(c).String())
+
+	// subject codes, from the closest tag
+	c.TagSubjects = en.TagSubjects{
+		"fiction/science-fiction": en.SubjectCode{Authority: en.AuthorityBISAC, Code: "FIC028000"},
+		"fiction/fantasy":         en.SubjectCode{Authority: en.AuthorityThema, Code: "FM"},
+	}
+	i = Metadata{Tags: Tags{{Name: "fiction/science-fiction/space-opera"}, {Name: "fiction/science-fiction"}, {Name: "fiction/fantasy"}, {Name: "dragons"}}}
+	assert.Equal([]en.SubjectCode{{Authority: en.AuthorityBISAC, Code: "FIC028000"}, {Authority: en.AuthorityThema, Code: "FM"}}, i.SubjectCodes(c))
+}
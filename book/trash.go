@@ -0,0 +1,193 @@
+package book
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	e "github.com/barsanuphe/endive/endive"
+	h "github.com/barsanuphe/helpers"
+	i "github.com/barsanuphe/helpers/ui"
+)
+
+// Reasons for moving epubs to the trash.
+const (
+	TrashReasonRemoved    = "removed"
+	TrashReasonSuperseded = "superseded"
+)
+
+const (
+	trashRecordFile = "book.json"
+	trashDateFormat = "2006-01-02_15-04-05"
+)
+
+// TrashEntry is a removed Book, or some of its epubs, with its database record.
+// The epubs of the record are relative to the directory of the entry.
+type TrashEntry struct {
+	Dir    string    `json:"-"`
+	Date   time.Time `json:"date"`
+	Reason string    `json:"reason"`
+	Book   Book      `json:"book"`
+}
+
+// moveFile, even across filesystems.
+func moveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	if err := h.CopyFile(src, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+// Trash all epubs of a Book, if any, before removing it from the collection.
+func (b *Book) Trash() error {
+	editions := []int{}
+	for k := range b.Editions {
+		editions = append(editions, k)
+	}
+	return b.trash(TrashReasonRemoved, editions...)
+}
+
+// trash the epubs of editions of a Book, with a copy of its database record.
+// A Book without epub can be trashed, to keep its record.
+// The record is written first, and epubs already moved are restored if one of
+// them cannot be moved.
+func (b *Book) trash(reason string, editions ...int) error {
+	if b.Config.TrashDir == "" {
+		return errors.New("Trash directory is not set")
+	}
+	record := b.Copy()
+	record.Editions = nil
+	trashed := make(map[int]bool)
+	paths := []string{}
+	for _, k := range editions {
+		if k >= 0 && k < len(b.Editions) && !trashed[k] {
+			record.Editions = append(record.Editions, b.Editions[k])
+			paths = append(paths, b.Editions[k].Epub.FullPath())
+			trashed[k] = true
+		}
+	}
+	if len(editions) != 0 && !record.HasEpub() {
+		return errors.New("No epub to move to the trash")
+	}
+	now := time.Now()
+	dir := filepath.Join(b.Config.TrashDir, fmt.Sprintf("%s_%d_%s", now.Format(trashDateFormat), b.ID(), reason))
+	// several entries can be created in the same second
+	for n := 2; h.DirectoryExists(dir); n++ {
+		dir = filepath.Join(b.Config.TrashDir, fmt.Sprintf("%s_%d_%s_%d", now.Format(trashDateFormat), b.ID(), reason, n))
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	for _, ep := range record.Epubs() {
+		ep.Filename = filepath.Base(ep.Filename)
+	}
+	data, err := json.MarshalIndent(TrashEntry{Date: now, Reason: reason, Book: *record}, "", "    ")
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, trashRecordFile), data, 0777)
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	for j, path := range paths {
+		if err := moveFile(path, filepath.Join(dir, filepath.Base(path))); err != nil {
+			for _, moved := range paths[:j] {
+				if restoreErr := moveFile(filepath.Join(dir, filepath.Base(moved)), moved); restoreErr != nil {
+					return fmt.Errorf("%s; could not restore %s from %s: %s", err.Error(), moved, dir, restoreErr.Error())
+				}
+			}
+			os.RemoveAll(dir)
+			return err
+		}
+	}
+	kept := []Edition{}
+	for k, ed := range b.Editions {
+		if !trashed[k] {
+			kept = append(kept, ed)
+		}
+	}
+	b.Editions = kept
+	return nil
+}
+
+// ListTrash returns the entries of the trash, the oldest first.
+func ListTrash(cfg e.Config, ui i.UserInterface) ([]TrashEntry, error) {
+	if cfg.TrashDir == "" {
+		return nil, errors.New("Trash directory is not set")
+	}
+	dirs, err := ioutil.ReadDir(cfg.TrashDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entries := []TrashEntry{}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		path := filepath.Join(cfg.TrashDir, dir.Name())
+		data, err := ioutil.ReadFile(filepath.Join(path, trashRecordFile))
+		if err != nil {
+			ui.Warning("Ignoring invalid trash entry " + path)
+			continue
+		}
+		var entry TrashEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			ui.Warning("Ignoring invalid trash entry " + path)
+			continue
+		}
+		entry.Dir = path
+		entry.Book.Config, entry.Book.UI = cfg, ui
+		for _, ep := range entry.Book.Epubs() {
+			ep.Config, ep.UI = cfg, ui
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(j, k int) bool {
+		return entries[j].Date.Before(entries[k].Date)
+	})
+	return entries, nil
+}
+
+// Hashes of the epubs of a TrashEntry.
+func (t TrashEntry) Hashes() []string {
+	hashes := []string{}
+	for _, ed := range t.Book.Editions {
+		if ep := ed.Epub; ep.Hash != "" {
+			hashes = append(hashes, ep.Hash)
+		}
+	}
+	return hashes
+}
+
+// Restore the epubs of a TrashEntry to the library, and return its Book.
+// The entry is then removed from the trash.
+func (t *TrashEntry) Restore() (*Book, error) {
+	bk := t.Book.Copy()
+	for _, ep := range bk.Epubs() {
+		if _, err := h.FileExists(filepath.Join(bk.Config.LibraryRoot, ep.Filename)); err == nil {
+			return nil, errors.New("File already exists in the library: " + ep.Filename)
+		}
+	}
+	for _, ep := range bk.Epubs() {
+		if err := moveFile(filepath.Join(t.Dir, ep.Filename), filepath.Join(bk.Config.LibraryRoot, ep.Filename)); err != nil {
+			return nil, err
+		}
+	}
+	return bk, t.Delete()
+}
+
+// Delete a TrashEntry and its epubs permanently.
+func (t *TrashEntry) Delete() error {
+	return os.RemoveAll(t.Dir)
+}
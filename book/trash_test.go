@@ -0,0 +1,91 @@
+package book
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	h "github.com/barsanuphe/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookTrash(t *testing.T) {
+	fmt.Println("+ Testing Book.Trash(), ListTrash(), TrashEntry.Restore()...")
+	assert := assert.New(t)
+	libraryDir, err := ioutil.TempDir("", "endive-library")
+	assert.Nil(err)
+	defer os.RemoveAll(libraryDir)
+	c := standardTestConfig
+	c.LibraryRoot = libraryDir
+
+	// no trash directory
+	bk := NewBook(ui, 3, "retail.epub", c, isRetail)
+	assert.NotNil(bk.Trash())
+	c.TrashDir = filepath.Join(libraryDir, "trash")
+
+	entries, err := ListTrash(c, ui)
+	assert.Nil(err)
+	assert.Equal(0, len(entries))
+
+	// a book with retail and non-retail epubs
+	for _, name := range []string{"retail.epub", "nonretail.epub"} {
+		assert.Nil(h.CopyFile(filepath.Join(parentDir, epubs[0].filename), filepath.Join(libraryDir, name)))
+	}
+	bk = NewBookWithMetadata(ui, 3, "retail.epub", c, isRetail, Metadata{BookTitle: "Excession"})
+	bk.MainEpub().Hash = "retailhash"
+	bk.Editions = append(bk.Editions, Edition{Epub: Epub{Filename: "nonretail.epub", Hash: "nonretailhash", Config: c, UI: ui}})
+	bk.Rating = "5"
+
+	// superseded non-retail epub
+	assert.Nil(bk.removeEdition(bk.EditionIndex(false)))
+	assert.False(bk.HasNonRetail())
+	assert.True(bk.HasRetail())
+	_, err = h.FileExists(filepath.Join(libraryDir, "nonretail.epub"))
+	assert.NotNil(err)
+	// whole book
+	assert.Nil(bk.Trash())
+	assert.False(bk.HasEpub())
+	// a book with missing epubs keeps its epubs if one cannot be moved
+	assert.Nil(h.CopyFile(filepath.Join(parentDir, epubs[0].filename), filepath.Join(libraryDir, "other.epub")))
+	other := NewBookWithMetadata(ui, 4, "other.epub", c, isRetail, Metadata{BookTitle: "Inversions"})
+	other.Editions = append(other.Editions, Edition{Epub: Epub{Filename: "missing.epub", Config: c, UI: ui}})
+	assert.NotNil(other.Trash())
+	assert.Equal(2, len(other.Editions))
+	_, err = h.FileExists(filepath.Join(libraryDir, "other.epub"))
+	assert.Nil(err)
+	// a book without epub only leaves its record
+	other.Editions = nil
+	assert.Nil(other.Trash())
+
+	entries, err = ListTrash(c, ui)
+	assert.Nil(err)
+	if assert.Equal(3, len(entries)) {
+		assert.Equal(4, entries[2].Book.ID())
+		assert.Equal(0, len(entries[2].Hashes()))
+		assert.Equal("Inversions", entries[2].Book.Metadata.BookTitle)
+		assert.Nil(entries[2].Delete())
+
+		assert.Equal(TrashReasonSuperseded, entries[0].Reason)
+		assert.Equal([]string{"nonretailhash"}, entries[0].Hashes())
+		assert.Equal(TrashReasonRemoved, entries[1].Reason)
+		assert.Equal([]string{"retailhash"}, entries[1].Hashes())
+		assert.Equal("5", entries[1].Book.Rating)
+		assert.Equal("Excession", entries[1].Book.Metadata.BookTitle)
+
+		// restoring
+		restored, err := entries[1].Restore()
+		assert.Nil(err)
+		assert.Equal(3, restored.ID())
+		assert.True(restored.HasRetail())
+		assert.Equal("5", restored.Rating)
+		_, err = h.FileExists(restored.FullPath())
+		assert.Nil(err)
+		// deleting
+		assert.Nil(entries[0].Delete())
+	}
+	entries, err = ListTrash(c, ui)
+	assert.Nil(err)
+	assert.Equal(0, len(entries))
+}
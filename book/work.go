@@ -0,0 +1,120 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// workIdentifier is the prefix of the IDs of works linked manually.
+const workIdentifier = "endive"
+
+// Work of which a Book is an edition or a translation.
+// Books describing the same work share its ID.
+type Work struct {
+	ID       string `json:"id"`
+	Title    string `json:"title,omitempty"`
+	Year     string `json:"year,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// String representation of a Work.
+func (w Work) String() string {
+	details := []string{}
+	for _, value := range []string{w.Year, w.Language} {
+		if value != "" {
+			details = append(details, value)
+		}
+	}
+	title := w.Title
+	if title == "" {
+		title = unknown
+	}
+	if len(details) != 0 {
+		title += " (" + strings.Join(details, ", ") + ")"
+	}
+	return fmt.Sprintf("%s [%s]", title, w.ID)
+}
+
+// newWork with a Book as its original edition.
+func newWork(b *Book) *Work {
+	w := &Work{ID: workIdentifier + ":" + strconv.Itoa(b.ID()), Title: b.Metadata.BookTitle, Language: b.Metadata.Language}
+	if b.Metadata.OriginalYear != unknownYear {
+		w.Year = b.Metadata.OriginalYear
+	}
+	return w
+}
+
+// WorkID of a Book, or an empty string if it is not linked to a work.
+func (b *Book) WorkID() string {
+	if b.Metadata.Work == nil {
+		return ""
+	}
+	return b.Metadata.Work.ID
+}
+
+// SetWork of a Book, or unlink it if nil. The original year of the work is
+// also the original year of the Book.
+func (b *Book) SetWork(w *Work) {
+	if w == nil {
+		b.Metadata.Work = nil
+		return
+	}
+	work := *w
+	b.Metadata.Work = &work
+	b.Metadata.cleanWork()
+}
+
+// LinkWork of several Books, translations or editions of the same work.
+// The work of the linked Books is used, or else a new one, with the first Book
+// as its original edition. Books already linked to different works must be
+// unlinked first.
+func LinkWork(books ...*Book) (*Work, error) {
+	if len(books) < 2 {
+		return nil, errors.New("At least two books must be given to be linked")
+	}
+	var work *Work
+	for _, b := range books {
+		if b.Metadata.Work == nil {
+			continue
+		}
+		if work == nil {
+			work = b.Metadata.Work
+		} else if b.Metadata.Work.ID != work.ID {
+			return nil, fmt.Errorf("Cannot link books of different works: %s and %s, unlink them first", work.String(), b.Metadata.Work.String())
+		}
+	}
+	if work == nil {
+		work = newWork(books[0])
+	}
+	linked := *work
+	for _, b := range books {
+		b.SetWork(&linked)
+	}
+	return &linked, nil
+}
+
+// mergeWork of another Metadata, if this one is not linked to a work.
+func (i *Metadata) mergeWork(o *Metadata) {
+	if i.Work == nil && o.Work != nil {
+		work := *o.Work
+		i.Work = &work
+	}
+}
+
+// cleanWork: the original year is the work's, and the work's language is
+// the language of its original edition.
+func (i *Metadata) cleanWork() {
+	if i.Work == nil {
+		return
+	}
+	if i.Work.Year != "" {
+		i.OriginalYear = i.Work.Year
+	} else if i.OriginalYear != "" && i.OriginalYear != unknownYear {
+		i.Work.Year = i.OriginalYear
+	}
+	if i.Work.Language == "" && i.Work.Title != "" && strings.EqualFold(i.Work.Title, i.BookTitle) {
+		i.Work.Language = i.Language
+	}
+}
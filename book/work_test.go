@@ -0,0 +1,110 @@
+package book
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGoodReadsWork = `<book>
+  <id>4812</id>
+  <title>La Fondation</title>
+  <language_code>fre</language_code>
+  <publication_year>2009</publication_year>
+  <work>
+    <id>1083280</id>
+    <original_publication_year>1951</original_publication_year>
+    <original_title>Foundation</original_title>
+  </work>
+</book>`
+
+func TestWork(t *testing.T) {
+	fmt.Println("+ Testing Work...")
+	assert := assert.New(t)
+	c := standardTestConfig
+
+	original := NewBookWithMetadata(ui, 1, "foundation.epub", c, isRetail, Metadata{BookTitle: "Foundation", Authors: []string{"Isaac Asimov"}, OriginalYear: "1951", Language: "en"})
+	translation := NewBookWithMetadata(ui, 2, "fondation.epub", c, isRetail, Metadata{BookTitle: "Fondation", Authors: []string{"Isaac Asimov"}, OriginalYear: "2009", EditionYear: "2009", Language: "fr"})
+	other := NewBookWithMetadata(ui, 3, "robots.epub", c, isRetail, Metadata{BookTitle: "I, Robot", Authors: []string{"Isaac Asimov"}, OriginalYear: "1950", Language: "en"})
+	assert.Equal("", original.WorkID())
+
+	// linking books
+	_, err := LinkWork(original)
+	assert.NotNil(err)
+	work, err := LinkWork(original, translation)
+	assert.Nil(err)
+	assert.Equal(Work{ID: "endive:1", Title: "Foundation", Year: "1951", Language: "en"}, *work)
+	assert.Equal("Foundation (1951, en) [endive:1]", work.String())
+	assert.Equal("endive:1", translation.WorkID())
+	assert.Equal("1951", translation.Metadata.OriginalYear)
+	assert.Equal("2009", translation.Metadata.EditionYear)
+	// the works are not shared
+	translation.Metadata.Work.Title = "Fondation"
+	assert.Equal("Foundation", original.Metadata.Work.Title)
+	translation.Metadata.Work.Title = "Foundation"
+
+	// the work of the first linked book is kept
+	_, err = LinkWork(other, translation)
+	assert.Nil(err)
+	assert.Equal("endive:1", other.WorkID())
+	assert.Equal("1951", other.Metadata.OriginalYear)
+	other.SetWork(nil)
+	assert.Equal("", other.WorkID())
+
+	// books of different works are not linked
+	sequel := NewBookWithMetadata(ui, 4, "foundation_and_empire.epub", c, isRetail, Metadata{BookTitle: "Foundation and Empire", Authors: []string{"Isaac Asimov"}, OriginalYear: "1952", Language: "en"})
+	_, err = LinkWork(sequel, other)
+	assert.Nil(err)
+	assert.Equal("endive:4", other.WorkID())
+	_, err = LinkWork(other, translation)
+	assert.NotNil(err)
+	assert.Equal("endive:4", other.WorkID())
+	assert.Equal("endive:1", translation.WorkID())
+	other.SetWork(nil)
+
+	books := Books{*original, *translation, *other}
+	assert.Equal(2, len(books.Work("endive:1").Books()))
+	assert.Equal(0, len(books.Work("").Books()))
+
+	// copies and saved books
+	assert.Equal(original.Metadata.Work, original.Metadata.copy().Work)
+	data, err := json.Marshal(translation)
+	assert.Nil(err)
+	assert.Contains(string(data), `"work":{"id":"endive:1","title":"Foundation","year":"1951","language":"en"}`)
+	data, err = json.Marshal(other)
+	assert.Nil(err)
+	assert.NotContains(string(data), `"work"`)
+}
+
+func TestGoodReadsWork(t *testing.T) {
+	fmt.Println("+ Testing goodReadsBook work...")
+	assert := assert.New(t)
+	g := goodReadsBook{}
+	assert.Nil(xml.Unmarshal([]byte(testGoodReadsWork), &g))
+	online := g.metadata()
+	if assert.NotNil(online.Work) {
+		assert.Equal(Work{ID: "goodreads:1083280", Title: "Foundation", Year: "1951"}, *online.Work)
+	}
+
+	// the work is merged with the online metadata
+	info := Metadata{BookTitle: "La Fondation", Language: "fr", OriginalYear: "2009", EditionYear: "2009"}
+	info.mergeWork(&online)
+	info.Clean(standardTestConfig)
+	if assert.NotNil(info.Work) {
+		assert.Equal("goodreads:1083280", info.Work.ID)
+		assert.Equal("", info.Work.Language)
+	}
+	assert.Equal("1951", info.OriginalYear)
+	// known works are kept
+	other := Metadata{Work: &Work{ID: "goodreads:1"}}
+	info.mergeWork(&other)
+	assert.Equal("goodreads:1083280", info.Work.ID)
+
+	// the language of the original edition is the language of the work
+	original := Metadata{BookTitle: "Foundation", Language: "English", Work: &Work{ID: "goodreads:1083280", Title: "Foundation"}}
+	original.Clean(standardTestConfig)
+	assert.Equal("en", original.Work.Language)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	docopt "github.com/docopt/docopt-go"
 
@@ -27,6 +28,9 @@ const (
 	infoAuthors           = "Authors"
 	infoBook              = "Book"
 	infoGeneral           = "General"
+	readingStart          = "start"
+	readingFinish         = "finish"
+	readingAbandon        = "abandon"
 
 	endiveVersion = "Endive -- CLI Epub collection manager -- v1.0."
 	endiveUsage   = `
@@ -40,33 +44,71 @@ The main commands are:
 	export, x	Export epubs to ereader
 	info		Display information
 	edit		Edit metadata
+	cover		Manage book covers
+	merge		Merge two books
+	split		Move an epub to a new book
+	work		Link translations and editions of the same work
+	remove		Move books to the trash
+	flag-replace	Flag an epub for replacement
+	trash		Manage removed epubs
 	progress, p	Set book reading progress
+	reading		Log when a book is started, finished or abandoned
 	list, ls	List books
+	stats		Show reading statistics
 	search, s	Search for specific books
 
 Searching / Exporting:
 	A list of strings can be given as input to search for books.
 	It is also possible to restrict a value to a specific field: field:value.
 	Valid fields are:
-		author, title, year, language, series, tag, publisher, category,
-		type, genre, description, exported, progress, review.
+		author, translator, editor, title, subtitle, year, language, series,
+		tag, publisher, category, type, genre, description, work, exported,
+		progress, review, readdate, started, abandoned.
+	Reading dates can be searched by year or month: readdate:2016, readdate:"2016-05".
+	The year of a book linked to a work is the original year of the work.
 	Examples:
 		'author:XX title:YY' will give results satifsying any of the two conditions.
 		'author:XX +title:YY' will give results satifsying both conditions.
 		'author:XX -title:YY' will give results satifsying the first condition excluding the second.
 
+Editing list fields:
+	Values of author, translator, editor, tags, series, identifiers and
+	custom list fields can be added with +value and removed with -value.
+	Existing series and identifiers are updated when added again.
+	Examples:
+		endive set field tags +space-opera -scifi 12 13
+		endive set field tags "+space-opera, -scifi" 12 13
+		endive set field series "+Culture:3" --query=author:banks
+
 Usage:
 	endive config
 	endive collection (check|refresh|rebuild-index|check-index)
+	endive collection embed [<ID>...]
+	endive collection retag [--dry-run]
+	endive collection lint [<check>...] [--aliases|--fix]
+	endive collection duplicates [--merge]
 	endive (import|i) ((retail|r)|(nonretail|nr)) [--list] [<epub>...]
 	endive (export|x) (all|(id <ID>...)|<search-criteria>...) [--dir=DIRECTORY]
 	endive info [tags|series|authors|publishers] [<ID>]
-	endive (list|ls) [--incomplete|--nonretail|--retail] [--first=N|--last=N] [--sort=SORT]
+	endive stats [--year=YEAR] [--json]
+	endive (list|ls) [--incomplete|--nonretail|--retail|--flagged] [--first=N|--last=N] [--sort=SORT]
 	endive (search|s) <search-criteria>... [--first=N|--last=N] [--sort=SORT]
-	endive review <ID> <rating> [<review>]
-	endive set (unread|read|reading|shortlisted|(field <field_name> <value>)) <ID>...
-	endive edit [(field <field_name>)] <ID>...
-	endive reset [(field <field_name>)] <ID>...
+	endive series <series_name> [--online]
+	endive next
+	endive review (<ID>|--query=QUERY) <rating> [<review>]
+	endive set ((field <field_name> <value>)|<progress>) (<ID>...|--query=QUERY) [--work]
+	endive reading (start|finish|abandon) (<ID>...|--query=QUERY) [--date=DATE] [--format=FORMAT] [--notes=NOTES] [--work]
+	endive edit [(field <field_name>)|--bulk] (<ID>...|--query=QUERY)
+	endive reset [(field <field_name>)] (<ID>...|--query=QUERY)
+	endive cover <ID> (set [<source>]|extract|show)
+	endive merge <ID> <ID>
+	endive split <ID> [retail|nonretail|--edition=N]
+	endive work (link|unlink) (<ID>...|--query=QUERY)
+	endive remove (<ID>...|--query=QUERY) [--forget]
+	endive flag-replace <ID> [retail|nonretail] [<reason>|--clear]
+	endive trash list
+	endive trash restore <trashed_ID>...
+	endive trash empty [--older-than=AGE] [--forget]
 	endive -h | --help
 	endive --version
 
@@ -80,7 +122,25 @@ Options:
 	-s SORT --sort=SORT  Sort results [default: id].
 	--incomplete         Filter books with incomplete metadata.
 	--retail             Only show retail books.
-	--nonretail          Only show non-retail books.`
+	--nonretail          Only show non-retail books.
+	--flagged            Only show books with an epub flagged for replacement.
+	--dry-run            Only show what would be changed.
+	--query=QUERY        Select books with search criteria instead of IDs.
+	--bulk               Edit all books at once as YAML, in $EDITOR.
+	--aliases            Suggest aliases for the configuration file.
+	--fix                Fix issues interactively.
+	--merge              Merge duplicates interactively.
+	--clear              Remove the replacement flags.
+	--forget             Forget the hashes of the epubs, so they can be imported again.
+	--older-than=AGE     Only delete epubs trashed before this age (ex: 30d, 12h).
+	--online             Get the complete series from Goodreads.
+	--edition=N          Number of the edition, as shown by endive info.
+	--work               Also set the progress of the other editions of the work.
+	--date=DATE          Date of the reading event (YYYY-MM-DD), today by default.
+	--format=FORMAT      Format or device used for reading (ex: paper, kobo).
+	--notes=NOTES        Notes about the reading.
+	--year=YEAR          Only show statistics for this year.
+	--json               Output statistics as JSON.`
 )
 
 // CLI sorts and checks user input
@@ -105,6 +165,15 @@ type CLI struct {
 	checkIndex        bool
 	refreshCollection bool
 	rebuildIndex      bool
+	embedMetadata     bool
+	retag             bool
+	dryRun            bool
+	lint              bool
+	lintChecks        []string
+	lintAliases       bool
+	lintFix           bool
+	duplicates        bool
+	mergeDuplicates   bool
 	// import
 	importRetail bool
 	importEpubs  bool
@@ -114,8 +183,16 @@ type CLI struct {
 	exportDirectory string
 	// info
 	info string
+	// stats
+	stats     bool
+	statsYear string
+	statsJSON bool
 	// search
 	search bool
+	// series
+	series       string
+	seriesOnline bool
+	next         bool
 	// review
 	review     bool
 	rating     string
@@ -124,14 +201,48 @@ type CLI struct {
 	list bool
 	// edit, set, reset
 	edit     bool
+	bulk     bool
 	set      bool
 	reset    bool
 	progress string
+	listEdit bool
+	// books selected with search criteria
+	selected bool
+	// merge, split
+	merge        bool
+	split        bool
+	splitRetail  bool
+	splitEdition int
+	// work
+	linkWork   bool
+	unlinkWork bool
+	// reading log
+	reading       string
+	readingDate   string
+	readingFormat string
+	readingNotes  string
+	// flag-replace
+	flagReplace bool
+	flagEpub    string
+	flagReason  string
+	clearFlag   bool
+	// remove, trash
+	remove         bool
+	forget         bool
+	showTrash      bool
+	restoreTrash   bool
+	restoreIDs     []int
+	emptyTrash     bool
+	trashOlderThan time.Duration
+	// cover
+	cover       bool
+	coverAction string
+	coverSource string
 }
 
 func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 	// parse arguments and options
-	args, err := docopt.Parse(endiveUsage, osArgs, true, endiveVersion, false, false)
+	args, err := docopt.Parse(endiveUsage, joinListEdits(osArgs), true, endiveVersion, false, false)
 	if err != nil {
 		return err
 	}
@@ -152,7 +263,12 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 			return errors.New(incorrectInput)
 		}
 		// if [<ID>], idsString can be an empty slice
-		if len(idsString) != 0 {
+		if len(idsString) != 0 && !areIDs(idsString) {
+			// search criteria were given instead of IDs
+			if err := o.selectBooks(e, strings.Join(idsString, " ")); err != nil {
+				return err
+			}
+		} else if len(idsString) != 0 {
 			// convert to int
 			ids := []int{}
 			for _, i := range idsString {
@@ -181,6 +297,13 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 		}
 	}
 
+	// selecting books with a search query instead of IDs
+	if query, ok := args["--query"].(string); ok {
+		if err := o.selectBooks(e, query); err != nil {
+			return err
+		}
+	}
+
 	// checking other common flags
 	o.firstN = invalidLimit
 	if args["--first"] != nil {
@@ -214,6 +337,15 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 		o.rebuildIndex = args["rebuild-index"].(bool)
 		o.refreshCollection = args["refresh"].(bool)
 		o.checkIndex = args["check-index"].(bool)
+		o.embedMetadata = args["embed"].(bool)
+		o.retag = args["retag"].(bool)
+		o.dryRun = args["--dry-run"].(bool)
+		o.lint = args["lint"].(bool)
+		o.lintChecks, _ = args["<check>"].([]string)
+		o.lintAliases = args["--aliases"].(bool)
+		o.lintFix = args["--fix"].(bool)
+		o.duplicates = args["duplicates"].(bool)
+		o.mergeDuplicates = args["--merge"].(bool)
 	}
 
 	if args["import"].(bool) || args["i"].(bool) {
@@ -249,7 +381,7 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 	if args["info"].(bool) {
 		if args["tags"].(bool) {
 			o.info = infoTags
-			o.collectionMap = o.collection.Tags()
+			o.collectionMap = o.collection.TagTree()
 		} else if args["series"].(bool) {
 			o.info = infoSeries
 			o.collectionMap = o.collection.Series()
@@ -268,6 +400,80 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 		}
 	}
 
+	o.stats = args["stats"].(bool)
+	if year, ok := args["--year"].(string); ok {
+		if _, err := strconv.Atoi(year); err != nil || len(year) != 4 {
+			return errors.New("Year must be given as YYYY.")
+		}
+		o.statsYear = year
+	}
+	o.statsJSON = args["--json"].(bool)
+
+	// "series" is shared with the info command
+	if args["series"].(bool) && !args["info"].(bool) {
+		o.series, _ = args["<series_name>"].(string)
+		o.seriesOnline = args["--online"].(bool)
+	}
+	o.next = args["next"].(bool)
+	o.merge = args["merge"].(bool)
+	if o.merge && (len(o.books) != 2 || o.books[0].ID() == o.books[1].ID()) {
+		return errors.New("Two different books must be given to be merged.")
+	}
+	o.flagReplace = args["flag-replace"].(bool)
+	if o.flagReplace {
+		if len(o.books) != 1 {
+			return errors.New("Search criteria must select a single book.")
+		}
+		if args["retail"].(bool) {
+			o.flagEpub = "retail"
+		} else if args["nonretail"].(bool) {
+			o.flagEpub = "nonretail"
+		}
+		o.flagReason, _ = args["<reason>"].(string)
+		o.clearFlag = args["--clear"].(bool)
+	}
+	o.remove = args["remove"].(bool)
+	o.forget = args["--forget"].(bool)
+	if args["trash"].(bool) {
+		o.showTrash = args["list"].(bool)
+		o.restoreTrash = args["restore"].(bool)
+		o.emptyTrash = args["empty"].(bool)
+		if o.restoreTrash {
+			for _, idString := range args["<trashed_ID>"].([]string) {
+				id, err := strconv.Atoi(idString)
+				if err != nil {
+					return errors.New(incorrectIDValue)
+				}
+				o.restoreIDs = append(o.restoreIDs, id)
+			}
+		}
+		if age, ok := args["--older-than"].(string); ok {
+			if o.trashOlderThan, err = parseAge(age); err != nil {
+				return err
+			}
+		}
+	}
+	o.split = args["split"].(bool)
+	if o.split {
+		if len(o.books) != 1 {
+			return errors.New("Search criteria must select a single book.")
+		}
+		o.splitRetail = args["retail"].(bool)
+		if edition, ok := args["--edition"].(string); ok {
+			if o.splitEdition, err = strconv.Atoi(edition); err != nil || o.splitEdition < 1 {
+				return errors.New("Edition must be a positive number.")
+			}
+		}
+	}
+
+	if args["work"].(bool) {
+		o.linkWork = args["link"].(bool)
+		o.unlinkWork = args["unlink"].(bool)
+		if o.linkWork && len(o.books) < 2 {
+			return errors.New("At least two books must be given to be linked.")
+		}
+	}
+
 	o.review = args["review"].(bool)
 	o.rating, ok = args["<rating>"].(string)
 	if ok {
@@ -278,13 +484,17 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 	}
 	o.reviewText, _ = args["<review>"].(string)
 
-	o.list = args["list"].(bool) || args["ls"].(bool)
+	// "list" is also a trash subcommand
+	o.list = (args["list"].(bool) && !args["trash"].(bool)) || args["ls"].(bool)
 	if args["--incomplete"].(bool) {
 		o.collection = o.collection.Incomplete()
 	}
 	if args["--retail"].(bool) {
 		o.collection = o.collection.Retail()
 	}
+	if args["--flagged"].(bool) {
+		o.collection = o.collection.NeedsReplacement()
+	}
 	if args["--nonretail"].(bool) {
 		o.collection = o.collection.NonRetailOnly()
 	}
@@ -294,23 +504,154 @@ func (o *CLI) parseArgs(e *Endive, osArgs []string) error {
 		o.field = strings.ToLower(o.field)
 	}
 	// check it's a valid field name
-	if ok && !b.CheckValidField(o.field) {
+	if ok && !b.CheckValidField(o.field, e.Config) {
 		return errors.New("Invalid field!")
 	}
 	o.value, _ = args["<value>"].(string)
+	// +value and -value add or remove values of list fields
+	o.listEdit = o.field != "" && b.IsListEdit(o.value)
 
 	o.edit = args["edit"].(bool)
+	o.bulk = args["--bulk"].(bool)
 	o.reset = args["reset"].(bool)
-	o.set = args["set"].(bool)
-	for _, p := range []string{"unread", "read", "reading", "shortlisted"} {
-		if args[p].(bool) {
-			o.progress = p
-			break
+	o.cover = args["cover"].(bool)
+	// "set" is shared with the cover command
+	o.set = args["set"].(bool) && !o.cover
+	if progress, ok := args["<progress>"].(string); ok && o.set {
+		o.progress, err = e.Config.ProgressVocabulary().Clean(progress)
+		if err != nil {
+			return errors.New("Invalid progress: " + err.Error())
 		}
 	}
-	if o.set && !args["field"].(bool) && o.progress == "" {
-		return errors.New("Invalid progress")
+	if args["reading"].(bool) {
+		for _, event := range []string{readingStart, readingFinish, readingAbandon} {
+			if args[event].(bool) {
+				o.reading = event
+				break
+			}
+		}
+		date, _ := args["--date"].(string)
+		if o.readingDate, err = b.CheckReadingDate(date); err != nil {
+			return err
+		}
+		o.readingFormat, _ = args["--format"].(string)
+		o.readingNotes, _ = args["--notes"].(string)
+	}
+	if args["--work"].(bool) {
+		if o.progress == "" && o.reading == "" {
+			return errors.New("--work can only be used to set the reading progress.")
+		}
+		o.selectWorks(e)
 	}
 
+	if o.cover {
+		for _, action := range []string{"set", "extract", "show"} {
+			if args[action].(bool) {
+				o.coverAction = action
+				break
+			}
+		}
+		o.coverSource, _ = args["<source>"].(string)
+	}
+	// search criteria can select several books, but only one is expected here
+	if (o.info == infoBook || o.cover) && len(o.books) > 1 {
+		return errors.New("Search criteria must select a single book.")
+	}
+	return nil
+}
+
+// changesSelection checks if the command modifies the selected books.
+// List edits already show what would change before asking for confirmation.
+func (o *CLI) changesSelection() bool {
+	return o.edit || o.reset || o.review || o.embedMetadata || o.reading != "" || (o.set && !o.listEdit)
+}
+
+// selectBooks found by a search query, as if their IDs had been given.
+func (o *CLI) selectBooks(e *Endive, query string) error {
+	results, err := e.Library.Search(query, "id", invalidLimit, invalidLimit, &b.Books{})
+	if err != nil {
+		return err
+	}
+	if results == nil {
+		return errors.New("No book found for query " + query)
+	}
+	// search results are copies, getting the Books from the collection
+	ids := []int{}
+	for _, result := range results.Books() {
+		bk, err := e.Library.Collection.FindByID(result.ID())
+		if err != nil {
+			return fmt.Errorf(noBookFound, result.ID())
+		}
+		o.books = append(o.books, bk.(*b.Book))
+		ids = append(ids, result.ID())
+	}
+	if len(o.books) == 0 {
+		return errors.New("No book found for query " + query)
+	}
+	o.collection = e.Library.Collection.WithID(ids...)
+	o.selected = true
 	return nil
 }
+
+// selectWorks adds the other editions of the works of the selected books.
+func (o *CLI) selectWorks(e *Endive) {
+	ids := []int{}
+	for _, bk := range o.books {
+		ids = append(ids, bk.ID())
+	}
+	for k := 0; k < len(o.books); k++ {
+		for _, edition := range e.Library.Collection.Work(o.books[k].WorkID()).Books() {
+			if !b.IDIsIn(edition.ID(), ids) {
+				o.books = append(o.books, edition.(*b.Book))
+				ids = append(ids, edition.ID())
+			}
+		}
+	}
+	o.collection = e.Library.Collection.WithID(ids...)
+}
+
+// parseAge such as 30d or 12h.
+func parseAge(age string) (time.Duration, error) {
+	if strings.HasSuffix(age, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(age, "d"))
+		if err != nil || days < 0 {
+			return 0, errors.New("Invalid age " + age)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(age)
+	if err != nil || duration < 0 {
+		return 0, errors.New("Invalid age " + age)
+	}
+	return duration, nil
+}
+
+// joinListEdits given as separate arguments to set field, so that values
+// starting with - are not mistaken for options:
+// set field tags +space-opera -scifi 12 is set field tags " +space-opera -scifi" 12.
+func joinListEdits(osArgs []string) []string {
+	if len(osArgs) < 4 || osArgs[0] != "set" || osArgs[1] != "field" {
+		return osArgs
+	}
+	end := 3
+	for end < len(osArgs) && !strings.HasPrefix(osArgs[end], "--") && b.IsListEdit(osArgs[end]) {
+		end++
+	}
+	if end == 3 {
+		return osArgs
+	}
+	joined := append([]string{}, osArgs[:3]...)
+	// a leading space keeps the value from being parsed as an option
+	joined = append(joined, " "+strings.Join(osArgs[3:end], " "))
+	return append(joined, osArgs[end:]...)
+}
+
+// areIDs checks if all arguments are book IDs rather than search criteria.
+func areIDs(args []string) bool {
+	for _, arg := range args {
+		if _, err := strconv.Atoi(arg); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -72,6 +74,26 @@ func TestCLI(t *testing.T) {
 	err = cli.parseArgs(endive, []string{"collection", "check-index"})
 	assert.Nil(err)
 	assert.True(cli.checkIndex)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"collection", "retag", "--dry-run"})
+	assert.Nil(err)
+	assert.True(cli.retag)
+	assert.True(cli.dryRun)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"collection", "lint", "authors", "series", "--aliases"})
+	assert.Nil(err)
+	assert.True(cli.lint)
+	assert.Equal([]string{"authors", "series"}, cli.lintChecks)
+	assert.True(cli.lintAliases)
+	assert.False(cli.lintFix)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"collection", "lint", "--aliases", "--fix"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"collection", "duplicates", "--merge"})
+	assert.Nil(err)
+	assert.True(cli.duplicates)
+	assert.True(cli.mergeDuplicates)
 
 	// testing import
 	fmt.Println(" + Testing import subcommand")
@@ -287,6 +309,168 @@ func TestCLI(t *testing.T) {
 	assert.Equal("mediocre", cli.reviewText)
 	assert.Equal(1, len(cli.collection.Books()))
 
+	// testing series
+	fmt.Println(" + Testing series and next subcommands")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"series", "Culture", "--online"})
+	assert.Nil(err)
+	assert.Equal("Culture", cli.series)
+	assert.True(cli.seriesOnline)
+	assert.Equal("", cli.info)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"info", "series"})
+	assert.Nil(err)
+	assert.Equal("", cli.series)
+	assert.Equal(infoSeries, cli.info)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"next"})
+	assert.Nil(err)
+	assert.True(cli.next)
+
+	// testing merge and split
+	fmt.Println(" + Testing merge and split subcommands")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"merge", "1", "2"})
+	assert.Nil(err)
+	assert.True(cli.merge)
+	assert.Equal(2, len(cli.books))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"merge", "1", "1"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"merge", "1"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"split", "1", "retail"})
+	assert.Nil(err)
+	assert.True(cli.split)
+	assert.True(cli.splitRetail)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"split", "2"})
+	assert.Nil(err)
+	assert.False(cli.splitRetail)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"split", "2", "--edition=3"})
+	assert.Nil(err)
+	assert.Equal(3, cli.splitEdition)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"split", "2", "--edition=0"})
+	assert.NotNil(err)
+
+	// testing work
+	fmt.Println(" + Testing work subcommand")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"work", "link", "1", "2"})
+	assert.Nil(err)
+	assert.True(cli.linkWork)
+	assert.Equal(2, len(cli.books))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"work", "link", "1"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"work", "unlink", "1"})
+	assert.Nil(err)
+	assert.True(cli.unlinkWork)
+	assert.False(cli.linkWork)
+
+	// testing stats
+	fmt.Println(" + Testing stats subcommand")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"stats"})
+	assert.Nil(err)
+	assert.True(cli.stats)
+	assert.Equal("", cli.statsYear)
+	assert.False(cli.statsJSON)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"stats", "--year", "2016", "--json"})
+	assert.Nil(err)
+	assert.Equal("2016", cli.statsYear)
+	assert.True(cli.statsJSON)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"stats", "--year=16"})
+	assert.NotNil(err)
+
+	// testing reading
+	fmt.Println(" + Testing reading subcommand")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"reading", "start", "1", "2", "--format=kobo"})
+	assert.Nil(err)
+	assert.Equal(readingStart, cli.reading)
+	assert.Equal("kobo", cli.readingFormat)
+	assert.Equal(time.Now().Local().Format("2006-01-02"), cli.readingDate)
+	assert.Equal(2, len(cli.books))
+	assert.True(cli.changesSelection())
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"reading", "abandon", "1", "--date=2016-01-02", "--notes=Too long."})
+	assert.Nil(err)
+	assert.Equal(readingAbandon, cli.reading)
+	assert.Equal("2016-01-02", cli.readingDate)
+	assert.Equal("Too long.", cli.readingNotes)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"reading", "finish", "1", "--date=yesterday"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"reading", "finish", "1", "--work"})
+	assert.Nil(err)
+	assert.Equal(readingFinish, cli.reading)
+
+	// testing flag-replace
+	fmt.Println(" + Testing flag-replace subcommand")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"flag-replace", "1", "nonretail", "OCR errors"})
+	assert.Nil(err)
+	assert.True(cli.flagReplace)
+	assert.Equal("nonretail", cli.flagEpub)
+	assert.Equal("OCR errors", cli.flagReason)
+	assert.False(cli.clearFlag)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"flag-replace", "1", "--clear"})
+	assert.Nil(err)
+	assert.Equal("", cli.flagEpub)
+	assert.True(cli.clearFlag)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"flag-replace", "1", "bad cover", "--clear"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"list", "--flagged"})
+	assert.Nil(err)
+	assert.True(cli.list)
+
+	// testing remove and trash
+	fmt.Println(" + Testing remove and trash subcommands")
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"remove", "1", "2", "--forget"})
+	assert.Nil(err)
+	assert.True(cli.remove)
+	assert.True(cli.forget)
+	assert.Equal(2, len(cli.collection.Books()))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "list"})
+	assert.Nil(err)
+	assert.True(cli.showTrash)
+	assert.False(cli.list)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "restore", "12", "13"})
+	assert.Nil(err)
+	assert.True(cli.restoreTrash)
+	assert.Equal([]int{12, 13}, cli.restoreIDs)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "restore", "twelve"})
+	assert.NotNil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "empty", "--older-than=30d"})
+	assert.Nil(err)
+	assert.True(cli.emptyTrash)
+	assert.Equal(30*24*time.Hour, cli.trashOlderThan)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "empty", "--older-than=12h", "--forget"})
+	assert.Nil(err)
+	assert.Equal(12*time.Hour, cli.trashOlderThan)
+	assert.True(cli.forget)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"trash", "empty", "--older-than=soon"})
+	assert.NotNil(err)
+
 	// testing set
 	fmt.Println(" + Testing set subcommand")
 	cli = CLI{}
@@ -309,6 +493,28 @@ func TestCLI(t *testing.T) {
 	assert.Equal("read", cli.progress)
 	assert.Equal(1, len(cli.books))
 
+	// setting the progress of all the editions of a work
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "read", "1", "--work"})
+	assert.Nil(err)
+	assert.Equal(1, len(cli.books))
+	first, err := endive.Library.Collection.FindByID(1)
+	assert.Nil(err)
+	second, err := endive.Library.Collection.FindByID(2)
+	assert.Nil(err)
+	_, err = b.LinkWork(first.(*b.Book), second.(*b.Book))
+	assert.Nil(err)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "read", "1", "--work"})
+	assert.Nil(err)
+	assert.Equal(2, len(cli.books))
+	assert.Equal(2, len(cli.collection.Books()))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "field", "title", "thing", "1", "--work"})
+	assert.NotNil(err)
+	first.(*b.Book).SetWork(nil)
+	second.(*b.Book).SetWork(nil)
+
 	cli = CLI{}
 	err = cli.parseArgs(endive, []string{"set", "field", "title", "thing", "1"})
 	assert.Nil(err)
@@ -317,6 +523,60 @@ func TestCLI(t *testing.T) {
 	assert.Equal(1, len(cli.books))
 	assert.Equal("title", cli.field)
 	assert.Equal("thing", cli.value)
+	assert.False(cli.listEdit)
+
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "field", "tags", "+space-opera, -scifi", "1", "2"})
+	assert.Nil(err)
+	assert.True(cli.listEdit)
+	assert.Equal(2, len(cli.books))
+	// unquoted operations, even starting with -
+	for _, args := range [][]string{
+		{"set", "field", "tags", "+space-opera", "-scifi", "1", "2"},
+		{"set", "field", "tags", "-scifi", "+space-opera", "1", "2"},
+	} {
+		cli = CLI{}
+		err = cli.parseArgs(endive, args)
+		assert.Nil(err)
+		assert.True(cli.listEdit)
+		assert.Equal("tags", cli.field)
+		assert.Equal(" "+args[3]+" "+args[4], cli.value)
+		assert.Equal(2, len(cli.books))
+	}
+	assert.Equal([]string{"set", "field", "title", "thing", "1"}, joinListEdits([]string{"set", "field", "title", "thing", "1"}))
+	assert.Equal([]string{"set", "field", "tags", " -scifi", "--query=author:banks"}, joinListEdits([]string{"set", "field", "tags", "-scifi", "--query=author:banks"}))
+	cli = CLI{}
+	// the mock index finds nothing
+	err = cli.parseArgs(endive, []string{"set", "field", "tags", "+space-opera", "--query=author:banks"})
+	assert.NotNil(err)
+	assert.Equal(0, len(cli.books))
+
+	// search criteria instead of IDs
+	assert.True(areIDs([]string{"1", "2"}))
+	assert.False(areIDs([]string{"1", "author:banks"}))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "read", "author:banks"})
+	assert.NotNil(err, "the mock index finds nothing")
+	assert.False(cli.selected)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"review", "--query=@unknown", "4"})
+	assert.NotNil(err, "unknown saved query")
+	cli = CLI{set: true, listEdit: true}
+	assert.False(cli.changesSelection())
+	cli = CLI{edit: true}
+	assert.True(cli.changesSelection())
+
+	// configured progress states
+	endive.Config.Progress = en.Vocabulary{"unread": nil, "read": nil, "abandoned": {"dnf"}}
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "dnf", "1"})
+	assert.Nil(err)
+	assert.True(cli.set)
+	assert.Equal("abandoned", cli.progress)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"set", "shortlisted", "1"})
+	assert.NotNil(err)
+	endive.Config.Progress = nil
 
 	// testing edit
 	fmt.Println(" + Testing edit subcommand")
@@ -345,6 +605,19 @@ func TestCLI(t *testing.T) {
 	assert.Equal("", cli.field)
 	assert.Equal("", cli.value)
 	assert.Equal(2, len(cli.books))
+	assert.False(cli.bulk)
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"edit", "--bulk", "1", "2"})
+	assert.Nil(err)
+	assert.True(cli.edit)
+	assert.True(cli.bulk)
+	assert.Equal(2, len(cli.books))
+	cli = CLI{}
+	err = cli.parseArgs(endive, []string{"edit", "--bulk", "field", "title", "1"})
+	assert.NotNil(err)
+	// errors from the previous edit are replaced
+	document := withBulkEditErrors([]byte(bulkEditErrorPrefix+"old\n- id: 1\n"), []error{errors.New("new")})
+	assert.Equal(bulkEditErrorPrefix+"new\n- id: 1\n", string(document))
 
 	// testing reset
 	fmt.Println(" + Testing reset subcommand")
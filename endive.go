@@ -77,6 +77,10 @@ func (e *Endive) openConfig() error {
 	}
 	// check config
 	e.UI.Debug("Checking Config...")
+	if err := b.CheckCustomFields(e.Config); err != nil {
+		e.UI.Error(err.Error())
+		return err
+	}
 	err = e.Config.Check()
 	if err == en.WarningNonRetailSourceDoesNotExist || err == en.WarningRetailSourceDoesNotExist {
 		e.UI.Warning(err.Error())
@@ -87,7 +91,7 @@ func (e *Endive) openConfig() error {
 // OpenLibrary constucts a valid new Library
 func (e *Endive) openLibrary() error {
 	// index
-	index := &i.Index{}
+	index := &i.Index{CustomFields: e.Config.CustomFields}
 	index.SetPath(en.GetIndexPath())
 	// db
 	db := &db.JSONDB{}
@@ -121,9 +125,11 @@ func (e *Endive) Refresh() (renamed int, err error) {
 				var book *b.Book
 				book = gBook.(*b.Book)
 				// if it is, rename found file to filename in DB
-				destination := book.RetailEpub.FullPath()
-				if book.NonRetailEpub.Hash == epub.Hash {
-					destination = book.NonRetailEpub.FullPath()
+				destination := book.FullPath()
+				for _, ep := range book.Epubs() {
+					if ep.Hash == epub.Hash {
+						destination = ep.FullPath()
+					}
 				}
 				// check if retail epub already exists
 				_, err := helpers.FileExists(destination)
@@ -161,11 +167,10 @@ func (e *Endive) Refresh() (renamed int, err error) {
 			// mark for deletion
 			deletedBooks = append(deletedBooks, e.Library.Collection.Books()[i].ID())
 		}
-		if wasRenamed[0] {
-			renamed++
-		}
-		if wasRenamed[1] {
-			renamed++
+		for _, r := range wasRenamed {
+			if r {
+				renamed++
+			}
 		}
 	}
 
@@ -1,8 +1,10 @@
 package endive
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"io/ioutil"
@@ -23,8 +25,16 @@ const (
 	XdgLockPath = Endive + "/" + Endive + ".lock"
 	// XdgArchiveDir is the path where database archives are kept
 	XdgArchiveDir = Endive + "/archives/"
+	// XdgBackupDir is the path where original epubs are kept before being modified
+	XdgBackupDir = Endive + "/backups/"
 	// index path
 	xdgIndexPath string = Endive + "/" + Endive + ".index"
+	// cover cache path
+	xdgCoverDir = Endive + "/covers/"
+	// trash path, for removed epubs and their database records
+	xdgTrashDir = Endive + "/trash/"
+	// DefaultConfirmAbove is the number of books above which changes must be confirmed.
+	DefaultConfirmAbove = 10
 )
 
 // Constant Error values which can be compared to determine the type of error
@@ -68,9 +78,22 @@ type Config struct {
 	EpubFilenameFormat string
 	AuthorAliases      map[string][]string
 	TagAliases         map[string][]string
+	TagRules           TagRules
+	TagHierarchy       TagHierarchy
+	TagSubjects        TagSubjects
 	PublisherAliases   map[string][]string
+	LanguageAliases    map[string][]string
+	Categories         Vocabulary
+	Types              Vocabulary
+	Progress           Vocabulary
+	CustomFields       CustomFields
+	SavedQueries       SavedQueries
+	PreferredEdition   EditionRule
+	ConfirmAbove       int
 	EReaderMountPoint  string
 	GoodReadsAPIKey    string
+	CoverDir           string
+	TrashDir           string
 }
 
 // GetArchiveUniqueName in the endive archive directory.
@@ -78,6 +101,11 @@ func GetArchiveUniqueName(filename string) (archive string, err error) {
 	return h.GetUniqueTimestampedFilename(filepath.Join(xdg.Data.Dirs()[0], XdgArchiveDir), filename)
 }
 
+// GetBackupUniqueName in the endive epub backup directory.
+func GetBackupUniqueName(filename string) (backup string, err error) {
+	return h.GetUniqueTimestampedFilename(filepath.Join(xdg.Data.Dirs()[0], XdgBackupDir), filename)
+}
+
 // GetConfigPath gets the default path for configuration.
 func GetConfigPath() (configFile string, err error) {
 	configFile, err = xdg.Config.Find(xdgConfigPath)
@@ -104,6 +132,16 @@ func GetIndexPath() (path string) {
 	return
 }
 
+// GetCoverDir gets the default cover cache directory.
+func GetCoverDir() string {
+	return filepath.Join(xdg.Cache.Dirs()[0], xdgCoverDir)
+}
+
+// GetTrashDir gets the default path for removed epubs.
+func GetTrashDir() string {
+	return filepath.Join(xdg.Data.Dirs()[0], xdgTrashDir)
+}
+
 // SetLock sets the library lock.
 func SetLock() (err error) {
 	_, err = xdg.Data.Find(XdgLockPath)
@@ -187,6 +225,16 @@ func (c *Config) Load() (err error) {
 	if val, ok := conf["ereader_root"]; ok {
 		c.EReaderMountPoint = val.(string)
 	}
+	if val, ok := conf["cover_directory"]; ok {
+		c.CoverDir = val.(string)
+	} else {
+		c.CoverDir = GetCoverDir()
+	}
+	if val, ok := conf["trash_directory"]; ok {
+		c.TrashDir = val.(string)
+	} else {
+		c.TrashDir = GetTrashDir()
+	}
 	if val, ok := conf["goodreads_api_key"]; ok {
 		c.GoodReadsAPIKey = val.(string)
 	} else {
@@ -219,12 +267,88 @@ func (c *Config) Load() (err error) {
 			return err
 		}
 	}
+	if val, ok := conf["tag_rules"]; ok {
+		c.TagRules, err = ParseTagRules(val)
+		if err != nil {
+			return err
+		}
+	}
+	if val, ok := conf["tag_hierarchy"]; ok {
+		hierarchy, err := interfaceToStringSlice(val)
+		if err != nil {
+			return err
+		}
+		for _, path := range hierarchy {
+			c.TagHierarchy = append(c.TagHierarchy, strings.ToLower(strings.Trim(path, TagSeparator)))
+		}
+	}
+	if val, ok := conf["tag_subjects"]; ok {
+		c.TagSubjects, err = ParseTagSubjects(val)
+		if err != nil {
+			return err
+		}
+	}
 	if val, ok := conf["publisher_aliases"]; ok {
 		c.PublisherAliases, err = interfaceToMap(val)
 		if err != nil {
 			return err
 		}
 	}
+	if val, ok := conf["language_aliases"]; ok {
+		c.LanguageAliases, err = interfaceToMap(val)
+		if err != nil {
+			return err
+		}
+	}
+	if val, ok := conf["preferred_edition"]; ok {
+		c.PreferredEdition, err = ParseEditionRule(val, c.LanguageAliases)
+		if err != nil {
+			return err
+		}
+	}
+	if val, ok := conf["categories"]; ok {
+		c.Categories, err = ParseVocabulary(val)
+		if err != nil {
+			return errors.New("Invalid categories: " + err.Error())
+		}
+	}
+	if val, ok := conf["types"]; ok {
+		c.Types, err = ParseVocabulary(val)
+		if err != nil {
+			return errors.New("Invalid types: " + err.Error())
+		}
+	}
+	if val, ok := conf["custom_fields"]; ok {
+		c.CustomFields, err = ParseCustomFields(val)
+		if err != nil {
+			return err
+		}
+	}
+	if val, ok := conf["saved_queries"]; ok {
+		c.SavedQueries, err = ParseSavedQueries(val)
+		if err != nil {
+			return err
+		}
+	}
+	if val, ok := conf["confirm_above"]; ok {
+		c.ConfirmAbove, ok = val.(int)
+		if !ok || c.ConfirmAbove < 0 {
+			return errors.New("Invalid confirm_above: expected a positive number of books")
+		}
+	} else {
+		c.ConfirmAbove = DefaultConfirmAbove
+	}
+	if val, ok := conf["progress"]; ok {
+		c.Progress, err = ParseVocabulary(val)
+		if err != nil {
+			return errors.New("Invalid progress: " + err.Error())
+		}
+		for _, required := range []string{ProgressUnread, ProgressRead} {
+			if !c.Progress.Has(required) {
+				return errors.New("Invalid progress: missing " + required)
+			}
+		}
+	}
 	return
 }
 
@@ -265,6 +389,8 @@ func (c *Config) String() string {
 		rows = append(rows, []string{"Goodreads API Key", "present"})
 	}
 	rows = append(rows, []string{"E-Reader mount point", c.EReaderMountPoint})
+	rows = append(rows, []string{"Cover directory", c.CoverDir})
+	rows = append(rows, []string{"Trash directory", c.TrashDir})
 	rows = append(rows, []string{"Retail sources", strings.Join(c.RetailSource, ", ")})
 	rows = append(rows, []string{"Non-Retail sources", strings.Join(c.NonRetailSource, ", ")})
 	for mainalias, aliases := range c.AuthorAliases {
@@ -276,5 +402,27 @@ func (c *Config) String() string {
 	for mainalias, aliases := range c.PublisherAliases {
 		rows = append(rows, []string{"Publisher alias: " + mainalias, strings.Join(aliases, ", ")})
 	}
+	for mainalias, aliases := range c.LanguageAliases {
+		rows = append(rows, []string{"Language alias: " + mainalias, strings.Join(aliases, ", ")})
+	}
+	rows = append(rows, []string{"Preferred edition", c.EditionRule().String()})
+	rows = append(rows, []string{"Categories", c.CategoryVocabulary().String()})
+	rows = append(rows, []string{"Types", c.TypeVocabulary().String()})
+	rows = append(rows, []string{"Progress", c.ProgressVocabulary().String()})
+	for _, name := range c.CustomFields.Names() {
+		field := c.CustomFields[name]
+		rows = append(rows, []string{"Custom field: " + name, strings.TrimSpace(field.Type + " " + strings.Join(field.Values, ", "))})
+	}
+	for _, name := range c.SavedQueries.Names() {
+		rows = append(rows, []string{"Saved query: " + SavedQueryPrefix + name, c.SavedQueries[name]})
+	}
+	rows = append(rows, []string{"Confirm changes above", strconv.Itoa(c.ConfirmAbove) + " books"})
+	rows = append(rows, c.TagRules.rows()...)
+	for _, path := range c.TagHierarchy {
+		rows = append(rows, []string{"Tag hierarchy", path})
+	}
+	for tag, code := range c.TagSubjects {
+		rows = append(rows, []string{"Tag subject: " + tag, code.String()})
+	}
 	return TabulateRows(rows, "Config", "Value")
 }
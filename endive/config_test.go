@@ -26,6 +26,19 @@ func TestConfigLoad(t *testing.T) {
 	assert.Equal(1, len(c.TagAliases), "Error: loading tag aliases, expected 1")
 	assert.Equal(3, len(c.TagAliases["science-fiction"]), "Expected 3 aliases for SF")
 	assert.Equal(1, len(c.PublisherAliases), "Error: loading publisher aliases, expected 1")
+	assert.Equal([]string{"fr-CA", "québécois"}, c.LanguageAliases["fr-ca"], "Error: loading language aliases")
+	assert.Equal([]string{"fiction", "nonfiction"}, c.CategoryVocabulary().Terms(), "Error: loading categories")
+	assert.Equal([]string{"comics"}, c.Types["graphic novel"], "Error: loading types")
+	assert.Equal([]string{"dnf"}, c.Progress["abandoned"], "Error: loading progress")
+	assert.Equal([]string{"book_club", "format", "lent_to", "owners", "price", "source"}, c.CustomFields.Names(), "Error: loading custom fields")
+	assert.Equal([]string{"paperback", "ebook"}, c.CustomFields["format"].Values, "Error: loading custom fields")
+	assert.Equal("progress:unread +tag:shortlisted", c.SavedQueries["to-read"], "Error: loading saved queries")
+	assert.Equal(20, c.ConfirmAbove, "Error: loading confirm_above")
+	assert.Equal("retail, language:fr-ca,en, newest", c.EditionRule().String(), "Error: loading preferred edition")
+	assert.Equal([]string{"science-fiction-classics"}, c.TagRules.Allow, "Error: loading tag rules")
+	assert.Equal(5, c.TagRules.Limit(TagSourceGoodReads), "Error: loading tag limits")
+	assert.Equal(TagHierarchy{"fiction/science-fiction/space-opera", "fiction/fantasy"}, c.TagHierarchy, "Error: loading tag hierarchy")
+	assert.Equal(SubjectCode{Authority: AuthorityThema, Code: "FM"}, c.TagSubjects["fiction/fantasy"], "Error: loading tag subjects")
 	// checking library root, expecting error
 	err = c.Check()
 	assert.NotNil(err, "Error checking configuration file, library root should not exist.")
@@ -0,0 +1,219 @@
+package endive
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of custom fields.
+const (
+	FieldString = "string"
+	FieldInt    = "int"
+	FieldFloat  = "float"
+	FieldDate   = "date"
+	FieldBool   = "bool"
+	FieldEnum   = "enum"
+	FieldList   = "list"
+)
+
+var (
+	customFieldTypes      = []string{FieldString, FieldInt, FieldFloat, FieldDate, FieldBool, FieldEnum, FieldList}
+	customFieldNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	// dates can be as precise as needed: a year, a month, a day.
+	customFieldDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+)
+
+// CustomField is a user-defined field, declared in the configuration file.
+type CustomField struct {
+	Name   string
+	Type   string
+	Values []string
+}
+
+// CustomFields by name.
+type CustomFields map[string]CustomField
+
+// ParseCustomFields from the custom_fields section of the configuration file.
+// Fields are defined either by their type only, or with type and values for enums.
+func ParseCustomFields(in interface{}) (CustomFields, error) {
+	fields := make(CustomFields)
+	conf, ok := in.(map[interface{}]interface{})
+	if !ok {
+		return fields, ErrorBadFormat
+	}
+	for k, v := range conf {
+		field := CustomField{Name: strings.ToLower(fmt.Sprint(k))}
+		switch v.(type) {
+		case string:
+			field.Type = v.(string)
+		case map[interface{}]interface{}:
+			for key, value := range v.(map[interface{}]interface{}) {
+				switch key {
+				case "type":
+					field.Type = fmt.Sprint(value)
+				case "values":
+					values, ok := value.([]interface{})
+					if !ok {
+						return fields, ErrorBadFormat
+					}
+					// values can be numbers, such as months
+					for _, v := range values {
+						field.Values = append(field.Values, fmt.Sprint(v))
+					}
+				default:
+					return fields, fmt.Errorf("Unknown option %v for custom field %s", key, field.Name)
+				}
+			}
+		default:
+			return fields, ErrorBadFormat
+		}
+		field.Type = strings.ToLower(strings.TrimSpace(field.Type))
+		if err := field.Check(); err != nil {
+			return fields, err
+		}
+		fields[field.Name] = field
+	}
+	return fields, nil
+}
+
+// Check the definition of a CustomField.
+func (f CustomField) Check() error {
+	if !customFieldNameRegexp.MatchString(f.Name) {
+		return errors.New("Invalid custom field name " + f.Name + ", expected lowercase letters, digits and underscores")
+	}
+	known := false
+	for _, t := range customFieldTypes {
+		if f.Type == t {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return errors.New("Invalid type " + f.Type + " for custom field " + f.Name + ", expected one of: " + strings.Join(customFieldTypes, ", "))
+	}
+	if f.Type == FieldEnum && len(f.Values) == 0 {
+		return errors.New("Custom field " + f.Name + " is an enum without values")
+	}
+	if f.Type != FieldEnum && len(f.Values) != 0 {
+		return errors.New("Only enums can have values, custom field " + f.Name + " is a " + f.Type)
+	}
+	return nil
+}
+
+// Usage of a CustomField, when editing it.
+func (f CustomField) Usage() string {
+	switch f.Type {
+	case FieldDate:
+		return "Date (YYYY-MM-DD, YYYY-MM or YYYY)."
+	case FieldBool:
+		return "true or false."
+	case FieldEnum:
+		return "One of: " + strings.Join(f.Values, ", ") + "."
+	case FieldList:
+		return "Comma-separated list of strings."
+	default:
+		return "A " + f.Type + " value."
+	}
+}
+
+// Clean a value for this CustomField, or return an error if it is invalid.
+// An empty value unsets the field.
+func (f CustomField) Clean(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	invalid := fmt.Errorf("Invalid value %s for %s field %s", value, f.Type, f.Name)
+	switch f.Type {
+	case FieldInt:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.Itoa(i), nil
+	case FieldFloat:
+		fl, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", invalid
+		}
+		return strconv.FormatFloat(fl, 'f', -1, 64), nil
+	case FieldDate:
+		for _, layout := range customFieldDateLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return value, nil
+			}
+		}
+		return "", invalid
+	case FieldBool:
+		switch strings.ToLower(value) {
+		case "true", "yes", "y", "1":
+			return True, nil
+		case "false", "no", "n", "0":
+			return False, nil
+		}
+		return "", invalid
+	case FieldEnum:
+		for _, v := range f.Values {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		return "", fmt.Errorf("Invalid value %s for field %s, expected one of: %s", value, f.Name, strings.Join(f.Values, ", "))
+	case FieldList:
+		items := []string{}
+		seen := make(map[string]bool)
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" && !seen[item] {
+				items = append(items, item)
+				seen[item] = true
+			}
+		}
+		return strings.Join(items, ", "), nil
+	}
+	return value, nil
+}
+
+// Less compares two values of this CustomField, for sorting.
+// Unset values come first.
+func (f CustomField) Less(a, b string) bool {
+	if a == "" || b == "" {
+		return a == "" && b != ""
+	}
+	switch f.Type {
+	case FieldInt, FieldFloat:
+		x, errA := strconv.ParseFloat(a, 64)
+		y, errB := strconv.ParseFloat(b, 64)
+		if errA == nil && errB == nil {
+			return x < y
+		}
+	case FieldEnum:
+		// in the order given in the configuration file
+		return f.index(a) < f.index(b)
+	}
+	return a < b
+}
+
+func (f CustomField) index(value string) int {
+	for j, v := range f.Values {
+		if v == value {
+			return j
+		}
+	}
+	return len(f.Values)
+}
+
+// Names of the CustomFields, sorted.
+func (cf CustomFields) Names() []string {
+	names := []string{}
+	for name := range cf {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,77 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestParseCustomFields(t *testing.T) {
+	fmt.Println("+ Testing ParseCustomFields()...")
+	assert := assert.New(t)
+	conf := make(map[interface{}]interface{})
+	assert.Nil(yaml.Unmarshal([]byte("source: string\nprice:\n    type: float\nformat:\n    type: enum\n    values: [paperback, ebook]\n"), &conf))
+	fields, err := ParseCustomFields(conf)
+	assert.Nil(err)
+	assert.Equal([]string{"format", "price", "source"}, fields.Names())
+	assert.Equal(CustomField{Name: "format", Type: FieldEnum, Values: []string{"paperback", "ebook"}}, fields["format"])
+	assert.Equal(FieldFloat, fields["price"].Type)
+
+	for _, invalid := range []string{
+		"source: text\n",
+		"Lent-To: string\n",
+		"format: enum\n",
+		"price:\n    type: float\n    values: [1, 2]\n",
+		"price:\n    kind: float\n",
+	} {
+		conf := make(map[interface{}]interface{})
+		assert.Nil(yaml.Unmarshal([]byte(invalid), &conf))
+		_, err := ParseCustomFields(conf)
+		assert.NotNil(err, invalid)
+	}
+}
+
+func TestCustomFieldClean(t *testing.T) {
+	fmt.Println("+ Testing CustomField.Clean()...")
+	assert := assert.New(t)
+	for _, c := range []struct {
+		field    CustomField
+		value    string
+		expected string
+		valid    bool
+	}{
+		{CustomField{Name: "a", Type: FieldString}, " used bookshop ", "used bookshop", true},
+		{CustomField{Name: "a", Type: FieldInt}, "012", "12", true},
+		{CustomField{Name: "a", Type: FieldInt}, "1.5", "", false},
+		{CustomField{Name: "a", Type: FieldFloat}, "4.50", "4.5", true},
+		{CustomField{Name: "a", Type: FieldFloat}, "cheap", "", false},
+		{CustomField{Name: "a", Type: FieldDate}, "2025-03", "2025-03", true},
+		{CustomField{Name: "a", Type: FieldDate}, "2025-03-12", "2025-03-12", true},
+		{CustomField{Name: "a", Type: FieldDate}, "March", "", false},
+		{CustomField{Name: "a", Type: FieldBool}, "Yes", True, true},
+		{CustomField{Name: "a", Type: FieldBool}, "maybe", "", false},
+		{CustomField{Name: "a", Type: FieldEnum, Values: []string{"paperback", "ebook"}}, "EBook", "ebook", true},
+		{CustomField{Name: "a", Type: FieldEnum, Values: []string{"paperback", "ebook"}}, "audio", "", false},
+		{CustomField{Name: "a", Type: FieldList}, "alice, bob,, alice ", "alice, bob", true},
+		{CustomField{Name: "a", Type: FieldInt}, "", "", true},
+	} {
+		clean, err := c.field.Clean(c.value)
+		assert.Equal(c.valid, err == nil, c.value)
+		assert.Equal(c.expected, clean, c.value)
+	}
+}
+
+func TestCustomFieldLess(t *testing.T) {
+	fmt.Println("+ Testing CustomField.Less()...")
+	assert := assert.New(t)
+	price := CustomField{Name: "price", Type: FieldFloat}
+	assert.True(price.Less("9.5", "10"))
+	assert.True(price.Less("", "1"))
+	assert.False(price.Less("1", ""))
+	format := CustomField{Name: "format", Type: FieldEnum, Values: []string{"paperback", "ebook"}}
+	assert.True(format.Less("paperback", "ebook"))
+	source := CustomField{Name: "source", Type: FieldString}
+	assert.True(source.Less("bookshop", "gift"))
+}
@@ -0,0 +1,95 @@
+package endive
+
+import (
+	"errors"
+	"strings"
+)
+
+// Criteria of the preferred edition rule.
+const (
+	// EditionRetail prefers retail editions.
+	EditionRetail = "retail"
+	// EditionNewest prefers the most recent editions.
+	EditionNewest = "newest"
+	// EditionOldest prefers the oldest editions.
+	EditionOldest = "oldest"
+	// EditionLanguage prefers editions in the given languages, in order: language:fr,en
+	EditionLanguage = "language"
+)
+
+var defaultEditionRule = EditionRule{{Name: EditionRetail}}
+
+// EditionCriterion is one step of an EditionRule.
+type EditionCriterion struct {
+	Name      string
+	Languages []string
+}
+
+// String representation of an EditionCriterion.
+func (c EditionCriterion) String() string {
+	if c.Name == EditionLanguage {
+		return c.Name + ":" + strings.Join(c.Languages, ",")
+	}
+	return c.Name
+}
+
+// EditionRule chooses the preferred edition of a book, used for its filename
+// and for exports. Each criterion breaks the ties left by the previous ones.
+type EditionRule []EditionCriterion
+
+// ParseEditionRule from the preferred_edition section of the configuration file.
+// Languages are normalized with the language aliases.
+func ParseEditionRule(in interface{}, languageAliases map[string][]string) (EditionRule, error) {
+	rule := EditionRule{}
+	criteria, ok := in.([]interface{})
+	if !ok {
+		return rule, ErrorBadFormat
+	}
+	for _, v := range criteria {
+		// language: fr would be parsed as a map
+		criterion, ok := v.(string)
+		if !ok {
+			return rule, errors.New("Invalid preferred edition criterion, expected retail, newest, oldest or language:code[,code...]")
+		}
+		parts := strings.SplitN(strings.ToLower(strings.TrimSpace(criterion)), ":", 2)
+		c := EditionCriterion{Name: strings.TrimSpace(parts[0])}
+		switch c.Name {
+		case EditionRetail, EditionNewest, EditionOldest:
+			if len(parts) == 2 {
+				return rule, errors.New("Invalid preferred edition criterion " + criterion + ": no value expected")
+			}
+		case EditionLanguage:
+			if len(parts) == 2 {
+				for _, language := range strings.Split(parts[1], ",") {
+					if language = strings.TrimSpace(language); language != "" {
+						c.Languages = append(c.Languages, NormalizeLanguage(language, languageAliases))
+					}
+				}
+			}
+			if len(c.Languages) == 0 {
+				return rule, errors.New("Invalid preferred edition criterion " + criterion + ": expected language:code[,code...]")
+			}
+		default:
+			return rule, errors.New("Unknown preferred edition criterion " + criterion)
+		}
+		rule = append(rule, c)
+	}
+	return rule, nil
+}
+
+// String representation of an EditionRule.
+func (r EditionRule) String() string {
+	criteria := []string{}
+	for _, c := range r {
+		criteria = append(criteria, c.String())
+	}
+	return strings.Join(criteria, ", ")
+}
+
+// EditionRule from the configuration file, or the default one.
+func (c Config) EditionRule() EditionRule {
+	if len(c.PreferredEdition) == 0 {
+		return defaultEditionRule
+	}
+	return c.PreferredEdition
+}
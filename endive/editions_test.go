@@ -0,0 +1,38 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestEditionRule(t *testing.T) {
+	fmt.Println("+ Testing EditionRule...")
+	assert := assert.New(t)
+
+	c := Config{}
+	assert.Equal(defaultEditionRule, c.EditionRule())
+	assert.Equal("retail", c.EditionRule().String())
+
+	var conf interface{}
+	assert.Nil(yaml.Unmarshal([]byte("- \"Language: French, en\"\n- oldest\n- retail\n"), &conf))
+	rule, err := ParseEditionRule(conf, map[string][]string{"fr-ca": {"québécois"}})
+	assert.Nil(err)
+	if assert.Equal(3, len(rule)) {
+		assert.Equal(EditionLanguage, rule[0].Name)
+		assert.Equal([]string{"fr", "en"}, rule[0].Languages)
+		assert.Equal(EditionOldest, rule[1].Name)
+		assert.Equal(EditionRetail, rule[2].Name)
+	}
+	assert.Equal("language:fr,en, oldest, retail", rule.String())
+	c.PreferredEdition = rule
+	assert.Equal(rule, c.EditionRule())
+
+	for _, invalid := range []string{"- cheapest\n", "- language\n", "- language: fr\n", "- \"language: ,\"\n", "- retail:yes\n", "retail\n"} {
+		assert.Nil(yaml.Unmarshal([]byte(invalid), &conf))
+		_, err = ParseEditionRule(conf, nil)
+		assert.NotNil(err, invalid)
+	}
+}
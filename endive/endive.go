@@ -21,6 +21,8 @@ const (
 	False = "false"
 	// EmptyIndexError for Indexer
 	EmptyIndexError = "Index is empty"
+	// OutdatedIndexError for Indexer, when it must be rebuilt
+	OutdatedIndexError = "Index is outdated"
 )
 
 // GenericBook interface for Books
@@ -35,6 +37,7 @@ type GenericBook interface {
 	AddEpub(string, bool, string) (bool, error)
 	Check() (bool, bool, error)
 	SetExported(bool)
+	ExportTo(string) error
 }
 
 // Indexer provides an interface for indexing books.
@@ -59,7 +62,7 @@ type Collection interface {
 	// search
 	FindByID(int) (GenericBook, error)
 	FindByHash(string) (GenericBook, error)
-	FindByMetadata(string, string, string) (GenericBook, error)
+	FindByMetadata(string, string, string, ...string) (GenericBook, error)
 	FindByFullPath(string) (GenericBook, error)
 	// extracting information
 	Retail() Collection
@@ -67,11 +70,17 @@ type Collection interface {
 	Exported() Collection
 	Progress(string) Collection
 	Incomplete() Collection
+	NeedsReplacement() Collection
 	WithID(...int) Collection
+	Work(string) Collection
 	Authors() map[string]int
 	Publishers() map[string]int
 	Tags() map[string]int
+	TagTree() map[string]int
 	Series() map[string]int
+	// maintenance
+	MigrateVocabularies() int
+	MigrateImportDates() int
 	// output
 	Table() string
 	Sort(string)
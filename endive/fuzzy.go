@@ -0,0 +1,94 @@
+package endive
+
+import (
+	"strings"
+	"unicode"
+)
+
+// nameNoise are words that do not help telling names apart.
+var nameNoise = map[string]bool{
+	// publishers
+	"books": true, "book": true, "publishing": true, "publishers": true, "publisher": true,
+	"press": true, "inc": true, "ltd": true, "llc": true, "co": true, "group": true,
+	"editions": true, "edition": true, "publications": true, "imprint": true,
+	// series
+	"the": true, "series": true, "trilogy": true, "saga": true, "cycle": true, "sequence": true,
+}
+
+// NormalizeName for fuzzy comparisons: lowercase words without punctuation,
+// initials or words such as "Books" or "Series".
+// "Iain M. Banks" and "Iain Banks", or "Tor" and "Tor Books" are normalized to
+// the same string.
+func NormalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	kept := []string{}
+	for _, word := range words {
+		if len([]rune(word)) > 1 && !nameNoise[word] {
+			kept = append(kept, word)
+		}
+	}
+	if len(kept) == 0 {
+		// only noise, comparing all words
+		return strings.Join(words, " ")
+	}
+	return strings.Join(kept, " ")
+}
+
+// Levenshtein distance between two strings.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for k := range previous {
+		previous[k] = k
+	}
+	for j := 1; j <= len(ra); j++ {
+		current[0] = j
+		for k := 1; k <= len(rb); k++ {
+			cost := 1
+			if ra[j-1] == rb[k-1] {
+				cost = 0
+			}
+			current[k] = minInt(previous[k]+1, current[k-1]+1, previous[k-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// Similarity between two strings, from 0 (different) to 1 (identical).
+func Similarity(a, b string) float64 {
+	length := len([]rune(a))
+	if l := len([]rune(b)); l > length {
+		length = l
+	}
+	if length == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(length)
+}
+
+// SimilarNames checks if two names probably refer to the same thing.
+func SimilarNames(a, b string) bool {
+	na, nb := NormalizeName(a), NormalizeName(b)
+	if na == nb {
+		return true
+	}
+	// short names need to be identical, a typo is too likely to be another name
+	if len([]rune(na)) < 6 || len([]rune(nb)) < 6 {
+		return false
+	}
+	return Similarity(na, nb) >= 0.85
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
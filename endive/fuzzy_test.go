@@ -0,0 +1,39 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyNames(t *testing.T) {
+	fmt.Println("+ Testing SimilarNames()...")
+	assert := assert.New(t)
+	assert.Equal("iain banks", NormalizeName("Iain M. Banks"))
+	assert.Equal("tor", NormalizeName("Tor Books"))
+	assert.Equal("culture", NormalizeName("The Culture Series"))
+	assert.Equal("the series", NormalizeName("The Series"))
+
+	assert.Equal(0, Levenshtein("banks", "banks"))
+	assert.Equal(3, Levenshtein("kitten", "sitting"))
+	assert.Equal(1, Levenshtein("", "a"))
+	assert.Equal(1.0, Similarity("", ""))
+
+	for _, similar := range [][]string{
+		{"Iain M. Banks", "Iain Banks"},
+		{"Tor", "Tor Books"},
+		{"Ursula K. Le Guin", "Ursula K. LeGuin"},
+		{"Culture", "The Culture"},
+		{"Gollancz", "Gollanz"},
+	} {
+		assert.True(SimilarNames(similar[0], similar[1]), similar)
+	}
+	for _, different := range [][]string{
+		{"Tor", "Orbit"},
+		{"Iain Banks", "Ian Banks Jr"},
+		{"Dune", "Dunk"},
+	} {
+		assert.False(SimilarNames(different[0], different[1]), different)
+	}
+}
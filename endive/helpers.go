@@ -45,6 +45,28 @@ func TabulateMap(input map[string]int, firstHeader string, secondHeader string)
 	return TabulateRows(rows, firstHeader, secondHeader)
 }
 
+// TabulateTree shows a map of tag paths as a tree, with indented levels.
+func TabulateTree(input map[string]int, firstHeader string, secondHeader string) (table string) {
+	if len(input) == 0 {
+		return
+	}
+	var keys []string
+	for key := range input {
+		keys = append(keys, key)
+	}
+	// sorting level by level, so that children follow their parents
+	sort.Slice(keys, func(j, k int) bool {
+		return strings.Replace(keys[j], TagSeparator, "\x00", -1) < strings.Replace(keys[k], TagSeparator, "\x00", -1)
+	})
+	var rows [][]string
+	for _, key := range keys {
+		levels := strings.Split(key, TagSeparator)
+		name := strings.Repeat("  ", len(levels)-1) + levels[len(levels)-1]
+		rows = append(rows, []string{name, strconv.Itoa(input[key])})
+	}
+	return TabulateRows(rows, firstHeader, secondHeader)
+}
+
 // CleanISBN from a string
 func CleanISBN(full string) (isbn13 string, err error) {
 	// cleanup string, only keep numbers
@@ -73,8 +95,18 @@ func CleanISBN(full string) (isbn13 string, err error) {
 	return
 }
 
-// AskForISBN when not found in epub
-func AskForISBN(ui i.UserInterface) (string, error) {
+// AskForISBN when not found in epub metadata.
+// Candidates found elsewhere (for example in the epub contents) are offered first.
+func AskForISBN(ui i.UserInterface, candidates ...string) (string, error) {
+	if len(candidates) != 0 {
+		choice, err := ui.SelectOption("ISBN", "ISBNs found in the epub contents. Choose one, or enter another.", candidates, false)
+		if err == nil {
+			if isbnCandidate, err := CleanISBN(choice); err == nil {
+				return isbnCandidate, nil
+			}
+			ui.Warning("Warning: Invalid value.")
+		}
+	}
 	if ui.Accept("Do you want to enter an ISBN manually") {
 		validChoice := false
 		errs := 0
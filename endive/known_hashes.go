@@ -23,6 +23,7 @@ type KnownHashes struct {
 	Filename string   `json:"-"`
 	Hashes   []string `json:"hashes"`
 	Count    int      `json:"-"`
+	removed  bool
 }
 
 // GetKnownHashesPath gets the default path for known hashes.
@@ -64,7 +65,7 @@ func (k *KnownHashes) Load() (err error) {
 // Save the known hashes database.
 func (k *KnownHashes) Save() (modified bool, err error) {
 	// check if hashes have been added
-	if k.Count != len(k.Hashes) {
+	if k.Count != len(k.Hashes) || k.removed {
 		modified = true
 		hashesJSON, err := json.Marshal(k)
 		if err != nil {
@@ -102,3 +103,13 @@ func (k *KnownHashes) IsIn(hash string) (isIn bool) {
 	_, isIn = h.StringInSlice(hash, k.Hashes)
 	return
 }
+
+// Remove a hash from the database, so that the epub can be imported again.
+func (k *KnownHashes) Remove(hash string) (removed bool) {
+	if index, isIn := h.StringInSlice(hash, k.Hashes); isIn {
+		k.Hashes = append(k.Hashes[:index], k.Hashes[index+1:]...)
+		k.removed = true
+		removed = true
+	}
+	return
+}
@@ -52,6 +52,20 @@ func TestKnownHashes(t *testing.T) {
 	assert.Nil(err, "Error saving")
 	assert.True(wasModified, "One hash was added, should have saved")
 
+	// check Remove
+	c = KnownHashes{Filename: hashesFile}
+	assert.Nil(c.Load())
+	assert.False(c.Remove("74657165a56c9a54ed887cd895a0f67a70f29cbecaa96dfda840c76580da3dd9"), "Unknown hash cannot be removed")
+	assert.True(c.Remove("74657165a56c9a54ed887cd895a0f67a70f29cbecaa96dfda840c76580da3dd8"), "Known hash should have been removed")
+	assert.False(c.IsIn("74657165a56c9a54ed887cd895a0f67a70f29cbecaa96dfda840c76580da3dd8"))
+	added, err = c.Add("74657165a56c9a54ed887cd895a0f67a70f29cbecaa96dfda840c76580da3dd9")
+	assert.Nil(err)
+	assert.True(added)
+	c.Filename += "_temp"
+	wasModified, err = c.Save()
+	assert.Nil(err, "Error saving")
+	assert.True(wasModified, "One hash was replaced, should have saved")
+
 	// cleanup
 	err = os.Remove(c.Filename)
 	assert.Nil(err, "Error during cleanup of file "+c.Filename)
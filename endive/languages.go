@@ -0,0 +1,328 @@
+package endive
+
+import (
+	"strings"
+)
+
+// UndeterminedLanguage is the ISO 639-2 code for an unknown language.
+const UndeterminedLanguage = "und"
+
+// Language as defined by ISO 639.
+type Language struct {
+	Part1  string // two-letter code
+	Part2B string // bibliographic three-letter code
+	Part2T string // terminological three-letter code, also the ISO 639-3 code
+	Name   string
+	Native []string
+}
+
+// Code of a Language: its two-letter code if it has one, the three-letter code otherwise.
+func (l Language) Code() string {
+	if l.Part1 != "" {
+		return l.Part1
+	}
+	return l.Part2T
+}
+
+//go:generate go run languages_generate.go /usr/share/iso-codes/json
+
+// isoLanguages lists all ISO 639-1 languages, and the most useful languages
+// that only have three-letter codes, with their usual English and native names.
+// They take precedence over the complete list of iso639Languages.
+var isoLanguages = []Language{
+	{"aa", "aar", "aar", "Afar", nil},
+	{"ab", "abk", "abk", "Abkhazian", nil},
+	{"ae", "ave", "ave", "Avestan", nil},
+	{"af", "afr", "afr", "Afrikaans", nil},
+	{"ak", "aka", "aka", "Akan", nil},
+	{"am", "amh", "amh", "Amharic", []string{"አማርኛ"}},
+	{"an", "arg", "arg", "Aragonese", []string{"aragonés"}},
+	{"ar", "ara", "ara", "Arabic", []string{"العربية"}},
+	{"as", "asm", "asm", "Assamese", nil},
+	{"av", "ava", "ava", "Avaric", nil},
+	{"ay", "aym", "aym", "Aymara", nil},
+	{"az", "aze", "aze", "Azerbaijani", []string{"azərbaycanca"}},
+	{"ba", "bak", "bak", "Bashkir", nil},
+	{"be", "bel", "bel", "Belarusian", []string{"беларуская"}},
+	{"bg", "bul", "bul", "Bulgarian", []string{"български"}},
+	{"bh", "bih", "bih", "Bihari", nil},
+	{"bi", "bis", "bis", "Bislama", nil},
+	{"bm", "bam", "bam", "Bambara", nil},
+	{"bn", "ben", "ben", "Bengali", []string{"বাংলা", "bangla"}},
+	{"bo", "tib", "bod", "Tibetan", nil},
+	{"br", "bre", "bre", "Breton", []string{"brezhoneg"}},
+	{"bs", "bos", "bos", "Bosnian", []string{"bosanski"}},
+	{"ca", "cat", "cat", "Catalan", []string{"català"}},
+	{"ce", "che", "che", "Chechen", nil},
+	{"ch", "cha", "cha", "Chamorro", nil},
+	{"co", "cos", "cos", "Corsican", []string{"corsu"}},
+	{"cr", "cre", "cre", "Cree", nil},
+	{"cs", "cze", "ces", "Czech", []string{"čeština", "cestina"}},
+	{"cu", "chu", "chu", "Church Slavic", nil},
+	{"cv", "chv", "chv", "Chuvash", nil},
+	{"cy", "wel", "cym", "Welsh", []string{"cymraeg"}},
+	{"da", "dan", "dan", "Danish", []string{"dansk"}},
+	{"de", "ger", "deu", "German", []string{"deutsch"}},
+	{"dv", "div", "div", "Divehi", nil},
+	{"dz", "dzo", "dzo", "Dzongkha", nil},
+	{"ee", "ewe", "ewe", "Ewe", nil},
+	{"el", "gre", "ell", "Greek", []string{"ελληνικά", "modern greek"}},
+	{"en", "eng", "eng", "English", nil},
+	{"eo", "epo", "epo", "Esperanto", nil},
+	{"es", "spa", "spa", "Spanish", []string{"español", "espanol", "castellano", "castilian"}},
+	{"et", "est", "est", "Estonian", []string{"eesti"}},
+	{"eu", "baq", "eus", "Basque", []string{"euskara"}},
+	{"fa", "per", "fas", "Persian", []string{"فارسی", "farsi"}},
+	{"ff", "ful", "ful", "Fulah", nil},
+	{"fi", "fin", "fin", "Finnish", []string{"suomi"}},
+	{"fj", "fij", "fij", "Fijian", nil},
+	{"fo", "fao", "fao", "Faroese", []string{"føroyskt"}},
+	{"fr", "fre", "fra", "French", []string{"français", "francais"}},
+	{"fy", "fry", "fry", "Western Frisian", []string{"frysk", "frisian"}},
+	{"ga", "gle", "gle", "Irish", []string{"gaeilge"}},
+	{"gd", "gla", "gla", "Scottish Gaelic", []string{"gàidhlig", "gaelic"}},
+	{"gl", "glg", "glg", "Galician", []string{"galego"}},
+	{"gn", "grn", "grn", "Guarani", nil},
+	{"gu", "guj", "guj", "Gujarati", nil},
+	{"gv", "glv", "glv", "Manx", nil},
+	{"ha", "hau", "hau", "Hausa", nil},
+	{"he", "heb", "heb", "Hebrew", []string{"עברית"}},
+	{"hi", "hin", "hin", "Hindi", []string{"हिन्दी"}},
+	{"ho", "hmo", "hmo", "Hiri Motu", nil},
+	{"hr", "hrv", "hrv", "Croatian", []string{"hrvatski"}},
+	{"ht", "hat", "hat", "Haitian", []string{"haitian creole", "kreyòl ayisyen"}},
+	{"hu", "hun", "hun", "Hungarian", []string{"magyar"}},
+	{"hy", "arm", "hye", "Armenian", []string{"հայերեն"}},
+	{"hz", "her", "her", "Herero", nil},
+	{"ia", "ina", "ina", "Interlingua", nil},
+	{"id", "ind", "ind", "Indonesian", []string{"bahasa indonesia"}},
+	{"ie", "ile", "ile", "Interlingue", nil},
+	{"ig", "ibo", "ibo", "Igbo", nil},
+	{"ii", "iii", "iii", "Sichuan Yi", nil},
+	{"ik", "ipk", "ipk", "Inupiaq", nil},
+	{"io", "ido", "ido", "Ido", nil},
+	{"is", "ice", "isl", "Icelandic", []string{"íslenska"}},
+	{"it", "ita", "ita", "Italian", []string{"italiano"}},
+	{"iu", "iku", "iku", "Inuktitut", nil},
+	{"ja", "jpn", "jpn", "Japanese", []string{"日本語"}},
+	{"jv", "jav", "jav", "Javanese", nil},
+	{"ka", "geo", "kat", "Georgian", []string{"ქართული"}},
+	{"kg", "kon", "kon", "Kongo", nil},
+	{"ki", "kik", "kik", "Kikuyu", nil},
+	{"kj", "kua", "kua", "Kuanyama", nil},
+	{"kk", "kaz", "kaz", "Kazakh", []string{"қазақ тілі"}},
+	{"kl", "kal", "kal", "Kalaallisut", []string{"greenlandic"}},
+	{"km", "khm", "khm", "Khmer", nil},
+	{"kn", "kan", "kan", "Kannada", nil},
+	{"ko", "kor", "kor", "Korean", []string{"한국어"}},
+	{"kr", "kau", "kau", "Kanuri", nil},
+	{"ks", "kas", "kas", "Kashmiri", nil},
+	{"ku", "kur", "kur", "Kurdish", []string{"kurdî"}},
+	{"kv", "kom", "kom", "Komi", nil},
+	{"kw", "cor", "cor", "Cornish", []string{"kernewek"}},
+	{"ky", "kir", "kir", "Kirghiz", []string{"kyrgyz"}},
+	{"la", "lat", "lat", "Latin", []string{"latina"}},
+	{"lb", "ltz", "ltz", "Luxembourgish", []string{"lëtzebuergesch"}},
+	{"lg", "lug", "lug", "Ganda", nil},
+	{"li", "lim", "lim", "Limburgish", nil},
+	{"ln", "lin", "lin", "Lingala", nil},
+	{"lo", "lao", "lao", "Lao", nil},
+	{"lt", "lit", "lit", "Lithuanian", []string{"lietuvių"}},
+	{"lu", "lub", "lub", "Luba-Katanga", nil},
+	{"lv", "lav", "lav", "Latvian", []string{"latviešu"}},
+	{"mg", "mlg", "mlg", "Malagasy", nil},
+	{"mh", "mah", "mah", "Marshallese", nil},
+	{"mi", "mao", "mri", "Maori", []string{"māori"}},
+	{"mk", "mac", "mkd", "Macedonian", []string{"македонски"}},
+	{"ml", "mal", "mal", "Malayalam", nil},
+	{"mn", "mon", "mon", "Mongolian", []string{"монгол"}},
+	{"mr", "mar", "mar", "Marathi", nil},
+	{"ms", "may", "msa", "Malay", []string{"bahasa melayu"}},
+	{"mt", "mlt", "mlt", "Maltese", []string{"malti"}},
+	{"my", "bur", "mya", "Burmese", nil},
+	{"na", "nau", "nau", "Nauru", nil},
+	{"nb", "nob", "nob", "Norwegian Bokmål", []string{"bokmål", "norwegian bokmal"}},
+	{"nd", "nde", "nde", "North Ndebele", nil},
+	{"ne", "nep", "nep", "Nepali", nil},
+	{"ng", "ndo", "ndo", "Ndonga", nil},
+	{"nl", "dut", "nld", "Dutch", []string{"nederlands", "flemish", "vlaams"}},
+	{"nn", "nno", "nno", "Norwegian Nynorsk", []string{"nynorsk"}},
+	{"no", "nor", "nor", "Norwegian", []string{"norsk"}},
+	{"nr", "nbl", "nbl", "South Ndebele", nil},
+	{"nv", "nav", "nav", "Navajo", nil},
+	{"ny", "nya", "nya", "Chichewa", nil},
+	{"oc", "oci", "oci", "Occitan", []string{"occitan"}},
+	{"oj", "oji", "oji", "Ojibwa", nil},
+	{"om", "orm", "orm", "Oromo", nil},
+	{"or", "ori", "ori", "Oriya", nil},
+	{"os", "oss", "oss", "Ossetian", nil},
+	{"pa", "pan", "pan", "Punjabi", nil},
+	{"pi", "pli", "pli", "Pali", nil},
+	{"pl", "pol", "pol", "Polish", []string{"polski"}},
+	{"ps", "pus", "pus", "Pashto", nil},
+	{"pt", "por", "por", "Portuguese", []string{"português", "portugues"}},
+	{"qu", "que", "que", "Quechua", nil},
+	{"rm", "roh", "roh", "Romansh", []string{"rumantsch"}},
+	{"rn", "run", "run", "Rundi", nil},
+	{"ro", "rum", "ron", "Romanian", []string{"română", "romana"}},
+	{"ru", "rus", "rus", "Russian", []string{"русский"}},
+	{"rw", "kin", "kin", "Kinyarwanda", nil},
+	{"sa", "san", "san", "Sanskrit", nil},
+	{"sc", "srd", "srd", "Sardinian", []string{"sardu"}},
+	{"sd", "snd", "snd", "Sindhi", nil},
+	{"se", "sme", "sme", "Northern Sami", nil},
+	{"sg", "sag", "sag", "Sango", nil},
+	{"si", "sin", "sin", "Sinhala", nil},
+	{"sk", "slo", "slk", "Slovak", []string{"slovenčina"}},
+	{"sl", "slv", "slv", "Slovenian", []string{"slovenščina", "slovene"}},
+	{"sm", "smo", "smo", "Samoan", nil},
+	{"sn", "sna", "sna", "Shona", nil},
+	{"so", "som", "som", "Somali", nil},
+	{"sq", "alb", "sqi", "Albanian", []string{"shqip"}},
+	{"sr", "srp", "srp", "Serbian", []string{"српски", "srpski"}},
+	{"ss", "ssw", "ssw", "Swati", nil},
+	{"st", "sot", "sot", "Southern Sotho", nil},
+	{"su", "sun", "sun", "Sundanese", nil},
+	{"sv", "swe", "swe", "Swedish", []string{"svenska"}},
+	{"sw", "swa", "swa", "Swahili", []string{"kiswahili"}},
+	{"ta", "tam", "tam", "Tamil", nil},
+	{"te", "tel", "tel", "Telugu", nil},
+	{"tg", "tgk", "tgk", "Tajik", nil},
+	{"th", "tha", "tha", "Thai", []string{"ไทย"}},
+	{"ti", "tir", "tir", "Tigrinya", nil},
+	{"tk", "tuk", "tuk", "Turkmen", nil},
+	{"tl", "tgl", "tgl", "Tagalog", nil},
+	{"tn", "tsn", "tsn", "Tswana", nil},
+	{"to", "ton", "ton", "Tonga", nil},
+	{"tr", "tur", "tur", "Turkish", []string{"türkçe", "turkce"}},
+	{"ts", "tso", "tso", "Tsonga", nil},
+	{"tt", "tat", "tat", "Tatar", nil},
+	{"tw", "twi", "twi", "Twi", nil},
+	{"ty", "tah", "tah", "Tahitian", nil},
+	{"ug", "uig", "uig", "Uighur", []string{"uyghur"}},
+	{"uk", "ukr", "ukr", "Ukrainian", []string{"українська"}},
+	{"ur", "urd", "urd", "Urdu", []string{"اردو"}},
+	{"uz", "uzb", "uzb", "Uzbek", []string{"oʻzbekcha"}},
+	{"ve", "ven", "ven", "Venda", nil},
+	{"vi", "vie", "vie", "Vietnamese", []string{"tiếng việt"}},
+	{"vo", "vol", "vol", "Volapük", nil},
+	{"wa", "wln", "wln", "Walloon", []string{"walon"}},
+	{"wo", "wol", "wol", "Wolof", nil},
+	{"xh", "xho", "xho", "Xhosa", nil},
+	{"yi", "yid", "yid", "Yiddish", []string{"ייִדיש"}},
+	{"yo", "yor", "yor", "Yoruba", nil},
+	{"za", "zha", "zha", "Zhuang", nil},
+	{"zh", "chi", "zho", "Chinese", []string{"中文", "汉语", "漢語"}},
+	{"zu", "zul", "zul", "Zulu", nil},
+	// languages without a two-letter code
+	{"", "ang", "ang", "Old English", nil},
+	{"", "ast", "ast", "Asturian", []string{"asturianu"}},
+	{"", "chr", "chr", "Cherokee", nil},
+	{"", "enm", "enm", "Middle English", nil},
+	{"", "fil", "fil", "Filipino", nil},
+	{"", "frm", "frm", "Middle French", nil},
+	{"", "fro", "fro", "Old French", nil},
+	{"", "gmh", "gmh", "Middle High German", nil},
+	{"", "goh", "goh", "Old High German", nil},
+	{"", "grc", "grc", "Ancient Greek", nil},
+	{"", "haw", "haw", "Hawaiian", nil},
+	{"", "non", "non", "Old Norse", nil},
+	{"", "sco", "sco", "Scots", nil},
+	{"", "yue", "yue", "Cantonese", []string{"粵語"}},
+	// special codes
+	{"", "mis", "mis", "Uncoded languages", nil},
+	{"", "mul", "mul", "Multiple languages", nil},
+	{"", "und", "und", "Undetermined", nil},
+	{"", "zxx", "zxx", "No linguistic content", nil},
+}
+
+// iso639Part3Individual maps ISO 639-3 individual languages to the
+// macrolanguage code that is commonly used for them.
+var iso639Part3Individual = map[string]string{
+	"arb": "ar",
+	"azj": "az",
+	"cmn": "zh",
+	"ekk": "et",
+	"khk": "mn",
+	"lvs": "lv",
+	"npi": "ne",
+	"pes": "fa",
+	"prs": "fa",
+	"quz": "qu",
+	"swh": "sw",
+	"uzn": "uz",
+	"ydd": "yi",
+	"zsm": "ms",
+}
+
+var languageIndex map[string]Language
+
+func init() {
+	languageIndex = make(map[string]Language)
+	for _, l := range isoLanguages {
+		for _, key := range append([]string{l.Part1, l.Part2B, l.Part2T, strings.ToLower(l.Name)}, l.Native...) {
+			if key != "" {
+				languageIndex[key] = l
+			}
+		}
+	}
+	for code, macrolanguage := range iso639Part3Individual {
+		languageIndex[code] = languageIndex[macrolanguage]
+	}
+	for _, l := range iso639Languages {
+		// some languages have several names: "Swiss German; Alemannic; Alsatian"
+		names := strings.Split(strings.ToLower(l.Name), "; ")
+		for _, key := range append([]string{l.Part1, l.Part2B, l.Part2T}, names...) {
+			if _, known := languageIndex[key]; key != "" && !known {
+				languageIndex[key] = l
+			}
+		}
+	}
+}
+
+// LookupLanguage from an ISO 639 code, an IETF language tag such as pt-BR, or a name.
+func LookupLanguage(language string) (Language, bool) {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if l, ok := languageIndex[language]; ok {
+		return l, true
+	}
+	// only keeping the primary subtag
+	if primary := strings.FieldsFunc(language, isSubtagSeparator); len(primary) > 1 {
+		l, ok := languageIndex[primary[0]]
+		return l, ok
+	}
+	return Language{}, false
+}
+
+func isSubtagSeparator(r rune) bool {
+	return r == '-' || r == '_'
+}
+
+// NormalizeLanguage to its shortest ISO 639 code.
+// Overrides map a main alias to other spellings, and take precedence over ISO 639.
+// Unknown languages are only lowercased.
+func NormalizeLanguage(language string, overrides map[string][]string) string {
+	language = strings.TrimSpace(language)
+	for mainalias, aliases := range overrides {
+		if strings.EqualFold(language, mainalias) {
+			return mainalias
+		}
+		for _, alias := range aliases {
+			if strings.EqualFold(language, alias) {
+				return mainalias
+			}
+		}
+	}
+	if l, ok := LookupLanguage(language); ok {
+		return l.Code()
+	}
+	return strings.ToLower(language)
+}
+
+// LanguageName in English, or an empty string for unknown languages.
+func LanguageName(language string) string {
+	if l, ok := LookupLanguage(language); ok {
+		return l.Name
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+//go:build ignore
+// +build ignore
+
+// languages_generate.go writes languages_iso639.go, from the ISO 639-2 and
+// ISO 639-3 tables of the iso-codes project.
+//
+//	go run languages_generate.go /usr/share/iso-codes/json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type isoCode struct {
+	Alpha2        string `json:"alpha_2"`
+	Alpha3        string `json:"alpha_3"`
+	Bibliographic string `json:"bibliographic"`
+	Name          string `json:"name"`
+}
+
+func load(filename, key string) ([]isoCode, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	table := make(map[string][]isoCode)
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, err
+	}
+	return table[key], nil
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Println("usage: go run languages_generate.go ISO_CODES_JSON_DIRECTORY")
+		os.Exit(1)
+	}
+	part2, err := load(filepath.Join(os.Args[1], "iso_639-2.json"), "639-2")
+	if err != nil {
+		panic(err)
+	}
+	part3, err := load(filepath.Join(os.Args[1], "iso_639-3.json"), "639-3")
+	if err != nil {
+		panic(err)
+	}
+	// ISO 639-2 for bibliographic codes and collective languages, then the
+	// individual languages of ISO 639-3.
+	languages := make(map[string]isoCode)
+	for _, l := range part2 {
+		// ranges of codes reserved for local use
+		if len(l.Alpha3) != 3 {
+			continue
+		}
+		if l.Bibliographic == "" {
+			l.Bibliographic = l.Alpha3
+		}
+		languages[l.Alpha3] = l
+	}
+	for _, l := range part3 {
+		if known, ok := languages[l.Alpha3]; ok {
+			if known.Alpha2 == "" {
+				known.Alpha2 = l.Alpha2
+				languages[l.Alpha3] = known
+			}
+			continue
+		}
+		if l.Bibliographic == "" {
+			l.Bibliographic = l.Alpha3
+		}
+		languages[l.Alpha3] = l
+	}
+	codes := []string{}
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by languages_generate.go; DO NOT EDIT.\n\n")
+	b.WriteString("package endive\n\n")
+	b.WriteString("// iso639Languages lists all ISO 639-2 and ISO 639-3 languages.\n")
+	b.WriteString("var iso639Languages = []Language{\n")
+	for _, code := range codes {
+		l := languages[code]
+		fmt.Fprintf(&b, "\t{%q, %q, %q, %q, nil},\n", l.Alpha2, l.Bibliographic, l.Alpha3, l.Name)
+	}
+	b.WriteString("}\n")
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := ioutil.WriteFile("languages_iso639.go", out, 0644); err != nil {
+		panic(err)
+	}
+}
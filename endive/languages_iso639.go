@@ -0,0 +1,7983 @@
+// Code generated by languages_generate.go; DO NOT EDIT.
+
+package endive
+
+// iso639Languages lists all ISO 639-2 and ISO 639-3 languages.
+var iso639Languages = []Language{
+	{"", "aaa", "aaa", "Ghotuo", nil},
+	{"", "aab", "aab", "Alumu-Tesu", nil},
+	{"", "aac", "aac", "Ari", nil},
+	{"", "aad", "aad", "Amal", nil},
+	{"", "aae", "aae", "Arbëreshë Albanian", nil},
+	{"", "aaf", "aaf", "Aranadan", nil},
+	{"", "aag", "aag", "Ambrak", nil},
+	{"", "aah", "aah", "Abu' Arapesh", nil},
+	{"", "aai", "aai", "Arifama-Miniafia", nil},
+	{"", "aak", "aak", "Ankave", nil},
+	{"", "aal", "aal", "Afade", nil},
+	{"", "aan", "aan", "Anambé", nil},
+	{"", "aao", "aao", "Algerian Saharan Arabic", nil},
+	{"", "aap", "aap", "Pará Arára", nil},
+	{"", "aaq", "aaq", "Eastern Abnaki", nil},
+	{"aa", "aar", "aar", "Afar", nil},
+	{"", "aas", "aas", "Aasáx", nil},
+	{"", "aat", "aat", "Arvanitika Albanian", nil},
+	{"", "aau", "aau", "Abau", nil},
+	{"", "aaw", "aaw", "Solong", nil},
+	{"", "aax", "aax", "Mandobo Atas", nil},
+	{"", "aaz", "aaz", "Amarasi", nil},
+	{"", "aba", "aba", "Abé", nil},
+	{"", "abb", "abb", "Bankon", nil},
+	{"", "abc", "abc", "Ambala Ayta", nil},
+	{"", "abd", "abd", "Manide", nil},
+	{"", "abe", "abe", "Western Abnaki", nil},
+	{"", "abf", "abf", "Abai Sungai", nil},
+	{"", "abg", "abg", "Abaga", nil},
+	{"", "abh", "abh", "Tajiki Arabic", nil},
+	{"", "abi", "abi", "Abidji", nil},
+	{"", "abj", "abj", "Aka-Bea", nil},
+	{"ab", "abk", "abk", "Abkhazian", nil},
+	{"", "abl", "abl", "Lampung Nyo", nil},
+	{"", "abm", "abm", "Abanyom", nil},
+	{"", "abn", "abn", "Abua", nil},
+	{"", "abo", "abo", "Abon", nil},
+	{"", "abp", "abp", "Abellen Ayta", nil},
+	{"", "abq", "abq", "Abaza", nil},
+	{"", "abr", "abr", "Abron", nil},
+	{"", "abs", "abs", "Ambonese Malay", nil},
+	{"", "abt", "abt", "Ambulas", nil},
+	{"", "abu", "abu", "Abure", nil},
+	{"", "abv", "abv", "Baharna Arabic", nil},
+	{"", "abw", "abw", "Pal", nil},
+	{"", "abx", "abx", "Inabaknon", nil},
+	{"", "aby", "aby", "Aneme Wake", nil},
+	{"", "abz", "abz", "Abui", nil},
+	{"", "aca", "aca", "Achagua", nil},
+	{"", "acb", "acb", "Áncá", nil},
+	{"", "acd", "acd", "Gikyode", nil},
+	{"", "ace", "ace", "Achinese", nil},
+	{"", "acf", "acf", "Saint Lucian Creole French", nil},
+	{"", "ach", "ach", "Acoli", nil},
+	{"", "aci", "aci", "Aka-Cari", nil},
+	{"", "ack", "ack", "Aka-Kora", nil},
+	{"", "acl", "acl", "Akar-Bale", nil},
+	{"", "acm", "acm", "Mesopotamian Arabic", nil},
+	{"", "acn", "acn", "Achang", nil},
+	{"", "acp", "acp", "Eastern Acipa", nil},
+	{"", "acq", "acq", "Ta'izzi-Adeni Arabic", nil},
+	{"", "acr", "acr", "Achi", nil},
+	{"", "acs", "acs", "Acroá", nil},
+	{"", "act", "act", "Achterhoeks", nil},
+	{"", "acu", "acu", "Achuar-Shiwiar", nil},
+	{"", "acv", "acv", "Achumawi", nil},
+	{"", "acw", "acw", "Hijazi Arabic", nil},
+	{"", "acx", "acx", "Omani Arabic", nil},
+	{"", "acy", "acy", "Cypriot Arabic", nil},
+	{"", "acz", "acz", "Acheron", nil},
+	{"", "ada", "ada", "Adangme", nil},
+	{"", "adb", "adb", "Atauran", nil},
+	{"", "add", "add", "Lidzonka", nil},
+	{"", "ade", "ade", "Adele", nil},
+	{"", "adf", "adf", "Dhofari Arabic", nil},
+	{"", "adg", "adg", "Andegerebinha", nil},
+	{"", "adh", "adh", "Adhola", nil},
+	{"", "adi", "adi", "Adi", nil},
+	{"", "adj", "adj", "Adioukrou", nil},
+	{"", "adl", "adl", "Galo", nil},
+	{"", "adn", "adn", "Adang", nil},
+	{"", "ado", "ado", "Abu", nil},
+	{"", "adq", "adq", "Adangbe", nil},
+	{"", "adr", "adr", "Adonara", nil},
+	{"", "ads", "ads", "Adamorobe Sign Language", nil},
+	{"", "adt", "adt", "Adnyamathanha", nil},
+	{"", "adu", "adu", "Aduge", nil},
+	{"", "adw", "adw", "Amundava", nil},
+	{"", "adx", "adx", "Amdo Tibetan", nil},
+	{"", "ady", "ady", "Adyghe; Adygei", nil},
+	{"", "adz", "adz", "Adzera", nil},
+	{"", "aea", "aea", "Areba", nil},
+	{"", "aeb", "aeb", "Tunisian Arabic", nil},
+	{"", "aec", "aec", "Saidi Arabic", nil},
+	{"", "aed", "aed", "Argentine Sign Language", nil},
+	{"", "aee", "aee", "Northeast Pashai", nil},
+	{"", "aek", "aek", "Haeke", nil},
+	{"", "ael", "ael", "Ambele", nil},
+	{"", "aem", "aem", "Arem", nil},
+	{"", "aen", "aen", "Armenian Sign Language", nil},
+	{"", "aeq", "aeq", "Aer", nil},
+	{"", "aer", "aer", "Eastern Arrernte", nil},
+	{"", "aes", "aes", "Alsea", nil},
+	{"", "aeu", "aeu", "Akeu", nil},
+	{"", "aew", "aew", "Ambakich", nil},
+	{"", "aey", "aey", "Amele", nil},
+	{"", "aez", "aez", "Aeka", nil},
+	{"", "afa", "afa", "Afro-Asiatic languages", nil},
+	{"", "afb", "afb", "Gulf Arabic", nil},
+	{"", "afd", "afd", "Andai", nil},
+	{"", "afe", "afe", "Putukwam", nil},
+	{"", "afg", "afg", "Afghan Sign Language", nil},
+	{"", "afh", "afh", "Afrihili", nil},
+	{"", "afi", "afi", "Akrukay", nil},
+	{"", "afk", "afk", "Nanubae", nil},
+	{"", "afn", "afn", "Defaka", nil},
+	{"", "afo", "afo", "Eloyi", nil},
+	{"", "afp", "afp", "Tapei", nil},
+	{"af", "afr", "afr", "Afrikaans", nil},
+	{"", "afs", "afs", "Afro-Seminole Creole", nil},
+	{"", "aft", "aft", "Afitti", nil},
+	{"", "afu", "afu", "Awutu", nil},
+	{"", "afz", "afz", "Obokuitai", nil},
+	{"", "aga", "aga", "Aguano", nil},
+	{"", "agb", "agb", "Legbo", nil},
+	{"", "agc", "agc", "Agatu", nil},
+	{"", "agd", "agd", "Agarabi", nil},
+	{"", "age", "age", "Angal", nil},
+	{"", "agf", "agf", "Arguni", nil},
+	{"", "agg", "agg", "Angor", nil},
+	{"", "agh", "agh", "Ngelima", nil},
+	{"", "agi", "agi", "Agariya", nil},
+	{"", "agj", "agj", "Argobba", nil},
+	{"", "agk", "agk", "Isarog Agta", nil},
+	{"", "agl", "agl", "Fembe", nil},
+	{"", "agm", "agm", "Angaataha", nil},
+	{"", "agn", "agn", "Agutaynen", nil},
+	{"", "ago", "ago", "Tainae", nil},
+	{"", "agq", "agq", "Aghem", nil},
+	{"", "agr", "agr", "Aguaruna", nil},
+	{"", "ags", "ags", "Esimbi", nil},
+	{"", "agt", "agt", "Central Cagayan Agta", nil},
+	{"", "agu", "agu", "Aguacateco", nil},
+	{"", "agv", "agv", "Remontado Dumagat", nil},
+	{"", "agw", "agw", "Kahua", nil},
+	{"", "agx", "agx", "Aghul", nil},
+	{"", "agy", "agy", "Southern Alta", nil},
+	{"", "agz", "agz", "Mt. Iriga Agta", nil},
+	{"", "aha", "aha", "Ahanta", nil},
+	{"", "ahb", "ahb", "Axamb", nil},
+	{"", "ahg", "ahg", "Qimant", nil},
+	{"", "ahh", "ahh", "Aghu", nil},
+	{"", "ahi", "ahi", "Tiagbamrin Aizi", nil},
+	{"", "ahk", "ahk", "Akha", nil},
+	{"", "ahl", "ahl", "Igo", nil},
+	{"", "ahm", "ahm", "Mobumrin Aizi", nil},
+	{"", "ahn", "ahn", "Àhàn", nil},
+	{"", "aho", "aho", "Ahom", nil},
+	{"", "ahp", "ahp", "Aproumu Aizi", nil},
+	{"", "ahr", "ahr", "Ahirani", nil},
+	{"", "ahs", "ahs", "Ashe", nil},
+	{"", "aht", "aht", "Ahtena", nil},
+	{"", "aia", "aia", "Arosi", nil},
+	{"", "aib", "aib", "Ainu (China)", nil},
+	{"", "aic", "aic", "Ainbai", nil},
+	{"", "aid", "aid", "Alngith", nil},
+	{"", "aie", "aie", "Amara", nil},
+	{"", "aif", "aif", "Agi", nil},
+	{"", "aig", "aig", "Antigua and Barbuda Creole English", nil},
+	{"", "aih", "aih", "Ai-Cham", nil},
+	{"", "aii", "aii", "Assyrian Neo-Aramaic", nil},
+	{"", "aij", "aij", "Lishanid Noshan", nil},
+	{"", "aik", "aik", "Ake", nil},
+	{"", "ail", "ail", "Aimele", nil},
+	{"", "aim", "aim", "Aimol", nil},
+	{"", "ain", "ain", "Ainu", nil},
+	{"", "aio", "aio", "Aiton", nil},
+	{"", "aip", "aip", "Burumakok", nil},
+	{"", "aiq", "aiq", "Aimaq", nil},
+	{"", "air", "air", "Airoran", nil},
+	{"", "ait", "ait", "Arikem", nil},
+	{"", "aiw", "aiw", "Aari", nil},
+	{"", "aix", "aix", "Aighon", nil},
+	{"", "aiy", "aiy", "Ali", nil},
+	{"", "aja", "aja", "Aja (South Sudan)", nil},
+	{"", "ajg", "ajg", "Aja (Benin)", nil},
+	{"", "aji", "aji", "Ajië", nil},
+	{"", "ajn", "ajn", "Andajin", nil},
+	{"", "ajp", "ajp", "South Levantine Arabic", nil},
+	{"", "ajs", "ajs", "Algerian Jewish Sign Language", nil},
+	{"", "aju", "aju", "Judeo-Moroccan Arabic", nil},
+	{"", "ajw", "ajw", "Ajawa", nil},
+	{"", "ajz", "ajz", "Amri Karbi", nil},
+	{"ak", "aka", "aka", "Akan", nil},
+	{"", "akb", "akb", "Batak Angkola", nil},
+	{"", "akc", "akc", "Mpur", nil},
+	{"", "akd", "akd", "Ukpet-Ehom", nil},
+	{"", "ake", "ake", "Akawaio", nil},
+	{"", "akf", "akf", "Akpa", nil},
+	{"", "akg", "akg", "Anakalangu", nil},
+	{"", "akh", "akh", "Angal Heneng", nil},
+	{"", "aki", "aki", "Aiome", nil},
+	{"", "akj", "akj", "Aka-Jeru", nil},
+	{"", "akk", "akk", "Akkadian", nil},
+	{"", "akl", "akl", "Aklanon", nil},
+	{"", "akm", "akm", "Aka-Bo", nil},
+	{"", "ako", "ako", "Akurio", nil},
+	{"", "akp", "akp", "Siwu", nil},
+	{"", "akq", "akq", "Ak", nil},
+	{"", "akr", "akr", "Araki", nil},
+	{"", "aks", "aks", "Akaselem", nil},
+	{"", "akt", "akt", "Akolet", nil},
+	{"", "aku", "aku", "Akum", nil},
+	{"", "akv", "akv", "Akhvakh", nil},
+	{"", "akw", "akw", "Akwa", nil},
+	{"", "akx", "akx", "Aka-Kede", nil},
+	{"", "aky", "aky", "Aka-Kol", nil},
+	{"", "akz", "akz", "Alabama", nil},
+	{"", "ala", "ala", "Alago", nil},
+	{"", "alc", "alc", "Qawasqar", nil},
+	{"", "ald", "ald", "Alladian", nil},
+	{"", "ale", "ale", "Aleut", nil},
+	{"", "alf", "alf", "Alege", nil},
+	{"", "alg", "alg", "Algonquian languages", nil},
+	{"", "alh", "alh", "Alawa", nil},
+	{"", "ali", "ali", "Amaimon", nil},
+	{"", "alj", "alj", "Alangan", nil},
+	{"", "alk", "alk", "Alak", nil},
+	{"", "all", "all", "Allar", nil},
+	{"", "alm", "alm", "Amblong", nil},
+	{"", "aln", "aln", "Gheg Albanian", nil},
+	{"", "alo", "alo", "Larike-Wakasihu", nil},
+	{"", "alp", "alp", "Alune", nil},
+	{"", "alq", "alq", "Algonquin", nil},
+	{"", "alr", "alr", "Alutor", nil},
+	{"", "als", "als", "Tosk Albanian", nil},
+	{"", "alt", "alt", "Southern Altai", nil},
+	{"", "alu", "alu", "'Are'are", nil},
+	{"", "alw", "alw", "Alaba-K’abeena", nil},
+	{"", "alx", "alx", "Amol", nil},
+	{"", "aly", "aly", "Alyawarr", nil},
+	{"", "alz", "alz", "Alur", nil},
+	{"", "ama", "ama", "Amanayé", nil},
+	{"", "amb", "amb", "Ambo", nil},
+	{"", "amc", "amc", "Amahuaca", nil},
+	{"", "ame", "ame", "Yanesha'", nil},
+	{"", "amf", "amf", "Hamer-Banna", nil},
+	{"", "amg", "amg", "Amurdak", nil},
+	{"am", "amh", "amh", "Amharic", nil},
+	{"", "ami", "ami", "Amis", nil},
+	{"", "amj", "amj", "Amdang", nil},
+	{"", "amk", "amk", "Ambai", nil},
+	{"", "aml", "aml", "War-Jaintia", nil},
+	{"", "amm", "amm", "Ama (Papua New Guinea)", nil},
+	{"", "amn", "amn", "Amanab", nil},
+	{"", "amo", "amo", "Amo", nil},
+	{"", "amp", "amp", "Alamblak", nil},
+	{"", "amq", "amq", "Amahai", nil},
+	{"", "amr", "amr", "Amarakaeri", nil},
+	{"", "ams", "ams", "Southern Amami-Oshima", nil},
+	{"", "amt", "amt", "Amto", nil},
+	{"", "amu", "amu", "Guerrero Amuzgo", nil},
+	{"", "amv", "amv", "Ambelau", nil},
+	{"", "amw", "amw", "Western Neo-Aramaic", nil},
+	{"", "amx", "amx", "Anmatyerre", nil},
+	{"", "amy", "amy", "Ami", nil},
+	{"", "amz", "amz", "Atampaya", nil},
+	{"", "ana", "ana", "Andaqui", nil},
+	{"", "anb", "anb", "Andoa", nil},
+	{"", "anc", "anc", "Ngas", nil},
+	{"", "and", "and", "Ansus", nil},
+	{"", "ane", "ane", "Xârâcùù", nil},
+	{"", "anf", "anf", "Animere", nil},
+	{"", "ang", "ang", "English, Old (ca. 450-1100)", nil},
+	{"", "anh", "anh", "Nend", nil},
+	{"", "ani", "ani", "Andi", nil},
+	{"", "anj", "anj", "Anor", nil},
+	{"", "ank", "ank", "Goemai", nil},
+	{"", "anl", "anl", "Anu-Hkongso Chin", nil},
+	{"", "anm", "anm", "Anal", nil},
+	{"", "ann", "ann", "Obolo", nil},
+	{"", "ano", "ano", "Andoque", nil},
+	{"", "anp", "anp", "Angika", nil},
+	{"", "anq", "anq", "Jarawa (India)", nil},
+	{"", "anr", "anr", "Andh", nil},
+	{"", "ans", "ans", "Anserma", nil},
+	{"", "ant", "ant", "Antakarinya", nil},
+	{"", "anu", "anu", "Anuak", nil},
+	{"", "anv", "anv", "Denya", nil},
+	{"", "anw", "anw", "Anaang", nil},
+	{"", "anx", "anx", "Andra-Hus", nil},
+	{"", "any", "any", "Anyin", nil},
+	{"", "anz", "anz", "Anem", nil},
+	{"", "aoa", "aoa", "Angolar", nil},
+	{"", "aob", "aob", "Abom", nil},
+	{"", "aoc", "aoc", "Pemon", nil},
+	{"", "aod", "aod", "Andarum", nil},
+	{"", "aoe", "aoe", "Angal Enen", nil},
+	{"", "aof", "aof", "Bragat", nil},
+	{"", "aog", "aog", "Angoram", nil},
+	{"", "aoi", "aoi", "Anindilyakwa", nil},
+	{"", "aoj", "aoj", "Mufian", nil},
+	{"", "aok", "aok", "Arhö", nil},
+	{"", "aol", "aol", "Alor", nil},
+	{"", "aom", "aom", "Ömie", nil},
+	{"", "aon", "aon", "Bumbita Arapesh", nil},
+	{"", "aor", "aor", "Aore", nil},
+	{"", "aos", "aos", "Taikat", nil},
+	{"", "aot", "aot", "Atong (India)", nil},
+	{"", "aou", "aou", "A'ou", nil},
+	{"", "aox", "aox", "Atorada", nil},
+	{"", "aoz", "aoz", "Uab Meto", nil},
+	{"", "apa", "apa", "Apache languages", nil},
+	{"", "apb", "apb", "Sa'a", nil},
+	{"", "apc", "apc", "North Levantine Arabic", nil},
+	{"", "apd", "apd", "Sudanese Arabic", nil},
+	{"", "ape", "ape", "Bukiyip", nil},
+	{"", "apf", "apf", "Pahanan Agta", nil},
+	{"", "apg", "apg", "Ampanang", nil},
+	{"", "aph", "aph", "Athpariya", nil},
+	{"", "api", "api", "Apiaká", nil},
+	{"", "apj", "apj", "Jicarilla Apache", nil},
+	{"", "apk", "apk", "Kiowa Apache", nil},
+	{"", "apl", "apl", "Lipan Apache", nil},
+	{"", "apm", "apm", "Mescalero-Chiricahua Apache", nil},
+	{"", "apn", "apn", "Apinayé", nil},
+	{"", "apo", "apo", "Ambul", nil},
+	{"", "app", "app", "Apma", nil},
+	{"", "apq", "apq", "A-Pucikwar", nil},
+	{"", "apr", "apr", "Arop-Lokep", nil},
+	{"", "aps", "aps", "Arop-Sissano", nil},
+	{"", "apt", "apt", "Apatani", nil},
+	{"", "apu", "apu", "Apurinã", nil},
+	{"", "apv", "apv", "Alapmunte", nil},
+	{"", "apw", "apw", "Western Apache", nil},
+	{"", "apx", "apx", "Aputai", nil},
+	{"", "apy", "apy", "Apalaí", nil},
+	{"", "apz", "apz", "Safeyoka", nil},
+	{"", "aqc", "aqc", "Archi", nil},
+	{"", "aqd", "aqd", "Ampari Dogon", nil},
+	{"", "aqg", "aqg", "Arigidi", nil},
+	{"", "aqk", "aqk", "Aninka", nil},
+	{"", "aqm", "aqm", "Atohwaim", nil},
+	{"", "aqn", "aqn", "Northern Alta", nil},
+	{"", "aqp", "aqp", "Atakapa", nil},
+	{"", "aqr", "aqr", "Arhâ", nil},
+	{"", "aqt", "aqt", "Angaité", nil},
+	{"", "aqz", "aqz", "Akuntsu", nil},
+	{"ar", "ara", "ara", "Arabic", nil},
+	{"", "arb", "arb", "Standard Arabic", nil},
+	{"", "arc", "arc", "Official Aramaic (700-300 BCE); Imperial Aramaic (700-300 BCE)", nil},
+	{"", "ard", "ard", "Arabana", nil},
+	{"", "are", "are", "Western Arrarnta", nil},
+	{"an", "arg", "arg", "Aragonese", nil},
+	{"", "arh", "arh", "Arhuaco", nil},
+	{"", "ari", "ari", "Arikara", nil},
+	{"", "arj", "arj", "Arapaso", nil},
+	{"", "ark", "ark", "Arikapú", nil},
+	{"", "arl", "arl", "Arabela", nil},
+	{"", "arn", "arn", "Mapudungun; Mapuche", nil},
+	{"", "aro", "aro", "Araona", nil},
+	{"", "arp", "arp", "Arapaho", nil},
+	{"", "arq", "arq", "Algerian Arabic", nil},
+	{"", "arr", "arr", "Karo (Brazil)", nil},
+	{"", "ars", "ars", "Najdi Arabic", nil},
+	{"", "art", "art", "Artificial languages", nil},
+	{"", "aru", "aru", "Aruá (Amazonas State)", nil},
+	{"", "arv", "arv", "Arbore", nil},
+	{"", "arw", "arw", "Arawak", nil},
+	{"", "arx", "arx", "Aruá (Rodonia State)", nil},
+	{"", "ary", "ary", "Moroccan Arabic", nil},
+	{"", "arz", "arz", "Egyptian Arabic", nil},
+	{"", "asa", "asa", "Asu (Tanzania)", nil},
+	{"", "asb", "asb", "Assiniboine", nil},
+	{"", "asc", "asc", "Casuarina Coast Asmat", nil},
+	{"", "ase", "ase", "American Sign Language", nil},
+	{"", "asf", "asf", "Auslan", nil},
+	{"", "asg", "asg", "Cishingini", nil},
+	{"", "ash", "ash", "Abishira", nil},
+	{"", "asi", "asi", "Buruwai", nil},
+	{"", "asj", "asj", "Sari", nil},
+	{"", "ask", "ask", "Ashkun", nil},
+	{"", "asl", "asl", "Asilulu", nil},
+	{"as", "asm", "asm", "Assamese", nil},
+	{"", "asn", "asn", "Xingú Asuriní", nil},
+	{"", "aso", "aso", "Dano", nil},
+	{"", "asp", "asp", "Algerian Sign Language", nil},
+	{"", "asq", "asq", "Austrian Sign Language", nil},
+	{"", "asr", "asr", "Asuri", nil},
+	{"", "ass", "ass", "Ipulo", nil},
+	{"", "ast", "ast", "Asturian; Bable; Leonese; Asturleonese", nil},
+	{"", "asu", "asu", "Tocantins Asurini", nil},
+	{"", "asv", "asv", "Asoa", nil},
+	{"", "asw", "asw", "Australian Aborigines Sign Language", nil},
+	{"", "asx", "asx", "Muratayak", nil},
+	{"", "asy", "asy", "Yaosakor Asmat", nil},
+	{"", "asz", "asz", "As", nil},
+	{"", "ata", "ata", "Pele-Ata", nil},
+	{"", "atb", "atb", "Zaiwa", nil},
+	{"", "atc", "atc", "Atsahuaca", nil},
+	{"", "atd", "atd", "Ata Manobo", nil},
+	{"", "ate", "ate", "Atemble", nil},
+	{"", "atg", "atg", "Ivbie North-Okpela-Arhe", nil},
+	{"", "ath", "ath", "Athapascan languages", nil},
+	{"", "ati", "ati", "Attié", nil},
+	{"", "atj", "atj", "Atikamekw", nil},
+	{"", "atk", "atk", "Ati", nil},
+	{"", "atl", "atl", "Mt. Iraya Agta", nil},
+	{"", "atm", "atm", "Ata", nil},
+	{"", "atn", "atn", "Ashtiani", nil},
+	{"", "ato", "ato", "Atong (Cameroon)", nil},
+	{"", "atp", "atp", "Pudtol Atta", nil},
+	{"", "atq", "atq", "Aralle-Tabulahan", nil},
+	{"", "atr", "atr", "Waimiri-Atroari", nil},
+	{"", "ats", "ats", "Gros Ventre", nil},
+	{"", "att", "att", "Pamplona Atta", nil},
+	{"", "atu", "atu", "Reel", nil},
+	{"", "atv", "atv", "Northern Altai", nil},
+	{"", "atw", "atw", "Atsugewi", nil},
+	{"", "atx", "atx", "Arutani", nil},
+	{"", "aty", "aty", "Aneityum", nil},
+	{"", "atz", "atz", "Arta", nil},
+	{"", "aua", "aua", "Asumboa", nil},
+	{"", "aub", "aub", "Alugu", nil},
+	{"", "auc", "auc", "Waorani", nil},
+	{"", "aud", "aud", "Anuta", nil},
+	{"", "aug", "aug", "Aguna", nil},
+	{"", "auh", "auh", "Aushi", nil},
+	{"", "aui", "aui", "Anuki", nil},
+	{"", "auj", "auj", "Awjilah", nil},
+	{"", "auk", "auk", "Heyo", nil},
+	{"", "aul", "aul", "Aulua", nil},
+	{"", "aum", "aum", "Asu (Nigeria)", nil},
+	{"", "aun", "aun", "Molmo One", nil},
+	{"", "auo", "auo", "Auyokawa", nil},
+	{"", "aup", "aup", "Makayam", nil},
+	{"", "auq", "auq", "Anus", nil},
+	{"", "aur", "aur", "Aruek", nil},
+	{"", "aus", "aus", "Australian languages", nil},
+	{"", "aut", "aut", "Austral", nil},
+	{"", "auu", "auu", "Auye", nil},
+	{"", "auw", "auw", "Awyi", nil},
+	{"", "aux", "aux", "Aurá", nil},
+	{"", "auy", "auy", "Awiyaana", nil},
+	{"", "auz", "auz", "Uzbeki Arabic", nil},
+	{"av", "ava", "ava", "Avaric", nil},
+	{"", "avb", "avb", "Avau", nil},
+	{"", "avd", "avd", "Alviri-Vidari", nil},
+	{"ae", "ave", "ave", "Avestan", nil},
+	{"", "avi", "avi", "Avikam", nil},
+	{"", "avk", "avk", "Kotava", nil},
+	{"", "avl", "avl", "Eastern Egyptian Bedawi Arabic", nil},
+	{"", "avm", "avm", "Angkamuthi", nil},
+	{"", "avn", "avn", "Avatime", nil},
+	{"", "avo", "avo", "Agavotaguerra", nil},
+	{"", "avs", "avs", "Aushiri", nil},
+	{"", "avt", "avt", "Au", nil},
+	{"", "avu", "avu", "Avokaya", nil},
+	{"", "avv", "avv", "Avá-Canoeiro", nil},
+	{"", "awa", "awa", "Awadhi", nil},
+	{"", "awb", "awb", "Awa (Papua New Guinea)", nil},
+	{"", "awc", "awc", "Cicipu", nil},
+	{"", "awe", "awe", "Awetí", nil},
+	{"", "awg", "awg", "Anguthimri", nil},
+	{"", "awh", "awh", "Awbono", nil},
+	{"", "awi", "awi", "Aekyom", nil},
+	{"", "awk", "awk", "Awabakal", nil},
+	{"", "awm", "awm", "Arawum", nil},
+	{"", "awn", "awn", "Awngi", nil},
+	{"", "awo", "awo", "Awak", nil},
+	{"", "awr", "awr", "Awera", nil},
+	{"", "aws", "aws", "South Awyu", nil},
+	{"", "awt", "awt", "Araweté", nil},
+	{"", "awu", "awu", "Central Awyu", nil},
+	{"", "awv", "awv", "Jair Awyu", nil},
+	{"", "aww", "aww", "Awun", nil},
+	{"", "awx", "awx", "Awara", nil},
+	{"", "awy", "awy", "Edera Awyu", nil},
+	{"", "axb", "axb", "Abipon", nil},
+	{"", "axe", "axe", "Ayerrerenge", nil},
+	{"", "axg", "axg", "Mato Grosso Arára", nil},
+	{"", "axk", "axk", "Yaka (Central African Republic)", nil},
+	{"", "axl", "axl", "Lower Southern Aranda", nil},
+	{"", "axm", "axm", "Middle Armenian", nil},
+	{"", "axx", "axx", "Xârâgurè", nil},
+	{"", "aya", "aya", "Awar", nil},
+	{"", "ayb", "ayb", "Ayizo Gbe", nil},
+	{"", "ayc", "ayc", "Southern Aymara", nil},
+	{"", "ayd", "ayd", "Ayabadhu", nil},
+	{"", "aye", "aye", "Ayere", nil},
+	{"", "ayg", "ayg", "Ginyanga", nil},
+	{"", "ayh", "ayh", "Hadrami Arabic", nil},
+	{"", "ayi", "ayi", "Leyigha", nil},
+	{"", "ayk", "ayk", "Akuku", nil},
+	{"", "ayl", "ayl", "Libyan Arabic", nil},
+	{"ay", "aym", "aym", "Aymara", nil},
+	{"", "ayn", "ayn", "Sanaani Arabic", nil},
+	{"", "ayo", "ayo", "Ayoreo", nil},
+	{"", "ayp", "ayp", "North Mesopotamian Arabic", nil},
+	{"", "ayq", "ayq", "Ayi (Papua New Guinea)", nil},
+	{"", "ayr", "ayr", "Central Aymara", nil},
+	{"", "ays", "ays", "Sorsogon Ayta", nil},
+	{"", "ayt", "ayt", "Magbukun Ayta", nil},
+	{"", "ayu", "ayu", "Ayu", nil},
+	{"", "ayz", "ayz", "Mai Brat", nil},
+	{"", "aza", "aza", "Azha", nil},
+	{"", "azb", "azb", "South Azerbaijani", nil},
+	{"", "azd", "azd", "Eastern Durango Nahuatl", nil},
+	{"az", "aze", "aze", "Azerbaijani", nil},
+	{"", "azg", "azg", "San Pedro Amuzgos Amuzgo", nil},
+	{"", "azj", "azj", "North Azerbaijani", nil},
+	{"", "azm", "azm", "Ipalapa Amuzgo", nil},
+	{"", "azn", "azn", "Western Durango Nahuatl", nil},
+	{"", "azo", "azo", "Awing", nil},
+	{"", "azt", "azt", "Faire Atta", nil},
+	{"", "azz", "azz", "Highland Puebla Nahuatl", nil},
+	{"", "baa", "baa", "Babatana", nil},
+	{"", "bab", "bab", "Bainouk-Gunyuño", nil},
+	{"", "bac", "bac", "Badui", nil},
+	{"", "bad", "bad", "Banda languages", nil},
+	{"", "bae", "bae", "Baré", nil},
+	{"", "baf", "baf", "Nubaca", nil},
+	{"", "bag", "bag", "Tuki", nil},
+	{"", "bah", "bah", "Bahamas Creole English", nil},
+	{"", "bai", "bai", "Bamileke languages", nil},
+	{"", "baj", "baj", "Barakai", nil},
+	{"ba", "bak", "bak", "Bashkir", nil},
+	{"", "bal", "bal", "Baluchi", nil},
+	{"bm", "bam", "bam", "Bambara", nil},
+	{"", "ban", "ban", "Balinese", nil},
+	{"", "bao", "bao", "Waimaha", nil},
+	{"", "bap", "bap", "Bantawa", nil},
+	{"", "bar", "bar", "Bavarian", nil},
+	{"", "bas", "bas", "Basa", nil},
+	{"", "bat", "bat", "Baltic languages", nil},
+	{"", "bau", "bau", "Bada (Nigeria)", nil},
+	{"", "bav", "bav", "Vengo", nil},
+	{"", "baw", "baw", "Bambili-Bambui", nil},
+	{"", "bax", "bax", "Bamun", nil},
+	{"", "bay", "bay", "Batuley", nil},
+	{"", "bba", "bba", "Baatonum", nil},
+	{"", "bbb", "bbb", "Barai", nil},
+	{"", "bbc", "bbc", "Batak Toba", nil},
+	{"", "bbd", "bbd", "Bau", nil},
+	{"", "bbe", "bbe", "Bangba", nil},
+	{"", "bbf", "bbf", "Baibai", nil},
+	{"", "bbg", "bbg", "Barama", nil},
+	{"", "bbh", "bbh", "Bugan", nil},
+	{"", "bbi", "bbi", "Barombi", nil},
+	{"", "bbj", "bbj", "Ghomálá'", nil},
+	{"", "bbk", "bbk", "Babanki", nil},
+	{"", "bbl", "bbl", "Bats", nil},
+	{"", "bbm", "bbm", "Babango", nil},
+	{"", "bbn", "bbn", "Uneapa", nil},
+	{"", "bbo", "bbo", "Northern Bobo Madaré", nil},
+	{"", "bbp", "bbp", "West Central Banda", nil},
+	{"", "bbq", "bbq", "Bamali", nil},
+	{"", "bbr", "bbr", "Girawa", nil},
+	{"", "bbs", "bbs", "Bakpinka", nil},
+	{"", "bbt", "bbt", "Mburku", nil},
+	{"", "bbu", "bbu", "Kulung (Nigeria)", nil},
+	{"", "bbv", "bbv", "Karnai", nil},
+	{"", "bbw", "bbw", "Baba", nil},
+	{"", "bbx", "bbx", "Bubia", nil},
+	{"", "bby", "bby", "Befang", nil},
+	{"", "bca", "bca", "Central Bai", nil},
+	{"", "bcb", "bcb", "Bainouk-Samik", nil},
+	{"", "bcc", "bcc", "Southern Balochi", nil},
+	{"", "bcd", "bcd", "North Babar", nil},
+	{"", "bce", "bce", "Bamenyam", nil},
+	{"", "bcf", "bcf", "Bamu", nil},
+	{"", "bcg", "bcg", "Baga Pokur", nil},
+	{"", "bch", "bch", "Bariai", nil},
+	{"", "bci", "bci", "Baoulé", nil},
+	{"", "bcj", "bcj", "Bardi", nil},
+	{"", "bck", "bck", "Bunuba", nil},
+	{"", "bcl", "bcl", "Central Bikol", nil},
+	{"", "bcm", "bcm", "Bannoni", nil},
+	{"", "bcn", "bcn", "Bali (Nigeria)", nil},
+	{"", "bco", "bco", "Kaluli", nil},
+	{"", "bcp", "bcp", "Bali (Democratic Republic of Congo)", nil},
+	{"", "bcq", "bcq", "Bench", nil},
+	{"", "bcr", "bcr", "Babine", nil},
+	{"", "bcs", "bcs", "Kohumono", nil},
+	{"", "bct", "bct", "Bendi", nil},
+	{"", "bcu", "bcu", "Awad Bing", nil},
+	{"", "bcv", "bcv", "Shoo-Minda-Nye", nil},
+	{"", "bcw", "bcw", "Bana", nil},
+	{"", "bcy", "bcy", "Bacama", nil},
+	{"", "bcz", "bcz", "Bainouk-Gunyaamolo", nil},
+	{"", "bda", "bda", "Bayot", nil},
+	{"", "bdb", "bdb", "Basap", nil},
+	{"", "bdc", "bdc", "Emberá-Baudó", nil},
+	{"", "bdd", "bdd", "Bunama", nil},
+	{"", "bde", "bde", "Bade", nil},
+	{"", "bdf", "bdf", "Biage", nil},
+	{"", "bdg", "bdg", "Bonggi", nil},
+	{"", "bdh", "bdh", "Baka (South Sudan)", nil},
+	{"", "bdi", "bdi", "Burun", nil},
+	{"", "bdj", "bdj", "Bai (South Sudan)", nil},
+	{"", "bdk", "bdk", "Budukh", nil},
+	{"", "bdl", "bdl", "Indonesian Bajau", nil},
+	{"", "bdm", "bdm", "Buduma", nil},
+	{"", "bdn", "bdn", "Baldemu", nil},
+	{"", "bdo", "bdo", "Morom", nil},
+	{"", "bdp", "bdp", "Bende", nil},
+	{"", "bdq", "bdq", "Bahnar", nil},
+	{"", "bdr", "bdr", "West Coast Bajau", nil},
+	{"", "bds", "bds", "Burunge", nil},
+	{"", "bdt", "bdt", "Bokoto", nil},
+	{"", "bdu", "bdu", "Oroko", nil},
+	{"", "bdv", "bdv", "Bodo Parja", nil},
+	{"", "bdw", "bdw", "Baham", nil},
+	{"", "bdx", "bdx", "Budong-Budong", nil},
+	{"", "bdy", "bdy", "Bandjalang", nil},
+	{"", "bdz", "bdz", "Badeshi", nil},
+	{"", "bea", "bea", "Beaver", nil},
+	{"", "beb", "beb", "Bebele", nil},
+	{"", "bec", "bec", "Iceve-Maci", nil},
+	{"", "bed", "bed", "Bedoanas", nil},
+	{"", "bee", "bee", "Byangsi", nil},
+	{"", "bef", "bef", "Benabena", nil},
+	{"", "beg", "beg", "Belait", nil},
+	{"", "beh", "beh", "Biali", nil},
+	{"", "bei", "bei", "Bekati'", nil},
+	{"", "bej", "bej", "Beja; Bedawiyet", nil},
+	{"", "bek", "bek", "Bebeli", nil},
+	{"be", "bel", "bel", "Belarusian", nil},
+	{"", "bem", "bem", "Bemba", nil},
+	{"bn", "ben", "ben", "Bengali", nil},
+	{"", "beo", "beo", "Beami", nil},
+	{"", "bep", "bep", "Besoa", nil},
+	{"", "beq", "beq", "Beembe", nil},
+	{"", "ber", "ber", "Berber languages", nil},
+	{"", "bes", "bes", "Besme", nil},
+	{"", "bet", "bet", "Guiberoua Béte", nil},
+	{"", "beu", "beu", "Blagar", nil},
+	{"", "bev", "bev", "Daloa Bété", nil},
+	{"", "bew", "bew", "Betawi", nil},
+	{"", "bex", "bex", "Jur Modo", nil},
+	{"", "bey", "bey", "Beli (Papua New Guinea)", nil},
+	{"", "bez", "bez", "Bena (Tanzania)", nil},
+	{"", "bfa", "bfa", "Bari", nil},
+	{"", "bfb", "bfb", "Pauri Bareli", nil},
+	{"", "bfc", "bfc", "Panyi Bai", nil},
+	{"", "bfd", "bfd", "Bafut", nil},
+	{"", "bfe", "bfe", "Betaf", nil},
+	{"", "bff", "bff", "Bofi", nil},
+	{"", "bfg", "bfg", "Busang Kayan", nil},
+	{"", "bfh", "bfh", "Blafe", nil},
+	{"", "bfi", "bfi", "British Sign Language", nil},
+	{"", "bfj", "bfj", "Bafanji", nil},
+	{"", "bfk", "bfk", "Ban Khor Sign Language", nil},
+	{"", "bfl", "bfl", "Banda-Ndélé", nil},
+	{"", "bfm", "bfm", "Mmen", nil},
+	{"", "bfn", "bfn", "Bunak", nil},
+	{"", "bfo", "bfo", "Malba Birifor", nil},
+	{"", "bfp", "bfp", "Beba", nil},
+	{"", "bfq", "bfq", "Badaga", nil},
+	{"", "bfr", "bfr", "Bazigar", nil},
+	{"", "bfs", "bfs", "Southern Bai", nil},
+	{"", "bft", "bft", "Balti", nil},
+	{"", "bfu", "bfu", "Gahri", nil},
+	{"", "bfw", "bfw", "Bondo", nil},
+	{"", "bfx", "bfx", "Bantayanon", nil},
+	{"", "bfy", "bfy", "Bagheli", nil},
+	{"", "bfz", "bfz", "Mahasu Pahari", nil},
+	{"", "bga", "bga", "Gwamhi-Wuri", nil},
+	{"", "bgb", "bgb", "Bobongko", nil},
+	{"", "bgc", "bgc", "Haryanvi", nil},
+	{"", "bgd", "bgd", "Rathwi Bareli", nil},
+	{"", "bge", "bge", "Bauria", nil},
+	{"", "bgf", "bgf", "Bangandu", nil},
+	{"", "bgg", "bgg", "Bugun", nil},
+	{"", "bgi", "bgi", "Giangan", nil},
+	{"", "bgj", "bgj", "Bangolan", nil},
+	{"", "bgk", "bgk", "Bit", nil},
+	{"", "bgl", "bgl", "Bo (Laos)", nil},
+	{"", "bgn", "bgn", "Western Balochi", nil},
+	{"", "bgo", "bgo", "Baga Koga", nil},
+	{"", "bgp", "bgp", "Eastern Balochi", nil},
+	{"", "bgq", "bgq", "Bagri", nil},
+	{"", "bgr", "bgr", "Bawm Chin", nil},
+	{"", "bgs", "bgs", "Tagabawa", nil},
+	{"", "bgt", "bgt", "Bughotu", nil},
+	{"", "bgu", "bgu", "Mbongno", nil},
+	{"", "bgv", "bgv", "Warkay-Bipim", nil},
+	{"", "bgw", "bgw", "Bhatri", nil},
+	{"", "bgx", "bgx", "Balkan Gagauz Turkish", nil},
+	{"", "bgy", "bgy", "Benggoi", nil},
+	{"", "bgz", "bgz", "Banggai", nil},
+	{"", "bha", "bha", "Bharia", nil},
+	{"", "bhb", "bhb", "Bhili", nil},
+	{"", "bhc", "bhc", "Biga", nil},
+	{"", "bhd", "bhd", "Bhadrawahi", nil},
+	{"", "bhe", "bhe", "Bhaya", nil},
+	{"", "bhf", "bhf", "Odiai", nil},
+	{"", "bhg", "bhg", "Binandere", nil},
+	{"", "bhh", "bhh", "Bukharic", nil},
+	{"", "bhi", "bhi", "Bhilali", nil},
+	{"", "bhj", "bhj", "Bahing", nil},
+	{"", "bhl", "bhl", "Bimin", nil},
+	{"", "bhm", "bhm", "Bathari", nil},
+	{"", "bhn", "bhn", "Bohtan Neo-Aramaic", nil},
+	{"", "bho", "bho", "Bhojpuri", nil},
+	{"", "bhp", "bhp", "Bima", nil},
+	{"", "bhq", "bhq", "Tukang Besi South", nil},
+	{"", "bhr", "bhr", "Bara Malagasy", nil},
+	{"", "bhs", "bhs", "Buwal", nil},
+	{"", "bht", "bht", "Bhattiyali", nil},
+	{"", "bhu", "bhu", "Bhunjia", nil},
+	{"", "bhv", "bhv", "Bahau", nil},
+	{"", "bhw", "bhw", "Biak", nil},
+	{"", "bhx", "bhx", "Bhalay", nil},
+	{"", "bhy", "bhy", "Bhele", nil},
+	{"", "bhz", "bhz", "Bada (Indonesia)", nil},
+	{"", "bia", "bia", "Badimaya", nil},
+	{"", "bib", "bib", "Bissa", nil},
+	{"", "bid", "bid", "Bidiyo", nil},
+	{"", "bie", "bie", "Bepour", nil},
+	{"", "bif", "bif", "Biafada", nil},
+	{"", "big", "big", "Biangai", nil},
+	{"bh", "bih", "bih", "Bihari languages", nil},
+	{"", "bik", "bik", "Bikol", nil},
+	{"", "bil", "bil", "Bile", nil},
+	{"", "bim", "bim", "Bimoba", nil},
+	{"", "bin", "bin", "Bini; Edo", nil},
+	{"", "bio", "bio", "Nai", nil},
+	{"", "bip", "bip", "Bila", nil},
+	{"", "biq", "biq", "Bipi", nil},
+	{"", "bir", "bir", "Bisorio", nil},
+	{"bi", "bis", "bis", "Bislama", nil},
+	{"", "bit", "bit", "Berinomo", nil},
+	{"", "biu", "biu", "Biete", nil},
+	{"", "biv", "biv", "Southern Birifor", nil},
+	{"", "biw", "biw", "Kol (Cameroon)", nil},
+	{"", "bix", "bix", "Bijori", nil},
+	{"", "biy", "biy", "Birhor", nil},
+	{"", "biz", "biz", "Baloi", nil},
+	{"", "bja", "bja", "Budza", nil},
+	{"", "bjb", "bjb", "Banggarla", nil},
+	{"", "bjc", "bjc", "Bariji", nil},
+	{"", "bje", "bje", "Biao-Jiao Mien", nil},
+	{"", "bjf", "bjf", "Barzani Jewish Neo-Aramaic", nil},
+	{"", "bjg", "bjg", "Bidyogo", nil},
+	{"", "bjh", "bjh", "Bahinemo", nil},
+	{"", "bji", "bji", "Burji", nil},
+	{"", "bjj", "bjj", "Kanauji", nil},
+	{"", "bjk", "bjk", "Barok", nil},
+	{"", "bjl", "bjl", "Bulu (Papua New Guinea)", nil},
+	{"", "bjm", "bjm", "Bajelani", nil},
+	{"", "bjn", "bjn", "Banjar", nil},
+	{"", "bjo", "bjo", "Mid-Southern Banda", nil},
+	{"", "bjp", "bjp", "Fanamaket", nil},
+	{"", "bjr", "bjr", "Binumarien", nil},
+	{"", "bjs", "bjs", "Bajan", nil},
+	{"", "bjt", "bjt", "Balanta-Ganja", nil},
+	{"", "bju", "bju", "Busuu", nil},
+	{"", "bjv", "bjv", "Bedjond", nil},
+	{"", "bjw", "bjw", "Bakwé", nil},
+	{"", "bjx", "bjx", "Banao Itneg", nil},
+	{"", "bjy", "bjy", "Bayali", nil},
+	{"", "bjz", "bjz", "Baruga", nil},
+	{"", "bka", "bka", "Kyak", nil},
+	{"", "bkc", "bkc", "Baka (Cameroon)", nil},
+	{"", "bkd", "bkd", "Binukid", nil},
+	{"", "bkf", "bkf", "Beeke", nil},
+	{"", "bkg", "bkg", "Buraka", nil},
+	{"", "bkh", "bkh", "Bakoko", nil},
+	{"", "bki", "bki", "Baki", nil},
+	{"", "bkj", "bkj", "Pande", nil},
+	{"", "bkk", "bkk", "Brokskat", nil},
+	{"", "bkl", "bkl", "Berik", nil},
+	{"", "bkm", "bkm", "Kom (Cameroon)", nil},
+	{"", "bkn", "bkn", "Bukitan", nil},
+	{"", "bko", "bko", "Kwa'", nil},
+	{"", "bkp", "bkp", "Boko (Democratic Republic of Congo)", nil},
+	{"", "bkq", "bkq", "Bakairí", nil},
+	{"", "bkr", "bkr", "Bakumpai", nil},
+	{"", "bks", "bks", "Northern Sorsoganon", nil},
+	{"", "bkt", "bkt", "Boloki", nil},
+	{"", "bku", "bku", "Buhid", nil},
+	{"", "bkv", "bkv", "Bekwarra", nil},
+	{"", "bkw", "bkw", "Bekwel", nil},
+	{"", "bkx", "bkx", "Baikeno", nil},
+	{"", "bky", "bky", "Bokyi", nil},
+	{"", "bkz", "bkz", "Bungku", nil},
+	{"", "bla", "bla", "Siksika", nil},
+	{"", "blb", "blb", "Bilua", nil},
+	{"", "blc", "blc", "Bella Coola", nil},
+	{"", "bld", "bld", "Bolango", nil},
+	{"", "ble", "ble", "Balanta-Kentohe", nil},
+	{"", "blf", "blf", "Buol", nil},
+	{"", "blh", "blh", "Kuwaa", nil},
+	{"", "bli", "bli", "Bolia", nil},
+	{"", "blj", "blj", "Bolongan", nil},
+	{"", "blk", "blk", "Pa'o Karen", nil},
+	{"", "bll", "bll", "Biloxi", nil},
+	{"", "blm", "blm", "Beli (South Sudan)", nil},
+	{"", "bln", "bln", "Southern Catanduanes Bikol", nil},
+	{"", "blo", "blo", "Anii", nil},
+	{"", "blp", "blp", "Blablanga", nil},
+	{"", "blq", "blq", "Baluan-Pam", nil},
+	{"", "blr", "blr", "Blang", nil},
+	{"", "bls", "bls", "Balaesang", nil},
+	{"", "blt", "blt", "Tai Dam", nil},
+	{"", "blv", "blv", "Kibala", nil},
+	{"", "blw", "blw", "Balangao", nil},
+	{"", "blx", "blx", "Mag-Indi Ayta", nil},
+	{"", "bly", "bly", "Notre", nil},
+	{"", "blz", "blz", "Balantak", nil},
+	{"", "bma", "bma", "Lame", nil},
+	{"", "bmb", "bmb", "Bembe", nil},
+	{"", "bmc", "bmc", "Biem", nil},
+	{"", "bmd", "bmd", "Baga Manduri", nil},
+	{"", "bme", "bme", "Limassa", nil},
+	{"", "bmf", "bmf", "Bom-Kim", nil},
+	{"", "bmg", "bmg", "Bamwe", nil},
+	{"", "bmh", "bmh", "Kein", nil},
+	{"", "bmi", "bmi", "Bagirmi", nil},
+	{"", "bmj", "bmj", "Bote-Majhi", nil},
+	{"", "bmk", "bmk", "Ghayavi", nil},
+	{"", "bml", "bml", "Bomboli", nil},
+	{"", "bmm", "bmm", "Northern Betsimisaraka Malagasy", nil},
+	{"", "bmn", "bmn", "Bina (Papua New Guinea)", nil},
+	{"", "bmo", "bmo", "Bambalang", nil},
+	{"", "bmp", "bmp", "Bulgebi", nil},
+	{"", "bmq", "bmq", "Bomu", nil},
+	{"", "bmr", "bmr", "Muinane", nil},
+	{"", "bms", "bms", "Bilma Kanuri", nil},
+	{"", "bmt", "bmt", "Biao Mon", nil},
+	{"", "bmu", "bmu", "Somba-Siawari", nil},
+	{"", "bmv", "bmv", "Bum", nil},
+	{"", "bmw", "bmw", "Bomwali", nil},
+	{"", "bmx", "bmx", "Baimak", nil},
+	{"", "bmz", "bmz", "Baramu", nil},
+	{"", "bna", "bna", "Bonerate", nil},
+	{"", "bnb", "bnb", "Bookan", nil},
+	{"", "bnc", "bnc", "Bontok", nil},
+	{"", "bnd", "bnd", "Banda (Indonesia)", nil},
+	{"", "bne", "bne", "Bintauna", nil},
+	{"", "bnf", "bnf", "Masiwang", nil},
+	{"", "bng", "bng", "Benga", nil},
+	{"", "bni", "bni", "Bangi", nil},
+	{"", "bnj", "bnj", "Eastern Tawbuid", nil},
+	{"", "bnk", "bnk", "Bierebo", nil},
+	{"", "bnl", "bnl", "Boon", nil},
+	{"", "bnm", "bnm", "Batanga", nil},
+	{"", "bnn", "bnn", "Bunun", nil},
+	{"", "bno", "bno", "Bantoanon", nil},
+	{"", "bnp", "bnp", "Bola", nil},
+	{"", "bnq", "bnq", "Bantik", nil},
+	{"", "bnr", "bnr", "Butmas-Tur", nil},
+	{"", "bns", "bns", "Bundeli", nil},
+	{"", "bnt", "bnt", "Bantu (Other)", nil},
+	{"", "bnu", "bnu", "Bentong", nil},
+	{"", "bnv", "bnv", "Bonerif", nil},
+	{"", "bnw", "bnw", "Bisis", nil},
+	{"", "bnx", "bnx", "Bangubangu", nil},
+	{"", "bny", "bny", "Bintulu", nil},
+	{"", "bnz", "bnz", "Beezen", nil},
+	{"", "boa", "boa", "Bora", nil},
+	{"", "bob", "bob", "Aweer", nil},
+	{"bo", "tib", "bod", "Tibetan", nil},
+	{"", "boe", "boe", "Mundabli", nil},
+	{"", "bof", "bof", "Bolon", nil},
+	{"", "bog", "bog", "Bamako Sign Language", nil},
+	{"", "boh", "boh", "Boma", nil},
+	{"", "boi", "boi", "Barbareño", nil},
+	{"", "boj", "boj", "Anjam", nil},
+	{"", "bok", "bok", "Bonjo", nil},
+	{"", "bol", "bol", "Bole", nil},
+	{"", "bom", "bom", "Berom", nil},
+	{"", "bon", "bon", "Bine", nil},
+	{"", "boo", "boo", "Tiemacèwè Bozo", nil},
+	{"", "bop", "bop", "Bonkiman", nil},
+	{"", "boq", "boq", "Bogaya", nil},
+	{"", "bor", "bor", "Borôro", nil},
+	{"bs", "bos", "bos", "Bosnian", nil},
+	{"", "bot", "bot", "Bongo", nil},
+	{"", "bou", "bou", "Bondei", nil},
+	{"", "bov", "bov", "Tuwuli", nil},
+	{"", "bow", "bow", "Rema", nil},
+	{"", "box", "box", "Buamu", nil},
+	{"", "boy", "boy", "Bodo (Central African Republic)", nil},
+	{"", "boz", "boz", "Tiéyaxo Bozo", nil},
+	{"", "bpa", "bpa", "Daakaka", nil},
+	{"", "bpc", "bpc", "Mbuk", nil},
+	{"", "bpd", "bpd", "Banda-Banda", nil},
+	{"", "bpe", "bpe", "Bauni", nil},
+	{"", "bpg", "bpg", "Bonggo", nil},
+	{"", "bph", "bph", "Botlikh", nil},
+	{"", "bpi", "bpi", "Bagupi", nil},
+	{"", "bpj", "bpj", "Binji", nil},
+	{"", "bpk", "bpk", "Orowe", nil},
+	{"", "bpl", "bpl", "Broome Pearling Lugger Pidgin", nil},
+	{"", "bpm", "bpm", "Biyom", nil},
+	{"", "bpn", "bpn", "Dzao Min", nil},
+	{"", "bpo", "bpo", "Anasi", nil},
+	{"", "bpp", "bpp", "Kaure", nil},
+	{"", "bpq", "bpq", "Banda Malay", nil},
+	{"", "bpr", "bpr", "Koronadal Blaan", nil},
+	{"", "bps", "bps", "Sarangani Blaan", nil},
+	{"", "bpt", "bpt", "Barrow Point", nil},
+	{"", "bpu", "bpu", "Bongu", nil},
+	{"", "bpv", "bpv", "Bian Marind", nil},
+	{"", "bpw", "bpw", "Bo (Papua New Guinea)", nil},
+	{"", "bpx", "bpx", "Palya Bareli", nil},
+	{"", "bpy", "bpy", "Bishnupriya", nil},
+	{"", "bpz", "bpz", "Bilba", nil},
+	{"", "bqa", "bqa", "Tchumbuli", nil},
+	{"", "bqb", "bqb", "Bagusa", nil},
+	{"", "bqc", "bqc", "Boko (Benin)", nil},
+	{"", "bqd", "bqd", "Bung", nil},
+	{"", "bqf", "bqf", "Baga Kaloum", nil},
+	{"", "bqg", "bqg", "Bago-Kusuntu", nil},
+	{"", "bqh", "bqh", "Baima", nil},
+	{"", "bqi", "bqi", "Bakhtiari", nil},
+	{"", "bqj", "bqj", "Bandial", nil},
+	{"", "bqk", "bqk", "Banda-Mbrès", nil},
+	{"", "bql", "bql", "Bilakura", nil},
+	{"", "bqm", "bqm", "Wumboko", nil},
+	{"", "bqn", "bqn", "Bulgarian Sign Language", nil},
+	{"", "bqo", "bqo", "Balo", nil},
+	{"", "bqp", "bqp", "Busa", nil},
+	{"", "bqq", "bqq", "Biritai", nil},
+	{"", "bqr", "bqr", "Burusu", nil},
+	{"", "bqs", "bqs", "Bosngun", nil},
+	{"", "bqt", "bqt", "Bamukumbit", nil},
+	{"", "bqu", "bqu", "Boguru", nil},
+	{"", "bqv", "bqv", "Koro Wachi", nil},
+	{"", "bqw", "bqw", "Buru (Nigeria)", nil},
+	{"", "bqx", "bqx", "Baangi", nil},
+	{"", "bqy", "bqy", "Bengkala Sign Language", nil},
+	{"", "bqz", "bqz", "Bakaka", nil},
+	{"", "bra", "bra", "Braj", nil},
+	{"", "brb", "brb", "Brao", nil},
+	{"", "brc", "brc", "Berbice Creole Dutch", nil},
+	{"", "brd", "brd", "Baraamu", nil},
+	{"br", "bre", "bre", "Breton", nil},
+	{"", "brf", "brf", "Bira", nil},
+	{"", "brg", "brg", "Baure", nil},
+	{"", "brh", "brh", "Brahui", nil},
+	{"", "bri", "bri", "Mokpwe", nil},
+	{"", "brj", "brj", "Bieria", nil},
+	{"", "brk", "brk", "Birked", nil},
+	{"", "brl", "brl", "Birwa", nil},
+	{"", "brm", "brm", "Barambu", nil},
+	{"", "brn", "brn", "Boruca", nil},
+	{"", "bro", "bro", "Brokkat", nil},
+	{"", "brp", "brp", "Barapasi", nil},
+	{"", "brq", "brq", "Breri", nil},
+	{"", "brr", "brr", "Birao", nil},
+	{"", "brs", "brs", "Baras", nil},
+	{"", "brt", "brt", "Bitare", nil},
+	{"", "bru", "bru", "Eastern Bru", nil},
+	{"", "brv", "brv", "Western Bru", nil},
+	{"", "brw", "brw", "Bellari", nil},
+	{"", "brx", "brx", "Bodo (India)", nil},
+	{"", "bry", "bry", "Burui", nil},
+	{"", "brz", "brz", "Bilbil", nil},
+	{"", "bsa", "bsa", "Abinomn", nil},
+	{"", "bsb", "bsb", "Brunei Bisaya", nil},
+	{"", "bsc", "bsc", "Bassari", nil},
+	{"", "bse", "bse", "Wushi", nil},
+	{"", "bsf", "bsf", "Bauchi", nil},
+	{"", "bsg", "bsg", "Bashkardi", nil},
+	{"", "bsh", "bsh", "Kati", nil},
+	{"", "bsi", "bsi", "Bassossi", nil},
+	{"", "bsj", "bsj", "Bangwinji", nil},
+	{"", "bsk", "bsk", "Burushaski", nil},
+	{"", "bsl", "bsl", "Basa-Gumna", nil},
+	{"", "bsm", "bsm", "Busami", nil},
+	{"", "bsn", "bsn", "Barasana-Eduria", nil},
+	{"", "bso", "bso", "Buso", nil},
+	{"", "bsp", "bsp", "Baga Sitemu", nil},
+	{"", "bsq", "bsq", "Bassa", nil},
+	{"", "bsr", "bsr", "Bassa-Kontagora", nil},
+	{"", "bss", "bss", "Akoose", nil},
+	{"", "bst", "bst", "Basketo", nil},
+	{"", "bsu", "bsu", "Bahonsuai", nil},
+	{"", "bsv", "bsv", "Baga Sobané", nil},
+	{"", "bsw", "bsw", "Baiso", nil},
+	{"", "bsx", "bsx", "Yangkam", nil},
+	{"", "bsy", "bsy", "Sabah Bisaya", nil},
+	{"", "bta", "bta", "Bata", nil},
+	{"", "btc", "btc", "Bati (Cameroon)", nil},
+	{"", "btd", "btd", "Batak Dairi", nil},
+	{"", "bte", "bte", "Gamo-Ningi", nil},
+	{"", "btf", "btf", "Birgit", nil},
+	{"", "btg", "btg", "Gagnoa Bété", nil},
+	{"", "bth", "bth", "Biatah Bidayuh", nil},
+	{"", "bti", "bti", "Burate", nil},
+	{"", "btj", "btj", "Bacanese Malay", nil},
+	{"", "btk", "btk", "Batak languages", nil},
+	{"", "btm", "btm", "Batak Mandailing", nil},
+	{"", "btn", "btn", "Ratagnon", nil},
+	{"", "bto", "bto", "Rinconada Bikol", nil},
+	{"", "btp", "btp", "Budibud", nil},
+	{"", "btq", "btq", "Batek", nil},
+	{"", "btr", "btr", "Baetora", nil},
+	{"", "bts", "bts", "Batak Simalungun", nil},
+	{"", "btt", "btt", "Bete-Bendi", nil},
+	{"", "btu", "btu", "Batu", nil},
+	{"", "btv", "btv", "Bateri", nil},
+	{"", "btw", "btw", "Butuanon", nil},
+	{"", "btx", "btx", "Batak Karo", nil},
+	{"", "bty", "bty", "Bobot", nil},
+	{"", "btz", "btz", "Batak Alas-Kluet", nil},
+	{"", "bua", "bua", "Buriat", nil},
+	{"", "bub", "bub", "Bua", nil},
+	{"", "buc", "buc", "Bushi", nil},
+	{"", "bud", "bud", "Ntcham", nil},
+	{"", "bue", "bue", "Beothuk", nil},
+	{"", "buf", "buf", "Bushoong", nil},
+	{"", "bug", "bug", "Buginese", nil},
+	{"", "buh", "buh", "Younuo Bunu", nil},
+	{"", "bui", "bui", "Bongili", nil},
+	{"", "buj", "buj", "Basa-Gurmana", nil},
+	{"", "buk", "buk", "Bugawac", nil},
+	{"bg", "bul", "bul", "Bulgarian", nil},
+	{"", "bum", "bum", "Bulu (Cameroon)", nil},
+	{"", "bun", "bun", "Sherbro", nil},
+	{"", "buo", "buo", "Terei", nil},
+	{"", "bup", "bup", "Busoa", nil},
+	{"", "buq", "buq", "Brem", nil},
+	{"", "bus", "bus", "Bokobaru", nil},
+	{"", "but", "but", "Bungain", nil},
+	{"", "buu", "buu", "Budu", nil},
+	{"", "buv", "buv", "Bun", nil},
+	{"", "buw", "buw", "Bubi", nil},
+	{"", "bux", "bux", "Boghom", nil},
+	{"", "buy", "buy", "Bullom So", nil},
+	{"", "buz", "buz", "Bukwen", nil},
+	{"", "bva", "bva", "Barein", nil},
+	{"", "bvb", "bvb", "Bube", nil},
+	{"", "bvc", "bvc", "Baelelea", nil},
+	{"", "bvd", "bvd", "Baeggu", nil},
+	{"", "bve", "bve", "Berau Malay", nil},
+	{"", "bvf", "bvf", "Boor", nil},
+	{"", "bvg", "bvg", "Bonkeng", nil},
+	{"", "bvh", "bvh", "Bure", nil},
+	{"", "bvi", "bvi", "Belanda Viri", nil},
+	{"", "bvj", "bvj", "Baan", nil},
+	{"", "bvk", "bvk", "Bukat", nil},
+	{"", "bvl", "bvl", "Bolivian Sign Language", nil},
+	{"", "bvm", "bvm", "Bamunka", nil},
+	{"", "bvn", "bvn", "Buna", nil},
+	{"", "bvo", "bvo", "Bolgo", nil},
+	{"", "bvp", "bvp", "Bumang", nil},
+	{"", "bvq", "bvq", "Birri", nil},
+	{"", "bvr", "bvr", "Burarra", nil},
+	{"", "bvt", "bvt", "Bati (Indonesia)", nil},
+	{"", "bvu", "bvu", "Bukit Malay", nil},
+	{"", "bvv", "bvv", "Baniva", nil},
+	{"", "bvw", "bvw", "Boga", nil},
+	{"", "bvx", "bvx", "Dibole", nil},
+	{"", "bvy", "bvy", "Baybayanon", nil},
+	{"", "bvz", "bvz", "Bauzi", nil},
+	{"", "bwa", "bwa", "Bwatoo", nil},
+	{"", "bwb", "bwb", "Namosi-Naitasiri-Serua", nil},
+	{"", "bwc", "bwc", "Bwile", nil},
+	{"", "bwd", "bwd", "Bwaidoka", nil},
+	{"", "bwe", "bwe", "Bwe Karen", nil},
+	{"", "bwf", "bwf", "Boselewa", nil},
+	{"", "bwg", "bwg", "Barwe", nil},
+	{"", "bwh", "bwh", "Bishuo", nil},
+	{"", "bwi", "bwi", "Baniwa", nil},
+	{"", "bwj", "bwj", "Láá Láá Bwamu", nil},
+	{"", "bwk", "bwk", "Bauwaki", nil},
+	{"", "bwl", "bwl", "Bwela", nil},
+	{"", "bwm", "bwm", "Biwat", nil},
+	{"", "bwn", "bwn", "Wunai Bunu", nil},
+	{"", "bwo", "bwo", "Boro (Ethiopia)", nil},
+	{"", "bwp", "bwp", "Mandobo Bawah", nil},
+	{"", "bwq", "bwq", "Southern Bobo Madaré", nil},
+	{"", "bwr", "bwr", "Bura-Pabir", nil},
+	{"", "bws", "bws", "Bomboma", nil},
+	{"", "bwt", "bwt", "Bafaw-Balong", nil},
+	{"", "bwu", "bwu", "Buli (Ghana)", nil},
+	{"", "bww", "bww", "Bwa", nil},
+	{"", "bwx", "bwx", "Bu-Nao Bunu", nil},
+	{"", "bwy", "bwy", "Cwi Bwamu", nil},
+	{"", "bwz", "bwz", "Bwisi", nil},
+	{"", "bxa", "bxa", "Tairaha", nil},
+	{"", "bxb", "bxb", "Belanda Bor", nil},
+	{"", "bxc", "bxc", "Molengue", nil},
+	{"", "bxd", "bxd", "Pela", nil},
+	{"", "bxe", "bxe", "Birale", nil},
+	{"", "bxf", "bxf", "Bilur", nil},
+	{"", "bxg", "bxg", "Bangala", nil},
+	{"", "bxh", "bxh", "Buhutu", nil},
+	{"", "bxi", "bxi", "Pirlatapa", nil},
+	{"", "bxj", "bxj", "Bayungu", nil},
+	{"", "bxk", "bxk", "Bukusu", nil},
+	{"", "bxl", "bxl", "Jalkunan", nil},
+	{"", "bxm", "bxm", "Mongolia Buriat", nil},
+	{"", "bxn", "bxn", "Burduna", nil},
+	{"", "bxo", "bxo", "Barikanchi", nil},
+	{"", "bxp", "bxp", "Bebil", nil},
+	{"", "bxq", "bxq", "Beele", nil},
+	{"", "bxr", "bxr", "Russia Buriat", nil},
+	{"", "bxs", "bxs", "Busam", nil},
+	{"", "bxu", "bxu", "China Buriat", nil},
+	{"", "bxv", "bxv", "Berakou", nil},
+	{"", "bxw", "bxw", "Bankagooma", nil},
+	{"", "bxz", "bxz", "Binahari", nil},
+	{"", "bya", "bya", "Batak", nil},
+	{"", "byb", "byb", "Bikya", nil},
+	{"", "byc", "byc", "Ubaghara", nil},
+	{"", "byd", "byd", "Benyadu'", nil},
+	{"", "bye", "bye", "Pouye", nil},
+	{"", "byf", "byf", "Bete", nil},
+	{"", "byg", "byg", "Baygo", nil},
+	{"", "byh", "byh", "Bhujel", nil},
+	{"", "byi", "byi", "Buyu", nil},
+	{"", "byj", "byj", "Bina (Nigeria)", nil},
+	{"", "byk", "byk", "Biao", nil},
+	{"", "byl", "byl", "Bayono", nil},
+	{"", "bym", "bym", "Bidjara", nil},
+	{"", "byn", "byn", "Blin; Bilin", nil},
+	{"", "byo", "byo", "Biyo", nil},
+	{"", "byp", "byp", "Bumaji", nil},
+	{"", "byq", "byq", "Basay", nil},
+	{"", "byr", "byr", "Baruya", nil},
+	{"", "bys", "bys", "Burak", nil},
+	{"", "byt", "byt", "Berti", nil},
+	{"", "byv", "byv", "Medumba", nil},
+	{"", "byw", "byw", "Belhariya", nil},
+	{"", "byx", "byx", "Qaqet", nil},
+	{"", "byz", "byz", "Banaro", nil},
+	{"", "bza", "bza", "Bandi", nil},
+	{"", "bzb", "bzb", "Andio", nil},
+	{"", "bzc", "bzc", "Southern Betsimisaraka Malagasy", nil},
+	{"", "bzd", "bzd", "Bribri", nil},
+	{"", "bze", "bze", "Jenaama Bozo", nil},
+	{"", "bzf", "bzf", "Boikin", nil},
+	{"", "bzg", "bzg", "Babuza", nil},
+	{"", "bzh", "bzh", "Mapos Buang", nil},
+	{"", "bzi", "bzi", "Bisu", nil},
+	{"", "bzj", "bzj", "Belize Kriol English", nil},
+	{"", "bzk", "bzk", "Nicaragua Creole English", nil},
+	{"", "bzl", "bzl", "Boano (Sulawesi)", nil},
+	{"", "bzm", "bzm", "Bolondo", nil},
+	{"", "bzn", "bzn", "Boano (Maluku)", nil},
+	{"", "bzo", "bzo", "Bozaba", nil},
+	{"", "bzp", "bzp", "Kemberano", nil},
+	{"", "bzq", "bzq", "Buli (Indonesia)", nil},
+	{"", "bzr", "bzr", "Biri", nil},
+	{"", "bzs", "bzs", "Brazilian Sign Language", nil},
+	{"", "bzt", "bzt", "Brithenig", nil},
+	{"", "bzu", "bzu", "Burmeso", nil},
+	{"", "bzv", "bzv", "Naami", nil},
+	{"", "bzw", "bzw", "Basa (Nigeria)", nil},
+	{"", "bzx", "bzx", "Kɛlɛngaxo Bozo", nil},
+	{"", "bzy", "bzy", "Obanliku", nil},
+	{"", "bzz", "bzz", "Evant", nil},
+	{"", "caa", "caa", "Chortí", nil},
+	{"", "cab", "cab", "Garifuna", nil},
+	{"", "cac", "cac", "Chuj", nil},
+	{"", "cad", "cad", "Caddo", nil},
+	{"", "cae", "cae", "Lehar", nil},
+	{"", "caf", "caf", "Southern Carrier", nil},
+	{"", "cag", "cag", "Nivaclé", nil},
+	{"", "cah", "cah", "Cahuarano", nil},
+	{"", "cai", "cai", "Central American Indian languages", nil},
+	{"", "caj", "caj", "Chané", nil},
+	{"", "cak", "cak", "Kaqchikel", nil},
+	{"", "cal", "cal", "Carolinian", nil},
+	{"", "cam", "cam", "Cemuhî", nil},
+	{"", "can", "can", "Chambri", nil},
+	{"", "cao", "cao", "Chácobo", nil},
+	{"", "cap", "cap", "Chipaya", nil},
+	{"", "caq", "caq", "Car Nicobarese", nil},
+	{"", "car", "car", "Galibi Carib", nil},
+	{"", "cas", "cas", "Tsimané", nil},
+	{"ca", "cat", "cat", "Catalan; Valencian", nil},
+	{"", "cau", "cau", "Caucasian languages", nil},
+	{"", "cav", "cav", "Cavineña", nil},
+	{"", "caw", "caw", "Callawalla", nil},
+	{"", "cax", "cax", "Chiquitano", nil},
+	{"", "cay", "cay", "Cayuga", nil},
+	{"", "caz", "caz", "Canichana", nil},
+	{"", "cbb", "cbb", "Cabiyarí", nil},
+	{"", "cbc", "cbc", "Carapana", nil},
+	{"", "cbd", "cbd", "Carijona", nil},
+	{"", "cbg", "cbg", "Chimila", nil},
+	{"", "cbi", "cbi", "Chachi", nil},
+	{"", "cbj", "cbj", "Ede Cabe", nil},
+	{"", "cbk", "cbk", "Chavacano", nil},
+	{"", "cbl", "cbl", "Bualkhaw Chin", nil},
+	{"", "cbn", "cbn", "Nyahkur", nil},
+	{"", "cbo", "cbo", "Izora", nil},
+	{"", "cbq", "cbq", "Tsucuba", nil},
+	{"", "cbr", "cbr", "Cashibo-Cacataibo", nil},
+	{"", "cbs", "cbs", "Cashinahua", nil},
+	{"", "cbt", "cbt", "Chayahuita", nil},
+	{"", "cbu", "cbu", "Candoshi-Shapra", nil},
+	{"", "cbv", "cbv", "Cacua", nil},
+	{"", "cbw", "cbw", "Kinabalian", nil},
+	{"", "cby", "cby", "Carabayo", nil},
+	{"", "ccc", "ccc", "Chamicuro", nil},
+	{"", "ccd", "ccd", "Cafundo Creole", nil},
+	{"", "cce", "cce", "Chopi", nil},
+	{"", "ccg", "ccg", "Samba Daka", nil},
+	{"", "cch", "cch", "Atsam", nil},
+	{"", "ccj", "ccj", "Kasanga", nil},
+	{"", "ccl", "ccl", "Cutchi-Swahili", nil},
+	{"", "ccm", "ccm", "Malaccan Creole Malay", nil},
+	{"", "cco", "cco", "Comaltepec Chinantec", nil},
+	{"", "ccp", "ccp", "Chakma", nil},
+	{"", "ccr", "ccr", "Cacaopera", nil},
+	{"", "cda", "cda", "Choni", nil},
+	{"", "cde", "cde", "Chenchu", nil},
+	{"", "cdf", "cdf", "Chiru", nil},
+	{"", "cdh", "cdh", "Chambeali", nil},
+	{"", "cdi", "cdi", "Chodri", nil},
+	{"", "cdj", "cdj", "Churahi", nil},
+	{"", "cdm", "cdm", "Chepang", nil},
+	{"", "cdn", "cdn", "Chaudangsi", nil},
+	{"", "cdo", "cdo", "Min Dong Chinese", nil},
+	{"", "cdr", "cdr", "Cinda-Regi-Tiyal", nil},
+	{"", "cds", "cds", "Chadian Sign Language", nil},
+	{"", "cdy", "cdy", "Chadong", nil},
+	{"", "cdz", "cdz", "Koda", nil},
+	{"", "cea", "cea", "Lower Chehalis", nil},
+	{"", "ceb", "ceb", "Cebuano", nil},
+	{"", "ceg", "ceg", "Chamacoco", nil},
+	{"", "cek", "cek", "Eastern Khumi Chin", nil},
+	{"", "cel", "cel", "Celtic languages", nil},
+	{"", "cen", "cen", "Cen", nil},
+	{"cs", "cze", "ces", "Czech", nil},
+	{"", "cet", "cet", "Centúúm", nil},
+	{"", "cey", "cey", "Ekai Chin", nil},
+	{"", "cfa", "cfa", "Dijim-Bwilim", nil},
+	{"", "cfd", "cfd", "Cara", nil},
+	{"", "cfg", "cfg", "Como Karim", nil},
+	{"", "cfm", "cfm", "Falam Chin", nil},
+	{"", "cga", "cga", "Changriwa", nil},
+	{"", "cgc", "cgc", "Kagayanen", nil},
+	{"", "cgg", "cgg", "Chiga", nil},
+	{"", "cgk", "cgk", "Chocangacakha", nil},
+	{"ch", "cha", "cha", "Chamorro", nil},
+	{"", "chb", "chb", "Chibcha", nil},
+	{"", "chc", "chc", "Catawba", nil},
+	{"", "chd", "chd", "Highland Oaxaca Chontal", nil},
+	{"ce", "che", "che", "Chechen", nil},
+	{"", "chf", "chf", "Tabasco Chontal", nil},
+	{"", "chg", "chg", "Chagatai", nil},
+	{"", "chh", "chh", "Chinook", nil},
+	{"", "chj", "chj", "Ojitlán Chinantec", nil},
+	{"", "chk", "chk", "Chuukese", nil},
+	{"", "chl", "chl", "Cahuilla", nil},
+	{"", "chm", "chm", "Mari", nil},
+	{"", "chn", "chn", "Chinook jargon", nil},
+	{"", "cho", "cho", "Choctaw", nil},
+	{"", "chp", "chp", "Chipewyan; Dene Suline", nil},
+	{"", "chq", "chq", "Quiotepec Chinantec", nil},
+	{"", "chr", "chr", "Cherokee", nil},
+	{"", "cht", "cht", "Cholón", nil},
+	{"cu", "chu", "chu", "Church Slavic; Old Slavonic; Church Slavonic; Old Bulgarian; Old Church Slavonic", nil},
+	{"cv", "chv", "chv", "Chuvash", nil},
+	{"", "chw", "chw", "Chuwabu", nil},
+	{"", "chx", "chx", "Chantyal", nil},
+	{"", "chy", "chy", "Cheyenne", nil},
+	{"", "chz", "chz", "Ozumacín Chinantec", nil},
+	{"", "cia", "cia", "Cia-Cia", nil},
+	{"", "cib", "cib", "Ci Gbe", nil},
+	{"", "cic", "cic", "Chickasaw", nil},
+	{"", "cid", "cid", "Chimariko", nil},
+	{"", "cie", "cie", "Cineni", nil},
+	{"", "cih", "cih", "Chinali", nil},
+	{"", "cik", "cik", "Chitkuli Kinnauri", nil},
+	{"", "cim", "cim", "Cimbrian", nil},
+	{"", "cin", "cin", "Cinta Larga", nil},
+	{"", "cip", "cip", "Chiapanec", nil},
+	{"", "cir", "cir", "Tiri", nil},
+	{"", "ciw", "ciw", "Chippewa", nil},
+	{"", "ciy", "ciy", "Chaima", nil},
+	{"", "cja", "cja", "Western Cham", nil},
+	{"", "cje", "cje", "Chru", nil},
+	{"", "cjh", "cjh", "Upper Chehalis", nil},
+	{"", "cji", "cji", "Chamalal", nil},
+	{"", "cjk", "cjk", "Chokwe", nil},
+	{"", "cjm", "cjm", "Eastern Cham", nil},
+	{"", "cjn", "cjn", "Chenapian", nil},
+	{"", "cjo", "cjo", "Ashéninka Pajonal", nil},
+	{"", "cjp", "cjp", "Cabécar", nil},
+	{"", "cjs", "cjs", "Shor", nil},
+	{"", "cjv", "cjv", "Chuave", nil},
+	{"", "cjy", "cjy", "Jinyu Chinese", nil},
+	{"", "ckb", "ckb", "Central Kurdish", nil},
+	{"", "ckh", "ckh", "Chak", nil},
+	{"", "ckl", "ckl", "Cibak", nil},
+	{"", "ckm", "ckm", "Chakavian", nil},
+	{"", "ckn", "ckn", "Kaang Chin", nil},
+	{"", "cko", "cko", "Anufo", nil},
+	{"", "ckq", "ckq", "Kajakse", nil},
+	{"", "ckr", "ckr", "Kairak", nil},
+	{"", "cks", "cks", "Tayo", nil},
+	{"", "ckt", "ckt", "Chukot", nil},
+	{"", "cku", "cku", "Koasati", nil},
+	{"", "ckv", "ckv", "Kavalan", nil},
+	{"", "ckx", "ckx", "Caka", nil},
+	{"", "cky", "cky", "Cakfem-Mushere", nil},
+	{"", "ckz", "ckz", "Cakchiquel-Quiché Mixed Language", nil},
+	{"", "cla", "cla", "Ron", nil},
+	{"", "clc", "clc", "Chilcotin", nil},
+	{"", "cld", "cld", "Chaldean Neo-Aramaic", nil},
+	{"", "cle", "cle", "Lealao Chinantec", nil},
+	{"", "clh", "clh", "Chilisso", nil},
+	{"", "cli", "cli", "Chakali", nil},
+	{"", "clj", "clj", "Laitu Chin", nil},
+	{"", "clk", "clk", "Idu-Mishmi", nil},
+	{"", "cll", "cll", "Chala", nil},
+	{"", "clm", "clm", "Clallam", nil},
+	{"", "clo", "clo", "Lowland Oaxaca Chontal", nil},
+	{"", "clt", "clt", "Lautu Chin", nil},
+	{"", "clu", "clu", "Caluyanun", nil},
+	{"", "clw", "clw", "Chulym", nil},
+	{"", "cly", "cly", "Eastern Highland Chatino", nil},
+	{"", "cma", "cma", "Maa", nil},
+	{"", "cmc", "cmc", "Chamic languages", nil},
+	{"", "cme", "cme", "Cerma", nil},
+	{"", "cmg", "cmg", "Classical Mongolian", nil},
+	{"", "cmi", "cmi", "Emberá-Chamí", nil},
+	{"", "cml", "cml", "Campalagian", nil},
+	{"", "cmm", "cmm", "Michigamea", nil},
+	{"", "cmn", "cmn", "Mandarin Chinese", nil},
+	{"", "cmo", "cmo", "Central Mnong", nil},
+	{"", "cmr", "cmr", "Mro-Khimi Chin", nil},
+	{"", "cms", "cms", "Messapic", nil},
+	{"", "cmt", "cmt", "Camtho", nil},
+	{"", "cna", "cna", "Changthang", nil},
+	{"", "cnb", "cnb", "Chinbon Chin", nil},
+	{"", "cnc", "cnc", "Côông", nil},
+	{"", "cng", "cng", "Northern Qiang", nil},
+	{"", "cnh", "cnh", "Hakha Chin", nil},
+	{"", "cni", "cni", "Asháninka", nil},
+	{"", "cnk", "cnk", "Khumi Chin", nil},
+	{"", "cnl", "cnl", "Lalana Chinantec", nil},
+	{"", "cno", "cno", "Con", nil},
+	{"", "cnp", "cnp", "Northern Ping Chinese", nil},
+	{"", "cnq", "cnq", "Chung", nil},
+	{"", "cnr", "cnr", "Montenegrin", nil},
+	{"", "cns", "cns", "Central Asmat", nil},
+	{"", "cnt", "cnt", "Tepetotutla Chinantec", nil},
+	{"", "cnu", "cnu", "Chenoua", nil},
+	{"", "cnw", "cnw", "Ngawn Chin", nil},
+	{"", "cnx", "cnx", "Middle Cornish", nil},
+	{"", "coa", "coa", "Cocos Islands Malay", nil},
+	{"", "cob", "cob", "Chicomuceltec", nil},
+	{"", "coc", "coc", "Cocopa", nil},
+	{"", "cod", "cod", "Cocama-Cocamilla", nil},
+	{"", "coe", "coe", "Koreguaje", nil},
+	{"", "cof", "cof", "Colorado", nil},
+	{"", "cog", "cog", "Chong", nil},
+	{"", "coh", "coh", "Chonyi-Dzihana-Kauma", nil},
+	{"", "coj", "coj", "Cochimi", nil},
+	{"", "cok", "cok", "Santa Teresa Cora", nil},
+	{"", "col", "col", "Columbia-Wenatchi", nil},
+	{"", "com", "com", "Comanche", nil},
+	{"", "con", "con", "Cofán", nil},
+	{"", "coo", "coo", "Comox", nil},
+	{"", "cop", "cop", "Coptic", nil},
+	{"", "coq", "coq", "Coquille", nil},
+	{"kw", "cor", "cor", "Cornish", nil},
+	{"co", "cos", "cos", "Corsican", nil},
+	{"", "cot", "cot", "Caquinte", nil},
+	{"", "cou", "cou", "Wamey", nil},
+	{"", "cov", "cov", "Cao Miao", nil},
+	{"", "cow", "cow", "Cowlitz", nil},
+	{"", "cox", "cox", "Nanti", nil},
+	{"", "coz", "coz", "Chochotec", nil},
+	{"", "cpa", "cpa", "Palantla Chinantec", nil},
+	{"", "cpb", "cpb", "Ucayali-Yurúa Ashéninka", nil},
+	{"", "cpc", "cpc", "Ajyíninka Apurucayali", nil},
+	{"", "cpe", "cpe", "Creoles and pidgins, English based", nil},
+	{"", "cpf", "cpf", "Creoles and pidgins, French-based", nil},
+	{"", "cpg", "cpg", "Cappadocian Greek", nil},
+	{"", "cpi", "cpi", "Chinese Pidgin English", nil},
+	{"", "cpn", "cpn", "Cherepon", nil},
+	{"", "cpo", "cpo", "Kpeego", nil},
+	{"", "cpp", "cpp", "Creoles and pidgins, Portuguese-based", nil},
+	{"", "cps", "cps", "Capiznon", nil},
+	{"", "cpu", "cpu", "Pichis Ashéninka", nil},
+	{"", "cpx", "cpx", "Pu-Xian Chinese", nil},
+	{"", "cpy", "cpy", "South Ucayali Ashéninka", nil},
+	{"", "cqd", "cqd", "Chuanqiandian Cluster Miao", nil},
+	{"", "cra", "cra", "Chara", nil},
+	{"", "crb", "crb", "Island Carib", nil},
+	{"", "crc", "crc", "Lonwolwol", nil},
+	{"", "crd", "crd", "Coeur d'Alene", nil},
+	{"cr", "cre", "cre", "Cree", nil},
+	{"", "crf", "crf", "Caramanta", nil},
+	{"", "crg", "crg", "Michif", nil},
+	{"", "crh", "crh", "Crimean Tatar; Crimean Turkish", nil},
+	{"", "cri", "cri", "Sãotomense", nil},
+	{"", "crj", "crj", "Southern East Cree", nil},
+	{"", "crk", "crk", "Plains Cree", nil},
+	{"", "crl", "crl", "Northern East Cree", nil},
+	{"", "crm", "crm", "Moose Cree", nil},
+	{"", "crn", "crn", "El Nayar Cora", nil},
+	{"", "cro", "cro", "Crow", nil},
+	{"", "crp", "crp", "Creoles and pidgins", nil},
+	{"", "crq", "crq", "Iyo'wujwa Chorote", nil},
+	{"", "crr", "crr", "Carolina Algonquian", nil},
+	{"", "crs", "crs", "Seselwa Creole French", nil},
+	{"", "crt", "crt", "Iyojwa'ja Chorote", nil},
+	{"", "crv", "crv", "Chaura", nil},
+	{"", "crw", "crw", "Chrau", nil},
+	{"", "crx", "crx", "Carrier", nil},
+	{"", "cry", "cry", "Cori", nil},
+	{"", "crz", "crz", "Cruzeño", nil},
+	{"", "csa", "csa", "Chiltepec Chinantec", nil},
+	{"", "csb", "csb", "Kashubian", nil},
+	{"", "csc", "csc", "Catalan Sign Language", nil},
+	{"", "csd", "csd", "Chiangmai Sign Language", nil},
+	{"", "cse", "cse", "Czech Sign Language", nil},
+	{"", "csf", "csf", "Cuba Sign Language", nil},
+	{"", "csg", "csg", "Chilean Sign Language", nil},
+	{"", "csh", "csh", "Asho Chin", nil},
+	{"", "csi", "csi", "Coast Miwok", nil},
+	{"", "csj", "csj", "Songlai Chin", nil},
+	{"", "csk", "csk", "Jola-Kasa", nil},
+	{"", "csl", "csl", "Chinese Sign Language", nil},
+	{"", "csm", "csm", "Central Sierra Miwok", nil},
+	{"", "csn", "csn", "Colombian Sign Language", nil},
+	{"", "cso", "cso", "Sochiapam Chinantec", nil},
+	{"", "csp", "csp", "Southern Ping Chinese", nil},
+	{"", "csq", "csq", "Croatia Sign Language", nil},
+	{"", "csr", "csr", "Costa Rican Sign Language", nil},
+	{"", "css", "css", "Southern Ohlone", nil},
+	{"", "cst", "cst", "Northern Ohlone", nil},
+	{"", "csv", "csv", "Sumtu Chin", nil},
+	{"", "csw", "csw", "Swampy Cree", nil},
+	{"", "csx", "csx", "Cambodian Sign Language", nil},
+	{"", "csy", "csy", "Siyin Chin", nil},
+	{"", "csz", "csz", "Coos", nil},
+	{"", "cta", "cta", "Tataltepec Chatino", nil},
+	{"", "ctc", "ctc", "Chetco", nil},
+	{"", "ctd", "ctd", "Tedim Chin", nil},
+	{"", "cte", "cte", "Tepinapa Chinantec", nil},
+	{"", "ctg", "ctg", "Chittagonian", nil},
+	{"", "cth", "cth", "Thaiphum Chin", nil},
+	{"", "ctl", "ctl", "Tlacoatzintepec Chinantec", nil},
+	{"", "ctm", "ctm", "Chitimacha", nil},
+	{"", "ctn", "ctn", "Chhintange", nil},
+	{"", "cto", "cto", "Emberá-Catío", nil},
+	{"", "ctp", "ctp", "Western Highland Chatino", nil},
+	{"", "cts", "cts", "Northern Catanduanes Bikol", nil},
+	{"", "ctt", "ctt", "Wayanad Chetti", nil},
+	{"", "ctu", "ctu", "Chol", nil},
+	{"", "cty", "cty", "Moundadan Chetty", nil},
+	{"", "ctz", "ctz", "Zacatepec Chatino", nil},
+	{"", "cua", "cua", "Cua", nil},
+	{"", "cub", "cub", "Cubeo", nil},
+	{"", "cuc", "cuc", "Usila Chinantec", nil},
+	{"", "cuh", "cuh", "Chuka", nil},
+	{"", "cui", "cui", "Cuiba", nil},
+	{"", "cuj", "cuj", "Mashco Piro", nil},
+	{"", "cuk", "cuk", "San Blas Kuna", nil},
+	{"", "cul", "cul", "Culina", nil},
+	{"", "cuo", "cuo", "Cumanagoto", nil},
+	{"", "cup", "cup", "Cupeño", nil},
+	{"", "cuq", "cuq", "Cun", nil},
+	{"", "cur", "cur", "Chhulung", nil},
+	{"", "cus", "cus", "Cushitic languages", nil},
+	{"", "cut", "cut", "Teutila Cuicatec", nil},
+	{"", "cuu", "cuu", "Tai Ya", nil},
+	{"", "cuv", "cuv", "Cuvok", nil},
+	{"", "cuw", "cuw", "Chukwa", nil},
+	{"", "cux", "cux", "Tepeuxila Cuicatec", nil},
+	{"", "cuy", "cuy", "Cuitlatec", nil},
+	{"", "cvg", "cvg", "Chug", nil},
+	{"", "cvn", "cvn", "Valle Nacional Chinantec", nil},
+	{"", "cwa", "cwa", "Kabwa", nil},
+	{"", "cwb", "cwb", "Maindo", nil},
+	{"", "cwd", "cwd", "Woods Cree", nil},
+	{"", "cwe", "cwe", "Kwere", nil},
+	{"", "cwg", "cwg", "Chewong", nil},
+	{"", "cwt", "cwt", "Kuwaataay", nil},
+	{"", "cya", "cya", "Nopala Chatino", nil},
+	{"", "cyb", "cyb", "Cayubaba", nil},
+	{"cy", "wel", "cym", "Welsh", nil},
+	{"", "cyo", "cyo", "Cuyonon", nil},
+	{"", "czh", "czh", "Huizhou Chinese", nil},
+	{"", "czk", "czk", "Knaanic", nil},
+	{"", "czn", "czn", "Zenzontepec Chatino", nil},
+	{"", "czo", "czo", "Min Zhong Chinese", nil},
+	{"", "czt", "czt", "Zotung Chin", nil},
+	{"", "daa", "daa", "Dangaléat", nil},
+	{"", "dac", "dac", "Dambi", nil},
+	{"", "dad", "dad", "Marik", nil},
+	{"", "dae", "dae", "Duupa", nil},
+	{"", "dag", "dag", "Dagbani", nil},
+	{"", "dah", "dah", "Gwahatike", nil},
+	{"", "dai", "dai", "Day", nil},
+	{"", "daj", "daj", "Dar Fur Daju", nil},
+	{"", "dak", "dak", "Dakota", nil},
+	{"", "dal", "dal", "Dahalo", nil},
+	{"", "dam", "dam", "Damakawa", nil},
+	{"da", "dan", "dan", "Danish", nil},
+	{"", "dao", "dao", "Daai Chin", nil},
+	{"", "daq", "daq", "Dandami Maria", nil},
+	{"", "dar", "dar", "Dargwa", nil},
+	{"", "das", "das", "Daho-Doo", nil},
+	{"", "dau", "dau", "Dar Sila Daju", nil},
+	{"", "dav", "dav", "Taita", nil},
+	{"", "daw", "daw", "Davawenyo", nil},
+	{"", "dax", "dax", "Dayi", nil},
+	{"", "day", "day", "Land Dayak languages", nil},
+	{"", "daz", "daz", "Dao", nil},
+	{"", "dba", "dba", "Bangime", nil},
+	{"", "dbb", "dbb", "Deno", nil},
+	{"", "dbd", "dbd", "Dadiya", nil},
+	{"", "dbe", "dbe", "Dabe", nil},
+	{"", "dbf", "dbf", "Edopi", nil},
+	{"", "dbg", "dbg", "Dogul Dom Dogon", nil},
+	{"", "dbi", "dbi", "Doka", nil},
+	{"", "dbj", "dbj", "Ida'an", nil},
+	{"", "dbl", "dbl", "Dyirbal", nil},
+	{"", "dbm", "dbm", "Duguri", nil},
+	{"", "dbn", "dbn", "Duriankere", nil},
+	{"", "dbo", "dbo", "Dulbu", nil},
+	{"", "dbp", "dbp", "Duwai", nil},
+	{"", "dbq", "dbq", "Daba", nil},
+	{"", "dbr", "dbr", "Dabarre", nil},
+	{"", "dbt", "dbt", "Ben Tey Dogon", nil},
+	{"", "dbu", "dbu", "Bondum Dom Dogon", nil},
+	{"", "dbv", "dbv", "Dungu", nil},
+	{"", "dbw", "dbw", "Bankan Tey Dogon", nil},
+	{"", "dby", "dby", "Dibiyaso", nil},
+	{"", "dcc", "dcc", "Deccan", nil},
+	{"", "dcr", "dcr", "Negerhollands", nil},
+	{"", "dda", "dda", "Dadi Dadi", nil},
+	{"", "ddd", "ddd", "Dongotono", nil},
+	{"", "dde", "dde", "Doondo", nil},
+	{"", "ddg", "ddg", "Fataluku", nil},
+	{"", "ddi", "ddi", "West Goodenough", nil},
+	{"", "ddj", "ddj", "Jaru", nil},
+	{"", "ddn", "ddn", "Dendi (Benin)", nil},
+	{"", "ddo", "ddo", "Dido", nil},
+	{"", "ddr", "ddr", "Dhudhuroa", nil},
+	{"", "dds", "dds", "Donno So Dogon", nil},
+	{"", "ddw", "ddw", "Dawera-Daweloor", nil},
+	{"", "dec", "dec", "Dagik", nil},
+	{"", "ded", "ded", "Dedua", nil},
+	{"", "dee", "dee", "Dewoin", nil},
+	{"", "def", "def", "Dezfuli", nil},
+	{"", "deg", "deg", "Degema", nil},
+	{"", "deh", "deh", "Dehwari", nil},
+	{"", "dei", "dei", "Demisa", nil},
+	{"", "dek", "dek", "Dek", nil},
+	{"", "del", "del", "Delaware", nil},
+	{"", "dem", "dem", "Dem", nil},
+	{"", "den", "den", "Slave (Athapascan)", nil},
+	{"", "dep", "dep", "Pidgin Delaware", nil},
+	{"", "deq", "deq", "Dendi (Central African Republic)", nil},
+	{"", "der", "der", "Deori", nil},
+	{"", "des", "des", "Desano", nil},
+	{"de", "ger", "deu", "German", nil},
+	{"", "dev", "dev", "Domung", nil},
+	{"", "dez", "dez", "Dengese", nil},
+	{"", "dga", "dga", "Southern Dagaare", nil},
+	{"", "dgb", "dgb", "Bunoge Dogon", nil},
+	{"", "dgc", "dgc", "Casiguran Dumagat Agta", nil},
+	{"", "dgd", "dgd", "Dagaari Dioula", nil},
+	{"", "dge", "dge", "Degenan", nil},
+	{"", "dgg", "dgg", "Doga", nil},
+	{"", "dgh", "dgh", "Dghwede", nil},
+	{"", "dgi", "dgi", "Northern Dagara", nil},
+	{"", "dgk", "dgk", "Dagba", nil},
+	{"", "dgl", "dgl", "Andaandi", nil},
+	{"", "dgn", "dgn", "Dagoman", nil},
+	{"", "dgo", "dgo", "Dogri (individual language)", nil},
+	{"", "dgr", "dgr", "Dogrib", nil},
+	{"", "dgs", "dgs", "Dogoso", nil},
+	{"", "dgt", "dgt", "Ndra'ngith", nil},
+	{"", "dgw", "dgw", "Daungwurrung", nil},
+	{"", "dgx", "dgx", "Doghoro", nil},
+	{"", "dgz", "dgz", "Daga", nil},
+	{"", "dhd", "dhd", "Dhundari", nil},
+	{"", "dhg", "dhg", "Dhangu-Djangu", nil},
+	{"", "dhi", "dhi", "Dhimal", nil},
+	{"", "dhl", "dhl", "Dhalandji", nil},
+	{"", "dhm", "dhm", "Zemba", nil},
+	{"", "dhn", "dhn", "Dhanki", nil},
+	{"", "dho", "dho", "Dhodia", nil},
+	{"", "dhr", "dhr", "Dhargari", nil},
+	{"", "dhs", "dhs", "Dhaiso", nil},
+	{"", "dhu", "dhu", "Dhurga", nil},
+	{"", "dhv", "dhv", "Dehu", nil},
+	{"", "dhw", "dhw", "Dhanwar (Nepal)", nil},
+	{"", "dhx", "dhx", "Dhungaloo", nil},
+	{"", "dia", "dia", "Dia", nil},
+	{"", "dib", "dib", "South Central Dinka", nil},
+	{"", "dic", "dic", "Lakota Dida", nil},
+	{"", "did", "did", "Didinga", nil},
+	{"", "dif", "dif", "Dieri", nil},
+	{"", "dig", "dig", "Digo", nil},
+	{"", "dih", "dih", "Kumiai", nil},
+	{"", "dii", "dii", "Dimbong", nil},
+	{"", "dij", "dij", "Dai", nil},
+	{"", "dik", "dik", "Southwestern Dinka", nil},
+	{"", "dil", "dil", "Dilling", nil},
+	{"", "dim", "dim", "Dime", nil},
+	{"", "din", "din", "Dinka", nil},
+	{"", "dio", "dio", "Dibo", nil},
+	{"", "dip", "dip", "Northeastern Dinka", nil},
+	{"", "diq", "diq", "Dimli (individual language)", nil},
+	{"", "dir", "dir", "Dirim", nil},
+	{"", "dis", "dis", "Dimasa", nil},
+	{"", "diu", "diu", "Diriku", nil},
+	{"dv", "div", "div", "Divehi; Dhivehi; Maldivian", nil},
+	{"", "diw", "diw", "Northwestern Dinka", nil},
+	{"", "dix", "dix", "Dixon Reef", nil},
+	{"", "diy", "diy", "Diuwe", nil},
+	{"", "diz", "diz", "Ding", nil},
+	{"", "dja", "dja", "Djadjawurrung", nil},
+	{"", "djb", "djb", "Djinba", nil},
+	{"", "djc", "djc", "Dar Daju Daju", nil},
+	{"", "djd", "djd", "Djamindjung", nil},
+	{"", "dje", "dje", "Zarma", nil},
+	{"", "djf", "djf", "Djangun", nil},
+	{"", "dji", "dji", "Djinang", nil},
+	{"", "djj", "djj", "Djeebbana", nil},
+	{"", "djk", "djk", "Eastern Maroon Creole", nil},
+	{"", "djm", "djm", "Jamsay Dogon", nil},
+	{"", "djn", "djn", "Jawoyn", nil},
+	{"", "djo", "djo", "Jangkang", nil},
+	{"", "djr", "djr", "Djambarrpuyngu", nil},
+	{"", "dju", "dju", "Kapriman", nil},
+	{"", "djw", "djw", "Djawi", nil},
+	{"", "dka", "dka", "Dakpakha", nil},
+	{"", "dkg", "dkg", "Kadung", nil},
+	{"", "dkk", "dkk", "Dakka", nil},
+	{"", "dkr", "dkr", "Kuijau", nil},
+	{"", "dks", "dks", "Southeastern Dinka", nil},
+	{"", "dkx", "dkx", "Mazagway", nil},
+	{"", "dlg", "dlg", "Dolgan", nil},
+	{"", "dlk", "dlk", "Dahalik", nil},
+	{"", "dlm", "dlm", "Dalmatian", nil},
+	{"", "dln", "dln", "Darlong", nil},
+	{"", "dma", "dma", "Duma", nil},
+	{"", "dmb", "dmb", "Mombo Dogon", nil},
+	{"", "dmc", "dmc", "Gavak", nil},
+	{"", "dmd", "dmd", "Madhi Madhi", nil},
+	{"", "dme", "dme", "Dugwor", nil},
+	{"", "dmf", "dmf", "Medefaidrin", nil},
+	{"", "dmg", "dmg", "Upper Kinabatangan", nil},
+	{"", "dmk", "dmk", "Domaaki", nil},
+	{"", "dml", "dml", "Dameli", nil},
+	{"", "dmm", "dmm", "Dama", nil},
+	{"", "dmo", "dmo", "Kemedzung", nil},
+	{"", "dmr", "dmr", "East Damar", nil},
+	{"", "dms", "dms", "Dampelas", nil},
+	{"", "dmu", "dmu", "Dubu", nil},
+	{"", "dmv", "dmv", "Dumpas", nil},
+	{"", "dmw", "dmw", "Mudburra", nil},
+	{"", "dmx", "dmx", "Dema", nil},
+	{"", "dmy", "dmy", "Demta", nil},
+	{"", "dna", "dna", "Upper Grand Valley Dani", nil},
+	{"", "dnd", "dnd", "Daonda", nil},
+	{"", "dne", "dne", "Ndendeule", nil},
+	{"", "dng", "dng", "Dungan", nil},
+	{"", "dni", "dni", "Lower Grand Valley Dani", nil},
+	{"", "dnj", "dnj", "Dan", nil},
+	{"", "dnk", "dnk", "Dengka", nil},
+	{"", "dnn", "dnn", "Dzùùngoo", nil},
+	{"", "dno", "dno", "Ndrulo", nil},
+	{"", "dnr", "dnr", "Danaru", nil},
+	{"", "dnt", "dnt", "Mid Grand Valley Dani", nil},
+	{"", "dnu", "dnu", "Danau", nil},
+	{"", "dnv", "dnv", "Danu", nil},
+	{"", "dnw", "dnw", "Western Dani", nil},
+	{"", "dny", "dny", "Dení", nil},
+	{"", "doa", "doa", "Dom", nil},
+	{"", "dob", "dob", "Dobu", nil},
+	{"", "doc", "doc", "Northern Dong", nil},
+	{"", "doe", "doe", "Doe", nil},
+	{"", "dof", "dof", "Domu", nil},
+	{"", "doh", "doh", "Dong", nil},
+	{"", "doi", "doi", "Dogri", nil},
+	{"", "dok", "dok", "Dondo", nil},
+	{"", "dol", "dol", "Doso", nil},
+	{"", "don", "don", "Toura (Papua New Guinea)", nil},
+	{"", "doo", "doo", "Dongo", nil},
+	{"", "dop", "dop", "Lukpa", nil},
+	{"", "doq", "doq", "Dominican Sign Language", nil},
+	{"", "dor", "dor", "Dori'o", nil},
+	{"", "dos", "dos", "Dogosé", nil},
+	{"", "dot", "dot", "Dass", nil},
+	{"", "dov", "dov", "Dombe", nil},
+	{"", "dow", "dow", "Doyayo", nil},
+	{"", "dox", "dox", "Bussa", nil},
+	{"", "doy", "doy", "Dompo", nil},
+	{"", "doz", "doz", "Dorze", nil},
+	{"", "dpp", "dpp", "Papar", nil},
+	{"", "dra", "dra", "Dravidian languages", nil},
+	{"", "drb", "drb", "Dair", nil},
+	{"", "drc", "drc", "Minderico", nil},
+	{"", "drd", "drd", "Darmiya", nil},
+	{"", "dre", "dre", "Dolpo", nil},
+	{"", "drg", "drg", "Rungus", nil},
+	{"", "dri", "dri", "C'Lela", nil},
+	{"", "drl", "drl", "Paakantyi", nil},
+	{"", "drn", "drn", "West Damar", nil},
+	{"", "dro", "dro", "Daro-Matu Melanau", nil},
+	{"", "drq", "drq", "Dura", nil},
+	{"", "drs", "drs", "Gedeo", nil},
+	{"", "drt", "drt", "Drents", nil},
+	{"", "dru", "dru", "Rukai", nil},
+	{"", "dry", "dry", "Darai", nil},
+	{"", "dsb", "dsb", "Lower Sorbian", nil},
+	{"", "dse", "dse", "Dutch Sign Language", nil},
+	{"", "dsh", "dsh", "Daasanach", nil},
+	{"", "dsi", "dsi", "Disa", nil},
+	{"", "dsl", "dsl", "Danish Sign Language", nil},
+	{"", "dsn", "dsn", "Dusner", nil},
+	{"", "dso", "dso", "Desiya", nil},
+	{"", "dsq", "dsq", "Tadaksahak", nil},
+	{"", "dsz", "dsz", "Mardin Sign Language", nil},
+	{"", "dta", "dta", "Daur", nil},
+	{"", "dtb", "dtb", "Labuk-Kinabatangan Kadazan", nil},
+	{"", "dtd", "dtd", "Ditidaht", nil},
+	{"", "dth", "dth", "Adithinngithigh", nil},
+	{"", "dti", "dti", "Ana Tinga Dogon", nil},
+	{"", "dtk", "dtk", "Tene Kan Dogon", nil},
+	{"", "dtm", "dtm", "Tomo Kan Dogon", nil},
+	{"", "dtn", "dtn", "Daatsʼíin", nil},
+	{"", "dto", "dto", "Tommo So Dogon", nil},
+	{"", "dtp", "dtp", "Kadazan Dusun", nil},
+	{"", "dtr", "dtr", "Lotud", nil},
+	{"", "dts", "dts", "Toro So Dogon", nil},
+	{"", "dtt", "dtt", "Toro Tegu Dogon", nil},
+	{"", "dtu", "dtu", "Tebul Ure Dogon", nil},
+	{"", "dty", "dty", "Dotyali", nil},
+	{"", "dua", "dua", "Duala", nil},
+	{"", "dub", "dub", "Dubli", nil},
+	{"", "duc", "duc", "Duna", nil},
+	{"", "due", "due", "Umiray Dumaget Agta", nil},
+	{"", "duf", "duf", "Dumbea", nil},
+	{"", "dug", "dug", "Duruma", nil},
+	{"", "duh", "duh", "Dungra Bhil", nil},
+	{"", "dui", "dui", "Dumun", nil},
+	{"", "duk", "duk", "Uyajitaya", nil},
+	{"", "dul", "dul", "Alabat Island Agta", nil},
+	{"", "dum", "dum", "Dutch, Middle (ca. 1050-1350)", nil},
+	{"", "dun", "dun", "Dusun Deyah", nil},
+	{"", "duo", "duo", "Dupaninan Agta", nil},
+	{"", "dup", "dup", "Duano", nil},
+	{"", "duq", "duq", "Dusun Malang", nil},
+	{"", "dur", "dur", "Dii", nil},
+	{"", "dus", "dus", "Dumi", nil},
+	{"", "duu", "duu", "Drung", nil},
+	{"", "duv", "duv", "Duvle", nil},
+	{"", "duw", "duw", "Dusun Witu", nil},
+	{"", "dux", "dux", "Duungooma", nil},
+	{"", "duy", "duy", "Dicamay Agta", nil},
+	{"", "duz", "duz", "Duli-Gey", nil},
+	{"", "dva", "dva", "Duau", nil},
+	{"", "dwa", "dwa", "Diri", nil},
+	{"", "dwk", "dwk", "Dawik Kui", nil},
+	{"", "dwr", "dwr", "Dawro", nil},
+	{"", "dws", "dws", "Dutton World Speedwords", nil},
+	{"", "dwu", "dwu", "Dhuwal", nil},
+	{"", "dww", "dww", "Dawawa", nil},
+	{"", "dwy", "dwy", "Dhuwaya", nil},
+	{"", "dwz", "dwz", "Dewas Rai", nil},
+	{"", "dya", "dya", "Dyan", nil},
+	{"", "dyb", "dyb", "Dyaberdyaber", nil},
+	{"", "dyd", "dyd", "Dyugun", nil},
+	{"", "dyg", "dyg", "Villa Viciosa Agta", nil},
+	{"", "dyi", "dyi", "Djimini Senoufo", nil},
+	{"", "dym", "dym", "Yanda Dom Dogon", nil},
+	{"", "dyn", "dyn", "Dyangadi", nil},
+	{"", "dyo", "dyo", "Jola-Fonyi", nil},
+	{"", "dyu", "dyu", "Dyula", nil},
+	{"", "dyy", "dyy", "Djabugay", nil},
+	{"", "dza", "dza", "Tunzu", nil},
+	{"", "dze", "dze", "Djiwarli", nil},
+	{"", "dzg", "dzg", "Dazaga", nil},
+	{"", "dzl", "dzl", "Dzalakha", nil},
+	{"", "dzn", "dzn", "Dzando", nil},
+	{"dz", "dzo", "dzo", "Dzongkha", nil},
+	{"", "eaa", "eaa", "Karenggapa", nil},
+	{"", "ebc", "ebc", "Beginci", nil},
+	{"", "ebg", "ebg", "Ebughu", nil},
+	{"", "ebk", "ebk", "Eastern Bontok", nil},
+	{"", "ebo", "ebo", "Teke-Ebo", nil},
+	{"", "ebr", "ebr", "Ebrié", nil},
+	{"", "ebu", "ebu", "Embu", nil},
+	{"", "ecr", "ecr", "Eteocretan", nil},
+	{"", "ecs", "ecs", "Ecuadorian Sign Language", nil},
+	{"", "ecy", "ecy", "Eteocypriot", nil},
+	{"", "eee", "eee", "E", nil},
+	{"", "efa", "efa", "Efai", nil},
+	{"", "efe", "efe", "Efe", nil},
+	{"", "efi", "efi", "Efik", nil},
+	{"", "ega", "ega", "Ega", nil},
+	{"", "egl", "egl", "Emilian", nil},
+	{"", "egm", "egm", "Benamanga", nil},
+	{"", "ego", "ego", "Eggon", nil},
+	{"", "egy", "egy", "Egyptian (Ancient)", nil},
+	{"", "ehs", "ehs", "Miyakubo Sign Language", nil},
+	{"", "ehu", "ehu", "Ehueun", nil},
+	{"", "eip", "eip", "Eipomek", nil},
+	{"", "eit", "eit", "Eitiep", nil},
+	{"", "eiv", "eiv", "Askopan", nil},
+	{"", "eja", "eja", "Ejamat", nil},
+	{"", "eka", "eka", "Ekajuk", nil},
+	{"", "eke", "eke", "Ekit", nil},
+	{"", "ekg", "ekg", "Ekari", nil},
+	{"", "eki", "eki", "Eki", nil},
+	{"", "ekk", "ekk", "Standard Estonian", nil},
+	{"", "ekl", "ekl", "Kol (Bangladesh)", nil},
+	{"", "ekm", "ekm", "Elip", nil},
+	{"", "eko", "eko", "Koti", nil},
+	{"", "ekp", "ekp", "Ekpeye", nil},
+	{"", "ekr", "ekr", "Yace", nil},
+	{"", "eky", "eky", "Eastern Kayah", nil},
+	{"", "ele", "ele", "Elepi", nil},
+	{"", "elh", "elh", "El Hugeirat", nil},
+	{"", "eli", "eli", "Nding", nil},
+	{"", "elk", "elk", "Elkei", nil},
+	{"el", "gre", "ell", "Greek, Modern (1453-)", nil},
+	{"", "elm", "elm", "Eleme", nil},
+	{"", "elo", "elo", "El Molo", nil},
+	{"", "elu", "elu", "Elu", nil},
+	{"", "elx", "elx", "Elamite", nil},
+	{"", "ema", "ema", "Emai-Iuleha-Ora", nil},
+	{"", "emb", "emb", "Embaloh", nil},
+	{"", "eme", "eme", "Emerillon", nil},
+	{"", "emg", "emg", "Eastern Meohang", nil},
+	{"", "emi", "emi", "Mussau-Emira", nil},
+	{"", "emk", "emk", "Eastern Maninkakan", nil},
+	{"", "emm", "emm", "Mamulique", nil},
+	{"", "emn", "emn", "Eman", nil},
+	{"", "emp", "emp", "Northern Emberá", nil},
+	{"", "emq", "emq", "Eastern Minyag", nil},
+	{"", "ems", "ems", "Pacific Gulf Yupik", nil},
+	{"", "emu", "emu", "Eastern Muria", nil},
+	{"", "emw", "emw", "Emplawas", nil},
+	{"", "emx", "emx", "Erromintxela", nil},
+	{"", "emy", "emy", "Epigraphic Mayan", nil},
+	{"", "emz", "emz", "Mbessa", nil},
+	{"", "ena", "ena", "Apali", nil},
+	{"", "enb", "enb", "Markweeta", nil},
+	{"", "enc", "enc", "En", nil},
+	{"", "end", "end", "Ende", nil},
+	{"", "enf", "enf", "Forest Enets", nil},
+	{"en", "eng", "eng", "English", nil},
+	{"", "enh", "enh", "Tundra Enets", nil},
+	{"", "enl", "enl", "Enlhet", nil},
+	{"", "enm", "enm", "English, Middle (1100-1500)", nil},
+	{"", "enn", "enn", "Engenni", nil},
+	{"", "eno", "eno", "Enggano", nil},
+	{"", "enq", "enq", "Enga", nil},
+	{"", "enr", "enr", "Emumu", nil},
+	{"", "enu", "enu", "Enu", nil},
+	{"", "env", "env", "Enwan (Edo State)", nil},
+	{"", "enw", "enw", "Enwan (Akwa Ibom State)", nil},
+	{"", "enx", "enx", "Enxet", nil},
+	{"", "eot", "eot", "Beti (Côte d'Ivoire)", nil},
+	{"", "epi", "epi", "Epie", nil},
+	{"eo", "epo", "epo", "Esperanto", nil},
+	{"", "era", "era", "Eravallan", nil},
+	{"", "erg", "erg", "Sie", nil},
+	{"", "erh", "erh", "Eruwa", nil},
+	{"", "eri", "eri", "Ogea", nil},
+	{"", "erk", "erk", "South Efate", nil},
+	{"", "ero", "ero", "Horpa", nil},
+	{"", "err", "err", "Erre", nil},
+	{"", "ers", "ers", "Ersu", nil},
+	{"", "ert", "ert", "Eritai", nil},
+	{"", "erw", "erw", "Erokwanas", nil},
+	{"", "ese", "ese", "Ese Ejja", nil},
+	{"", "esg", "esg", "Aheri Gondi", nil},
+	{"", "esh", "esh", "Eshtehardi", nil},
+	{"", "esi", "esi", "North Alaskan Inupiatun", nil},
+	{"", "esk", "esk", "Northwest Alaska Inupiatun", nil},
+	{"", "esl", "esl", "Egypt Sign Language", nil},
+	{"", "esm", "esm", "Esuma", nil},
+	{"", "esn", "esn", "Salvadoran Sign Language", nil},
+	{"", "eso", "eso", "Estonian Sign Language", nil},
+	{"", "esq", "esq", "Esselen", nil},
+	{"", "ess", "ess", "Central Siberian Yupik", nil},
+	{"et", "est", "est", "Estonian", nil},
+	{"", "esu", "esu", "Central Yupik", nil},
+	{"", "esy", "esy", "Eskayan", nil},
+	{"", "etb", "etb", "Etebi", nil},
+	{"", "etc", "etc", "Etchemin", nil},
+	{"", "eth", "eth", "Ethiopian Sign Language", nil},
+	{"", "etn", "etn", "Eton (Vanuatu)", nil},
+	{"", "eto", "eto", "Eton (Cameroon)", nil},
+	{"", "etr", "etr", "Edolo", nil},
+	{"", "ets", "ets", "Yekhee", nil},
+	{"", "ett", "ett", "Etruscan", nil},
+	{"", "etu", "etu", "Ejagham", nil},
+	{"", "etx", "etx", "Eten", nil},
+	{"", "etz", "etz", "Semimi", nil},
+	{"eu", "baq", "eus", "Basque", nil},
+	{"", "eve", "eve", "Even", nil},
+	{"", "evh", "evh", "Uvbie", nil},
+	{"", "evn", "evn", "Evenki", nil},
+	{"ee", "ewe", "ewe", "Ewe", nil},
+	{"", "ewo", "ewo", "Ewondo", nil},
+	{"", "ext", "ext", "Extremaduran", nil},
+	{"", "eya", "eya", "Eyak", nil},
+	{"", "eyo", "eyo", "Keiyo", nil},
+	{"", "eza", "eza", "Ezaa", nil},
+	{"", "eze", "eze", "Uzekwe", nil},
+	{"", "faa", "faa", "Fasu", nil},
+	{"", "fab", "fab", "Fa d'Ambu", nil},
+	{"", "fad", "fad", "Wagi", nil},
+	{"", "faf", "faf", "Fagani", nil},
+	{"", "fag", "fag", "Finongan", nil},
+	{"", "fah", "fah", "Baissa Fali", nil},
+	{"", "fai", "fai", "Faiwol", nil},
+	{"", "faj", "faj", "Faita", nil},
+	{"", "fak", "fak", "Fang (Cameroon)", nil},
+	{"", "fal", "fal", "South Fali", nil},
+	{"", "fam", "fam", "Fam", nil},
+	{"", "fan", "fan", "Fang", nil},
+	{"fo", "fao", "fao", "Faroese", nil},
+	{"", "fap", "fap", "Paloor", nil},
+	{"", "far", "far", "Fataleka", nil},
+	{"fa", "per", "fas", "Persian", nil},
+	{"", "fat", "fat", "Fanti", nil},
+	{"", "fau", "fau", "Fayu", nil},
+	{"", "fax", "fax", "Fala", nil},
+	{"", "fay", "fay", "Southwestern Fars", nil},
+	{"", "faz", "faz", "Northwestern Fars", nil},
+	{"", "fbl", "fbl", "West Albay Bikol", nil},
+	{"", "fcs", "fcs", "Quebec Sign Language", nil},
+	{"", "fer", "fer", "Feroge", nil},
+	{"", "ffi", "ffi", "Foia Foia", nil},
+	{"", "ffm", "ffm", "Maasina Fulfulde", nil},
+	{"", "fgr", "fgr", "Fongoro", nil},
+	{"", "fia", "fia", "Nobiin", nil},
+	{"", "fie", "fie", "Fyer", nil},
+	{"", "fif", "fif", "Faifi", nil},
+	{"fj", "fij", "fij", "Fijian", nil},
+	{"", "fil", "fil", "Filipino; Pilipino", nil},
+	{"fi", "fin", "fin", "Finnish", nil},
+	{"", "fip", "fip", "Fipa", nil},
+	{"", "fir", "fir", "Firan", nil},
+	{"", "fit", "fit", "Tornedalen Finnish", nil},
+	{"", "fiu", "fiu", "Finno-Ugrian languages", nil},
+	{"", "fiw", "fiw", "Fiwaga", nil},
+	{"", "fkk", "fkk", "Kirya-Konzəl", nil},
+	{"", "fkv", "fkv", "Kven Finnish", nil},
+	{"", "fla", "fla", "Kalispel-Pend d'Oreille", nil},
+	{"", "flh", "flh", "Foau", nil},
+	{"", "fli", "fli", "Fali", nil},
+	{"", "fll", "fll", "North Fali", nil},
+	{"", "fln", "fln", "Flinders Island", nil},
+	{"", "flr", "flr", "Fuliiru", nil},
+	{"", "fly", "fly", "Flaaitaal", nil},
+	{"", "fmp", "fmp", "Fe'fe'", nil},
+	{"", "fmu", "fmu", "Far Western Muria", nil},
+	{"", "fnb", "fnb", "Fanbak", nil},
+	{"", "fng", "fng", "Fanagalo", nil},
+	{"", "fni", "fni", "Fania", nil},
+	{"", "fod", "fod", "Foodo", nil},
+	{"", "foi", "foi", "Foi", nil},
+	{"", "fom", "fom", "Foma", nil},
+	{"", "fon", "fon", "Fon", nil},
+	{"", "for", "for", "Fore", nil},
+	{"", "fos", "fos", "Siraya", nil},
+	{"", "fpe", "fpe", "Fernando Po Creole English", nil},
+	{"", "fqs", "fqs", "Fas", nil},
+	{"fr", "fre", "fra", "French", nil},
+	{"", "frc", "frc", "Cajun French", nil},
+	{"", "frd", "frd", "Fordata", nil},
+	{"", "frk", "frk", "Frankish", nil},
+	{"", "frm", "frm", "French, Middle (ca. 1400-1600)", nil},
+	{"", "fro", "fro", "French, Old (842-ca. 1400)", nil},
+	{"", "frp", "frp", "Arpitan", nil},
+	{"", "frq", "frq", "Forak", nil},
+	{"", "frr", "frr", "Northern Frisian", nil},
+	{"", "frs", "frs", "Eastern Frisian", nil},
+	{"", "frt", "frt", "Fortsenal", nil},
+	{"fy", "fry", "fry", "Western Frisian", nil},
+	{"", "fse", "fse", "Finnish Sign Language", nil},
+	{"", "fsl", "fsl", "French Sign Language", nil},
+	{"", "fss", "fss", "Finland-Swedish Sign Language", nil},
+	{"", "fub", "fub", "Adamawa Fulfulde", nil},
+	{"", "fuc", "fuc", "Pulaar", nil},
+	{"", "fud", "fud", "East Futuna", nil},
+	{"", "fue", "fue", "Borgu Fulfulde", nil},
+	{"", "fuf", "fuf", "Pular", nil},
+	{"", "fuh", "fuh", "Western Niger Fulfulde", nil},
+	{"", "fui", "fui", "Bagirmi Fulfulde", nil},
+	{"", "fuj", "fuj", "Ko", nil},
+	{"ff", "ful", "ful", "Fulah", nil},
+	{"", "fum", "fum", "Fum", nil},
+	{"", "fun", "fun", "Fulniô", nil},
+	{"", "fuq", "fuq", "Central-Eastern Niger Fulfulde", nil},
+	{"", "fur", "fur", "Friulian", nil},
+	{"", "fut", "fut", "Futuna-Aniwa", nil},
+	{"", "fuu", "fuu", "Furu", nil},
+	{"", "fuv", "fuv", "Nigerian Fulfulde", nil},
+	{"", "fuy", "fuy", "Fuyug", nil},
+	{"", "fvr", "fvr", "Fur", nil},
+	{"", "fwa", "fwa", "Fwâi", nil},
+	{"", "fwe", "fwe", "Fwe", nil},
+	{"", "gaa", "gaa", "Ga", nil},
+	{"", "gab", "gab", "Gabri", nil},
+	{"", "gac", "gac", "Mixed Great Andamanese", nil},
+	{"", "gad", "gad", "Gaddang", nil},
+	{"", "gae", "gae", "Guarequena", nil},
+	{"", "gaf", "gaf", "Gende", nil},
+	{"", "gag", "gag", "Gagauz", nil},
+	{"", "gah", "gah", "Alekano", nil},
+	{"", "gai", "gai", "Borei", nil},
+	{"", "gaj", "gaj", "Gadsup", nil},
+	{"", "gak", "gak", "Gamkonora", nil},
+	{"", "gal", "gal", "Galolen", nil},
+	{"", "gam", "gam", "Kandawo", nil},
+	{"", "gan", "gan", "Gan Chinese", nil},
+	{"", "gao", "gao", "Gants", nil},
+	{"", "gap", "gap", "Gal", nil},
+	{"", "gaq", "gaq", "Gata'", nil},
+	{"", "gar", "gar", "Galeya", nil},
+	{"", "gas", "gas", "Adiwasi Garasia", nil},
+	{"", "gat", "gat", "Kenati", nil},
+	{"", "gau", "gau", "Mudhili Gadaba", nil},
+	{"", "gaw", "gaw", "Nobonob", nil},
+	{"", "gax", "gax", "Borana-Arsi-Guji Oromo", nil},
+	{"", "gay", "gay", "Gayo", nil},
+	{"", "gaz", "gaz", "West Central Oromo", nil},
+	{"", "gba", "gba", "Gbaya", nil},
+	{"", "gbb", "gbb", "Kaytetye", nil},
+	{"", "gbd", "gbd", "Karajarri", nil},
+	{"", "gbe", "gbe", "Niksek", nil},
+	{"", "gbf", "gbf", "Gaikundi", nil},
+	{"", "gbg", "gbg", "Gbanziri", nil},
+	{"", "gbh", "gbh", "Defi Gbe", nil},
+	{"", "gbi", "gbi", "Galela", nil},
+	{"", "gbj", "gbj", "Bodo Gadaba", nil},
+	{"", "gbk", "gbk", "Gaddi", nil},
+	{"", "gbl", "gbl", "Gamit", nil},
+	{"", "gbm", "gbm", "Garhwali", nil},
+	{"", "gbn", "gbn", "Mo'da", nil},
+	{"", "gbo", "gbo", "Northern Grebo", nil},
+	{"", "gbp", "gbp", "Gbaya-Bossangoa", nil},
+	{"", "gbq", "gbq", "Gbaya-Bozoum", nil},
+	{"", "gbr", "gbr", "Gbagyi", nil},
+	{"", "gbs", "gbs", "Gbesi Gbe", nil},
+	{"", "gbu", "gbu", "Gagadu", nil},
+	{"", "gbv", "gbv", "Gbanu", nil},
+	{"", "gbw", "gbw", "Gabi-Gabi", nil},
+	{"", "gbx", "gbx", "Eastern Xwla Gbe", nil},
+	{"", "gby", "gby", "Gbari", nil},
+	{"", "gbz", "gbz", "Zoroastrian Dari", nil},
+	{"", "gcc", "gcc", "Mali", nil},
+	{"", "gcd", "gcd", "Ganggalida", nil},
+	{"", "gce", "gce", "Galice", nil},
+	{"", "gcf", "gcf", "Guadeloupean Creole French", nil},
+	{"", "gcl", "gcl", "Grenadian Creole English", nil},
+	{"", "gcn", "gcn", "Gaina", nil},
+	{"", "gcr", "gcr", "Guianese Creole French", nil},
+	{"", "gct", "gct", "Colonia Tovar German", nil},
+	{"", "gda", "gda", "Gade Lohar", nil},
+	{"", "gdb", "gdb", "Pottangi Ollar Gadaba", nil},
+	{"", "gdc", "gdc", "Gugu Badhun", nil},
+	{"", "gdd", "gdd", "Gedaged", nil},
+	{"", "gde", "gde", "Gude", nil},
+	{"", "gdf", "gdf", "Guduf-Gava", nil},
+	{"", "gdg", "gdg", "Ga'dang", nil},
+	{"", "gdh", "gdh", "Gadjerawang", nil},
+	{"", "gdi", "gdi", "Gundi", nil},
+	{"", "gdj", "gdj", "Gurdjar", nil},
+	{"", "gdk", "gdk", "Gadang", nil},
+	{"", "gdl", "gdl", "Dirasha", nil},
+	{"", "gdm", "gdm", "Laal", nil},
+	{"", "gdn", "gdn", "Umanakaina", nil},
+	{"", "gdo", "gdo", "Ghodoberi", nil},
+	{"", "gdq", "gdq", "Mehri", nil},
+	{"", "gdr", "gdr", "Wipi", nil},
+	{"", "gds", "gds", "Ghandruk Sign Language", nil},
+	{"", "gdt", "gdt", "Kungardutyi", nil},
+	{"", "gdu", "gdu", "Gudu", nil},
+	{"", "gdx", "gdx", "Godwari", nil},
+	{"", "gea", "gea", "Geruma", nil},
+	{"", "geb", "geb", "Kire", nil},
+	{"", "gec", "gec", "Gboloo Grebo", nil},
+	{"", "ged", "ged", "Gade", nil},
+	{"", "gef", "gef", "Gerai", nil},
+	{"", "geg", "geg", "Gengle", nil},
+	{"", "geh", "geh", "Hutterite German", nil},
+	{"", "gei", "gei", "Gebe", nil},
+	{"", "gej", "gej", "Gen", nil},
+	{"", "gek", "gek", "Ywom", nil},
+	{"", "gel", "gel", "ut-Ma'in", nil},
+	{"", "gem", "gem", "Germanic languages", nil},
+	{"", "geq", "geq", "Geme", nil},
+	{"", "ges", "ges", "Geser-Gorom", nil},
+	{"", "gev", "gev", "Eviya", nil},
+	{"", "gew", "gew", "Gera", nil},
+	{"", "gex", "gex", "Garre", nil},
+	{"", "gey", "gey", "Enya", nil},
+	{"", "gez", "gez", "Geez", nil},
+	{"", "gfk", "gfk", "Patpatar", nil},
+	{"", "gft", "gft", "Gafat", nil},
+	{"", "gga", "gga", "Gao", nil},
+	{"", "ggb", "ggb", "Gbii", nil},
+	{"", "ggd", "ggd", "Gugadj", nil},
+	{"", "gge", "gge", "Gurr-goni", nil},
+	{"", "ggg", "ggg", "Gurgula", nil},
+	{"", "ggk", "ggk", "Kungarakany", nil},
+	{"", "ggl", "ggl", "Ganglau", nil},
+	{"", "ggt", "ggt", "Gitua", nil},
+	{"", "ggu", "ggu", "Gagu", nil},
+	{"", "ggw", "ggw", "Gogodala", nil},
+	{"", "gha", "gha", "Ghadamès", nil},
+	{"", "ghc", "ghc", "Hiberno-Scottish Gaelic", nil},
+	{"", "ghe", "ghe", "Southern Ghale", nil},
+	{"", "ghh", "ghh", "Northern Ghale", nil},
+	{"", "ghk", "ghk", "Geko Karen", nil},
+	{"", "ghl", "ghl", "Ghulfan", nil},
+	{"", "ghn", "ghn", "Ghanongga", nil},
+	{"", "gho", "gho", "Ghomara", nil},
+	{"", "ghr", "ghr", "Ghera", nil},
+	{"", "ghs", "ghs", "Guhu-Samane", nil},
+	{"", "ght", "ght", "Kuke", nil},
+	{"", "gia", "gia", "Kija", nil},
+	{"", "gib", "gib", "Gibanawa", nil},
+	{"", "gic", "gic", "Gail", nil},
+	{"", "gid", "gid", "Gidar", nil},
+	{"", "gie", "gie", "Gaɓogbo", nil},
+	{"", "gig", "gig", "Goaria", nil},
+	{"", "gih", "gih", "Githabul", nil},
+	{"", "gii", "gii", "Girirra", nil},
+	{"", "gil", "gil", "Gilbertese", nil},
+	{"", "gim", "gim", "Gimi (Eastern Highlands)", nil},
+	{"", "gin", "gin", "Hinukh", nil},
+	{"", "gip", "gip", "Gimi (West New Britain)", nil},
+	{"", "giq", "giq", "Green Gelao", nil},
+	{"", "gir", "gir", "Red Gelao", nil},
+	{"", "gis", "gis", "North Giziga", nil},
+	{"", "git", "git", "Gitxsan", nil},
+	{"", "giu", "giu", "Mulao", nil},
+	{"", "giw", "giw", "White Gelao", nil},
+	{"", "gix", "gix", "Gilima", nil},
+	{"", "giy", "giy", "Giyug", nil},
+	{"", "giz", "giz", "South Giziga", nil},
+	{"", "gjk", "gjk", "Kachi Koli", nil},
+	{"", "gjm", "gjm", "Gunditjmara", nil},
+	{"", "gjn", "gjn", "Gonja", nil},
+	{"", "gjr", "gjr", "Gurindji Kriol", nil},
+	{"", "gju", "gju", "Gujari", nil},
+	{"", "gka", "gka", "Guya", nil},
+	{"", "gkd", "gkd", "Magɨ (Madang Province)", nil},
+	{"", "gke", "gke", "Ndai", nil},
+	{"", "gkn", "gkn", "Gokana", nil},
+	{"", "gko", "gko", "Kok-Nar", nil},
+	{"", "gkp", "gkp", "Guinea Kpelle", nil},
+	{"", "gku", "gku", "ǂUngkue", nil},
+	{"gd", "gla", "gla", "Gaelic; Scottish Gaelic", nil},
+	{"", "glb", "glb", "Belning", nil},
+	{"", "glc", "glc", "Bon Gula", nil},
+	{"", "gld", "gld", "Nanai", nil},
+	{"ga", "gle", "gle", "Irish", nil},
+	{"gl", "glg", "glg", "Galician", nil},
+	{"", "glh", "glh", "Northwest Pashai", nil},
+	{"", "glj", "glj", "Gula Iro", nil},
+	{"", "glk", "glk", "Gilaki", nil},
+	{"", "gll", "gll", "Garlali", nil},
+	{"", "glo", "glo", "Galambu", nil},
+	{"", "glr", "glr", "Glaro-Twabo", nil},
+	{"", "glu", "glu", "Gula (Chad)", nil},
+	{"gv", "glv", "glv", "Manx", nil},
+	{"", "glw", "glw", "Glavda", nil},
+	{"", "gly", "gly", "Gule", nil},
+	{"", "gma", "gma", "Gambera", nil},
+	{"", "gmb", "gmb", "Gula'alaa", nil},
+	{"", "gmd", "gmd", "Mághdì", nil},
+	{"", "gmg", "gmg", "Magɨyi", nil},
+	{"", "gmh", "gmh", "German, Middle High (ca. 1050-1500)", nil},
+	{"", "gml", "gml", "Middle Low German", nil},
+	{"", "gmm", "gmm", "Gbaya-Mbodomo", nil},
+	{"", "gmn", "gmn", "Gimnime", nil},
+	{"", "gmr", "gmr", "Mirning", nil},
+	{"", "gmu", "gmu", "Gumalu", nil},
+	{"", "gmv", "gmv", "Gamo", nil},
+	{"", "gmx", "gmx", "Magoma", nil},
+	{"", "gmy", "gmy", "Mycenaean Greek", nil},
+	{"", "gmz", "gmz", "Mgbolizhia", nil},
+	{"", "gna", "gna", "Kaansa", nil},
+	{"", "gnb", "gnb", "Gangte", nil},
+	{"", "gnc", "gnc", "Guanche", nil},
+	{"", "gnd", "gnd", "Zulgo-Gemzek", nil},
+	{"", "gne", "gne", "Ganang", nil},
+	{"", "gng", "gng", "Ngangam", nil},
+	{"", "gnh", "gnh", "Lere", nil},
+	{"", "gni", "gni", "Gooniyandi", nil},
+	{"", "gnj", "gnj", "Ngen", nil},
+	{"", "gnk", "gnk", "ǁGana", nil},
+	{"", "gnl", "gnl", "Gangulu", nil},
+	{"", "gnm", "gnm", "Ginuman", nil},
+	{"", "gnn", "gnn", "Gumatj", nil},
+	{"", "gno", "gno", "Northern Gondi", nil},
+	{"", "gnq", "gnq", "Gana", nil},
+	{"", "gnr", "gnr", "Gureng Gureng", nil},
+	{"", "gnt", "gnt", "Guntai", nil},
+	{"", "gnu", "gnu", "Gnau", nil},
+	{"", "gnw", "gnw", "Western Bolivian Guaraní", nil},
+	{"", "gnz", "gnz", "Ganzi", nil},
+	{"", "goa", "goa", "Guro", nil},
+	{"", "gob", "gob", "Playero", nil},
+	{"", "goc", "goc", "Gorakor", nil},
+	{"", "god", "god", "Godié", nil},
+	{"", "goe", "goe", "Gongduk", nil},
+	{"", "gof", "gof", "Gofa", nil},
+	{"", "gog", "gog", "Gogo", nil},
+	{"", "goh", "goh", "German, Old High (ca. 750-1050)", nil},
+	{"", "goi", "goi", "Gobasi", nil},
+	{"", "goj", "goj", "Gowlan", nil},
+	{"", "gok", "gok", "Gowli", nil},
+	{"", "gol", "gol", "Gola", nil},
+	{"", "gom", "gom", "Goan Konkani", nil},
+	{"", "gon", "gon", "Gondi", nil},
+	{"", "goo", "goo", "Gone Dau", nil},
+	{"", "gop", "gop", "Yeretuar", nil},
+	{"", "goq", "goq", "Gorap", nil},
+	{"", "gor", "gor", "Gorontalo", nil},
+	{"", "gos", "gos", "Gronings", nil},
+	{"", "got", "got", "Gothic", nil},
+	{"", "gou", "gou", "Gavar", nil},
+	{"", "gov", "gov", "Goo", nil},
+	{"", "gow", "gow", "Gorowa", nil},
+	{"", "gox", "gox", "Gobu", nil},
+	{"", "goy", "goy", "Goundo", nil},
+	{"", "goz", "goz", "Gozarkhani", nil},
+	{"", "gpa", "gpa", "Gupa-Abawa", nil},
+	{"", "gpe", "gpe", "Ghanaian Pidgin English", nil},
+	{"", "gpn", "gpn", "Taiap", nil},
+	{"", "gqa", "gqa", "Ga'anda", nil},
+	{"", "gqi", "gqi", "Guiqiong", nil},
+	{"", "gqn", "gqn", "Guana (Brazil)", nil},
+	{"", "gqr", "gqr", "Gor", nil},
+	{"", "gqu", "gqu", "Qau", nil},
+	{"", "gra", "gra", "Rajput Garasia", nil},
+	{"", "grb", "grb", "Grebo", nil},
+	{"", "grc", "grc", "Greek, Ancient (to 1453)", nil},
+	{"", "grd", "grd", "Guruntum-Mbaaru", nil},
+	{"", "grg", "grg", "Madi", nil},
+	{"", "grh", "grh", "Gbiri-Niragu", nil},
+	{"", "gri", "gri", "Ghari", nil},
+	{"", "grj", "grj", "Southern Grebo", nil},
+	{"", "grm", "grm", "Kota Marudu Talantang", nil},
+	{"gn", "grn", "grn", "Guarani", nil},
+	{"", "gro", "gro", "Groma", nil},
+	{"", "grq", "grq", "Gorovu", nil},
+	{"", "grr", "grr", "Taznatit", nil},
+	{"", "grs", "grs", "Gresi", nil},
+	{"", "grt", "grt", "Garo", nil},
+	{"", "gru", "gru", "Kistane", nil},
+	{"", "grv", "grv", "Central Grebo", nil},
+	{"", "grw", "grw", "Gweda", nil},
+	{"", "grx", "grx", "Guriaso", nil},
+	{"", "gry", "gry", "Barclayville Grebo", nil},
+	{"", "grz", "grz", "Guramalum", nil},
+	{"", "gse", "gse", "Ghanaian Sign Language", nil},
+	{"", "gsg", "gsg", "German Sign Language", nil},
+	{"", "gsl", "gsl", "Gusilay", nil},
+	{"", "gsm", "gsm", "Guatemalan Sign Language", nil},
+	{"", "gsn", "gsn", "Nema", nil},
+	{"", "gso", "gso", "Southwest Gbaya", nil},
+	{"", "gsp", "gsp", "Wasembo", nil},
+	{"", "gss", "gss", "Greek Sign Language", nil},
+	{"", "gsw", "gsw", "Swiss German; Alemannic; Alsatian", nil},
+	{"", "gta", "gta", "Guató", nil},
+	{"", "gtu", "gtu", "Aghu-Tharnggala", nil},
+	{"", "gua", "gua", "Shiki", nil},
+	{"", "gub", "gub", "Guajajára", nil},
+	{"", "guc", "guc", "Wayuu", nil},
+	{"", "gud", "gud", "Yocoboué Dida", nil},
+	{"", "gue", "gue", "Gurindji", nil},
+	{"", "guf", "guf", "Gupapuyngu", nil},
+	{"", "gug", "gug", "Paraguayan Guaraní", nil},
+	{"", "guh", "guh", "Guahibo", nil},
+	{"", "gui", "gui", "Eastern Bolivian Guaraní", nil},
+	{"gu", "guj", "guj", "Gujarati", nil},
+	{"", "guk", "guk", "Gumuz", nil},
+	{"", "gul", "gul", "Sea Island Creole English", nil},
+	{"", "gum", "gum", "Guambiano", nil},
+	{"", "gun", "gun", "Mbyá Guaraní", nil},
+	{"", "guo", "guo", "Guayabero", nil},
+	{"", "gup", "gup", "Gunwinggu", nil},
+	{"", "guq", "guq", "Aché", nil},
+	{"", "gur", "gur", "Farefare", nil},
+	{"", "gus", "gus", "Guinean Sign Language", nil},
+	{"", "gut", "gut", "Maléku Jaíka", nil},
+	{"", "guu", "guu", "Yanomamö", nil},
+	{"", "guw", "guw", "Gun", nil},
+	{"", "gux", "gux", "Gourmanchéma", nil},
+	{"", "guz", "guz", "Gusii", nil},
+	{"", "gva", "gva", "Guana (Paraguay)", nil},
+	{"", "gvc", "gvc", "Guanano", nil},
+	{"", "gve", "gve", "Duwet", nil},
+	{"", "gvf", "gvf", "Golin", nil},
+	{"", "gvj", "gvj", "Guajá", nil},
+	{"", "gvl", "gvl", "Gulay", nil},
+	{"", "gvm", "gvm", "Gurmana", nil},
+	{"", "gvn", "gvn", "Kuku-Yalanji", nil},
+	{"", "gvo", "gvo", "Gavião Do Jiparaná", nil},
+	{"", "gvp", "gvp", "Pará Gavião", nil},
+	{"", "gvr", "gvr", "Gurung", nil},
+	{"", "gvs", "gvs", "Gumawana", nil},
+	{"", "gvy", "gvy", "Guyani", nil},
+	{"", "gwa", "gwa", "Mbato", nil},
+	{"", "gwb", "gwb", "Gwa", nil},
+	{"", "gwc", "gwc", "Gawri", nil},
+	{"", "gwd", "gwd", "Gawwada", nil},
+	{"", "gwe", "gwe", "Gweno", nil},
+	{"", "gwf", "gwf", "Gowro", nil},
+	{"", "gwg", "gwg", "Moo", nil},
+	{"", "gwi", "gwi", "Gwich'in", nil},
+	{"", "gwj", "gwj", "ǀGwi", nil},
+	{"", "gwm", "gwm", "Awngthim", nil},
+	{"", "gwn", "gwn", "Gwandara", nil},
+	{"", "gwr", "gwr", "Gwere", nil},
+	{"", "gwt", "gwt", "Gawar-Bati", nil},
+	{"", "gwu", "gwu", "Guwamu", nil},
+	{"", "gww", "gww", "Kwini", nil},
+	{"", "gwx", "gwx", "Gua", nil},
+	{"", "gxx", "gxx", "Wè Southern", nil},
+	{"", "gya", "gya", "Northwest Gbaya", nil},
+	{"", "gyb", "gyb", "Garus", nil},
+	{"", "gyd", "gyd", "Kayardild", nil},
+	{"", "gye", "gye", "Gyem", nil},
+	{"", "gyf", "gyf", "Gungabula", nil},
+	{"", "gyg", "gyg", "Gbayi", nil},
+	{"", "gyi", "gyi", "Gyele", nil},
+	{"", "gyl", "gyl", "Gayil", nil},
+	{"", "gym", "gym", "Ngäbere", nil},
+	{"", "gyn", "gyn", "Guyanese Creole English", nil},
+	{"", "gyo", "gyo", "Gyalsumdo", nil},
+	{"", "gyr", "gyr", "Guarayu", nil},
+	{"", "gyy", "gyy", "Gunya", nil},
+	{"", "gyz", "gyz", "Geji", nil},
+	{"", "gza", "gza", "Ganza", nil},
+	{"", "gzi", "gzi", "Gazi", nil},
+	{"", "gzn", "gzn", "Gane", nil},
+	{"", "haa", "haa", "Han", nil},
+	{"", "hab", "hab", "Hanoi Sign Language", nil},
+	{"", "hac", "hac", "Gurani", nil},
+	{"", "had", "had", "Hatam", nil},
+	{"", "hae", "hae", "Eastern Oromo", nil},
+	{"", "haf", "haf", "Haiphong Sign Language", nil},
+	{"", "hag", "hag", "Hanga", nil},
+	{"", "hah", "hah", "Hahon", nil},
+	{"", "hai", "hai", "Haida", nil},
+	{"", "haj", "haj", "Hajong", nil},
+	{"", "hak", "hak", "Hakka Chinese", nil},
+	{"", "hal", "hal", "Halang", nil},
+	{"", "ham", "ham", "Hewa", nil},
+	{"", "han", "han", "Hangaza", nil},
+	{"", "hao", "hao", "Hakö", nil},
+	{"", "hap", "hap", "Hupla", nil},
+	{"", "haq", "haq", "Ha", nil},
+	{"", "har", "har", "Harari", nil},
+	{"", "has", "has", "Haisla", nil},
+	{"ht", "hat", "hat", "Haitian; Haitian Creole", nil},
+	{"ha", "hau", "hau", "Hausa", nil},
+	{"", "hav", "hav", "Havu", nil},
+	{"", "haw", "haw", "Hawaiian", nil},
+	{"", "hax", "hax", "Southern Haida", nil},
+	{"", "hay", "hay", "Haya", nil},
+	{"", "haz", "haz", "Hazaragi", nil},
+	{"", "hba", "hba", "Hamba", nil},
+	{"", "hbb", "hbb", "Huba", nil},
+	{"", "hbn", "hbn", "Heiban", nil},
+	{"", "hbo", "hbo", "Ancient Hebrew", nil},
+	{"sh", "hbs", "hbs", "Serbo-Croatian", nil},
+	{"", "hbu", "hbu", "Habu", nil},
+	{"", "hca", "hca", "Andaman Creole Hindi", nil},
+	{"", "hch", "hch", "Huichol", nil},
+	{"", "hdn", "hdn", "Northern Haida", nil},
+	{"", "hds", "hds", "Honduras Sign Language", nil},
+	{"", "hdy", "hdy", "Hadiyya", nil},
+	{"", "hea", "hea", "Northern Qiandong Miao", nil},
+	{"he", "heb", "heb", "Hebrew", nil},
+	{"", "hed", "hed", "Herdé", nil},
+	{"", "heg", "heg", "Helong", nil},
+	{"", "heh", "heh", "Hehe", nil},
+	{"", "hei", "hei", "Heiltsuk", nil},
+	{"", "hem", "hem", "Hemba", nil},
+	{"hz", "her", "her", "Herero", nil},
+	{"", "hgm", "hgm", "Haiǁom", nil},
+	{"", "hgw", "hgw", "Haigwai", nil},
+	{"", "hhi", "hhi", "Hoia Hoia", nil},
+	{"", "hhr", "hhr", "Kerak", nil},
+	{"", "hhy", "hhy", "Hoyahoya", nil},
+	{"", "hia", "hia", "Lamang", nil},
+	{"", "hib", "hib", "Hibito", nil},
+	{"", "hid", "hid", "Hidatsa", nil},
+	{"", "hif", "hif", "Fiji Hindi", nil},
+	{"", "hig", "hig", "Kamwe", nil},
+	{"", "hih", "hih", "Pamosu", nil},
+	{"", "hii", "hii", "Hinduri", nil},
+	{"", "hij", "hij", "Hijuk", nil},
+	{"", "hik", "hik", "Seit-Kaitetu", nil},
+	{"", "hil", "hil", "Hiligaynon", nil},
+	{"", "him", "him", "Himachali languages; Western Pahari languages", nil},
+	{"hi", "hin", "hin", "Hindi", nil},
+	{"", "hio", "hio", "Tsoa", nil},
+	{"", "hir", "hir", "Himarimã", nil},
+	{"", "hit", "hit", "Hittite", nil},
+	{"", "hiw", "hiw", "Hiw", nil},
+	{"", "hix", "hix", "Hixkaryána", nil},
+	{"", "hji", "hji", "Haji", nil},
+	{"", "hka", "hka", "Kahe", nil},
+	{"", "hke", "hke", "Hunde", nil},
+	{"", "hkh", "hkh", "Khah", nil},
+	{"", "hkk", "hkk", "Hunjara-Kaina Ke", nil},
+	{"", "hkn", "hkn", "Mel-Khaonh", nil},
+	{"", "hks", "hks", "Hong Kong Sign Language", nil},
+	{"", "hla", "hla", "Halia", nil},
+	{"", "hlb", "hlb", "Halbi", nil},
+	{"", "hld", "hld", "Halang Doan", nil},
+	{"", "hle", "hle", "Hlersu", nil},
+	{"", "hlt", "hlt", "Matu Chin", nil},
+	{"", "hlu", "hlu", "Hieroglyphic Luwian", nil},
+	{"", "hma", "hma", "Southern Mashan Hmong", nil},
+	{"", "hmb", "hmb", "Humburi Senni Songhay", nil},
+	{"", "hmc", "hmc", "Central Huishui Hmong", nil},
+	{"", "hmd", "hmd", "Large Flowery Miao", nil},
+	{"", "hme", "hme", "Eastern Huishui Hmong", nil},
+	{"", "hmf", "hmf", "Hmong Don", nil},
+	{"", "hmg", "hmg", "Southwestern Guiyang Hmong", nil},
+	{"", "hmh", "hmh", "Southwestern Huishui Hmong", nil},
+	{"", "hmi", "hmi", "Northern Huishui Hmong", nil},
+	{"", "hmj", "hmj", "Ge", nil},
+	{"", "hmk", "hmk", "Maek", nil},
+	{"", "hml", "hml", "Luopohe Hmong", nil},
+	{"", "hmm", "hmm", "Central Mashan Hmong", nil},
+	{"", "hmn", "hmn", "Hmong; Mong", nil},
+	{"ho", "hmo", "hmo", "Hiri Motu", nil},
+	{"", "hmp", "hmp", "Northern Mashan Hmong", nil},
+	{"", "hmq", "hmq", "Eastern Qiandong Miao", nil},
+	{"", "hmr", "hmr", "Hmar", nil},
+	{"", "hms", "hms", "Southern Qiandong Miao", nil},
+	{"", "hmt", "hmt", "Hamtai", nil},
+	{"", "hmu", "hmu", "Hamap", nil},
+	{"", "hmv", "hmv", "Hmong Dô", nil},
+	{"", "hmw", "hmw", "Western Mashan Hmong", nil},
+	{"", "hmy", "hmy", "Southern Guiyang Hmong", nil},
+	{"", "hmz", "hmz", "Hmong Shua", nil},
+	{"", "hna", "hna", "Mina (Cameroon)", nil},
+	{"", "hnd", "hnd", "Southern Hindko", nil},
+	{"", "hne", "hne", "Chhattisgarhi", nil},
+	{"", "hng", "hng", "Hungu", nil},
+	{"", "hnh", "hnh", "ǁAni", nil},
+	{"", "hni", "hni", "Hani", nil},
+	{"", "hnj", "hnj", "Hmong Njua", nil},
+	{"", "hnn", "hnn", "Hanunoo", nil},
+	{"", "hno", "hno", "Northern Hindko", nil},
+	{"", "hns", "hns", "Caribbean Hindustani", nil},
+	{"", "hnu", "hnu", "Hung", nil},
+	{"", "hoa", "hoa", "Hoava", nil},
+	{"", "hob", "hob", "Mari (Madang Province)", nil},
+	{"", "hoc", "hoc", "Ho", nil},
+	{"", "hod", "hod", "Holma", nil},
+	{"", "hoe", "hoe", "Horom", nil},
+	{"", "hoh", "hoh", "Hobyót", nil},
+	{"", "hoi", "hoi", "Holikachuk", nil},
+	{"", "hoj", "hoj", "Hadothi", nil},
+	{"", "hol", "hol", "Holu", nil},
+	{"", "hom", "hom", "Homa", nil},
+	{"", "hoo", "hoo", "Holoholo", nil},
+	{"", "hop", "hop", "Hopi", nil},
+	{"", "hor", "hor", "Horo", nil},
+	{"", "hos", "hos", "Ho Chi Minh City Sign Language", nil},
+	{"", "hot", "hot", "Hote", nil},
+	{"", "hov", "hov", "Hovongan", nil},
+	{"", "how", "how", "Honi", nil},
+	{"", "hoy", "hoy", "Holiya", nil},
+	{"", "hoz", "hoz", "Hozo", nil},
+	{"", "hpo", "hpo", "Hpon", nil},
+	{"", "hps", "hps", "Hawai'i Sign Language (HSL)", nil},
+	{"", "hra", "hra", "Hrangkhol", nil},
+	{"", "hrc", "hrc", "Niwer Mil", nil},
+	{"", "hre", "hre", "Hre", nil},
+	{"", "hrk", "hrk", "Haruku", nil},
+	{"", "hrm", "hrm", "Horned Miao", nil},
+	{"", "hro", "hro", "Haroi", nil},
+	{"", "hrp", "hrp", "Nhirrpi", nil},
+	{"", "hrt", "hrt", "Hértevin", nil},
+	{"", "hru", "hru", "Hruso", nil},
+	{"hr", "hrv", "hrv", "Croatian", nil},
+	{"", "hrw", "hrw", "Warwar Feni", nil},
+	{"", "hrx", "hrx", "Hunsrik", nil},
+	{"", "hrz", "hrz", "Harzani", nil},
+	{"", "hsb", "hsb", "Upper Sorbian", nil},
+	{"", "hsh", "hsh", "Hungarian Sign Language", nil},
+	{"", "hsl", "hsl", "Hausa Sign Language", nil},
+	{"", "hsn", "hsn", "Xiang Chinese", nil},
+	{"", "hss", "hss", "Harsusi", nil},
+	{"", "hti", "hti", "Hoti", nil},
+	{"", "hto", "hto", "Minica Huitoto", nil},
+	{"", "hts", "hts", "Hadza", nil},
+	{"", "htu", "htu", "Hitu", nil},
+	{"", "htx", "htx", "Middle Hittite", nil},
+	{"", "hub", "hub", "Huambisa", nil},
+	{"", "huc", "huc", "ǂHua", nil},
+	{"", "hud", "hud", "Huaulu", nil},
+	{"", "hue", "hue", "San Francisco Del Mar Huave", nil},
+	{"", "huf", "huf", "Humene", nil},
+	{"", "hug", "hug", "Huachipaeri", nil},
+	{"", "huh", "huh", "Huilliche", nil},
+	{"", "hui", "hui", "Huli", nil},
+	{"", "huj", "huj", "Northern Guiyang Hmong", nil},
+	{"", "huk", "huk", "Hulung", nil},
+	{"", "hul", "hul", "Hula", nil},
+	{"", "hum", "hum", "Hungana", nil},
+	{"hu", "hun", "hun", "Hungarian", nil},
+	{"", "huo", "huo", "Hu", nil},
+	{"", "hup", "hup", "Hupa", nil},
+	{"", "huq", "huq", "Tsat", nil},
+	{"", "hur", "hur", "Halkomelem", nil},
+	{"", "hus", "hus", "Huastec", nil},
+	{"", "hut", "hut", "Humla", nil},
+	{"", "huu", "huu", "Murui Huitoto", nil},
+	{"", "huv", "huv", "San Mateo Del Mar Huave", nil},
+	{"", "huw", "huw", "Hukumina", nil},
+	{"", "hux", "hux", "Nüpode Huitoto", nil},
+	{"", "huy", "huy", "Hulaulá", nil},
+	{"", "huz", "huz", "Hunzib", nil},
+	{"", "hvc", "hvc", "Haitian Vodoun Culture Language", nil},
+	{"", "hve", "hve", "San Dionisio Del Mar Huave", nil},
+	{"", "hvk", "hvk", "Haveke", nil},
+	{"", "hvn", "hvn", "Sabu", nil},
+	{"", "hvv", "hvv", "Santa María Del Mar Huave", nil},
+	{"", "hwa", "hwa", "Wané", nil},
+	{"", "hwc", "hwc", "Hawai'i Creole English", nil},
+	{"", "hwo", "hwo", "Hwana", nil},
+	{"", "hya", "hya", "Hya", nil},
+	{"hy", "arm", "hye", "Armenian", nil},
+	{"", "hyw", "hyw", "Western Armenian", nil},
+	{"", "iai", "iai", "Iaai", nil},
+	{"", "ian", "ian", "Iatmul", nil},
+	{"", "iar", "iar", "Purari", nil},
+	{"", "iba", "iba", "Iban", nil},
+	{"", "ibb", "ibb", "Ibibio", nil},
+	{"", "ibd", "ibd", "Iwaidja", nil},
+	{"", "ibe", "ibe", "Akpes", nil},
+	{"", "ibg", "ibg", "Ibanag", nil},
+	{"", "ibh", "ibh", "Bih", nil},
+	{"", "ibl", "ibl", "Ibaloi", nil},
+	{"", "ibm", "ibm", "Agoi", nil},
+	{"", "ibn", "ibn", "Ibino", nil},
+	{"ig", "ibo", "ibo", "Igbo", nil},
+	{"", "ibr", "ibr", "Ibuoro", nil},
+	{"", "ibu", "ibu", "Ibu", nil},
+	{"", "iby", "iby", "Ibani", nil},
+	{"", "ica", "ica", "Ede Ica", nil},
+	{"", "ich", "ich", "Etkywan", nil},
+	{"", "icl", "icl", "Icelandic Sign Language", nil},
+	{"", "icr", "icr", "Islander Creole English", nil},
+	{"", "ida", "ida", "Idakho-Isukha-Tiriki", nil},
+	{"", "idb", "idb", "Indo-Portuguese", nil},
+	{"", "idc", "idc", "Idon", nil},
+	{"", "idd", "idd", "Ede Idaca", nil},
+	{"", "ide", "ide", "Idere", nil},
+	{"", "idi", "idi", "Idi", nil},
+	{"io", "ido", "ido", "Ido", nil},
+	{"", "idr", "idr", "Indri", nil},
+	{"", "ids", "ids", "Idesa", nil},
+	{"", "idt", "idt", "Idaté", nil},
+	{"", "idu", "idu", "Idoma", nil},
+	{"", "ifa", "ifa", "Amganad Ifugao", nil},
+	{"", "ifb", "ifb", "Batad Ifugao", nil},
+	{"", "ife", "ife", "Ifè", nil},
+	{"", "iff", "iff", "Ifo", nil},
+	{"", "ifk", "ifk", "Tuwali Ifugao", nil},
+	{"", "ifm", "ifm", "Teke-Fuumu", nil},
+	{"", "ifu", "ifu", "Mayoyao Ifugao", nil},
+	{"", "ify", "ify", "Keley-I Kallahan", nil},
+	{"", "igb", "igb", "Ebira", nil},
+	{"", "ige", "ige", "Igede", nil},
+	{"", "igg", "igg", "Igana", nil},
+	{"", "igl", "igl", "Igala", nil},
+	{"", "igm", "igm", "Kanggape", nil},
+	{"", "ign", "ign", "Ignaciano", nil},
+	{"", "igo", "igo", "Isebe", nil},
+	{"", "igs", "igs", "Interglossa", nil},
+	{"", "igw", "igw", "Igwe", nil},
+	{"", "ihb", "ihb", "Iha Based Pidgin", nil},
+	{"", "ihi", "ihi", "Ihievbe", nil},
+	{"", "ihp", "ihp", "Iha", nil},
+	{"", "ihw", "ihw", "Bidhawal", nil},
+	{"ii", "iii", "iii", "Sichuan Yi; Nuosu", nil},
+	{"", "iin", "iin", "Thiin", nil},
+	{"", "ijc", "ijc", "Izon", nil},
+	{"", "ije", "ije", "Biseni", nil},
+	{"", "ijj", "ijj", "Ede Ije", nil},
+	{"", "ijn", "ijn", "Kalabari", nil},
+	{"", "ijo", "ijo", "Ijo languages", nil},
+	{"", "ijs", "ijs", "Southeast Ijo", nil},
+	{"", "ike", "ike", "Eastern Canadian Inuktitut", nil},
+	{"", "iki", "iki", "Iko", nil},
+	{"", "ikk", "ikk", "Ika", nil},
+	{"", "ikl", "ikl", "Ikulu", nil},
+	{"", "iko", "iko", "Olulumo-Ikom", nil},
+	{"", "ikp", "ikp", "Ikpeshi", nil},
+	{"", "ikr", "ikr", "Ikaranggal", nil},
+	{"", "iks", "iks", "Inuit Sign Language", nil},
+	{"", "ikt", "ikt", "Inuinnaqtun", nil},
+	{"iu", "iku", "iku", "Inuktitut", nil},
+	{"", "ikv", "ikv", "Iku-Gora-Ankwa", nil},
+	{"", "ikw", "ikw", "Ikwere", nil},
+	{"", "ikx", "ikx", "Ik", nil},
+	{"", "ikz", "ikz", "Ikizu", nil},
+	{"", "ila", "ila", "Ile Ape", nil},
+	{"", "ilb", "ilb", "Ila", nil},
+	{"ie", "ile", "ile", "Interlingue; Occidental", nil},
+	{"", "ilg", "ilg", "Garig-Ilgar", nil},
+	{"", "ili", "ili", "Ili Turki", nil},
+	{"", "ilk", "ilk", "Ilongot", nil},
+	{"", "ilm", "ilm", "Iranun (Malaysia)", nil},
+	{"", "ilo", "ilo", "Iloko", nil},
+	{"", "ilp", "ilp", "Iranun (Philippines)", nil},
+	{"", "ils", "ils", "International Sign", nil},
+	{"", "ilu", "ilu", "Ili'uun", nil},
+	{"", "ilv", "ilv", "Ilue", nil},
+	{"", "ima", "ima", "Mala Malasar", nil},
+	{"", "imi", "imi", "Anamgura", nil},
+	{"", "iml", "iml", "Miluk", nil},
+	{"", "imn", "imn", "Imonda", nil},
+	{"", "imo", "imo", "Imbongu", nil},
+	{"", "imr", "imr", "Imroing", nil},
+	{"", "ims", "ims", "Marsian", nil},
+	{"", "imt", "imt", "Imotong", nil},
+	{"", "imy", "imy", "Milyan", nil},
+	{"ia", "ina", "ina", "Interlingua (International Auxiliary Language Association)", nil},
+	{"", "inb", "inb", "Inga", nil},
+	{"", "inc", "inc", "Indic languages", nil},
+	{"id", "ind", "ind", "Indonesian", nil},
+	{"", "ine", "ine", "Indo-European languages", nil},
+	{"", "ing", "ing", "Degexit'an", nil},
+	{"", "inh", "inh", "Ingush", nil},
+	{"", "inj", "inj", "Jungle Inga", nil},
+	{"", "inl", "inl", "Indonesian Sign Language", nil},
+	{"", "inm", "inm", "Minaean", nil},
+	{"", "inn", "inn", "Isinai", nil},
+	{"", "ino", "ino", "Inoke-Yate", nil},
+	{"", "inp", "inp", "Iñapari", nil},
+	{"", "ins", "ins", "Indian Sign Language", nil},
+	{"", "int", "int", "Intha", nil},
+	{"", "inz", "inz", "Ineseño", nil},
+	{"", "ior", "ior", "Inor", nil},
+	{"", "iou", "iou", "Tuma-Irumu", nil},
+	{"", "iow", "iow", "Iowa-Oto", nil},
+	{"", "ipi", "ipi", "Ipili", nil},
+	{"ik", "ipk", "ipk", "Inupiaq", nil},
+	{"", "ipo", "ipo", "Ipiko", nil},
+	{"", "iqu", "iqu", "Iquito", nil},
+	{"", "iqw", "iqw", "Ikwo", nil},
+	{"", "ira", "ira", "Iranian languages", nil},
+	{"", "ire", "ire", "Iresim", nil},
+	{"", "irh", "irh", "Irarutu", nil},
+	{"", "iri", "iri", "Rigwe", nil},
+	{"", "irk", "irk", "Iraqw", nil},
+	{"", "irn", "irn", "Irántxe", nil},
+	{"", "iro", "iro", "Iroquoian languages", nil},
+	{"", "irr", "irr", "Ir", nil},
+	{"", "iru", "iru", "Irula", nil},
+	{"", "irx", "irx", "Kamberau", nil},
+	{"", "iry", "iry", "Iraya", nil},
+	{"", "isa", "isa", "Isabi", nil},
+	{"", "isc", "isc", "Isconahua", nil},
+	{"", "isd", "isd", "Isnag", nil},
+	{"", "ise", "ise", "Italian Sign Language", nil},
+	{"", "isg", "isg", "Irish Sign Language", nil},
+	{"", "ish", "ish", "Esan", nil},
+	{"", "isi", "isi", "Nkem-Nkum", nil},
+	{"", "isk", "isk", "Ishkashimi", nil},
+	{"is", "ice", "isl", "Icelandic", nil},
+	{"", "ism", "ism", "Masimasi", nil},
+	{"", "isn", "isn", "Isanzu", nil},
+	{"", "iso", "iso", "Isoko", nil},
+	{"", "isr", "isr", "Israeli Sign Language", nil},
+	{"", "ist", "ist", "Istriot", nil},
+	{"", "isu", "isu", "Isu (Menchum Division)", nil},
+	{"it", "ita", "ita", "Italian", nil},
+	{"", "itb", "itb", "Binongan Itneg", nil},
+	{"", "itd", "itd", "Southern Tidung", nil},
+	{"", "ite", "ite", "Itene", nil},
+	{"", "iti", "iti", "Inlaod Itneg", nil},
+	{"", "itk", "itk", "Judeo-Italian", nil},
+	{"", "itl", "itl", "Itelmen", nil},
+	{"", "itm", "itm", "Itu Mbon Uzo", nil},
+	{"", "ito", "ito", "Itonama", nil},
+	{"", "itr", "itr", "Iteri", nil},
+	{"", "its", "its", "Isekiri", nil},
+	{"", "itt", "itt", "Maeng Itneg", nil},
+	{"", "itv", "itv", "Itawit", nil},
+	{"", "itw", "itw", "Ito", nil},
+	{"", "itx", "itx", "Itik", nil},
+	{"", "ity", "ity", "Moyadan Itneg", nil},
+	{"", "itz", "itz", "Itzá", nil},
+	{"", "ium", "ium", "Iu Mien", nil},
+	{"", "ivb", "ivb", "Ibatan", nil},
+	{"", "ivv", "ivv", "Ivatan", nil},
+	{"", "iwk", "iwk", "I-Wak", nil},
+	{"", "iwm", "iwm", "Iwam", nil},
+	{"", "iwo", "iwo", "Iwur", nil},
+	{"", "iws", "iws", "Sepik Iwam", nil},
+	{"", "ixc", "ixc", "Ixcatec", nil},
+	{"", "ixl", "ixl", "Ixil", nil},
+	{"", "iya", "iya", "Iyayu", nil},
+	{"", "iyo", "iyo", "Mesaka", nil},
+	{"", "iyx", "iyx", "Yaka (Congo)", nil},
+	{"", "izh", "izh", "Ingrian", nil},
+	{"", "izr", "izr", "Izere", nil},
+	{"", "izz", "izz", "Izii", nil},
+	{"", "jaa", "jaa", "Jamamadí", nil},
+	{"", "jab", "jab", "Hyam", nil},
+	{"", "jac", "jac", "Popti'", nil},
+	{"", "jad", "jad", "Jahanka", nil},
+	{"", "jae", "jae", "Yabem", nil},
+	{"", "jaf", "jaf", "Jara", nil},
+	{"", "jah", "jah", "Jah Hut", nil},
+	{"", "jaj", "jaj", "Zazao", nil},
+	{"", "jak", "jak", "Jakun", nil},
+	{"", "jal", "jal", "Yalahatan", nil},
+	{"", "jam", "jam", "Jamaican Creole English", nil},
+	{"", "jan", "jan", "Jandai", nil},
+	{"", "jao", "jao", "Yanyuwa", nil},
+	{"", "jaq", "jaq", "Yaqay", nil},
+	{"", "jas", "jas", "New Caledonian Javanese", nil},
+	{"", "jat", "jat", "Jakati", nil},
+	{"", "jau", "jau", "Yaur", nil},
+	{"jv", "jav", "jav", "Javanese", nil},
+	{"", "jax", "jax", "Jambi Malay", nil},
+	{"", "jay", "jay", "Yan-nhangu", nil},
+	{"", "jaz", "jaz", "Jawe", nil},
+	{"", "jbe", "jbe", "Judeo-Berber", nil},
+	{"", "jbi", "jbi", "Badjiri", nil},
+	{"", "jbj", "jbj", "Arandai", nil},
+	{"", "jbk", "jbk", "Barikewa", nil},
+	{"", "jbm", "jbm", "Bijim", nil},
+	{"", "jbn", "jbn", "Nafusi", nil},
+	{"", "jbo", "jbo", "Lojban", nil},
+	{"", "jbr", "jbr", "Jofotek-Bromnya", nil},
+	{"", "jbt", "jbt", "Jabutí", nil},
+	{"", "jbu", "jbu", "Jukun Takum", nil},
+	{"", "jbw", "jbw", "Yawijibaya", nil},
+	{"", "jcs", "jcs", "Jamaican Country Sign Language", nil},
+	{"", "jct", "jct", "Krymchak", nil},
+	{"", "jda", "jda", "Jad", nil},
+	{"", "jdg", "jdg", "Jadgali", nil},
+	{"", "jdt", "jdt", "Judeo-Tat", nil},
+	{"", "jeb", "jeb", "Jebero", nil},
+	{"", "jee", "jee", "Jerung", nil},
+	{"", "jeh", "jeh", "Jeh", nil},
+	{"", "jei", "jei", "Yei", nil},
+	{"", "jek", "jek", "Jeri Kuo", nil},
+	{"", "jel", "jel", "Yelmek", nil},
+	{"", "jen", "jen", "Dza", nil},
+	{"", "jer", "jer", "Jere", nil},
+	{"", "jet", "jet", "Manem", nil},
+	{"", "jeu", "jeu", "Jonkor Bourmataguil", nil},
+	{"", "jgb", "jgb", "Ngbee", nil},
+	{"", "jge", "jge", "Judeo-Georgian", nil},
+	{"", "jgk", "jgk", "Gwak", nil},
+	{"", "jgo", "jgo", "Ngomba", nil},
+	{"", "jhi", "jhi", "Jehai", nil},
+	{"", "jhs", "jhs", "Jhankot Sign Language", nil},
+	{"", "jia", "jia", "Jina", nil},
+	{"", "jib", "jib", "Jibu", nil},
+	{"", "jic", "jic", "Tol", nil},
+	{"", "jid", "jid", "Bu (Kaduna State)", nil},
+	{"", "jie", "jie", "Jilbe", nil},
+	{"", "jig", "jig", "Jingulu", nil},
+	{"", "jih", "jih", "sTodsde", nil},
+	{"", "jii", "jii", "Jiiddu", nil},
+	{"", "jil", "jil", "Jilim", nil},
+	{"", "jim", "jim", "Jimi (Cameroon)", nil},
+	{"", "jio", "jio", "Jiamao", nil},
+	{"", "jiq", "jiq", "Guanyinqiao", nil},
+	{"", "jit", "jit", "Jita", nil},
+	{"", "jiu", "jiu", "Youle Jinuo", nil},
+	{"", "jiv", "jiv", "Shuar", nil},
+	{"", "jiy", "jiy", "Buyuan Jinuo", nil},
+	{"", "jje", "jje", "Jejueo", nil},
+	{"", "jjr", "jjr", "Bankal", nil},
+	{"", "jka", "jka", "Kaera", nil},
+	{"", "jkm", "jkm", "Mobwa Karen", nil},
+	{"", "jko", "jko", "Kubo", nil},
+	{"", "jkp", "jkp", "Paku Karen", nil},
+	{"", "jkr", "jkr", "Koro (India)", nil},
+	{"", "jks", "jks", "Amami Koniya Sign Language", nil},
+	{"", "jku", "jku", "Labir", nil},
+	{"", "jle", "jle", "Ngile", nil},
+	{"", "jls", "jls", "Jamaican Sign Language", nil},
+	{"", "jma", "jma", "Dima", nil},
+	{"", "jmb", "jmb", "Zumbun", nil},
+	{"", "jmc", "jmc", "Machame", nil},
+	{"", "jmd", "jmd", "Yamdena", nil},
+	{"", "jmi", "jmi", "Jimi (Nigeria)", nil},
+	{"", "jml", "jml", "Jumli", nil},
+	{"", "jmn", "jmn", "Makuri Naga", nil},
+	{"", "jmr", "jmr", "Kamara", nil},
+	{"", "jms", "jms", "Mashi (Nigeria)", nil},
+	{"", "jmw", "jmw", "Mouwase", nil},
+	{"", "jmx", "jmx", "Western Juxtlahuaca Mixtec", nil},
+	{"", "jna", "jna", "Jangshung", nil},
+	{"", "jnd", "jnd", "Jandavra", nil},
+	{"", "jng", "jng", "Yangman", nil},
+	{"", "jni", "jni", "Janji", nil},
+	{"", "jnj", "jnj", "Yemsa", nil},
+	{"", "jnl", "jnl", "Rawat", nil},
+	{"", "jns", "jns", "Jaunsari", nil},
+	{"", "job", "job", "Joba", nil},
+	{"", "jod", "jod", "Wojenaka", nil},
+	{"", "jog", "jog", "Jogi", nil},
+	{"", "jor", "jor", "Jorá", nil},
+	{"", "jos", "jos", "Jordanian Sign Language", nil},
+	{"", "jow", "jow", "Jowulu", nil},
+	{"", "jpa", "jpa", "Jewish Palestinian Aramaic", nil},
+	{"ja", "jpn", "jpn", "Japanese", nil},
+	{"", "jpr", "jpr", "Judeo-Persian", nil},
+	{"", "jqr", "jqr", "Jaqaru", nil},
+	{"", "jra", "jra", "Jarai", nil},
+	{"", "jrb", "jrb", "Judeo-Arabic", nil},
+	{"", "jrr", "jrr", "Jiru", nil},
+	{"", "jrt", "jrt", "Jakattoe", nil},
+	{"", "jru", "jru", "Japrería", nil},
+	{"", "jsl", "jsl", "Japanese Sign Language", nil},
+	{"", "jua", "jua", "Júma", nil},
+	{"", "jub", "jub", "Wannu", nil},
+	{"", "juc", "juc", "Jurchen", nil},
+	{"", "jud", "jud", "Worodougou", nil},
+	{"", "juh", "juh", "Hõne", nil},
+	{"", "jui", "jui", "Ngadjuri", nil},
+	{"", "juk", "juk", "Wapan", nil},
+	{"", "jul", "jul", "Jirel", nil},
+	{"", "jum", "jum", "Jumjum", nil},
+	{"", "jun", "jun", "Juang", nil},
+	{"", "juo", "juo", "Jiba", nil},
+	{"", "jup", "jup", "Hupdë", nil},
+	{"", "jur", "jur", "Jurúna", nil},
+	{"", "jus", "jus", "Jumla Sign Language", nil},
+	{"", "jut", "jut", "Jutish", nil},
+	{"", "juu", "juu", "Ju", nil},
+	{"", "juw", "juw", "Wãpha", nil},
+	{"", "juy", "juy", "Juray", nil},
+	{"", "jvd", "jvd", "Javindo", nil},
+	{"", "jvn", "jvn", "Caribbean Javanese", nil},
+	{"", "jwi", "jwi", "Jwira-Pepesa", nil},
+	{"", "jya", "jya", "Jiarong", nil},
+	{"", "jye", "jye", "Judeo-Yemeni Arabic", nil},
+	{"", "jyy", "jyy", "Jaya", nil},
+	{"", "kaa", "kaa", "Kara-Kalpak", nil},
+	{"", "kab", "kab", "Kabyle", nil},
+	{"", "kac", "kac", "Kachin; Jingpho", nil},
+	{"", "kad", "kad", "Adara", nil},
+	{"", "kae", "kae", "Ketangalan", nil},
+	{"", "kaf", "kaf", "Katso", nil},
+	{"", "kag", "kag", "Kajaman", nil},
+	{"", "kah", "kah", "Kara (Central African Republic)", nil},
+	{"", "kai", "kai", "Karekare", nil},
+	{"", "kaj", "kaj", "Jju", nil},
+	{"", "kak", "kak", "Kalanguya", nil},
+	{"kl", "kal", "kal", "Kalaallisut; Greenlandic", nil},
+	{"", "kam", "kam", "Kamba", nil},
+	{"kn", "kan", "kan", "Kannada", nil},
+	{"", "kao", "kao", "Xaasongaxango", nil},
+	{"", "kap", "kap", "Bezhta", nil},
+	{"", "kaq", "kaq", "Capanahua", nil},
+	{"", "kar", "kar", "Karen languages", nil},
+	{"ks", "kas", "kas", "Kashmiri", nil},
+	{"ka", "geo", "kat", "Georgian", nil},
+	{"kr", "kau", "kau", "Kanuri", nil},
+	{"", "kav", "kav", "Katukína", nil},
+	{"", "kaw", "kaw", "Kawi", nil},
+	{"", "kax", "kax", "Kao", nil},
+	{"", "kay", "kay", "Kamayurá", nil},
+	{"kk", "kaz", "kaz", "Kazakh", nil},
+	{"", "kba", "kba", "Kalarko", nil},
+	{"", "kbb", "kbb", "Kaxuiâna", nil},
+	{"", "kbc", "kbc", "Kadiwéu", nil},
+	{"", "kbd", "kbd", "Kabardian", nil},
+	{"", "kbe", "kbe", "Kanju", nil},
+	{"", "kbg", "kbg", "Khamba", nil},
+	{"", "kbh", "kbh", "Camsá", nil},
+	{"", "kbi", "kbi", "Kaptiau", nil},
+	{"", "kbj", "kbj", "Kari", nil},
+	{"", "kbk", "kbk", "Grass Koiari", nil},
+	{"", "kbl", "kbl", "Kanembu", nil},
+	{"", "kbm", "kbm", "Iwal", nil},
+	{"", "kbn", "kbn", "Kare (Central African Republic)", nil},
+	{"", "kbo", "kbo", "Keliko", nil},
+	{"", "kbp", "kbp", "Kabiyè", nil},
+	{"", "kbq", "kbq", "Kamano", nil},
+	{"", "kbr", "kbr", "Kafa", nil},
+	{"", "kbs", "kbs", "Kande", nil},
+	{"", "kbt", "kbt", "Abadi", nil},
+	{"", "kbu", "kbu", "Kabutra", nil},
+	{"", "kbv", "kbv", "Dera (Indonesia)", nil},
+	{"", "kbw", "kbw", "Kaiep", nil},
+	{"", "kbx", "kbx", "Ap Ma", nil},
+	{"", "kby", "kby", "Manga Kanuri", nil},
+	{"", "kbz", "kbz", "Duhwa", nil},
+	{"", "kca", "kca", "Khanty", nil},
+	{"", "kcb", "kcb", "Kawacha", nil},
+	{"", "kcc", "kcc", "Lubila", nil},
+	{"", "kcd", "kcd", "Ngkâlmpw Kanum", nil},
+	{"", "kce", "kce", "Kaivi", nil},
+	{"", "kcf", "kcf", "Ukaan", nil},
+	{"", "kcg", "kcg", "Tyap", nil},
+	{"", "kch", "kch", "Vono", nil},
+	{"", "kci", "kci", "Kamantan", nil},
+	{"", "kcj", "kcj", "Kobiana", nil},
+	{"", "kck", "kck", "Kalanga", nil},
+	{"", "kcl", "kcl", "Kela (Papua New Guinea)", nil},
+	{"", "kcm", "kcm", "Gula (Central African Republic)", nil},
+	{"", "kcn", "kcn", "Nubi", nil},
+	{"", "kco", "kco", "Kinalakna", nil},
+	{"", "kcp", "kcp", "Kanga", nil},
+	{"", "kcq", "kcq", "Kamo", nil},
+	{"", "kcr", "kcr", "Katla", nil},
+	{"", "kcs", "kcs", "Koenoem", nil},
+	{"", "kct", "kct", "Kaian", nil},
+	{"", "kcu", "kcu", "Kami (Tanzania)", nil},
+	{"", "kcv", "kcv", "Kete", nil},
+	{"", "kcw", "kcw", "Kabwari", nil},
+	{"", "kcx", "kcx", "Kachama-Ganjule", nil},
+	{"", "kcy", "kcy", "Korandje", nil},
+	{"", "kcz", "kcz", "Konongo", nil},
+	{"", "kda", "kda", "Worimi", nil},
+	{"", "kdc", "kdc", "Kutu", nil},
+	{"", "kdd", "kdd", "Yankunytjatjara", nil},
+	{"", "kde", "kde", "Makonde", nil},
+	{"", "kdf", "kdf", "Mamusi", nil},
+	{"", "kdg", "kdg", "Seba", nil},
+	{"", "kdh", "kdh", "Tem", nil},
+	{"", "kdi", "kdi", "Kumam", nil},
+	{"", "kdj", "kdj", "Karamojong", nil},
+	{"", "kdk", "kdk", "Numèè", nil},
+	{"", "kdl", "kdl", "Tsikimba", nil},
+	{"", "kdm", "kdm", "Kagoma", nil},
+	{"", "kdn", "kdn", "Kunda", nil},
+	{"", "kdp", "kdp", "Kaningdon-Nindem", nil},
+	{"", "kdq", "kdq", "Koch", nil},
+	{"", "kdr", "kdr", "Karaim", nil},
+	{"", "kdt", "kdt", "Kuy", nil},
+	{"", "kdu", "kdu", "Kadaru", nil},
+	{"", "kdw", "kdw", "Koneraw", nil},
+	{"", "kdx", "kdx", "Kam", nil},
+	{"", "kdy", "kdy", "Keder", nil},
+	{"", "kdz", "kdz", "Kwaja", nil},
+	{"", "kea", "kea", "Kabuverdianu", nil},
+	{"", "keb", "keb", "Kélé", nil},
+	{"", "kec", "kec", "Keiga", nil},
+	{"", "ked", "ked", "Kerewe", nil},
+	{"", "kee", "kee", "Eastern Keres", nil},
+	{"", "kef", "kef", "Kpessi", nil},
+	{"", "keg", "keg", "Tese", nil},
+	{"", "keh", "keh", "Keak", nil},
+	{"", "kei", "kei", "Kei", nil},
+	{"", "kej", "kej", "Kadar", nil},
+	{"", "kek", "kek", "Kekchí", nil},
+	{"", "kel", "kel", "Kela (Democratic Republic of Congo)", nil},
+	{"", "kem", "kem", "Kemak", nil},
+	{"", "ken", "ken", "Kenyang", nil},
+	{"", "keo", "keo", "Kakwa", nil},
+	{"", "kep", "kep", "Kaikadi", nil},
+	{"", "keq", "keq", "Kamar", nil},
+	{"", "ker", "ker", "Kera", nil},
+	{"", "kes", "kes", "Kugbo", nil},
+	{"", "ket", "ket", "Ket", nil},
+	{"", "keu", "keu", "Akebu", nil},
+	{"", "kev", "kev", "Kanikkaran", nil},
+	{"", "kew", "kew", "West Kewa", nil},
+	{"", "kex", "kex", "Kukna", nil},
+	{"", "key", "key", "Kupia", nil},
+	{"", "kez", "kez", "Kukele", nil},
+	{"", "kfa", "kfa", "Kodava", nil},
+	{"", "kfb", "kfb", "Northwestern Kolami", nil},
+	{"", "kfc", "kfc", "Konda-Dora", nil},
+	{"", "kfd", "kfd", "Korra Koraga", nil},
+	{"", "kfe", "kfe", "Kota (India)", nil},
+	{"", "kff", "kff", "Koya", nil},
+	{"", "kfg", "kfg", "Kudiya", nil},
+	{"", "kfh", "kfh", "Kurichiya", nil},
+	{"", "kfi", "kfi", "Kannada Kurumba", nil},
+	{"", "kfj", "kfj", "Kemiehua", nil},
+	{"", "kfk", "kfk", "Kinnauri", nil},
+	{"", "kfl", "kfl", "Kung", nil},
+	{"", "kfm", "kfm", "Khunsari", nil},
+	{"", "kfn", "kfn", "Kuk", nil},
+	{"", "kfo", "kfo", "Koro (Côte d'Ivoire)", nil},
+	{"", "kfp", "kfp", "Korwa", nil},
+	{"", "kfq", "kfq", "Korku", nil},
+	{"", "kfr", "kfr", "Kachhi", nil},
+	{"", "kfs", "kfs", "Bilaspuri", nil},
+	{"", "kft", "kft", "Kanjari", nil},
+	{"", "kfu", "kfu", "Katkari", nil},
+	{"", "kfv", "kfv", "Kurmukar", nil},
+	{"", "kfw", "kfw", "Kharam Naga", nil},
+	{"", "kfx", "kfx", "Kullu Pahari", nil},
+	{"", "kfy", "kfy", "Kumaoni", nil},
+	{"", "kfz", "kfz", "Koromfé", nil},
+	{"", "kga", "kga", "Koyaga", nil},
+	{"", "kgb", "kgb", "Kawe", nil},
+	{"", "kge", "kge", "Komering", nil},
+	{"", "kgf", "kgf", "Kube", nil},
+	{"", "kgg", "kgg", "Kusunda", nil},
+	{"", "kgi", "kgi", "Selangor Sign Language", nil},
+	{"", "kgj", "kgj", "Gamale Kham", nil},
+	{"", "kgk", "kgk", "Kaiwá", nil},
+	{"", "kgl", "kgl", "Kunggari", nil},
+	{"", "kgm", "kgm", "Karipúna", nil},
+	{"", "kgn", "kgn", "Karingani", nil},
+	{"", "kgo", "kgo", "Krongo", nil},
+	{"", "kgp", "kgp", "Kaingang", nil},
+	{"", "kgq", "kgq", "Kamoro", nil},
+	{"", "kgr", "kgr", "Abun", nil},
+	{"", "kgs", "kgs", "Kumbainggar", nil},
+	{"", "kgt", "kgt", "Somyev", nil},
+	{"", "kgu", "kgu", "Kobol", nil},
+	{"", "kgv", "kgv", "Karas", nil},
+	{"", "kgw", "kgw", "Karon Dori", nil},
+	{"", "kgx", "kgx", "Kamaru", nil},
+	{"", "kgy", "kgy", "Kyerung", nil},
+	{"", "kha", "kha", "Khasi", nil},
+	{"", "khb", "khb", "Lü", nil},
+	{"", "khc", "khc", "Tukang Besi North", nil},
+	{"", "khd", "khd", "Bädi Kanum", nil},
+	{"", "khe", "khe", "Korowai", nil},
+	{"", "khf", "khf", "Khuen", nil},
+	{"", "khg", "khg", "Khams Tibetan", nil},
+	{"", "khh", "khh", "Kehu", nil},
+	{"", "khi", "khi", "Khoisan languages", nil},
+	{"", "khj", "khj", "Kuturmi", nil},
+	{"", "khk", "khk", "Halh Mongolian", nil},
+	{"", "khl", "khl", "Lusi", nil},
+	{"km", "khm", "khm", "Central Khmer", nil},
+	{"", "khn", "khn", "Khandesi", nil},
+	{"", "kho", "kho", "Khotanese; Sakan", nil},
+	{"", "khp", "khp", "Kapori", nil},
+	{"", "khq", "khq", "Koyra Chiini Songhay", nil},
+	{"", "khr", "khr", "Kharia", nil},
+	{"", "khs", "khs", "Kasua", nil},
+	{"", "kht", "kht", "Khamti", nil},
+	{"", "khu", "khu", "Nkhumbi", nil},
+	{"", "khv", "khv", "Khvarshi", nil},
+	{"", "khw", "khw", "Khowar", nil},
+	{"", "khx", "khx", "Kanu", nil},
+	{"", "khy", "khy", "Kele (Democratic Republic of Congo)", nil},
+	{"", "khz", "khz", "Keapara", nil},
+	{"", "kia", "kia", "Kim", nil},
+	{"", "kib", "kib", "Koalib", nil},
+	{"", "kic", "kic", "Kickapoo", nil},
+	{"", "kid", "kid", "Koshin", nil},
+	{"", "kie", "kie", "Kibet", nil},
+	{"", "kif", "kif", "Eastern Parbate Kham", nil},
+	{"", "kig", "kig", "Kimaama", nil},
+	{"", "kih", "kih", "Kilmeri", nil},
+	{"", "kii", "kii", "Kitsai", nil},
+	{"", "kij", "kij", "Kilivila", nil},
+	{"ki", "kik", "kik", "Kikuyu; Gikuyu", nil},
+	{"", "kil", "kil", "Kariya", nil},
+	{"", "kim", "kim", "Karagas", nil},
+	{"rw", "kin", "kin", "Kinyarwanda", nil},
+	{"", "kio", "kio", "Kiowa", nil},
+	{"", "kip", "kip", "Sheshi Kham", nil},
+	{"", "kiq", "kiq", "Kosadle", nil},
+	{"ky", "kir", "kir", "Kirghiz; Kyrgyz", nil},
+	{"", "kis", "kis", "Kis", nil},
+	{"", "kit", "kit", "Agob", nil},
+	{"", "kiu", "kiu", "Kirmanjki (individual language)", nil},
+	{"", "kiv", "kiv", "Kimbu", nil},
+	{"", "kiw", "kiw", "Northeast Kiwai", nil},
+	{"", "kix", "kix", "Khiamniungan Naga", nil},
+	{"", "kiy", "kiy", "Kirikiri", nil},
+	{"", "kiz", "kiz", "Kisi", nil},
+	{"", "kja", "kja", "Mlap", nil},
+	{"", "kjb", "kjb", "Q'anjob'al", nil},
+	{"", "kjc", "kjc", "Coastal Konjo", nil},
+	{"", "kjd", "kjd", "Southern Kiwai", nil},
+	{"", "kje", "kje", "Kisar", nil},
+	{"", "kjg", "kjg", "Khmu", nil},
+	{"", "kjh", "kjh", "Khakas", nil},
+	{"", "kji", "kji", "Zabana", nil},
+	{"", "kjj", "kjj", "Khinalugh", nil},
+	{"", "kjk", "kjk", "Highland Konjo", nil},
+	{"", "kjl", "kjl", "Western Parbate Kham", nil},
+	{"", "kjm", "kjm", "Kháng", nil},
+	{"", "kjn", "kjn", "Kunjen", nil},
+	{"", "kjo", "kjo", "Harijan Kinnauri", nil},
+	{"", "kjp", "kjp", "Pwo Eastern Karen", nil},
+	{"", "kjq", "kjq", "Western Keres", nil},
+	{"", "kjr", "kjr", "Kurudu", nil},
+	{"", "kjs", "kjs", "East Kewa", nil},
+	{"", "kjt", "kjt", "Phrae Pwo Karen", nil},
+	{"", "kju", "kju", "Kashaya", nil},
+	{"", "kjv", "kjv", "Kaikavian Literary Language", nil},
+	{"", "kjx", "kjx", "Ramopa", nil},
+	{"", "kjy", "kjy", "Erave", nil},
+	{"", "kjz", "kjz", "Bumthangkha", nil},
+	{"", "kka", "kka", "Kakanda", nil},
+	{"", "kkb", "kkb", "Kwerisa", nil},
+	{"", "kkc", "kkc", "Odoodee", nil},
+	{"", "kkd", "kkd", "Kinuku", nil},
+	{"", "kke", "kke", "Kakabe", nil},
+	{"", "kkf", "kkf", "Kalaktang Monpa", nil},
+	{"", "kkg", "kkg", "Mabaka Valley Kalinga", nil},
+	{"", "kkh", "kkh", "Khün", nil},
+	{"", "kki", "kki", "Kagulu", nil},
+	{"", "kkj", "kkj", "Kako", nil},
+	{"", "kkk", "kkk", "Kokota", nil},
+	{"", "kkl", "kkl", "Kosarek Yale", nil},
+	{"", "kkm", "kkm", "Kiong", nil},
+	{"", "kkn", "kkn", "Kon Keu", nil},
+	{"", "kko", "kko", "Karko", nil},
+	{"", "kkp", "kkp", "Gugubera", nil},
+	{"", "kkq", "kkq", "Kaeku", nil},
+	{"", "kkr", "kkr", "Kir-Balar", nil},
+	{"", "kks", "kks", "Giiwo", nil},
+	{"", "kkt", "kkt", "Koi", nil},
+	{"", "kku", "kku", "Tumi", nil},
+	{"", "kkv", "kkv", "Kangean", nil},
+	{"", "kkw", "kkw", "Teke-Kukuya", nil},
+	{"", "kkx", "kkx", "Kohin", nil},
+	{"", "kky", "kky", "Guugu Yimidhirr", nil},
+	{"", "kkz", "kkz", "Kaska", nil},
+	{"", "kla", "kla", "Klamath-Modoc", nil},
+	{"", "klb", "klb", "Kiliwa", nil},
+	{"", "klc", "klc", "Kolbila", nil},
+	{"", "kld", "kld", "Gamilaraay", nil},
+	{"", "kle", "kle", "Kulung (Nepal)", nil},
+	{"", "klf", "klf", "Kendeje", nil},
+	{"", "klg", "klg", "Tagakaulo", nil},
+	{"", "klh", "klh", "Weliki", nil},
+	{"", "kli", "kli", "Kalumpang", nil},
+	{"", "klj", "klj", "Khalaj", nil},
+	{"", "klk", "klk", "Kono (Nigeria)", nil},
+	{"", "kll", "kll", "Kagan Kalagan", nil},
+	{"", "klm", "klm", "Migum", nil},
+	{"", "kln", "kln", "Kalenjin", nil},
+	{"", "klo", "klo", "Kapya", nil},
+	{"", "klp", "klp", "Kamasa", nil},
+	{"", "klq", "klq", "Rumu", nil},
+	{"", "klr", "klr", "Khaling", nil},
+	{"", "kls", "kls", "Kalasha", nil},
+	{"", "klt", "klt", "Nukna", nil},
+	{"", "klu", "klu", "Klao", nil},
+	{"", "klv", "klv", "Maskelynes", nil},
+	{"", "klw", "klw", "Tado", nil},
+	{"", "klx", "klx", "Koluwawa", nil},
+	{"", "kly", "kly", "Kalao", nil},
+	{"", "klz", "klz", "Kabola", nil},
+	{"", "kma", "kma", "Konni", nil},
+	{"", "kmb", "kmb", "Kimbundu", nil},
+	{"", "kmc", "kmc", "Southern Dong", nil},
+	{"", "kmd", "kmd", "Majukayang Kalinga", nil},
+	{"", "kme", "kme", "Bakole", nil},
+	{"", "kmf", "kmf", "Kare (Papua New Guinea)", nil},
+	{"", "kmg", "kmg", "Kâte", nil},
+	{"", "kmh", "kmh", "Kalam", nil},
+	{"", "kmi", "kmi", "Kami (Nigeria)", nil},
+	{"", "kmj", "kmj", "Kumarbhag Paharia", nil},
+	{"", "kmk", "kmk", "Limos Kalinga", nil},
+	{"", "kml", "kml", "Tanudan Kalinga", nil},
+	{"", "kmm", "kmm", "Kom (India)", nil},
+	{"", "kmn", "kmn", "Awtuw", nil},
+	{"", "kmo", "kmo", "Kwoma", nil},
+	{"", "kmp", "kmp", "Gimme", nil},
+	{"", "kmq", "kmq", "Kwama", nil},
+	{"", "kmr", "kmr", "Northern Kurdish", nil},
+	{"", "kms", "kms", "Kamasau", nil},
+	{"", "kmt", "kmt", "Kemtuik", nil},
+	{"", "kmu", "kmu", "Kanite", nil},
+	{"", "kmv", "kmv", "Karipúna Creole French", nil},
+	{"", "kmw", "kmw", "Komo (Democratic Republic of Congo)", nil},
+	{"", "kmx", "kmx", "Waboda", nil},
+	{"", "kmy", "kmy", "Koma", nil},
+	{"", "kmz", "kmz", "Khorasani Turkish", nil},
+	{"", "kna", "kna", "Dera (Nigeria)", nil},
+	{"", "knb", "knb", "Lubuagan Kalinga", nil},
+	{"", "knc", "knc", "Central Kanuri", nil},
+	{"", "knd", "knd", "Konda", nil},
+	{"", "kne", "kne", "Kankanaey", nil},
+	{"", "knf", "knf", "Mankanya", nil},
+	{"", "kng", "kng", "Koongo", nil},
+	{"", "kni", "kni", "Kanufi", nil},
+	{"", "knj", "knj", "Western Kanjobal", nil},
+	{"", "knk", "knk", "Kuranko", nil},
+	{"", "knl", "knl", "Keninjal", nil},
+	{"", "knm", "knm", "Kanamarí", nil},
+	{"", "knn", "knn", "Konkani (individual language)", nil},
+	{"", "kno", "kno", "Kono (Sierra Leone)", nil},
+	{"", "knp", "knp", "Kwanja", nil},
+	{"", "knq", "knq", "Kintaq", nil},
+	{"", "knr", "knr", "Kaningra", nil},
+	{"", "kns", "kns", "Kensiu", nil},
+	{"", "knt", "knt", "Panoan Katukína", nil},
+	{"", "knu", "knu", "Kono (Guinea)", nil},
+	{"", "knv", "knv", "Tabo", nil},
+	{"", "knw", "knw", "Kung-Ekoka", nil},
+	{"", "knx", "knx", "Kendayan", nil},
+	{"", "kny", "kny", "Kanyok", nil},
+	{"", "knz", "knz", "Kalamsé", nil},
+	{"", "koa", "koa", "Konomala", nil},
+	{"", "koc", "koc", "Kpati", nil},
+	{"", "kod", "kod", "Kodi", nil},
+	{"", "koe", "koe", "Kacipo-Bale Suri", nil},
+	{"", "kof", "kof", "Kubi", nil},
+	{"", "kog", "kog", "Cogui", nil},
+	{"", "koh", "koh", "Koyo", nil},
+	{"", "koi", "koi", "Komi-Permyak", nil},
+	{"", "kok", "kok", "Konkani", nil},
+	{"", "kol", "kol", "Kol (Papua New Guinea)", nil},
+	{"kv", "kom", "kom", "Komi", nil},
+	{"kg", "kon", "kon", "Kongo", nil},
+	{"", "koo", "koo", "Konzo", nil},
+	{"", "kop", "kop", "Waube", nil},
+	{"", "koq", "koq", "Kota (Gabon)", nil},
+	{"ko", "kor", "kor", "Korean", nil},
+	{"", "kos", "kos", "Kosraean", nil},
+	{"", "kot", "kot", "Lagwan", nil},
+	{"", "kou", "kou", "Koke", nil},
+	{"", "kov", "kov", "Kudu-Camo", nil},
+	{"", "kow", "kow", "Kugama", nil},
+	{"", "koy", "koy", "Koyukon", nil},
+	{"", "koz", "koz", "Korak", nil},
+	{"", "kpa", "kpa", "Kutto", nil},
+	{"", "kpb", "kpb", "Mullu Kurumba", nil},
+	{"", "kpc", "kpc", "Curripaco", nil},
+	{"", "kpd", "kpd", "Koba", nil},
+	{"", "kpe", "kpe", "Kpelle", nil},
+	{"", "kpf", "kpf", "Komba", nil},
+	{"", "kpg", "kpg", "Kapingamarangi", nil},
+	{"", "kph", "kph", "Kplang", nil},
+	{"", "kpi", "kpi", "Kofei", nil},
+	{"", "kpj", "kpj", "Karajá", nil},
+	{"", "kpk", "kpk", "Kpan", nil},
+	{"", "kpl", "kpl", "Kpala", nil},
+	{"", "kpm", "kpm", "Koho", nil},
+	{"", "kpn", "kpn", "Kepkiriwát", nil},
+	{"", "kpo", "kpo", "Ikposo", nil},
+	{"", "kpq", "kpq", "Korupun-Sela", nil},
+	{"", "kpr", "kpr", "Korafe-Yegha", nil},
+	{"", "kps", "kps", "Tehit", nil},
+	{"", "kpt", "kpt", "Karata", nil},
+	{"", "kpu", "kpu", "Kafoa", nil},
+	{"", "kpv", "kpv", "Komi-Zyrian", nil},
+	{"", "kpw", "kpw", "Kobon", nil},
+	{"", "kpx", "kpx", "Mountain Koiali", nil},
+	{"", "kpy", "kpy", "Koryak", nil},
+	{"", "kpz", "kpz", "Kupsabiny", nil},
+	{"", "kqa", "kqa", "Mum", nil},
+	{"", "kqb", "kqb", "Kovai", nil},
+	{"", "kqc", "kqc", "Doromu-Koki", nil},
+	{"", "kqd", "kqd", "Koy Sanjaq Surat", nil},
+	{"", "kqe", "kqe", "Kalagan", nil},
+	{"", "kqf", "kqf", "Kakabai", nil},
+	{"", "kqg", "kqg", "Khe", nil},
+	{"", "kqh", "kqh", "Kisankasa", nil},
+	{"", "kqi", "kqi", "Koitabu", nil},
+	{"", "kqj", "kqj", "Koromira", nil},
+	{"", "kqk", "kqk", "Kotafon Gbe", nil},
+	{"", "kql", "kql", "Kyenele", nil},
+	{"", "kqm", "kqm", "Khisa", nil},
+	{"", "kqn", "kqn", "Kaonde", nil},
+	{"", "kqo", "kqo", "Eastern Krahn", nil},
+	{"", "kqp", "kqp", "Kimré", nil},
+	{"", "kqq", "kqq", "Krenak", nil},
+	{"", "kqr", "kqr", "Kimaragang", nil},
+	{"", "kqs", "kqs", "Northern Kissi", nil},
+	{"", "kqt", "kqt", "Klias River Kadazan", nil},
+	{"", "kqu", "kqu", "Seroa", nil},
+	{"", "kqv", "kqv", "Okolod", nil},
+	{"", "kqw", "kqw", "Kandas", nil},
+	{"", "kqx", "kqx", "Mser", nil},
+	{"", "kqy", "kqy", "Koorete", nil},
+	{"", "kqz", "kqz", "Korana", nil},
+	{"", "kra", "kra", "Kumhali", nil},
+	{"", "krb", "krb", "Karkin", nil},
+	{"", "krc", "krc", "Karachay-Balkar", nil},
+	{"", "krd", "krd", "Kairui-Midiki", nil},
+	{"", "kre", "kre", "Panará", nil},
+	{"", "krf", "krf", "Koro (Vanuatu)", nil},
+	{"", "krh", "krh", "Kurama", nil},
+	{"", "kri", "kri", "Krio", nil},
+	{"", "krj", "krj", "Kinaray-A", nil},
+	{"", "krk", "krk", "Kerek", nil},
+	{"", "krl", "krl", "Karelian", nil},
+	{"", "krn", "krn", "Sapo", nil},
+	{"", "kro", "kro", "Kru languages", nil},
+	{"", "krp", "krp", "Korop", nil},
+	{"", "krr", "krr", "Krung", nil},
+	{"", "krs", "krs", "Gbaya (Sudan)", nil},
+	{"", "krt", "krt", "Tumari Kanuri", nil},
+	{"", "kru", "kru", "Kurukh", nil},
+	{"", "krv", "krv", "Kavet", nil},
+	{"", "krw", "krw", "Western Krahn", nil},
+	{"", "krx", "krx", "Karon", nil},
+	{"", "kry", "kry", "Kryts", nil},
+	{"", "krz", "krz", "Sota Kanum", nil},
+	{"", "ksa", "ksa", "Shuwa-Zamani", nil},
+	{"", "ksb", "ksb", "Shambala", nil},
+	{"", "ksc", "ksc", "Southern Kalinga", nil},
+	{"", "ksd", "ksd", "Kuanua", nil},
+	{"", "kse", "kse", "Kuni", nil},
+	{"", "ksf", "ksf", "Bafia", nil},
+	{"", "ksg", "ksg", "Kusaghe", nil},
+	{"", "ksh", "ksh", "Kölsch", nil},
+	{"", "ksi", "ksi", "Krisa", nil},
+	{"", "ksj", "ksj", "Uare", nil},
+	{"", "ksk", "ksk", "Kansa", nil},
+	{"", "ksl", "ksl", "Kumalu", nil},
+	{"", "ksm", "ksm", "Kumba", nil},
+	{"", "ksn", "ksn", "Kasiguranin", nil},
+	{"", "kso", "kso", "Kofa", nil},
+	{"", "ksp", "ksp", "Kaba", nil},
+	{"", "ksq", "ksq", "Kwaami", nil},
+	{"", "ksr", "ksr", "Borong", nil},
+	{"", "kss", "kss", "Southern Kisi", nil},
+	{"", "kst", "kst", "Winyé", nil},
+	{"", "ksu", "ksu", "Khamyang", nil},
+	{"", "ksv", "ksv", "Kusu", nil},
+	{"", "ksw", "ksw", "S'gaw Karen", nil},
+	{"", "ksx", "ksx", "Kedang", nil},
+	{"", "ksy", "ksy", "Kharia Thar", nil},
+	{"", "ksz", "ksz", "Kodaku", nil},
+	{"", "kta", "kta", "Katua", nil},
+	{"", "ktb", "ktb", "Kambaata", nil},
+	{"", "ktc", "ktc", "Kholok", nil},
+	{"", "ktd", "ktd", "Kokata", nil},
+	{"", "kte", "kte", "Nubri", nil},
+	{"", "ktf", "ktf", "Kwami", nil},
+	{"", "ktg", "ktg", "Kalkutung", nil},
+	{"", "kth", "kth", "Karanga", nil},
+	{"", "kti", "kti", "North Muyu", nil},
+	{"", "ktj", "ktj", "Plapo Krumen", nil},
+	{"", "ktk", "ktk", "Kaniet", nil},
+	{"", "ktl", "ktl", "Koroshi", nil},
+	{"", "ktm", "ktm", "Kurti", nil},
+	{"", "ktn", "ktn", "Karitiâna", nil},
+	{"", "kto", "kto", "Kuot", nil},
+	{"", "ktp", "ktp", "Kaduo", nil},
+	{"", "ktq", "ktq", "Katabaga", nil},
+	{"", "kts", "kts", "South Muyu", nil},
+	{"", "ktt", "ktt", "Ketum", nil},
+	{"", "ktu", "ktu", "Kituba (Democratic Republic of Congo)", nil},
+	{"", "ktv", "ktv", "Eastern Katu", nil},
+	{"", "ktw", "ktw", "Kato", nil},
+	{"", "ktx", "ktx", "Kaxararí", nil},
+	{"", "kty", "kty", "Kango (Bas-Uélé District)", nil},
+	{"", "ktz", "ktz", "Juǀʼhoan", nil},
+	{"kj", "kua", "kua", "Kuanyama; Kwanyama", nil},
+	{"", "kub", "kub", "Kutep", nil},
+	{"", "kuc", "kuc", "Kwinsu", nil},
+	{"", "kud", "kud", "'Auhelawa", nil},
+	{"", "kue", "kue", "Kuman (Papua New Guinea)", nil},
+	{"", "kuf", "kuf", "Western Katu", nil},
+	{"", "kug", "kug", "Kupa", nil},
+	{"", "kuh", "kuh", "Kushi", nil},
+	{"", "kui", "kui", "Kuikúro-Kalapálo", nil},
+	{"", "kuj", "kuj", "Kuria", nil},
+	{"", "kuk", "kuk", "Kepo'", nil},
+	{"", "kul", "kul", "Kulere", nil},
+	{"", "kum", "kum", "Kumyk", nil},
+	{"", "kun", "kun", "Kunama", nil},
+	{"", "kuo", "kuo", "Kumukio", nil},
+	{"", "kup", "kup", "Kunimaipa", nil},
+	{"", "kuq", "kuq", "Karipuna", nil},
+	{"ku", "kur", "kur", "Kurdish", nil},
+	{"", "kus", "kus", "Kusaal", nil},
+	{"", "kut", "kut", "Kutenai", nil},
+	{"", "kuu", "kuu", "Upper Kuskokwim", nil},
+	{"", "kuv", "kuv", "Kur", nil},
+	{"", "kuw", "kuw", "Kpagua", nil},
+	{"", "kux", "kux", "Kukatja", nil},
+	{"", "kuy", "kuy", "Kuuku-Ya'u", nil},
+	{"", "kuz", "kuz", "Kunza", nil},
+	{"", "kva", "kva", "Bagvalal", nil},
+	{"", "kvb", "kvb", "Kubu", nil},
+	{"", "kvc", "kvc", "Kove", nil},
+	{"", "kvd", "kvd", "Kui (Indonesia)", nil},
+	{"", "kve", "kve", "Kalabakan", nil},
+	{"", "kvf", "kvf", "Kabalai", nil},
+	{"", "kvg", "kvg", "Kuni-Boazi", nil},
+	{"", "kvh", "kvh", "Komodo", nil},
+	{"", "kvi", "kvi", "Kwang", nil},
+	{"", "kvj", "kvj", "Psikye", nil},
+	{"", "kvk", "kvk", "Korean Sign Language", nil},
+	{"", "kvl", "kvl", "Kayaw", nil},
+	{"", "kvm", "kvm", "Kendem", nil},
+	{"", "kvn", "kvn", "Border Kuna", nil},
+	{"", "kvo", "kvo", "Dobel", nil},
+	{"", "kvp", "kvp", "Kompane", nil},
+	{"", "kvq", "kvq", "Geba Karen", nil},
+	{"", "kvr", "kvr", "Kerinci", nil},
+	{"", "kvt", "kvt", "Lahta Karen", nil},
+	{"", "kvu", "kvu", "Yinbaw Karen", nil},
+	{"", "kvv", "kvv", "Kola", nil},
+	{"", "kvw", "kvw", "Wersing", nil},
+	{"", "kvx", "kvx", "Parkari Koli", nil},
+	{"", "kvy", "kvy", "Yintale Karen", nil},
+	{"", "kvz", "kvz", "Tsakwambo", nil},
+	{"", "kwa", "kwa", "Dâw", nil},
+	{"", "kwb", "kwb", "Kwa", nil},
+	{"", "kwc", "kwc", "Likwala", nil},
+	{"", "kwd", "kwd", "Kwaio", nil},
+	{"", "kwe", "kwe", "Kwerba", nil},
+	{"", "kwf", "kwf", "Kwara'ae", nil},
+	{"", "kwg", "kwg", "Sara Kaba Deme", nil},
+	{"", "kwh", "kwh", "Kowiai", nil},
+	{"", "kwi", "kwi", "Awa-Cuaiquer", nil},
+	{"", "kwj", "kwj", "Kwanga", nil},
+	{"", "kwk", "kwk", "Kwakiutl", nil},
+	{"", "kwl", "kwl", "Kofyar", nil},
+	{"", "kwm", "kwm", "Kwambi", nil},
+	{"", "kwn", "kwn", "Kwangali", nil},
+	{"", "kwo", "kwo", "Kwomtari", nil},
+	{"", "kwp", "kwp", "Kodia", nil},
+	{"", "kwr", "kwr", "Kwer", nil},
+	{"", "kws", "kws", "Kwese", nil},
+	{"", "kwt", "kwt", "Kwesten", nil},
+	{"", "kwu", "kwu", "Kwakum", nil},
+	{"", "kwv", "kwv", "Sara Kaba Náà", nil},
+	{"", "kww", "kww", "Kwinti", nil},
+	{"", "kwx", "kwx", "Khirwar", nil},
+	{"", "kwy", "kwy", "San Salvador Kongo", nil},
+	{"", "kwz", "kwz", "Kwadi", nil},
+	{"", "kxa", "kxa", "Kairiru", nil},
+	{"", "kxb", "kxb", "Krobu", nil},
+	{"", "kxc", "kxc", "Konso", nil},
+	{"", "kxd", "kxd", "Brunei", nil},
+	{"", "kxf", "kxf", "Manumanaw Karen", nil},
+	{"", "kxh", "kxh", "Karo (Ethiopia)", nil},
+	{"", "kxi", "kxi", "Keningau Murut", nil},
+	{"", "kxj", "kxj", "Kulfa", nil},
+	{"", "kxk", "kxk", "Zayein Karen", nil},
+	{"", "kxm", "kxm", "Northern Khmer", nil},
+	{"", "kxn", "kxn", "Kanowit-Tanjong Melanau", nil},
+	{"", "kxo", "kxo", "Kanoé", nil},
+	{"", "kxp", "kxp", "Wadiyara Koli", nil},
+	{"", "kxq", "kxq", "Smärky Kanum", nil},
+	{"", "kxr", "kxr", "Koro (Papua New Guinea)", nil},
+	{"", "kxs", "kxs", "Kangjia", nil},
+	{"", "kxt", "kxt", "Koiwat", nil},
+	{"", "kxv", "kxv", "Kuvi", nil},
+	{"", "kxw", "kxw", "Konai", nil},
+	{"", "kxx", "kxx", "Likuba", nil},
+	{"", "kxy", "kxy", "Kayong", nil},
+	{"", "kxz", "kxz", "Kerewo", nil},
+	{"", "kya", "kya", "Kwaya", nil},
+	{"", "kyb", "kyb", "Butbut Kalinga", nil},
+	{"", "kyc", "kyc", "Kyaka", nil},
+	{"", "kyd", "kyd", "Karey", nil},
+	{"", "kye", "kye", "Krache", nil},
+	{"", "kyf", "kyf", "Kouya", nil},
+	{"", "kyg", "kyg", "Keyagana", nil},
+	{"", "kyh", "kyh", "Karok", nil},
+	{"", "kyi", "kyi", "Kiput", nil},
+	{"", "kyj", "kyj", "Karao", nil},
+	{"", "kyk", "kyk", "Kamayo", nil},
+	{"", "kyl", "kyl", "Kalapuya", nil},
+	{"", "kym", "kym", "Kpatili", nil},
+	{"", "kyn", "kyn", "Northern Binukidnon", nil},
+	{"", "kyo", "kyo", "Kelon", nil},
+	{"", "kyp", "kyp", "Kang", nil},
+	{"", "kyq", "kyq", "Kenga", nil},
+	{"", "kyr", "kyr", "Kuruáya", nil},
+	{"", "kys", "kys", "Baram Kayan", nil},
+	{"", "kyt", "kyt", "Kayagar", nil},
+	{"", "kyu", "kyu", "Western Kayah", nil},
+	{"", "kyv", "kyv", "Kayort", nil},
+	{"", "kyw", "kyw", "Kudmali", nil},
+	{"", "kyx", "kyx", "Rapoisi", nil},
+	{"", "kyy", "kyy", "Kambaira", nil},
+	{"", "kyz", "kyz", "Kayabí", nil},
+	{"", "kza", "kza", "Western Karaboro", nil},
+	{"", "kzb", "kzb", "Kaibobo", nil},
+	{"", "kzc", "kzc", "Bondoukou Kulango", nil},
+	{"", "kzd", "kzd", "Kadai", nil},
+	{"", "kze", "kze", "Kosena", nil},
+	{"", "kzf", "kzf", "Da'a Kaili", nil},
+	{"", "kzg", "kzg", "Kikai", nil},
+	{"", "kzi", "kzi", "Kelabit", nil},
+	{"", "kzk", "kzk", "Kazukuru", nil},
+	{"", "kzl", "kzl", "Kayeli", nil},
+	{"", "kzm", "kzm", "Kais", nil},
+	{"", "kzn", "kzn", "Kokola", nil},
+	{"", "kzo", "kzo", "Kaningi", nil},
+	{"", "kzp", "kzp", "Kaidipang", nil},
+	{"", "kzq", "kzq", "Kaike", nil},
+	{"", "kzr", "kzr", "Karang", nil},
+	{"", "kzs", "kzs", "Sugut Dusun", nil},
+	{"", "kzu", "kzu", "Kayupulau", nil},
+	{"", "kzv", "kzv", "Komyandaret", nil},
+	{"", "kzw", "kzw", "Karirí-Xocó", nil},
+	{"", "kzx", "kzx", "Kamarian", nil},
+	{"", "kzy", "kzy", "Kango (Tshopo District)", nil},
+	{"", "kzz", "kzz", "Kalabra", nil},
+	{"", "laa", "laa", "Southern Subanen", nil},
+	{"", "lab", "lab", "Linear A", nil},
+	{"", "lac", "lac", "Lacandon", nil},
+	{"", "lad", "lad", "Ladino", nil},
+	{"", "lae", "lae", "Pattani", nil},
+	{"", "laf", "laf", "Lafofa", nil},
+	{"", "lag", "lag", "Langi", nil},
+	{"", "lah", "lah", "Lahnda", nil},
+	{"", "lai", "lai", "Lambya", nil},
+	{"", "laj", "laj", "Lango (Uganda)", nil},
+	{"", "lal", "lal", "Lalia", nil},
+	{"", "lam", "lam", "Lamba", nil},
+	{"", "lan", "lan", "Laru", nil},
+	{"lo", "lao", "lao", "Lao", nil},
+	{"", "lap", "lap", "Laka (Chad)", nil},
+	{"", "laq", "laq", "Qabiao", nil},
+	{"", "lar", "lar", "Larteh", nil},
+	{"", "las", "las", "Lama (Togo)", nil},
+	{"la", "lat", "lat", "Latin", nil},
+	{"", "lau", "lau", "Laba", nil},
+	{"lv", "lav", "lav", "Latvian", nil},
+	{"", "law", "law", "Lauje", nil},
+	{"", "lax", "lax", "Tiwa", nil},
+	{"", "lay", "lay", "Lama Bai", nil},
+	{"", "laz", "laz", "Aribwatsa", nil},
+	{"", "lbb", "lbb", "Label", nil},
+	{"", "lbc", "lbc", "Lakkia", nil},
+	{"", "lbe", "lbe", "Lak", nil},
+	{"", "lbf", "lbf", "Tinani", nil},
+	{"", "lbg", "lbg", "Laopang", nil},
+	{"", "lbi", "lbi", "La'bi", nil},
+	{"", "lbj", "lbj", "Ladakhi", nil},
+	{"", "lbk", "lbk", "Central Bontok", nil},
+	{"", "lbl", "lbl", "Libon Bikol", nil},
+	{"", "lbm", "lbm", "Lodhi", nil},
+	{"", "lbn", "lbn", "Rmeet", nil},
+	{"", "lbo", "lbo", "Laven", nil},
+	{"", "lbq", "lbq", "Wampar", nil},
+	{"", "lbr", "lbr", "Lohorung", nil},
+	{"", "lbs", "lbs", "Libyan Sign Language", nil},
+	{"", "lbt", "lbt", "Lachi", nil},
+	{"", "lbu", "lbu", "Labu", nil},
+	{"", "lbv", "lbv", "Lavatbura-Lamusong", nil},
+	{"", "lbw", "lbw", "Tolaki", nil},
+	{"", "lbx", "lbx", "Lawangan", nil},
+	{"", "lby", "lby", "Lamalama", nil},
+	{"", "lbz", "lbz", "Lardil", nil},
+	{"", "lcc", "lcc", "Legenyem", nil},
+	{"", "lcd", "lcd", "Lola", nil},
+	{"", "lce", "lce", "Loncong", nil},
+	{"", "lcf", "lcf", "Lubu", nil},
+	{"", "lch", "lch", "Luchazi", nil},
+	{"", "lcl", "lcl", "Lisela", nil},
+	{"", "lcm", "lcm", "Tungag", nil},
+	{"", "lcp", "lcp", "Western Lawa", nil},
+	{"", "lcq", "lcq", "Luhu", nil},
+	{"", "lcs", "lcs", "Lisabata-Nuniali", nil},
+	{"", "lda", "lda", "Kla-Dan", nil},
+	{"", "ldb", "ldb", "Dũya", nil},
+	{"", "ldd", "ldd", "Luri", nil},
+	{"", "ldg", "ldg", "Lenyima", nil},
+	{"", "ldh", "ldh", "Lamja-Dengsa-Tola", nil},
+	{"", "ldi", "ldi", "Laari", nil},
+	{"", "ldj", "ldj", "Lemoro", nil},
+	{"", "ldk", "ldk", "Leelau", nil},
+	{"", "ldl", "ldl", "Kaan", nil},
+	{"", "ldm", "ldm", "Landoma", nil},
+	{"", "ldn", "ldn", "Láadan", nil},
+	{"", "ldo", "ldo", "Loo", nil},
+	{"", "ldp", "ldp", "Tso", nil},
+	{"", "ldq", "ldq", "Lufu", nil},
+	{"", "lea", "lea", "Lega-Shabunda", nil},
+	{"", "leb", "leb", "Lala-Bisa", nil},
+	{"", "lec", "lec", "Leco", nil},
+	{"", "led", "led", "Lendu", nil},
+	{"", "lee", "lee", "Lyélé", nil},
+	{"", "lef", "lef", "Lelemi", nil},
+	{"", "leh", "leh", "Lenje", nil},
+	{"", "lei", "lei", "Lemio", nil},
+	{"", "lej", "lej", "Lengola", nil},
+	{"", "lek", "lek", "Leipon", nil},
+	{"", "lel", "lel", "Lele (Democratic Republic of Congo)", nil},
+	{"", "lem", "lem", "Nomaande", nil},
+	{"", "len", "len", "Lenca", nil},
+	{"", "leo", "leo", "Leti (Cameroon)", nil},
+	{"", "lep", "lep", "Lepcha", nil},
+	{"", "leq", "leq", "Lembena", nil},
+	{"", "ler", "ler", "Lenkau", nil},
+	{"", "les", "les", "Lese", nil},
+	{"", "let", "let", "Lesing-Gelimi", nil},
+	{"", "leu", "leu", "Kara (Papua New Guinea)", nil},
+	{"", "lev", "lev", "Lamma", nil},
+	{"", "lew", "lew", "Ledo Kaili", nil},
+	{"", "lex", "lex", "Luang", nil},
+	{"", "ley", "ley", "Lemolang", nil},
+	{"", "lez", "lez", "Lezghian", nil},
+	{"", "lfa", "lfa", "Lefa", nil},
+	{"", "lfn", "lfn", "Lingua Franca Nova", nil},
+	{"", "lga", "lga", "Lungga", nil},
+	{"", "lgb", "lgb", "Laghu", nil},
+	{"", "lgg", "lgg", "Lugbara", nil},
+	{"", "lgh", "lgh", "Laghuu", nil},
+	{"", "lgi", "lgi", "Lengilu", nil},
+	{"", "lgk", "lgk", "Lingarak", nil},
+	{"", "lgl", "lgl", "Wala", nil},
+	{"", "lgm", "lgm", "Lega-Mwenga", nil},
+	{"", "lgn", "lgn", "T'apo", nil},
+	{"", "lgo", "lgo", "Lango (South Sudan)", nil},
+	{"", "lgq", "lgq", "Logba", nil},
+	{"", "lgr", "lgr", "Lengo", nil},
+	{"", "lgt", "lgt", "Pahi", nil},
+	{"", "lgu", "lgu", "Longgu", nil},
+	{"", "lgz", "lgz", "Ligenza", nil},
+	{"", "lha", "lha", "Laha (Viet Nam)", nil},
+	{"", "lhh", "lhh", "Laha (Indonesia)", nil},
+	{"", "lhi", "lhi", "Lahu Shi", nil},
+	{"", "lhl", "lhl", "Lahul Lohar", nil},
+	{"", "lhm", "lhm", "Lhomi", nil},
+	{"", "lhn", "lhn", "Lahanan", nil},
+	{"", "lhp", "lhp", "Lhokpu", nil},
+	{"", "lhs", "lhs", "Mlahsö", nil},
+	{"", "lht", "lht", "Lo-Toga", nil},
+	{"", "lhu", "lhu", "Lahu", nil},
+	{"", "lia", "lia", "West-Central Limba", nil},
+	{"", "lib", "lib", "Likum", nil},
+	{"", "lic", "lic", "Hlai", nil},
+	{"", "lid", "lid", "Nyindrou", nil},
+	{"", "lie", "lie", "Likila", nil},
+	{"", "lif", "lif", "Limbu", nil},
+	{"", "lig", "lig", "Ligbi", nil},
+	{"", "lih", "lih", "Lihir", nil},
+	{"", "lij", "lij", "Ligurian", nil},
+	{"", "lik", "lik", "Lika", nil},
+	{"", "lil", "lil", "Lillooet", nil},
+	{"li", "lim", "lim", "Limburgan; Limburger; Limburgish", nil},
+	{"ln", "lin", "lin", "Lingala", nil},
+	{"", "lio", "lio", "Liki", nil},
+	{"", "lip", "lip", "Sekpele", nil},
+	{"", "liq", "liq", "Libido", nil},
+	{"", "lir", "lir", "Liberian English", nil},
+	{"", "lis", "lis", "Lisu", nil},
+	{"lt", "lit", "lit", "Lithuanian", nil},
+	{"", "liu", "liu", "Logorik", nil},
+	{"", "liv", "liv", "Liv", nil},
+	{"", "liw", "liw", "Col", nil},
+	{"", "lix", "lix", "Liabuku", nil},
+	{"", "liy", "liy", "Banda-Bambari", nil},
+	{"", "liz", "liz", "Libinza", nil},
+	{"", "lja", "lja", "Golpa", nil},
+	{"", "lje", "lje", "Rampi", nil},
+	{"", "lji", "lji", "Laiyolo", nil},
+	{"", "ljl", "ljl", "Li'o", nil},
+	{"", "ljp", "ljp", "Lampung Api", nil},
+	{"", "ljw", "ljw", "Yirandali", nil},
+	{"", "ljx", "ljx", "Yuru", nil},
+	{"", "lka", "lka", "Lakalei", nil},
+	{"", "lkb", "lkb", "Kabras", nil},
+	{"", "lkc", "lkc", "Kucong", nil},
+	{"", "lkd", "lkd", "Lakondê", nil},
+	{"", "lke", "lke", "Kenyi", nil},
+	{"", "lkh", "lkh", "Lakha", nil},
+	{"", "lki", "lki", "Laki", nil},
+	{"", "lkj", "lkj", "Remun", nil},
+	{"", "lkl", "lkl", "Laeko-Libuat", nil},
+	{"", "lkm", "lkm", "Kalaamaya", nil},
+	{"", "lkn", "lkn", "Lakon", nil},
+	{"", "lko", "lko", "Khayo", nil},
+	{"", "lkr", "lkr", "Päri", nil},
+	{"", "lks", "lks", "Kisa", nil},
+	{"", "lkt", "lkt", "Lakota", nil},
+	{"", "lku", "lku", "Kungkari", nil},
+	{"", "lky", "lky", "Lokoya", nil},
+	{"", "lla", "lla", "Lala-Roba", nil},
+	{"", "llb", "llb", "Lolo", nil},
+	{"", "llc", "llc", "Lele (Guinea)", nil},
+	{"", "lld", "lld", "Ladin", nil},
+	{"", "lle", "lle", "Lele (Papua New Guinea)", nil},
+	{"", "llf", "llf", "Hermit", nil},
+	{"", "llg", "llg", "Lole", nil},
+	{"", "llh", "llh", "Lamu", nil},
+	{"", "lli", "lli", "Teke-Laali", nil},
+	{"", "llj", "llj", "Ladji Ladji", nil},
+	{"", "llk", "llk", "Lelak", nil},
+	{"", "lll", "lll", "Lilau", nil},
+	{"", "llm", "llm", "Lasalimu", nil},
+	{"", "lln", "lln", "Lele (Chad)", nil},
+	{"", "llp", "llp", "North Efate", nil},
+	{"", "llq", "llq", "Lolak", nil},
+	{"", "lls", "lls", "Lithuanian Sign Language", nil},
+	{"", "llu", "llu", "Lau", nil},
+	{"", "llx", "llx", "Lauan", nil},
+	{"", "lma", "lma", "East Limba", nil},
+	{"", "lmb", "lmb", "Merei", nil},
+	{"", "lmc", "lmc", "Limilngan", nil},
+	{"", "lmd", "lmd", "Lumun", nil},
+	{"", "lme", "lme", "Pévé", nil},
+	{"", "lmf", "lmf", "South Lembata", nil},
+	{"", "lmg", "lmg", "Lamogai", nil},
+	{"", "lmh", "lmh", "Lambichhong", nil},
+	{"", "lmi", "lmi", "Lombi", nil},
+	{"", "lmj", "lmj", "West Lembata", nil},
+	{"", "lmk", "lmk", "Lamkang", nil},
+	{"", "lml", "lml", "Hano", nil},
+	{"", "lmn", "lmn", "Lambadi", nil},
+	{"", "lmo", "lmo", "Lombard", nil},
+	{"", "lmp", "lmp", "Limbum", nil},
+	{"", "lmq", "lmq", "Lamatuka", nil},
+	{"", "lmr", "lmr", "Lamalera", nil},
+	{"", "lmu", "lmu", "Lamenu", nil},
+	{"", "lmv", "lmv", "Lomaiviti", nil},
+	{"", "lmw", "lmw", "Lake Miwok", nil},
+	{"", "lmx", "lmx", "Laimbue", nil},
+	{"", "lmy", "lmy", "Lamboya", nil},
+	{"", "lna", "lna", "Langbashe", nil},
+	{"", "lnb", "lnb", "Mbalanhu", nil},
+	{"", "lnd", "lnd", "Lundayeh", nil},
+	{"", "lng", "lng", "Langobardic", nil},
+	{"", "lnh", "lnh", "Lanoh", nil},
+	{"", "lni", "lni", "Daantanai'", nil},
+	{"", "lnj", "lnj", "Leningitij", nil},
+	{"", "lnl", "lnl", "South Central Banda", nil},
+	{"", "lnm", "lnm", "Langam", nil},
+	{"", "lnn", "lnn", "Lorediakarkar", nil},
+	{"", "lns", "lns", "Lamnso'", nil},
+	{"", "lnu", "lnu", "Longuda", nil},
+	{"", "lnw", "lnw", "Lanima", nil},
+	{"", "lnz", "lnz", "Lonzo", nil},
+	{"", "loa", "loa", "Loloda", nil},
+	{"", "lob", "lob", "Lobi", nil},
+	{"", "loc", "loc", "Inonhan", nil},
+	{"", "loe", "loe", "Saluan", nil},
+	{"", "lof", "lof", "Logol", nil},
+	{"", "log", "log", "Logo", nil},
+	{"", "loh", "loh", "Narim", nil},
+	{"", "loi", "loi", "Loma (Côte d'Ivoire)", nil},
+	{"", "loj", "loj", "Lou", nil},
+	{"", "lok", "lok", "Loko", nil},
+	{"", "lol", "lol", "Mongo", nil},
+	{"", "lom", "lom", "Loma (Liberia)", nil},
+	{"", "lon", "lon", "Malawi Lomwe", nil},
+	{"", "loo", "loo", "Lombo", nil},
+	{"", "lop", "lop", "Lopa", nil},
+	{"", "loq", "loq", "Lobala", nil},
+	{"", "lor", "lor", "Téén", nil},
+	{"", "los", "los", "Loniu", nil},
+	{"", "lot", "lot", "Otuho", nil},
+	{"", "lou", "lou", "Louisiana Creole", nil},
+	{"", "lov", "lov", "Lopi", nil},
+	{"", "low", "low", "Tampias Lobu", nil},
+	{"", "lox", "lox", "Loun", nil},
+	{"", "loy", "loy", "Loke", nil},
+	{"", "loz", "loz", "Lozi", nil},
+	{"", "lpa", "lpa", "Lelepa", nil},
+	{"", "lpe", "lpe", "Lepki", nil},
+	{"", "lpn", "lpn", "Long Phuri Naga", nil},
+	{"", "lpo", "lpo", "Lipo", nil},
+	{"", "lpx", "lpx", "Lopit", nil},
+	{"", "lqr", "lqr", "Logir", nil},
+	{"", "lra", "lra", "Rara Bakati'", nil},
+	{"", "lrc", "lrc", "Northern Luri", nil},
+	{"", "lre", "lre", "Laurentian", nil},
+	{"", "lrg", "lrg", "Laragia", nil},
+	{"", "lri", "lri", "Marachi", nil},
+	{"", "lrk", "lrk", "Loarki", nil},
+	{"", "lrl", "lrl", "Lari", nil},
+	{"", "lrm", "lrm", "Marama", nil},
+	{"", "lrn", "lrn", "Lorang", nil},
+	{"", "lro", "lro", "Laro", nil},
+	{"", "lrr", "lrr", "Southern Yamphu", nil},
+	{"", "lrt", "lrt", "Larantuka Malay", nil},
+	{"", "lrv", "lrv", "Larevat", nil},
+	{"", "lrz", "lrz", "Lemerig", nil},
+	{"", "lsa", "lsa", "Lasgerdi", nil},
+	{"", "lsb", "lsb", "Burundian Sign Language", nil},
+	{"", "lsc", "lsc", "Albarradas Sign Language", nil},
+	{"", "lsd", "lsd", "Lishana Deni", nil},
+	{"", "lse", "lse", "Lusengo", nil},
+	{"", "lsh", "lsh", "Lish", nil},
+	{"", "lsi", "lsi", "Lashi", nil},
+	{"", "lsl", "lsl", "Latvian Sign Language", nil},
+	{"", "lsm", "lsm", "Saamia", nil},
+	{"", "lsn", "lsn", "Tibetan Sign Language", nil},
+	{"", "lso", "lso", "Laos Sign Language", nil},
+	{"", "lsp", "lsp", "Panamanian Sign Language", nil},
+	{"", "lsr", "lsr", "Aruop", nil},
+	{"", "lss", "lss", "Lasi", nil},
+	{"", "lst", "lst", "Trinidad and Tobago Sign Language", nil},
+	{"", "lsv", "lsv", "Sivia Sign Language", nil},
+	{"", "lsw", "lsw", "Seychelles Sign Language", nil},
+	{"", "lsy", "lsy", "Mauritian Sign Language", nil},
+	{"", "ltc", "ltc", "Late Middle Chinese", nil},
+	{"", "ltg", "ltg", "Latgalian", nil},
+	{"", "lth", "lth", "Thur", nil},
+	{"", "lti", "lti", "Leti (Indonesia)", nil},
+	{"", "ltn", "ltn", "Latundê", nil},
+	{"", "lto", "lto", "Tsotso", nil},
+	{"", "lts", "lts", "Tachoni", nil},
+	{"", "ltu", "ltu", "Latu", nil},
+	{"lb", "ltz", "ltz", "Luxembourgish; Letzeburgesch", nil},
+	{"", "lua", "lua", "Luba-Lulua", nil},
+	{"lu", "lub", "lub", "Luba-Katanga", nil},
+	{"", "luc", "luc", "Aringa", nil},
+	{"", "lud", "lud", "Ludian", nil},
+	{"", "lue", "lue", "Luvale", nil},
+	{"", "luf", "luf", "Laua", nil},
+	{"lg", "lug", "lug", "Ganda", nil},
+	{"", "lui", "lui", "Luiseno", nil},
+	{"", "luj", "luj", "Luna", nil},
+	{"", "luk", "luk", "Lunanakha", nil},
+	{"", "lul", "lul", "Olu'bo", nil},
+	{"", "lum", "lum", "Luimbi", nil},
+	{"", "lun", "lun", "Lunda", nil},
+	{"", "luo", "luo", "Luo (Kenya and Tanzania)", nil},
+	{"", "lup", "lup", "Lumbu", nil},
+	{"", "luq", "luq", "Lucumi", nil},
+	{"", "lur", "lur", "Laura", nil},
+	{"", "lus", "lus", "Lushai", nil},
+	{"", "lut", "lut", "Lushootseed", nil},
+	{"", "luu", "luu", "Lumba-Yakkha", nil},
+	{"", "luv", "luv", "Luwati", nil},
+	{"", "luw", "luw", "Luo (Cameroon)", nil},
+	{"", "luy", "luy", "Luyia", nil},
+	{"", "luz", "luz", "Southern Luri", nil},
+	{"", "lva", "lva", "Maku'a", nil},
+	{"", "lvi", "lvi", "Lavi", nil},
+	{"", "lvk", "lvk", "Lavukaleve", nil},
+	{"", "lvs", "lvs", "Standard Latvian", nil},
+	{"", "lvu", "lvu", "Levuka", nil},
+	{"", "lwa", "lwa", "Lwalu", nil},
+	{"", "lwe", "lwe", "Lewo Eleng", nil},
+	{"", "lwg", "lwg", "Wanga", nil},
+	{"", "lwh", "lwh", "White Lachi", nil},
+	{"", "lwl", "lwl", "Eastern Lawa", nil},
+	{"", "lwm", "lwm", "Laomian", nil},
+	{"", "lwo", "lwo", "Luwo", nil},
+	{"", "lws", "lws", "Malawian Sign Language", nil},
+	{"", "lwt", "lwt", "Lewotobi", nil},
+	{"", "lwu", "lwu", "Lawu", nil},
+	{"", "lww", "lww", "Lewo", nil},
+	{"", "lxm", "lxm", "Lakurumau", nil},
+	{"", "lya", "lya", "Layakha", nil},
+	{"", "lyg", "lyg", "Lyngngam", nil},
+	{"", "lyn", "lyn", "Luyana", nil},
+	{"", "lzh", "lzh", "Literary Chinese", nil},
+	{"", "lzl", "lzl", "Litzlitz", nil},
+	{"", "lzn", "lzn", "Leinong Naga", nil},
+	{"", "lzz", "lzz", "Laz", nil},
+	{"", "maa", "maa", "San Jerónimo Tecóatl Mazatec", nil},
+	{"", "mab", "mab", "Yutanduchi Mixtec", nil},
+	{"", "mad", "mad", "Madurese", nil},
+	{"", "mae", "mae", "Bo-Rukul", nil},
+	{"", "maf", "maf", "Mafa", nil},
+	{"", "mag", "mag", "Magahi", nil},
+	{"mh", "mah", "mah", "Marshallese", nil},
+	{"", "mai", "mai", "Maithili", nil},
+	{"", "maj", "maj", "Jalapa De Díaz Mazatec", nil},
+	{"", "mak", "mak", "Makasar", nil},
+	{"ml", "mal", "mal", "Malayalam", nil},
+	{"", "mam", "mam", "Mam", nil},
+	{"", "man", "man", "Mandingo", nil},
+	{"", "map", "map", "Austronesian languages", nil},
+	{"", "maq", "maq", "Chiquihuitlán Mazatec", nil},
+	{"mr", "mar", "mar", "Marathi", nil},
+	{"", "mas", "mas", "Masai", nil},
+	{"", "mat", "mat", "San Francisco Matlatzinca", nil},
+	{"", "mau", "mau", "Huautla Mazatec", nil},
+	{"", "mav", "mav", "Sateré-Mawé", nil},
+	{"", "maw", "maw", "Mampruli", nil},
+	{"", "max", "max", "North Moluccan Malay", nil},
+	{"", "maz", "maz", "Central Mazahua", nil},
+	{"", "mba", "mba", "Higaonon", nil},
+	{"", "mbb", "mbb", "Western Bukidnon Manobo", nil},
+	{"", "mbc", "mbc", "Macushi", nil},
+	{"", "mbd", "mbd", "Dibabawon Manobo", nil},
+	{"", "mbe", "mbe", "Molale", nil},
+	{"", "mbf", "mbf", "Baba Malay", nil},
+	{"", "mbh", "mbh", "Mangseng", nil},
+	{"", "mbi", "mbi", "Ilianen Manobo", nil},
+	{"", "mbj", "mbj", "Nadëb", nil},
+	{"", "mbk", "mbk", "Malol", nil},
+	{"", "mbl", "mbl", "Maxakalí", nil},
+	{"", "mbm", "mbm", "Ombamba", nil},
+	{"", "mbn", "mbn", "Macaguán", nil},
+	{"", "mbo", "mbo", "Mbo (Cameroon)", nil},
+	{"", "mbp", "mbp", "Malayo", nil},
+	{"", "mbq", "mbq", "Maisin", nil},
+	{"", "mbr", "mbr", "Nukak Makú", nil},
+	{"", "mbs", "mbs", "Sarangani Manobo", nil},
+	{"", "mbt", "mbt", "Matigsalug Manobo", nil},
+	{"", "mbu", "mbu", "Mbula-Bwazza", nil},
+	{"", "mbv", "mbv", "Mbulungish", nil},
+	{"", "mbw", "mbw", "Maring", nil},
+	{"", "mbx", "mbx", "Mari (East Sepik Province)", nil},
+	{"", "mby", "mby", "Memoni", nil},
+	{"", "mbz", "mbz", "Amoltepec Mixtec", nil},
+	{"", "mca", "mca", "Maca", nil},
+	{"", "mcb", "mcb", "Machiguenga", nil},
+	{"", "mcc", "mcc", "Bitur", nil},
+	{"", "mcd", "mcd", "Sharanahua", nil},
+	{"", "mce", "mce", "Itundujia Mixtec", nil},
+	{"", "mcf", "mcf", "Matsés", nil},
+	{"", "mcg", "mcg", "Mapoyo", nil},
+	{"", "mch", "mch", "Maquiritari", nil},
+	{"", "mci", "mci", "Mese", nil},
+	{"", "mcj", "mcj", "Mvanip", nil},
+	{"", "mck", "mck", "Mbunda", nil},
+	{"", "mcl", "mcl", "Macaguaje", nil},
+	{"", "mcm", "mcm", "Malaccan Creole Portuguese", nil},
+	{"", "mcn", "mcn", "Masana", nil},
+	{"", "mco", "mco", "Coatlán Mixe", nil},
+	{"", "mcp", "mcp", "Makaa", nil},
+	{"", "mcq", "mcq", "Ese", nil},
+	{"", "mcr", "mcr", "Menya", nil},
+	{"", "mcs", "mcs", "Mambai", nil},
+	{"", "mct", "mct", "Mengisa", nil},
+	{"", "mcu", "mcu", "Cameroon Mambila", nil},
+	{"", "mcv", "mcv", "Minanibai", nil},
+	{"", "mcw", "mcw", "Mawa (Chad)", nil},
+	{"", "mcx", "mcx", "Mpiemo", nil},
+	{"", "mcy", "mcy", "South Watut", nil},
+	{"", "mcz", "mcz", "Mawan", nil},
+	{"", "mda", "mda", "Mada (Nigeria)", nil},
+	{"", "mdb", "mdb", "Morigi", nil},
+	{"", "mdc", "mdc", "Male (Papua New Guinea)", nil},
+	{"", "mdd", "mdd", "Mbum", nil},
+	{"", "mde", "mde", "Maba (Chad)", nil},
+	{"", "mdf", "mdf", "Moksha", nil},
+	{"", "mdg", "mdg", "Massalat", nil},
+	{"", "mdh", "mdh", "Maguindanaon", nil},
+	{"", "mdi", "mdi", "Mamvu", nil},
+	{"", "mdj", "mdj", "Mangbetu", nil},
+	{"", "mdk", "mdk", "Mangbutu", nil},
+	{"", "mdl", "mdl", "Maltese Sign Language", nil},
+	{"", "mdm", "mdm", "Mayogo", nil},
+	{"", "mdn", "mdn", "Mbati", nil},
+	{"", "mdp", "mdp", "Mbala", nil},
+	{"", "mdq", "mdq", "Mbole", nil},
+	{"", "mdr", "mdr", "Mandar", nil},
+	{"", "mds", "mds", "Maria (Papua New Guinea)", nil},
+	{"", "mdt", "mdt", "Mbere", nil},
+	{"", "mdu", "mdu", "Mboko", nil},
+	{"", "mdv", "mdv", "Santa Lucía Monteverde Mixtec", nil},
+	{"", "mdw", "mdw", "Mbosi", nil},
+	{"", "mdx", "mdx", "Dizin", nil},
+	{"", "mdy", "mdy", "Male (Ethiopia)", nil},
+	{"", "mdz", "mdz", "Suruí Do Pará", nil},
+	{"", "mea", "mea", "Menka", nil},
+	{"", "meb", "meb", "Ikobi", nil},
+	{"", "mec", "mec", "Marra", nil},
+	{"", "med", "med", "Melpa", nil},
+	{"", "mee", "mee", "Mengen", nil},
+	{"", "mef", "mef", "Megam", nil},
+	{"", "meh", "meh", "Southwestern Tlaxiaco Mixtec", nil},
+	{"", "mei", "mei", "Midob", nil},
+	{"", "mej", "mej", "Meyah", nil},
+	{"", "mek", "mek", "Mekeo", nil},
+	{"", "mel", "mel", "Central Melanau", nil},
+	{"", "mem", "mem", "Mangala", nil},
+	{"", "men", "men", "Mende", nil},
+	{"", "meo", "meo", "Kedah Malay", nil},
+	{"", "mep", "mep", "Miriwoong", nil},
+	{"", "meq", "meq", "Merey", nil},
+	{"", "mer", "mer", "Meru", nil},
+	{"", "mes", "mes", "Masmaje", nil},
+	{"", "met", "met", "Mato", nil},
+	{"", "meu", "meu", "Motu", nil},
+	{"", "mev", "mev", "Mano", nil},
+	{"", "mew", "mew", "Maaka", nil},
+	{"", "mey", "mey", "Hassaniyya", nil},
+	{"", "mez", "mez", "Menominee", nil},
+	{"", "mfa", "mfa", "Pattani Malay", nil},
+	{"", "mfb", "mfb", "Bangka", nil},
+	{"", "mfc", "mfc", "Mba", nil},
+	{"", "mfd", "mfd", "Mendankwe-Nkwen", nil},
+	{"", "mfe", "mfe", "Morisyen", nil},
+	{"", "mff", "mff", "Naki", nil},
+	{"", "mfg", "mfg", "Mogofin", nil},
+	{"", "mfh", "mfh", "Matal", nil},
+	{"", "mfi", "mfi", "Wandala", nil},
+	{"", "mfj", "mfj", "Mefele", nil},
+	{"", "mfk", "mfk", "North Mofu", nil},
+	{"", "mfl", "mfl", "Putai", nil},
+	{"", "mfm", "mfm", "Marghi South", nil},
+	{"", "mfn", "mfn", "Cross River Mbembe", nil},
+	{"", "mfo", "mfo", "Mbe", nil},
+	{"", "mfp", "mfp", "Makassar Malay", nil},
+	{"", "mfq", "mfq", "Moba", nil},
+	{"", "mfr", "mfr", "Marrithiyel", nil},
+	{"", "mfs", "mfs", "Mexican Sign Language", nil},
+	{"", "mft", "mft", "Mokerang", nil},
+	{"", "mfu", "mfu", "Mbwela", nil},
+	{"", "mfv", "mfv", "Mandjak", nil},
+	{"", "mfw", "mfw", "Mulaha", nil},
+	{"", "mfx", "mfx", "Melo", nil},
+	{"", "mfy", "mfy", "Mayo", nil},
+	{"", "mfz", "mfz", "Mabaan", nil},
+	{"", "mga", "mga", "Irish, Middle (900-1200)", nil},
+	{"", "mgb", "mgb", "Mararit", nil},
+	{"", "mgc", "mgc", "Morokodo", nil},
+	{"", "mgd", "mgd", "Moru", nil},
+	{"", "mge", "mge", "Mango", nil},
+	{"", "mgf", "mgf", "Maklew", nil},
+	{"", "mgg", "mgg", "Mpumpong", nil},
+	{"", "mgh", "mgh", "Makhuwa-Meetto", nil},
+	{"", "mgi", "mgi", "Lijili", nil},
+	{"", "mgj", "mgj", "Abureni", nil},
+	{"", "mgk", "mgk", "Mawes", nil},
+	{"", "mgl", "mgl", "Maleu-Kilenge", nil},
+	{"", "mgm", "mgm", "Mambae", nil},
+	{"", "mgn", "mgn", "Mbangi", nil},
+	{"", "mgo", "mgo", "Meta'", nil},
+	{"", "mgp", "mgp", "Eastern Magar", nil},
+	{"", "mgq", "mgq", "Malila", nil},
+	{"", "mgr", "mgr", "Mambwe-Lungu", nil},
+	{"", "mgs", "mgs", "Manda (Tanzania)", nil},
+	{"", "mgt", "mgt", "Mongol", nil},
+	{"", "mgu", "mgu", "Mailu", nil},
+	{"", "mgv", "mgv", "Matengo", nil},
+	{"", "mgw", "mgw", "Matumbi", nil},
+	{"", "mgy", "mgy", "Mbunga", nil},
+	{"", "mgz", "mgz", "Mbugwe", nil},
+	{"", "mha", "mha", "Manda (India)", nil},
+	{"", "mhb", "mhb", "Mahongwe", nil},
+	{"", "mhc", "mhc", "Mocho", nil},
+	{"", "mhd", "mhd", "Mbugu", nil},
+	{"", "mhe", "mhe", "Besisi", nil},
+	{"", "mhf", "mhf", "Mamaa", nil},
+	{"", "mhg", "mhg", "Margu", nil},
+	{"", "mhi", "mhi", "Ma'di", nil},
+	{"", "mhj", "mhj", "Mogholi", nil},
+	{"", "mhk", "mhk", "Mungaka", nil},
+	{"", "mhl", "mhl", "Mauwake", nil},
+	{"", "mhm", "mhm", "Makhuwa-Moniga", nil},
+	{"", "mhn", "mhn", "Mócheno", nil},
+	{"", "mho", "mho", "Mashi (Zambia)", nil},
+	{"", "mhp", "mhp", "Balinese Malay", nil},
+	{"", "mhq", "mhq", "Mandan", nil},
+	{"", "mhr", "mhr", "Eastern Mari", nil},
+	{"", "mhs", "mhs", "Buru (Indonesia)", nil},
+	{"", "mht", "mht", "Mandahuaca", nil},
+	{"", "mhu", "mhu", "Digaro-Mishmi", nil},
+	{"", "mhw", "mhw", "Mbukushu", nil},
+	{"", "mhx", "mhx", "Maru", nil},
+	{"", "mhy", "mhy", "Ma'anyan", nil},
+	{"", "mhz", "mhz", "Mor (Mor Islands)", nil},
+	{"", "mia", "mia", "Miami", nil},
+	{"", "mib", "mib", "Atatláhuca Mixtec", nil},
+	{"", "mic", "mic", "Mi'kmaq; Micmac", nil},
+	{"", "mid", "mid", "Mandaic", nil},
+	{"", "mie", "mie", "Ocotepec Mixtec", nil},
+	{"", "mif", "mif", "Mofu-Gudur", nil},
+	{"", "mig", "mig", "San Miguel El Grande Mixtec", nil},
+	{"", "mih", "mih", "Chayuco Mixtec", nil},
+	{"", "mii", "mii", "Chigmecatitlán Mixtec", nil},
+	{"", "mij", "mij", "Abar", nil},
+	{"", "mik", "mik", "Mikasuki", nil},
+	{"", "mil", "mil", "Peñoles Mixtec", nil},
+	{"", "mim", "mim", "Alacatlatzala Mixtec", nil},
+	{"", "min", "min", "Minangkabau", nil},
+	{"", "mio", "mio", "Pinotepa Nacional Mixtec", nil},
+	{"", "mip", "mip", "Apasco-Apoala Mixtec", nil},
+	{"", "miq", "miq", "Mískito", nil},
+	{"", "mir", "mir", "Isthmus Mixe", nil},
+	{"", "mis", "mis", "Uncoded languages", nil},
+	{"", "mit", "mit", "Southern Puebla Mixtec", nil},
+	{"", "miu", "miu", "Cacaloxtepec Mixtec", nil},
+	{"", "miw", "miw", "Akoye", nil},
+	{"", "mix", "mix", "Mixtepec Mixtec", nil},
+	{"", "miy", "miy", "Ayutla Mixtec", nil},
+	{"", "miz", "miz", "Coatzospan Mixtec", nil},
+	{"", "mjb", "mjb", "Makalero", nil},
+	{"", "mjc", "mjc", "San Juan Colorado Mixtec", nil},
+	{"", "mjd", "mjd", "Northwest Maidu", nil},
+	{"", "mje", "mje", "Muskum", nil},
+	{"", "mjg", "mjg", "Tu", nil},
+	{"", "mjh", "mjh", "Mwera (Nyasa)", nil},
+	{"", "mji", "mji", "Kim Mun", nil},
+	{"", "mjj", "mjj", "Mawak", nil},
+	{"", "mjk", "mjk", "Matukar", nil},
+	{"", "mjl", "mjl", "Mandeali", nil},
+	{"", "mjm", "mjm", "Medebur", nil},
+	{"", "mjn", "mjn", "Ma (Papua New Guinea)", nil},
+	{"", "mjo", "mjo", "Malankuravan", nil},
+	{"", "mjp", "mjp", "Malapandaram", nil},
+	{"", "mjq", "mjq", "Malaryan", nil},
+	{"", "mjr", "mjr", "Malavedan", nil},
+	{"", "mjs", "mjs", "Miship", nil},
+	{"", "mjt", "mjt", "Sauria Paharia", nil},
+	{"", "mju", "mju", "Manna-Dora", nil},
+	{"", "mjv", "mjv", "Mannan", nil},
+	{"", "mjw", "mjw", "Karbi", nil},
+	{"", "mjx", "mjx", "Mahali", nil},
+	{"", "mjy", "mjy", "Mahican", nil},
+	{"", "mjz", "mjz", "Majhi", nil},
+	{"", "mka", "mka", "Mbre", nil},
+	{"", "mkb", "mkb", "Mal Paharia", nil},
+	{"", "mkc", "mkc", "Siliput", nil},
+	{"mk", "mac", "mkd", "Macedonian", nil},
+	{"", "mke", "mke", "Mawchi", nil},
+	{"", "mkf", "mkf", "Miya", nil},
+	{"", "mkg", "mkg", "Mak (China)", nil},
+	{"", "mkh", "mkh", "Mon-Khmer languages", nil},
+	{"", "mki", "mki", "Dhatki", nil},
+	{"", "mkj", "mkj", "Mokilese", nil},
+	{"", "mkk", "mkk", "Byep", nil},
+	{"", "mkl", "mkl", "Mokole", nil},
+	{"", "mkm", "mkm", "Moklen", nil},
+	{"", "mkn", "mkn", "Kupang Malay", nil},
+	{"", "mko", "mko", "Mingang Doso", nil},
+	{"", "mkp", "mkp", "Moikodi", nil},
+	{"", "mkq", "mkq", "Bay Miwok", nil},
+	{"", "mkr", "mkr", "Malas", nil},
+	{"", "mks", "mks", "Silacayoapan Mixtec", nil},
+	{"", "mkt", "mkt", "Vamale", nil},
+	{"", "mku", "mku", "Konyanka Maninka", nil},
+	{"", "mkv", "mkv", "Mafea", nil},
+	{"", "mkw", "mkw", "Kituba (Congo)", nil},
+	{"", "mkx", "mkx", "Kinamiging Manobo", nil},
+	{"", "mky", "mky", "East Makian", nil},
+	{"", "mkz", "mkz", "Makasae", nil},
+	{"", "mla", "mla", "Malo", nil},
+	{"", "mlb", "mlb", "Mbule", nil},
+	{"", "mlc", "mlc", "Cao Lan", nil},
+	{"", "mle", "mle", "Manambu", nil},
+	{"", "mlf", "mlf", "Mal", nil},
+	{"mg", "mlg", "mlg", "Malagasy", nil},
+	{"", "mlh", "mlh", "Mape", nil},
+	{"", "mli", "mli", "Malimpung", nil},
+	{"", "mlj", "mlj", "Miltu", nil},
+	{"", "mlk", "mlk", "Ilwana", nil},
+	{"", "mll", "mll", "Malua Bay", nil},
+	{"", "mlm", "mlm", "Mulam", nil},
+	{"", "mln", "mln", "Malango", nil},
+	{"", "mlo", "mlo", "Mlomp", nil},
+	{"", "mlp", "mlp", "Bargam", nil},
+	{"", "mlq", "mlq", "Western Maninkakan", nil},
+	{"", "mlr", "mlr", "Vame", nil},
+	{"", "mls", "mls", "Masalit", nil},
+	{"mt", "mlt", "mlt", "Maltese", nil},
+	{"", "mlu", "mlu", "To'abaita", nil},
+	{"", "mlv", "mlv", "Motlav", nil},
+	{"", "mlw", "mlw", "Moloko", nil},
+	{"", "mlx", "mlx", "Malfaxal", nil},
+	{"", "mlz", "mlz", "Malaynon", nil},
+	{"", "mma", "mma", "Mama", nil},
+	{"", "mmb", "mmb", "Momina", nil},
+	{"", "mmc", "mmc", "Michoacán Mazahua", nil},
+	{"", "mmd", "mmd", "Maonan", nil},
+	{"", "mme", "mme", "Mae", nil},
+	{"", "mmf", "mmf", "Mundat", nil},
+	{"", "mmg", "mmg", "North Ambrym", nil},
+	{"", "mmh", "mmh", "Mehináku", nil},
+	{"", "mmi", "mmi", "Musar", nil},
+	{"", "mmj", "mmj", "Majhwar", nil},
+	{"", "mmk", "mmk", "Mukha-Dora", nil},
+	{"", "mml", "mml", "Man Met", nil},
+	{"", "mmm", "mmm", "Maii", nil},
+	{"", "mmn", "mmn", "Mamanwa", nil},
+	{"", "mmo", "mmo", "Mangga Buang", nil},
+	{"", "mmp", "mmp", "Siawi", nil},
+	{"", "mmq", "mmq", "Musak", nil},
+	{"", "mmr", "mmr", "Western Xiangxi Miao", nil},
+	{"", "mmt", "mmt", "Malalamai", nil},
+	{"", "mmu", "mmu", "Mmaala", nil},
+	{"", "mmv", "mmv", "Miriti", nil},
+	{"", "mmw", "mmw", "Emae", nil},
+	{"", "mmx", "mmx", "Madak", nil},
+	{"", "mmy", "mmy", "Migaama", nil},
+	{"", "mmz", "mmz", "Mabaale", nil},
+	{"", "mna", "mna", "Mbula", nil},
+	{"", "mnb", "mnb", "Muna", nil},
+	{"", "mnc", "mnc", "Manchu", nil},
+	{"", "mnd", "mnd", "Mondé", nil},
+	{"", "mne", "mne", "Naba", nil},
+	{"", "mnf", "mnf", "Mundani", nil},
+	{"", "mng", "mng", "Eastern Mnong", nil},
+	{"", "mnh", "mnh", "Mono (Democratic Republic of Congo)", nil},
+	{"", "mni", "mni", "Manipuri", nil},
+	{"", "mnj", "mnj", "Munji", nil},
+	{"", "mnk", "mnk", "Mandinka", nil},
+	{"", "mnl", "mnl", "Tiale", nil},
+	{"", "mnm", "mnm", "Mapena", nil},
+	{"", "mnn", "mnn", "Southern Mnong", nil},
+	{"", "mno", "mno", "Manobo languages", nil},
+	{"", "mnp", "mnp", "Min Bei Chinese", nil},
+	{"", "mnq", "mnq", "Minriq", nil},
+	{"", "mnr", "mnr", "Mono (USA)", nil},
+	{"", "mns", "mns", "Mansi", nil},
+	{"", "mnu", "mnu", "Mer", nil},
+	{"", "mnv", "mnv", "Rennell-Bellona", nil},
+	{"", "mnw", "mnw", "Mon", nil},
+	{"", "mnx", "mnx", "Manikion", nil},
+	{"", "mny", "mny", "Manyawa", nil},
+	{"", "mnz", "mnz", "Moni", nil},
+	{"", "moa", "moa", "Mwan", nil},
+	{"", "moc", "moc", "Mocoví", nil},
+	{"", "mod", "mod", "Mobilian", nil},
+	{"", "moe", "moe", "Innu", nil},
+	{"", "mog", "mog", "Mongondow", nil},
+	{"", "moh", "moh", "Mohawk", nil},
+	{"", "moi", "moi", "Mboi", nil},
+	{"", "moj", "moj", "Monzombo", nil},
+	{"", "mok", "mok", "Morori", nil},
+	{"", "mom", "mom", "Mangue", nil},
+	{"mn", "mon", "mon", "Mongolian", nil},
+	{"", "moo", "moo", "Monom", nil},
+	{"", "mop", "mop", "Mopán Maya", nil},
+	{"", "moq", "moq", "Mor (Bomberai Peninsula)", nil},
+	{"", "mor", "mor", "Moro", nil},
+	{"", "mos", "mos", "Mossi", nil},
+	{"", "mot", "mot", "Barí", nil},
+	{"", "mou", "mou", "Mogum", nil},
+	{"", "mov", "mov", "Mohave", nil},
+	{"", "mow", "mow", "Moi (Congo)", nil},
+	{"", "mox", "mox", "Molima", nil},
+	{"", "moy", "moy", "Shekkacho", nil},
+	{"", "moz", "moz", "Mukulu", nil},
+	{"", "mpa", "mpa", "Mpoto", nil},
+	{"", "mpb", "mpb", "Malak Malak", nil},
+	{"", "mpc", "mpc", "Mangarrayi", nil},
+	{"", "mpd", "mpd", "Machinere", nil},
+	{"", "mpe", "mpe", "Majang", nil},
+	{"", "mpg", "mpg", "Marba", nil},
+	{"", "mph", "mph", "Maung", nil},
+	{"", "mpi", "mpi", "Mpade", nil},
+	{"", "mpj", "mpj", "Martu Wangka", nil},
+	{"", "mpk", "mpk", "Mbara (Chad)", nil},
+	{"", "mpl", "mpl", "Middle Watut", nil},
+	{"", "mpm", "mpm", "Yosondúa Mixtec", nil},
+	{"", "mpn", "mpn", "Mindiri", nil},
+	{"", "mpo", "mpo", "Miu", nil},
+	{"", "mpp", "mpp", "Migabac", nil},
+	{"", "mpq", "mpq", "Matís", nil},
+	{"", "mpr", "mpr", "Vangunu", nil},
+	{"", "mps", "mps", "Dadibi", nil},
+	{"", "mpt", "mpt", "Mian", nil},
+	{"", "mpu", "mpu", "Makuráp", nil},
+	{"", "mpv", "mpv", "Mungkip", nil},
+	{"", "mpw", "mpw", "Mapidian", nil},
+	{"", "mpx", "mpx", "Misima-Panaeati", nil},
+	{"", "mpy", "mpy", "Mapia", nil},
+	{"", "mpz", "mpz", "Mpi", nil},
+	{"", "mqa", "mqa", "Maba (Indonesia)", nil},
+	{"", "mqb", "mqb", "Mbuko", nil},
+	{"", "mqc", "mqc", "Mangole", nil},
+	{"", "mqe", "mqe", "Matepi", nil},
+	{"", "mqf", "mqf", "Momuna", nil},
+	{"", "mqg", "mqg", "Kota Bangun Kutai Malay", nil},
+	{"", "mqh", "mqh", "Tlazoyaltepec Mixtec", nil},
+	{"", "mqi", "mqi", "Mariri", nil},
+	{"", "mqj", "mqj", "Mamasa", nil},
+	{"", "mqk", "mqk", "Rajah Kabunsuwan Manobo", nil},
+	{"", "mql", "mql", "Mbelime", nil},
+	{"", "mqm", "mqm", "South Marquesan", nil},
+	{"", "mqn", "mqn", "Moronene", nil},
+	{"", "mqo", "mqo", "Modole", nil},
+	{"", "mqp", "mqp", "Manipa", nil},
+	{"", "mqq", "mqq", "Minokok", nil},
+	{"", "mqr", "mqr", "Mander", nil},
+	{"", "mqs", "mqs", "West Makian", nil},
+	{"", "mqt", "mqt", "Mok", nil},
+	{"", "mqu", "mqu", "Mandari", nil},
+	{"", "mqv", "mqv", "Mosimo", nil},
+	{"", "mqw", "mqw", "Murupi", nil},
+	{"", "mqx", "mqx", "Mamuju", nil},
+	{"", "mqy", "mqy", "Manggarai", nil},
+	{"", "mqz", "mqz", "Pano", nil},
+	{"", "mra", "mra", "Mlabri", nil},
+	{"", "mrb", "mrb", "Marino", nil},
+	{"", "mrc", "mrc", "Maricopa", nil},
+	{"", "mrd", "mrd", "Western Magar", nil},
+	{"", "mre", "mre", "Martha's Vineyard Sign Language", nil},
+	{"", "mrf", "mrf", "Elseng", nil},
+	{"", "mrg", "mrg", "Mising", nil},
+	{"", "mrh", "mrh", "Mara Chin", nil},
+	{"mi", "mao", "mri", "Maori", nil},
+	{"", "mrj", "mrj", "Western Mari", nil},
+	{"", "mrk", "mrk", "Hmwaveke", nil},
+	{"", "mrl", "mrl", "Mortlockese", nil},
+	{"", "mrm", "mrm", "Merlav", nil},
+	{"", "mrn", "mrn", "Cheke Holo", nil},
+	{"", "mro", "mro", "Mru", nil},
+	{"", "mrp", "mrp", "Morouas", nil},
+	{"", "mrq", "mrq", "North Marquesan", nil},
+	{"", "mrr", "mrr", "Maria (India)", nil},
+	{"", "mrs", "mrs", "Maragus", nil},
+	{"", "mrt", "mrt", "Marghi Central", nil},
+	{"", "mru", "mru", "Mono (Cameroon)", nil},
+	{"", "mrv", "mrv", "Mangareva", nil},
+	{"", "mrw", "mrw", "Maranao", nil},
+	{"", "mrx", "mrx", "Maremgi", nil},
+	{"", "mry", "mry", "Mandaya", nil},
+	{"", "mrz", "mrz", "Marind", nil},
+	{"ms", "may", "msa", "Malay", nil},
+	{"", "msb", "msb", "Masbatenyo", nil},
+	{"", "msc", "msc", "Sankaran Maninka", nil},
+	{"", "msd", "msd", "Yucatec Maya Sign Language", nil},
+	{"", "mse", "mse", "Musey", nil},
+	{"", "msf", "msf", "Mekwei", nil},
+	{"", "msg", "msg", "Moraid", nil},
+	{"", "msh", "msh", "Masikoro Malagasy", nil},
+	{"", "msi", "msi", "Sabah Malay", nil},
+	{"", "msj", "msj", "Ma (Democratic Republic of Congo)", nil},
+	{"", "msk", "msk", "Mansaka", nil},
+	{"", "msl", "msl", "Molof", nil},
+	{"", "msm", "msm", "Agusan Manobo", nil},
+	{"", "msn", "msn", "Vurës", nil},
+	{"", "mso", "mso", "Mombum", nil},
+	{"", "msp", "msp", "Maritsauá", nil},
+	{"", "msq", "msq", "Caac", nil},
+	{"", "msr", "msr", "Mongolian Sign Language", nil},
+	{"", "mss", "mss", "West Masela", nil},
+	{"", "msu", "msu", "Musom", nil},
+	{"", "msv", "msv", "Maslam", nil},
+	{"", "msw", "msw", "Mansoanka", nil},
+	{"", "msx", "msx", "Moresada", nil},
+	{"", "msy", "msy", "Aruamu", nil},
+	{"", "msz", "msz", "Momare", nil},
+	{"", "mta", "mta", "Cotabato Manobo", nil},
+	{"", "mtb", "mtb", "Anyin Morofo", nil},
+	{"", "mtc", "mtc", "Munit", nil},
+	{"", "mtd", "mtd", "Mualang", nil},
+	{"", "mte", "mte", "Mono (Solomon Islands)", nil},
+	{"", "mtf", "mtf", "Murik (Papua New Guinea)", nil},
+	{"", "mtg", "mtg", "Una", nil},
+	{"", "mth", "mth", "Munggui", nil},
+	{"", "mti", "mti", "Maiwa (Papua New Guinea)", nil},
+	{"", "mtj", "mtj", "Moskona", nil},
+	{"", "mtk", "mtk", "Mbe'", nil},
+	{"", "mtl", "mtl", "Montol", nil},
+	{"", "mtm", "mtm", "Mator", nil},
+	{"", "mtn", "mtn", "Matagalpa", nil},
+	{"", "mto", "mto", "Totontepec Mixe", nil},
+	{"", "mtp", "mtp", "Wichí Lhamtés Nocten", nil},
+	{"", "mtq", "mtq", "Muong", nil},
+	{"", "mtr", "mtr", "Mewari", nil},
+	{"", "mts", "mts", "Yora", nil},
+	{"", "mtt", "mtt", "Mota", nil},
+	{"", "mtu", "mtu", "Tututepec Mixtec", nil},
+	{"", "mtv", "mtv", "Asaro'o", nil},
+	{"", "mtw", "mtw", "Southern Binukidnon", nil},
+	{"", "mtx", "mtx", "Tidaá Mixtec", nil},
+	{"", "mty", "mty", "Nabi", nil},
+	{"", "mua", "mua", "Mundang", nil},
+	{"", "mub", "mub", "Mubi", nil},
+	{"", "muc", "muc", "Ajumbu", nil},
+	{"", "mud", "mud", "Mednyj Aleut", nil},
+	{"", "mue", "mue", "Media Lengua", nil},
+	{"", "mug", "mug", "Musgu", nil},
+	{"", "muh", "muh", "Mündü", nil},
+	{"", "mui", "mui", "Musi", nil},
+	{"", "muj", "muj", "Mabire", nil},
+	{"", "muk", "muk", "Mugom", nil},
+	{"", "mul", "mul", "Multiple languages", nil},
+	{"", "mum", "mum", "Maiwala", nil},
+	{"", "mun", "mun", "Munda languages", nil},
+	{"", "muo", "muo", "Nyong", nil},
+	{"", "mup", "mup", "Malvi", nil},
+	{"", "muq", "muq", "Eastern Xiangxi Miao", nil},
+	{"", "mur", "mur", "Murle", nil},
+	{"", "mus", "mus", "Creek", nil},
+	{"", "mut", "mut", "Western Muria", nil},
+	{"", "muu", "muu", "Yaaku", nil},
+	{"", "muv", "muv", "Muthuvan", nil},
+	{"", "mux", "mux", "Bo-Ung", nil},
+	{"", "muy", "muy", "Muyang", nil},
+	{"", "muz", "muz", "Mursi", nil},
+	{"", "mva", "mva", "Manam", nil},
+	{"", "mvb", "mvb", "Mattole", nil},
+	{"", "mvd", "mvd", "Mamboru", nil},
+	{"", "mve", "mve", "Marwari (Pakistan)", nil},
+	{"", "mvf", "mvf", "Peripheral Mongolian", nil},
+	{"", "mvg", "mvg", "Yucuañe Mixtec", nil},
+	{"", "mvh", "mvh", "Mulgi", nil},
+	{"", "mvi", "mvi", "Miyako", nil},
+	{"", "mvk", "mvk", "Mekmek", nil},
+	{"", "mvl", "mvl", "Mbara (Australia)", nil},
+	{"", "mvn", "mvn", "Minaveha", nil},
+	{"", "mvo", "mvo", "Marovo", nil},
+	{"", "mvp", "mvp", "Duri", nil},
+	{"", "mvq", "mvq", "Moere", nil},
+	{"", "mvr", "mvr", "Marau", nil},
+	{"", "mvs", "mvs", "Massep", nil},
+	{"", "mvt", "mvt", "Mpotovoro", nil},
+	{"", "mvu", "mvu", "Marfa", nil},
+	{"", "mvv", "mvv", "Tagal Murut", nil},
+	{"", "mvw", "mvw", "Machinga", nil},
+	{"", "mvx", "mvx", "Meoswar", nil},
+	{"", "mvy", "mvy", "Indus Kohistani", nil},
+	{"", "mvz", "mvz", "Mesqan", nil},
+	{"", "mwa", "mwa", "Mwatebu", nil},
+	{"", "mwb", "mwb", "Juwal", nil},
+	{"", "mwc", "mwc", "Are", nil},
+	{"", "mwe", "mwe", "Mwera (Chimwera)", nil},
+	{"", "mwf", "mwf", "Murrinh-Patha", nil},
+	{"", "mwg", "mwg", "Aiklep", nil},
+	{"", "mwh", "mwh", "Mouk-Aria", nil},
+	{"", "mwi", "mwi", "Labo", nil},
+	{"", "mwk", "mwk", "Kita Maninkakan", nil},
+	{"", "mwl", "mwl", "Mirandese", nil},
+	{"", "mwm", "mwm", "Sar", nil},
+	{"", "mwn", "mwn", "Nyamwanga", nil},
+	{"", "mwo", "mwo", "Central Maewo", nil},
+	{"", "mwp", "mwp", "Kala Lagaw Ya", nil},
+	{"", "mwq", "mwq", "Mün Chin", nil},
+	{"", "mwr", "mwr", "Marwari", nil},
+	{"", "mws", "mws", "Mwimbi-Muthambi", nil},
+	{"", "mwt", "mwt", "Moken", nil},
+	{"", "mwu", "mwu", "Mittu", nil},
+	{"", "mwv", "mwv", "Mentawai", nil},
+	{"", "mww", "mww", "Hmong Daw", nil},
+	{"", "mwz", "mwz", "Moingi", nil},
+	{"", "mxa", "mxa", "Northwest Oaxaca Mixtec", nil},
+	{"", "mxb", "mxb", "Tezoatlán Mixtec", nil},
+	{"", "mxc", "mxc", "Manyika", nil},
+	{"", "mxd", "mxd", "Modang", nil},
+	{"", "mxe", "mxe", "Mele-Fila", nil},
+	{"", "mxf", "mxf", "Malgbe", nil},
+	{"", "mxg", "mxg", "Mbangala", nil},
+	{"", "mxh", "mxh", "Mvuba", nil},
+	{"", "mxi", "mxi", "Mozarabic", nil},
+	{"", "mxj", "mxj", "Miju-Mishmi", nil},
+	{"", "mxk", "mxk", "Monumbo", nil},
+	{"", "mxl", "mxl", "Maxi Gbe", nil},
+	{"", "mxm", "mxm", "Meramera", nil},
+	{"", "mxn", "mxn", "Moi (Indonesia)", nil},
+	{"", "mxo", "mxo", "Mbowe", nil},
+	{"", "mxp", "mxp", "Tlahuitoltepec Mixe", nil},
+	{"", "mxq", "mxq", "Juquila Mixe", nil},
+	{"", "mxr", "mxr", "Murik (Malaysia)", nil},
+	{"", "mxs", "mxs", "Huitepec Mixtec", nil},
+	{"", "mxt", "mxt", "Jamiltepec Mixtec", nil},
+	{"", "mxu", "mxu", "Mada (Cameroon)", nil},
+	{"", "mxv", "mxv", "Metlatónoc Mixtec", nil},
+	{"", "mxw", "mxw", "Namo", nil},
+	{"", "mxx", "mxx", "Mahou", nil},
+	{"", "mxy", "mxy", "Southeastern Nochixtlán Mixtec", nil},
+	{"", "mxz", "mxz", "Central Masela", nil},
+	{"my", "bur", "mya", "Burmese", nil},
+	{"", "myb", "myb", "Mbay", nil},
+	{"", "myc", "myc", "Mayeka", nil},
+	{"", "mye", "mye", "Myene", nil},
+	{"", "myf", "myf", "Bambassi", nil},
+	{"", "myg", "myg", "Manta", nil},
+	{"", "myh", "myh", "Makah", nil},
+	{"", "myj", "myj", "Mangayat", nil},
+	{"", "myk", "myk", "Mamara Senoufo", nil},
+	{"", "myl", "myl", "Moma", nil},
+	{"", "mym", "mym", "Me'en", nil},
+	{"", "myn", "myn", "Mayan languages", nil},
+	{"", "myo", "myo", "Anfillo", nil},
+	{"", "myp", "myp", "Pirahã", nil},
+	{"", "myr", "myr", "Muniche", nil},
+	{"", "mys", "mys", "Mesmes", nil},
+	{"", "myu", "myu", "Mundurukú", nil},
+	{"", "myv", "myv", "Erzya", nil},
+	{"", "myw", "myw", "Muyuw", nil},
+	{"", "myx", "myx", "Masaaba", nil},
+	{"", "myy", "myy", "Macuna", nil},
+	{"", "myz", "myz", "Classical Mandaic", nil},
+	{"", "mza", "mza", "Santa María Zacatepec Mixtec", nil},
+	{"", "mzb", "mzb", "Tumzabt", nil},
+	{"", "mzc", "mzc", "Madagascar Sign Language", nil},
+	{"", "mzd", "mzd", "Malimba", nil},
+	{"", "mze", "mze", "Morawa", nil},
+	{"", "mzg", "mzg", "Monastic Sign Language", nil},
+	{"", "mzh", "mzh", "Wichí Lhamtés Güisnay", nil},
+	{"", "mzi", "mzi", "Ixcatlán Mazatec", nil},
+	{"", "mzj", "mzj", "Manya", nil},
+	{"", "mzk", "mzk", "Nigeria Mambila", nil},
+	{"", "mzl", "mzl", "Mazatlán Mixe", nil},
+	{"", "mzm", "mzm", "Mumuye", nil},
+	{"", "mzn", "mzn", "Mazanderani", nil},
+	{"", "mzo", "mzo", "Matipuhy", nil},
+	{"", "mzp", "mzp", "Movima", nil},
+	{"", "mzq", "mzq", "Mori Atas", nil},
+	{"", "mzr", "mzr", "Marúbo", nil},
+	{"", "mzs", "mzs", "Macanese", nil},
+	{"", "mzt", "mzt", "Mintil", nil},
+	{"", "mzu", "mzu", "Inapang", nil},
+	{"", "mzv", "mzv", "Manza", nil},
+	{"", "mzw", "mzw", "Deg", nil},
+	{"", "mzx", "mzx", "Mawayana", nil},
+	{"", "mzy", "mzy", "Mozambican Sign Language", nil},
+	{"", "mzz", "mzz", "Maiadomu", nil},
+	{"", "naa", "naa", "Namla", nil},
+	{"", "nab", "nab", "Southern Nambikuára", nil},
+	{"", "nac", "nac", "Narak", nil},
+	{"", "nae", "nae", "Naka'ela", nil},
+	{"", "naf", "naf", "Nabak", nil},
+	{"", "nag", "nag", "Naga Pidgin", nil},
+	{"", "nah", "nah", "Nahuatl languages", nil},
+	{"", "nai", "nai", "North American Indian languages", nil},
+	{"", "naj", "naj", "Nalu", nil},
+	{"", "nak", "nak", "Nakanai", nil},
+	{"", "nal", "nal", "Nalik", nil},
+	{"", "nam", "nam", "Ngan'gityemerri", nil},
+	{"", "nan", "nan", "Min Nan Chinese", nil},
+	{"", "nao", "nao", "Naaba", nil},
+	{"", "nap", "nap", "Neapolitan", nil},
+	{"", "naq", "naq", "Khoekhoe", nil},
+	{"", "nar", "nar", "Iguta", nil},
+	{"", "nas", "nas", "Naasioi", nil},
+	{"", "nat", "nat", "Ca̱hungwa̱rya̱", nil},
+	{"na", "nau", "nau", "Nauru", nil},
+	{"nv", "nav", "nav", "Navajo; Navaho", nil},
+	{"", "naw", "naw", "Nawuri", nil},
+	{"", "nax", "nax", "Nakwi", nil},
+	{"", "nay", "nay", "Ngarrindjeri", nil},
+	{"", "naz", "naz", "Coatepec Nahuatl", nil},
+	{"", "nba", "nba", "Nyemba", nil},
+	{"", "nbb", "nbb", "Ndoe", nil},
+	{"", "nbc", "nbc", "Chang Naga", nil},
+	{"", "nbd", "nbd", "Ngbinda", nil},
+	{"", "nbe", "nbe", "Konyak Naga", nil},
+	{"", "nbg", "nbg", "Nagarchal", nil},
+	{"", "nbh", "nbh", "Ngamo", nil},
+	{"", "nbi", "nbi", "Mao Naga", nil},
+	{"", "nbj", "nbj", "Ngarinyman", nil},
+	{"", "nbk", "nbk", "Nake", nil},
+	{"nr", "nbl", "nbl", "Ndebele, South; South Ndebele", nil},
+	{"", "nbm", "nbm", "Ngbaka Ma'bo", nil},
+	{"", "nbn", "nbn", "Kuri", nil},
+	{"", "nbo", "nbo", "Nkukoli", nil},
+	{"", "nbp", "nbp", "Nnam", nil},
+	{"", "nbq", "nbq", "Nggem", nil},
+	{"", "nbr", "nbr", "Numana", nil},
+	{"", "nbs", "nbs", "Namibian Sign Language", nil},
+	{"", "nbt", "nbt", "Na", nil},
+	{"", "nbu", "nbu", "Rongmei Naga", nil},
+	{"", "nbv", "nbv", "Ngamambo", nil},
+	{"", "nbw", "nbw", "Southern Ngbandi", nil},
+	{"", "nby", "nby", "Ningera", nil},
+	{"", "nca", "nca", "Iyo", nil},
+	{"", "ncb", "ncb", "Central Nicobarese", nil},
+	{"", "ncc", "ncc", "Ponam", nil},
+	{"", "ncd", "ncd", "Nachering", nil},
+	{"", "nce", "nce", "Yale", nil},
+	{"", "ncf", "ncf", "Notsi", nil},
+	{"", "ncg", "ncg", "Nisga'a", nil},
+	{"", "nch", "nch", "Central Huasteca Nahuatl", nil},
+	{"", "nci", "nci", "Classical Nahuatl", nil},
+	{"", "ncj", "ncj", "Northern Puebla Nahuatl", nil},
+	{"", "nck", "nck", "Na-kara", nil},
+	{"", "ncl", "ncl", "Michoacán Nahuatl", nil},
+	{"", "ncm", "ncm", "Nambo", nil},
+	{"", "ncn", "ncn", "Nauna", nil},
+	{"", "nco", "nco", "Sibe", nil},
+	{"", "ncq", "ncq", "Northern Katang", nil},
+	{"", "ncr", "ncr", "Ncane", nil},
+	{"", "ncs", "ncs", "Nicaraguan Sign Language", nil},
+	{"", "nct", "nct", "Chothe Naga", nil},
+	{"", "ncu", "ncu", "Chumburung", nil},
+	{"", "ncx", "ncx", "Central Puebla Nahuatl", nil},
+	{"", "ncz", "ncz", "Natchez", nil},
+	{"", "nda", "nda", "Ndasa", nil},
+	{"", "ndb", "ndb", "Kenswei Nsei", nil},
+	{"", "ndc", "ndc", "Ndau", nil},
+	{"", "ndd", "ndd", "Nde-Nsele-Nta", nil},
+	{"nd", "nde", "nde", "Ndebele, North; North Ndebele", nil},
+	{"", "ndf", "ndf", "Nadruvian", nil},
+	{"", "ndg", "ndg", "Ndengereko", nil},
+	{"", "ndh", "ndh", "Ndali", nil},
+	{"", "ndi", "ndi", "Samba Leko", nil},
+	{"", "ndj", "ndj", "Ndamba", nil},
+	{"", "ndk", "ndk", "Ndaka", nil},
+	{"", "ndl", "ndl", "Ndolo", nil},
+	{"", "ndm", "ndm", "Ndam", nil},
+	{"", "ndn", "ndn", "Ngundi", nil},
+	{"ng", "ndo", "ndo", "Ndonga", nil},
+	{"", "ndp", "ndp", "Ndo", nil},
+	{"", "ndq", "ndq", "Ndombe", nil},
+	{"", "ndr", "ndr", "Ndoola", nil},
+	{"", "nds", "nds", "Low German; Low Saxon; German, Low; Saxon, Low", nil},
+	{"", "ndt", "ndt", "Ndunga", nil},
+	{"", "ndu", "ndu", "Dugun", nil},
+	{"", "ndv", "ndv", "Ndut", nil},
+	{"", "ndw", "ndw", "Ndobo", nil},
+	{"", "ndx", "ndx", "Nduga", nil},
+	{"", "ndy", "ndy", "Lutos", nil},
+	{"", "ndz", "ndz", "Ndogo", nil},
+	{"", "nea", "nea", "Eastern Ngad'a", nil},
+	{"", "neb", "neb", "Toura (Côte d'Ivoire)", nil},
+	{"", "nec", "nec", "Nedebang", nil},
+	{"", "ned", "ned", "Nde-Gbite", nil},
+	{"", "nee", "nee", "Nêlêmwa-Nixumwak", nil},
+	{"", "nef", "nef", "Nefamese", nil},
+	{"", "neg", "neg", "Negidal", nil},
+	{"", "neh", "neh", "Nyenkha", nil},
+	{"", "nei", "nei", "Neo-Hittite", nil},
+	{"", "nej", "nej", "Neko", nil},
+	{"", "nek", "nek", "Neku", nil},
+	{"", "nem", "nem", "Nemi", nil},
+	{"", "nen", "nen", "Nengone", nil},
+	{"", "neo", "neo", "Ná-Meo", nil},
+	{"ne", "nep", "nep", "Nepali", nil},
+	{"", "neq", "neq", "North Central Mixe", nil},
+	{"", "ner", "ner", "Yahadian", nil},
+	{"", "nes", "nes", "Bhoti Kinnauri", nil},
+	{"", "net", "net", "Nete", nil},
+	{"", "neu", "neu", "Neo", nil},
+	{"", "nev", "nev", "Nyaheun", nil},
+	{"", "new", "new", "Nepal Bhasa; Newari", nil},
+	{"", "nex", "nex", "Neme", nil},
+	{"", "ney", "ney", "Neyo", nil},
+	{"", "nez", "nez", "Nez Perce", nil},
+	{"", "nfa", "nfa", "Dhao", nil},
+	{"", "nfd", "nfd", "Ahwai", nil},
+	{"", "nfl", "nfl", "Ayiwo", nil},
+	{"", "nfr", "nfr", "Nafaanra", nil},
+	{"", "nfu", "nfu", "Mfumte", nil},
+	{"", "nga", "nga", "Ngbaka", nil},
+	{"", "ngb", "ngb", "Northern Ngbandi", nil},
+	{"", "ngc", "ngc", "Ngombe (Democratic Republic of Congo)", nil},
+	{"", "ngd", "ngd", "Ngando (Central African Republic)", nil},
+	{"", "nge", "nge", "Ngemba", nil},
+	{"", "ngg", "ngg", "Ngbaka Manza", nil},
+	{"", "ngh", "ngh", "Nǁng", nil},
+	{"", "ngi", "ngi", "Ngizim", nil},
+	{"", "ngj", "ngj", "Ngie", nil},
+	{"", "ngk", "ngk", "Dalabon", nil},
+	{"", "ngl", "ngl", "Lomwe", nil},
+	{"", "ngm", "ngm", "Ngatik Men's Creole", nil},
+	{"", "ngn", "ngn", "Ngwo", nil},
+	{"", "ngp", "ngp", "Ngulu", nil},
+	{"", "ngq", "ngq", "Ngurimi", nil},
+	{"", "ngr", "ngr", "Engdewu", nil},
+	{"", "ngs", "ngs", "Gvoko", nil},
+	{"", "ngt", "ngt", "Kriang", nil},
+	{"", "ngu", "ngu", "Guerrero Nahuatl", nil},
+	{"", "ngv", "ngv", "Nagumi", nil},
+	{"", "ngw", "ngw", "Ngwaba", nil},
+	{"", "ngx", "ngx", "Nggwahyi", nil},
+	{"", "ngy", "ngy", "Tibea", nil},
+	{"", "ngz", "ngz", "Ngungwel", nil},
+	{"", "nha", "nha", "Nhanda", nil},
+	{"", "nhb", "nhb", "Beng", nil},
+	{"", "nhc", "nhc", "Tabasco Nahuatl", nil},
+	{"", "nhd", "nhd", "Chiripá", nil},
+	{"", "nhe", "nhe", "Eastern Huasteca Nahuatl", nil},
+	{"", "nhf", "nhf", "Nhuwala", nil},
+	{"", "nhg", "nhg", "Tetelcingo Nahuatl", nil},
+	{"", "nhh", "nhh", "Nahari", nil},
+	{"", "nhi", "nhi", "Zacatlán-Ahuacatlán-Tepetzintla Nahuatl", nil},
+	{"", "nhk", "nhk", "Isthmus-Cosoleacaque Nahuatl", nil},
+	{"", "nhm", "nhm", "Morelos Nahuatl", nil},
+	{"", "nhn", "nhn", "Central Nahuatl", nil},
+	{"", "nho", "nho", "Takuu", nil},
+	{"", "nhp", "nhp", "Isthmus-Pajapan Nahuatl", nil},
+	{"", "nhq", "nhq", "Huaxcaleca Nahuatl", nil},
+	{"", "nhr", "nhr", "Naro", nil},
+	{"", "nht", "nht", "Ometepec Nahuatl", nil},
+	{"", "nhu", "nhu", "Noone", nil},
+	{"", "nhv", "nhv", "Temascaltepec Nahuatl", nil},
+	{"", "nhw", "nhw", "Western Huasteca Nahuatl", nil},
+	{"", "nhx", "nhx", "Isthmus-Mecayapan Nahuatl", nil},
+	{"", "nhy", "nhy", "Northern Oaxaca Nahuatl", nil},
+	{"", "nhz", "nhz", "Santa María La Alta Nahuatl", nil},
+	{"", "nia", "nia", "Nias", nil},
+	{"", "nib", "nib", "Nakame", nil},
+	{"", "nic", "nic", "Niger-Kordofanian languages", nil},
+	{"", "nid", "nid", "Ngandi", nil},
+	{"", "nie", "nie", "Niellim", nil},
+	{"", "nif", "nif", "Nek", nil},
+	{"", "nig", "nig", "Ngalakgan", nil},
+	{"", "nih", "nih", "Nyiha (Tanzania)", nil},
+	{"", "nii", "nii", "Nii", nil},
+	{"", "nij", "nij", "Ngaju", nil},
+	{"", "nik", "nik", "Southern Nicobarese", nil},
+	{"", "nil", "nil", "Nila", nil},
+	{"", "nim", "nim", "Nilamba", nil},
+	{"", "nin", "nin", "Ninzo", nil},
+	{"", "nio", "nio", "Nganasan", nil},
+	{"", "niq", "niq", "Nandi", nil},
+	{"", "nir", "nir", "Nimboran", nil},
+	{"", "nis", "nis", "Nimi", nil},
+	{"", "nit", "nit", "Southeastern Kolami", nil},
+	{"", "niu", "niu", "Niuean", nil},
+	{"", "niv", "niv", "Gilyak", nil},
+	{"", "niw", "niw", "Nimo", nil},
+	{"", "nix", "nix", "Hema", nil},
+	{"", "niy", "niy", "Ngiti", nil},
+	{"", "niz", "niz", "Ningil", nil},
+	{"", "nja", "nja", "Nzanyi", nil},
+	{"", "njb", "njb", "Nocte Naga", nil},
+	{"", "njd", "njd", "Ndonde Hamba", nil},
+	{"", "njh", "njh", "Lotha Naga", nil},
+	{"", "nji", "nji", "Gudanji", nil},
+	{"", "njj", "njj", "Njen", nil},
+	{"", "njl", "njl", "Njalgulgule", nil},
+	{"", "njm", "njm", "Angami Naga", nil},
+	{"", "njn", "njn", "Liangmai Naga", nil},
+	{"", "njo", "njo", "Ao Naga", nil},
+	{"", "njr", "njr", "Njerep", nil},
+	{"", "njs", "njs", "Nisa", nil},
+	{"", "njt", "njt", "Ndyuka-Trio Pidgin", nil},
+	{"", "nju", "nju", "Ngadjunmaya", nil},
+	{"", "njx", "njx", "Kunyi", nil},
+	{"", "njy", "njy", "Njyem", nil},
+	{"", "njz", "njz", "Nyishi", nil},
+	{"", "nka", "nka", "Nkoya", nil},
+	{"", "nkb", "nkb", "Khoibu Naga", nil},
+	{"", "nkc", "nkc", "Nkongho", nil},
+	{"", "nkd", "nkd", "Koireng", nil},
+	{"", "nke", "nke", "Duke", nil},
+	{"", "nkf", "nkf", "Inpui Naga", nil},
+	{"", "nkg", "nkg", "Nekgini", nil},
+	{"", "nkh", "nkh", "Khezha Naga", nil},
+	{"", "nki", "nki", "Thangal Naga", nil},
+	{"", "nkj", "nkj", "Nakai", nil},
+	{"", "nkk", "nkk", "Nokuku", nil},
+	{"", "nkm", "nkm", "Namat", nil},
+	{"", "nkn", "nkn", "Nkangala", nil},
+	{"", "nko", "nko", "Nkonya", nil},
+	{"", "nkp", "nkp", "Niuatoputapu", nil},
+	{"", "nkq", "nkq", "Nkami", nil},
+	{"", "nkr", "nkr", "Nukuoro", nil},
+	{"", "nks", "nks", "North Asmat", nil},
+	{"", "nkt", "nkt", "Nyika (Tanzania)", nil},
+	{"", "nku", "nku", "Bouna Kulango", nil},
+	{"", "nkv", "nkv", "Nyika (Malawi and Zambia)", nil},
+	{"", "nkw", "nkw", "Nkutu", nil},
+	{"", "nkx", "nkx", "Nkoroo", nil},
+	{"", "nkz", "nkz", "Nkari", nil},
+	{"", "nla", "nla", "Ngombale", nil},
+	{"", "nlc", "nlc", "Nalca", nil},
+	{"nl", "dut", "nld", "Dutch; Flemish", nil},
+	{"", "nle", "nle", "East Nyala", nil},
+	{"", "nlg", "nlg", "Gela", nil},
+	{"", "nli", "nli", "Grangali", nil},
+	{"", "nlj", "nlj", "Nyali", nil},
+	{"", "nlk", "nlk", "Ninia Yali", nil},
+	{"", "nll", "nll", "Nihali", nil},
+	{"", "nlm", "nlm", "Mankiyali", nil},
+	{"", "nlo", "nlo", "Ngul", nil},
+	{"", "nlq", "nlq", "Lao Naga", nil},
+	{"", "nlu", "nlu", "Nchumbulu", nil},
+	{"", "nlv", "nlv", "Orizaba Nahuatl", nil},
+	{"", "nlw", "nlw", "Walangama", nil},
+	{"", "nlx", "nlx", "Nahali", nil},
+	{"", "nly", "nly", "Nyamal", nil},
+	{"", "nlz", "nlz", "Nalögo", nil},
+	{"", "nma", "nma", "Maram Naga", nil},
+	{"", "nmb", "nmb", "Big Nambas", nil},
+	{"", "nmc", "nmc", "Ngam", nil},
+	{"", "nmd", "nmd", "Ndumu", nil},
+	{"", "nme", "nme", "Mzieme Naga", nil},
+	{"", "nmf", "nmf", "Tangkhul Naga (India)", nil},
+	{"", "nmg", "nmg", "Kwasio", nil},
+	{"", "nmh", "nmh", "Monsang Naga", nil},
+	{"", "nmi", "nmi", "Nyam", nil},
+	{"", "nmj", "nmj", "Ngombe (Central African Republic)", nil},
+	{"", "nmk", "nmk", "Namakura", nil},
+	{"", "nml", "nml", "Ndemli", nil},
+	{"", "nmm", "nmm", "Manangba", nil},
+	{"", "nmn", "nmn", "ǃXóõ", nil},
+	{"", "nmo", "nmo", "Moyon Naga", nil},
+	{"", "nmp", "nmp", "Nimanbur", nil},
+	{"", "nmq", "nmq", "Nambya", nil},
+	{"", "nmr", "nmr", "Nimbari", nil},
+	{"", "nms", "nms", "Letemboi", nil},
+	{"", "nmt", "nmt", "Namonuito", nil},
+	{"", "nmu", "nmu", "Northeast Maidu", nil},
+	{"", "nmv", "nmv", "Ngamini", nil},
+	{"", "nmw", "nmw", "Nimoa", nil},
+	{"", "nmx", "nmx", "Nama (Papua New Guinea)", nil},
+	{"", "nmy", "nmy", "Namuyi", nil},
+	{"", "nmz", "nmz", "Nawdm", nil},
+	{"", "nna", "nna", "Nyangumarta", nil},
+	{"", "nnb", "nnb", "Nande", nil},
+	{"", "nnc", "nnc", "Nancere", nil},
+	{"", "nnd", "nnd", "West Ambae", nil},
+	{"", "nne", "nne", "Ngandyera", nil},
+	{"", "nnf", "nnf", "Ngaing", nil},
+	{"", "nng", "nng", "Maring Naga", nil},
+	{"", "nnh", "nnh", "Ngiemboon", nil},
+	{"", "nni", "nni", "North Nuaulu", nil},
+	{"", "nnj", "nnj", "Nyangatom", nil},
+	{"", "nnk", "nnk", "Nankina", nil},
+	{"", "nnl", "nnl", "Northern Rengma Naga", nil},
+	{"", "nnm", "nnm", "Namia", nil},
+	{"", "nnn", "nnn", "Ngete", nil},
+	{"nn", "nno", "nno", "Norwegian Nynorsk; Nynorsk, Norwegian", nil},
+	{"", "nnp", "nnp", "Wancho Naga", nil},
+	{"", "nnq", "nnq", "Ngindo", nil},
+	{"", "nnr", "nnr", "Narungga", nil},
+	{"", "nnt", "nnt", "Nanticoke", nil},
+	{"", "nnu", "nnu", "Dwang", nil},
+	{"", "nnv", "nnv", "Nugunu (Australia)", nil},
+	{"", "nnw", "nnw", "Southern Nuni", nil},
+	{"", "nny", "nny", "Nyangga", nil},
+	{"", "nnz", "nnz", "Nda'nda'", nil},
+	{"", "noa", "noa", "Woun Meu", nil},
+	{"nb", "nob", "nob", "Bokmål, Norwegian; Norwegian Bokmål", nil},
+	{"", "noc", "noc", "Nuk", nil},
+	{"", "nod", "nod", "Northern Thai", nil},
+	{"", "noe", "noe", "Nimadi", nil},
+	{"", "nof", "nof", "Nomane", nil},
+	{"", "nog", "nog", "Nogai", nil},
+	{"", "noh", "noh", "Nomu", nil},
+	{"", "noi", "noi", "Noiri", nil},
+	{"", "noj", "noj", "Nonuya", nil},
+	{"", "nok", "nok", "Nooksack", nil},
+	{"", "nol", "nol", "Nomlaki", nil},
+	{"", "nom", "nom", "Nocamán", nil},
+	{"", "non", "non", "Norse, Old", nil},
+	{"", "nop", "nop", "Numanggang", nil},
+	{"", "noq", "noq", "Ngongo", nil},
+	{"no", "nor", "nor", "Norwegian", nil},
+	{"", "nos", "nos", "Eastern Nisu", nil},
+	{"", "not", "not", "Nomatsiguenga", nil},
+	{"", "nou", "nou", "Ewage-Notu", nil},
+	{"", "nov", "nov", "Novial", nil},
+	{"", "now", "now", "Nyambo", nil},
+	{"", "noy", "noy", "Noy", nil},
+	{"", "noz", "noz", "Nayi", nil},
+	{"", "npa", "npa", "Nar Phu", nil},
+	{"", "npb", "npb", "Nupbikha", nil},
+	{"", "npg", "npg", "Ponyo-Gongwang Naga", nil},
+	{"", "nph", "nph", "Phom Naga", nil},
+	{"", "npi", "npi", "Nepali (individual language)", nil},
+	{"", "npl", "npl", "Southeastern Puebla Nahuatl", nil},
+	{"", "npn", "npn", "Mondropolon", nil},
+	{"", "npo", "npo", "Pochuri Naga", nil},
+	{"", "nps", "nps", "Nipsan", nil},
+	{"", "npu", "npu", "Puimei Naga", nil},
+	{"", "npx", "npx", "Noipx", nil},
+	{"", "npy", "npy", "Napu", nil},
+	{"", "nqg", "nqg", "Southern Nago", nil},
+	{"", "nqk", "nqk", "Kura Ede Nago", nil},
+	{"", "nql", "nql", "Ngendelengo", nil},
+	{"", "nqm", "nqm", "Ndom", nil},
+	{"", "nqn", "nqn", "Nen", nil},
+	{"", "nqo", "nqo", "N'Ko", nil},
+	{"", "nqq", "nqq", "Kyan-Karyaw Naga", nil},
+	{"", "nqt", "nqt", "Nteng", nil},
+	{"", "nqy", "nqy", "Akyaung Ari Naga", nil},
+	{"", "nra", "nra", "Ngom", nil},
+	{"", "nrb", "nrb", "Nara", nil},
+	{"", "nrc", "nrc", "Noric", nil},
+	{"", "nre", "nre", "Southern Rengma Naga", nil},
+	{"", "nrf", "nrf", "Jèrriais", nil},
+	{"", "nrg", "nrg", "Narango", nil},
+	{"", "nri", "nri", "Chokri Naga", nil},
+	{"", "nrk", "nrk", "Ngarla", nil},
+	{"", "nrl", "nrl", "Ngarluma", nil},
+	{"", "nrm", "nrm", "Narom", nil},
+	{"", "nrn", "nrn", "Norn", nil},
+	{"", "nrp", "nrp", "North Picene", nil},
+	{"", "nrr", "nrr", "Norra", nil},
+	{"", "nrt", "nrt", "Northern Kalapuya", nil},
+	{"", "nru", "nru", "Narua", nil},
+	{"", "nrx", "nrx", "Ngurmbur", nil},
+	{"", "nrz", "nrz", "Lala", nil},
+	{"", "nsa", "nsa", "Sangtam Naga", nil},
+	{"", "nsb", "nsb", "Lower Nossob", nil},
+	{"", "nsc", "nsc", "Nshi", nil},
+	{"", "nsd", "nsd", "Southern Nisu", nil},
+	{"", "nse", "nse", "Nsenga", nil},
+	{"", "nsf", "nsf", "Northwestern Nisu", nil},
+	{"", "nsg", "nsg", "Ngasa", nil},
+	{"", "nsh", "nsh", "Ngoshie", nil},
+	{"", "nsi", "nsi", "Nigerian Sign Language", nil},
+	{"", "nsk", "nsk", "Naskapi", nil},
+	{"", "nsl", "nsl", "Norwegian Sign Language", nil},
+	{"", "nsm", "nsm", "Sumi Naga", nil},
+	{"", "nsn", "nsn", "Nehan", nil},
+	{"", "nso", "nso", "Pedi; Sepedi; Northern Sotho", nil},
+	{"", "nsp", "nsp", "Nepalese Sign Language", nil},
+	{"", "nsq", "nsq", "Northern Sierra Miwok", nil},
+	{"", "nsr", "nsr", "Maritime Sign Language", nil},
+	{"", "nss", "nss", "Nali", nil},
+	{"", "nst", "nst", "Tase Naga", nil},
+	{"", "nsu", "nsu", "Sierra Negra Nahuatl", nil},
+	{"", "nsv", "nsv", "Southwestern Nisu", nil},
+	{"", "nsw", "nsw", "Navut", nil},
+	{"", "nsx", "nsx", "Nsongo", nil},
+	{"", "nsy", "nsy", "Nasal", nil},
+	{"", "nsz", "nsz", "Nisenan", nil},
+	{"", "ntd", "ntd", "Northern Tidung", nil},
+	{"", "nte", "nte", "Nathembo", nil},
+	{"", "ntg", "ntg", "Ngantangarra", nil},
+	{"", "nti", "nti", "Natioro", nil},
+	{"", "ntj", "ntj", "Ngaanyatjarra", nil},
+	{"", "ntk", "ntk", "Ikoma-Nata-Isenye", nil},
+	{"", "ntm", "ntm", "Nateni", nil},
+	{"", "nto", "nto", "Ntomba", nil},
+	{"", "ntp", "ntp", "Northern Tepehuan", nil},
+	{"", "ntr", "ntr", "Delo", nil},
+	{"", "ntu", "ntu", "Natügu", nil},
+	{"", "ntw", "ntw", "Nottoway", nil},
+	{"", "ntx", "ntx", "Tangkhul Naga (Myanmar)", nil},
+	{"", "nty", "nty", "Mantsi", nil},
+	{"", "ntz", "ntz", "Natanzi", nil},
+	{"", "nua", "nua", "Yuanga", nil},
+	{"", "nub", "nub", "Nubian languages", nil},
+	{"", "nuc", "nuc", "Nukuini", nil},
+	{"", "nud", "nud", "Ngala", nil},
+	{"", "nue", "nue", "Ngundu", nil},
+	{"", "nuf", "nuf", "Nusu", nil},
+	{"", "nug", "nug", "Nungali", nil},
+	{"", "nuh", "nuh", "Ndunda", nil},
+	{"", "nui", "nui", "Ngumbi", nil},
+	{"", "nuj", "nuj", "Nyole", nil},
+	{"", "nuk", "nuk", "Nuu-chah-nulth", nil},
+	{"", "nul", "nul", "Nusa Laut", nil},
+	{"", "num", "num", "Niuafo'ou", nil},
+	{"", "nun", "nun", "Anong", nil},
+	{"", "nuo", "nuo", "Nguôn", nil},
+	{"", "nup", "nup", "Nupe-Nupe-Tako", nil},
+	{"", "nuq", "nuq", "Nukumanu", nil},
+	{"", "nur", "nur", "Nukuria", nil},
+	{"", "nus", "nus", "Nuer", nil},
+	{"", "nut", "nut", "Nung (Viet Nam)", nil},
+	{"", "nuu", "nuu", "Ngbundu", nil},
+	{"", "nuv", "nuv", "Northern Nuni", nil},
+	{"", "nuw", "nuw", "Nguluwan", nil},
+	{"", "nux", "nux", "Mehek", nil},
+	{"", "nuy", "nuy", "Nunggubuyu", nil},
+	{"", "nuz", "nuz", "Tlamacazapa Nahuatl", nil},
+	{"", "nvh", "nvh", "Nasarian", nil},
+	{"", "nvm", "nvm", "Namiae", nil},
+	{"", "nvo", "nvo", "Nyokon", nil},
+	{"", "nwa", "nwa", "Nawathinehena", nil},
+	{"", "nwb", "nwb", "Nyabwa", nil},
+	{"", "nwc", "nwc", "Classical Newari; Old Newari; Classical Nepal Bhasa", nil},
+	{"", "nwe", "nwe", "Ngwe", nil},
+	{"", "nwg", "nwg", "Ngayawung", nil},
+	{"", "nwi", "nwi", "Southwest Tanna", nil},
+	{"", "nwm", "nwm", "Nyamusa-Molo", nil},
+	{"", "nwo", "nwo", "Nauo", nil},
+	{"", "nwr", "nwr", "Nawaru", nil},
+	{"", "nww", "nww", "Ndwewe", nil},
+	{"", "nwx", "nwx", "Middle Newar", nil},
+	{"", "nwy", "nwy", "Nottoway-Meherrin", nil},
+	{"", "nxa", "nxa", "Nauete", nil},
+	{"", "nxd", "nxd", "Ngando (Democratic Republic of Congo)", nil},
+	{"", "nxe", "nxe", "Nage", nil},
+	{"", "nxg", "nxg", "Ngad'a", nil},
+	{"", "nxi", "nxi", "Nindi", nil},
+	{"", "nxk", "nxk", "Koki Naga", nil},
+	{"", "nxl", "nxl", "South Nuaulu", nil},
+	{"", "nxm", "nxm", "Numidian", nil},
+	{"", "nxn", "nxn", "Ngawun", nil},
+	{"", "nxo", "nxo", "Ndambomo", nil},
+	{"", "nxq", "nxq", "Naxi", nil},
+	{"", "nxr", "nxr", "Ninggerum", nil},
+	{"", "nxx", "nxx", "Nafri", nil},
+	{"ny", "nya", "nya", "Chichewa; Chewa; Nyanja", nil},
+	{"", "nyb", "nyb", "Nyangbo", nil},
+	{"", "nyc", "nyc", "Nyanga-li", nil},
+	{"", "nyd", "nyd", "Nyore", nil},
+	{"", "nye", "nye", "Nyengo", nil},
+	{"", "nyf", "nyf", "Giryama", nil},
+	{"", "nyg", "nyg", "Nyindu", nil},
+	{"", "nyh", "nyh", "Nyikina", nil},
+	{"", "nyi", "nyi", "Ama (Sudan)", nil},
+	{"", "nyj", "nyj", "Nyanga", nil},
+	{"", "nyk", "nyk", "Nyaneka", nil},
+	{"", "nyl", "nyl", "Nyeu", nil},
+	{"", "nym", "nym", "Nyamwezi", nil},
+	{"", "nyn", "nyn", "Nyankole", nil},
+	{"", "nyo", "nyo", "Nyoro", nil},
+	{"", "nyp", "nyp", "Nyang'i", nil},
+	{"", "nyq", "nyq", "Nayini", nil},
+	{"", "nyr", "nyr", "Nyiha (Malawi)", nil},
+	{"", "nys", "nys", "Nyungar", nil},
+	{"", "nyt", "nyt", "Nyawaygi", nil},
+	{"", "nyu", "nyu", "Nyungwe", nil},
+	{"", "nyv", "nyv", "Nyulnyul", nil},
+	{"", "nyw", "nyw", "Nyaw", nil},
+	{"", "nyx", "nyx", "Nganyaywana", nil},
+	{"", "nyy", "nyy", "Nyakyusa-Ngonde", nil},
+	{"", "nza", "nza", "Tigon Mbembe", nil},
+	{"", "nzb", "nzb", "Njebi", nil},
+	{"", "nzd", "nzd", "Nzadi", nil},
+	{"", "nzi", "nzi", "Nzima", nil},
+	{"", "nzk", "nzk", "Nzakara", nil},
+	{"", "nzm", "nzm", "Zeme Naga", nil},
+	{"", "nzs", "nzs", "New Zealand Sign Language", nil},
+	{"", "nzu", "nzu", "Teke-Nzikou", nil},
+	{"", "nzy", "nzy", "Nzakambay", nil},
+	{"", "nzz", "nzz", "Nanga Dama Dogon", nil},
+	{"", "oaa", "oaa", "Orok", nil},
+	{"", "oac", "oac", "Oroch", nil},
+	{"", "oar", "oar", "Old Aramaic (up to 700 BCE)", nil},
+	{"", "oav", "oav", "Old Avar", nil},
+	{"", "obi", "obi", "Obispeño", nil},
+	{"", "obk", "obk", "Southern Bontok", nil},
+	{"", "obl", "obl", "Oblo", nil},
+	{"", "obm", "obm", "Moabite", nil},
+	{"", "obo", "obo", "Obo Manobo", nil},
+	{"", "obr", "obr", "Old Burmese", nil},
+	{"", "obt", "obt", "Old Breton", nil},
+	{"", "obu", "obu", "Obulom", nil},
+	{"", "oca", "oca", "Ocaina", nil},
+	{"", "och", "och", "Old Chinese", nil},
+	{"oc", "oci", "oci", "Occitan (post 1500); Provençal", nil},
+	{"", "ocm", "ocm", "Old Cham", nil},
+	{"", "oco", "oco", "Old Cornish", nil},
+	{"", "ocu", "ocu", "Atzingo Matlatzinca", nil},
+	{"", "oda", "oda", "Odut", nil},
+	{"", "odk", "odk", "Od", nil},
+	{"", "odt", "odt", "Old Dutch", nil},
+	{"", "odu", "odu", "Odual", nil},
+	{"", "ofo", "ofo", "Ofo", nil},
+	{"", "ofs", "ofs", "Old Frisian", nil},
+	{"", "ofu", "ofu", "Efutop", nil},
+	{"", "ogb", "ogb", "Ogbia", nil},
+	{"", "ogc", "ogc", "Ogbah", nil},
+	{"", "oge", "oge", "Old Georgian", nil},
+	{"", "ogg", "ogg", "Ogbogolo", nil},
+	{"", "ogo", "ogo", "Khana", nil},
+	{"", "ogu", "ogu", "Ogbronuagum", nil},
+	{"", "oht", "oht", "Old Hittite", nil},
+	{"", "ohu", "ohu", "Old Hungarian", nil},
+	{"", "oia", "oia", "Oirata", nil},
+	{"", "oie", "oie", "Okolie", nil},
+	{"", "oin", "oin", "Inebu One", nil},
+	{"", "ojb", "ojb", "Northwestern Ojibwa", nil},
+	{"", "ojc", "ojc", "Central Ojibwa", nil},
+	{"", "ojg", "ojg", "Eastern Ojibwa", nil},
+	{"oj", "oji", "oji", "Ojibwa", nil},
+	{"", "ojp", "ojp", "Old Japanese", nil},
+	{"", "ojs", "ojs", "Severn Ojibwa", nil},
+	{"", "ojv", "ojv", "Ontong Java", nil},
+	{"", "ojw", "ojw", "Western Ojibwa", nil},
+	{"", "oka", "oka", "Okanagan", nil},
+	{"", "okb", "okb", "Okobo", nil},
+	{"", "okc", "okc", "Kobo", nil},
+	{"", "okd", "okd", "Okodia", nil},
+	{"", "oke", "oke", "Okpe (Southwestern Edo)", nil},
+	{"", "okg", "okg", "Koko Babangk", nil},
+	{"", "okh", "okh", "Koresh-e Rostam", nil},
+	{"", "oki", "oki", "Okiek", nil},
+	{"", "okj", "okj", "Oko-Juwoi", nil},
+	{"", "okk", "okk", "Kwamtim One", nil},
+	{"", "okl", "okl", "Old Kentish Sign Language", nil},
+	{"", "okm", "okm", "Middle Korean (10th-16th cent.)", nil},
+	{"", "okn", "okn", "Oki-No-Erabu", nil},
+	{"", "oko", "oko", "Old Korean (3rd-9th cent.)", nil},
+	{"", "okr", "okr", "Kirike", nil},
+	{"", "oks", "oks", "Oko-Eni-Osayen", nil},
+	{"", "oku", "oku", "Oku", nil},
+	{"", "okv", "okv", "Orokaiva", nil},
+	{"", "okx", "okx", "Okpe (Northwestern Edo)", nil},
+	{"", "okz", "okz", "Old Khmer", nil},
+	{"", "ola", "ola", "Walungge", nil},
+	{"", "old", "old", "Mochi", nil},
+	{"", "ole", "ole", "Olekha", nil},
+	{"", "olk", "olk", "Olkol", nil},
+	{"", "olm", "olm", "Oloma", nil},
+	{"", "olo", "olo", "Livvi", nil},
+	{"", "olr", "olr", "Olrat", nil},
+	{"", "olt", "olt", "Old Lithuanian", nil},
+	{"", "olu", "olu", "Kuvale", nil},
+	{"", "oma", "oma", "Omaha-Ponca", nil},
+	{"", "omb", "omb", "East Ambae", nil},
+	{"", "omc", "omc", "Mochica", nil},
+	{"", "omg", "omg", "Omagua", nil},
+	{"", "omi", "omi", "Omi", nil},
+	{"", "omk", "omk", "Omok", nil},
+	{"", "oml", "oml", "Ombo", nil},
+	{"", "omn", "omn", "Minoan", nil},
+	{"", "omo", "omo", "Utarmbung", nil},
+	{"", "omp", "omp", "Old Manipuri", nil},
+	{"", "omr", "omr", "Old Marathi", nil},
+	{"", "omt", "omt", "Omotik", nil},
+	{"", "omu", "omu", "Omurano", nil},
+	{"", "omw", "omw", "South Tairora", nil},
+	{"", "omx", "omx", "Old Mon", nil},
+	{"", "omy", "omy", "Old Malay", nil},
+	{"", "ona", "ona", "Ona", nil},
+	{"", "onb", "onb", "Lingao", nil},
+	{"", "one", "one", "Oneida", nil},
+	{"", "ong", "ong", "Olo", nil},
+	{"", "oni", "oni", "Onin", nil},
+	{"", "onj", "onj", "Onjob", nil},
+	{"", "onk", "onk", "Kabore One", nil},
+	{"", "onn", "onn", "Onobasulu", nil},
+	{"", "ono", "ono", "Onondaga", nil},
+	{"", "onp", "onp", "Sartang", nil},
+	{"", "onr", "onr", "Northern One", nil},
+	{"", "ons", "ons", "Ono", nil},
+	{"", "ont", "ont", "Ontenu", nil},
+	{"", "onu", "onu", "Unua", nil},
+	{"", "onw", "onw", "Old Nubian", nil},
+	{"", "onx", "onx", "Onin Based Pidgin", nil},
+	{"", "ood", "ood", "Tohono O'odham", nil},
+	{"", "oog", "oog", "Ong", nil},
+	{"", "oon", "oon", "Önge", nil},
+	{"", "oor", "oor", "Oorlams", nil},
+	{"", "oos", "oos", "Old Ossetic", nil},
+	{"", "opa", "opa", "Okpamheri", nil},
+	{"", "opk", "opk", "Kopkaka", nil},
+	{"", "opm", "opm", "Oksapmin", nil},
+	{"", "opo", "opo", "Opao", nil},
+	{"", "opt", "opt", "Opata", nil},
+	{"", "opy", "opy", "Ofayé", nil},
+	{"", "ora", "ora", "Oroha", nil},
+	{"", "orc", "orc", "Orma", nil},
+	{"", "ore", "ore", "Orejón", nil},
+	{"", "org", "org", "Oring", nil},
+	{"", "orh", "orh", "Oroqen", nil},
+	{"or", "ori", "ori", "Oriya", nil},
+	{"om", "orm", "orm", "Oromo", nil},
+	{"", "orn", "orn", "Orang Kanaq", nil},
+	{"", "oro", "oro", "Orokolo", nil},
+	{"", "orr", "orr", "Oruma", nil},
+	{"", "ors", "ors", "Orang Seletar", nil},
+	{"", "ort", "ort", "Adivasi Oriya", nil},
+	{"", "oru", "oru", "Ormuri", nil},
+	{"", "orv", "orv", "Old Russian", nil},
+	{"", "orw", "orw", "Oro Win", nil},
+	{"", "orx", "orx", "Oro", nil},
+	{"", "ory", "ory", "Odia", nil},
+	{"", "orz", "orz", "Ormu", nil},
+	{"", "osa", "osa", "Osage", nil},
+	{"", "osc", "osc", "Oscan", nil},
+	{"", "osi", "osi", "Osing", nil},
+	{"", "osn", "osn", "Old Sundanese", nil},
+	{"", "oso", "oso", "Ososo", nil},
+	{"", "osp", "osp", "Old Spanish", nil},
+	{"os", "oss", "oss", "Ossetian; Ossetic", nil},
+	{"", "ost", "ost", "Osatu", nil},
+	{"", "osu", "osu", "Southern One", nil},
+	{"", "osx", "osx", "Old Saxon", nil},
+	{"", "ota", "ota", "Turkish, Ottoman (1500-1928)", nil},
+	{"", "otb", "otb", "Old Tibetan", nil},
+	{"", "otd", "otd", "Ot Danum", nil},
+	{"", "ote", "ote", "Mezquital Otomi", nil},
+	{"", "oti", "oti", "Oti", nil},
+	{"", "otk", "otk", "Old Turkish", nil},
+	{"", "otl", "otl", "Tilapa Otomi", nil},
+	{"", "otm", "otm", "Eastern Highland Otomi", nil},
+	{"", "otn", "otn", "Tenango Otomi", nil},
+	{"", "oto", "oto", "Otomian languages", nil},
+	{"", "otq", "otq", "Querétaro Otomi", nil},
+	{"", "otr", "otr", "Otoro", nil},
+	{"", "ots", "ots", "Estado de México Otomi", nil},
+	{"", "ott", "ott", "Temoaya Otomi", nil},
+	{"", "otu", "otu", "Otuke", nil},
+	{"", "otw", "otw", "Ottawa", nil},
+	{"", "otx", "otx", "Texcatepec Otomi", nil},
+	{"", "oty", "oty", "Old Tamil", nil},
+	{"", "otz", "otz", "Ixtenco Otomi", nil},
+	{"", "oua", "oua", "Tagargrent", nil},
+	{"", "oub", "oub", "Glio-Oubi", nil},
+	{"", "oue", "oue", "Oune", nil},
+	{"", "oui", "oui", "Old Uighur", nil},
+	{"", "oum", "oum", "Ouma", nil},
+	{"", "ovd", "ovd", "Elfdalian", nil},
+	{"", "owi", "owi", "Owiniga", nil},
+	{"", "owl", "owl", "Old Welsh", nil},
+	{"", "oyb", "oyb", "Oy", nil},
+	{"", "oyd", "oyd", "Oyda", nil},
+	{"", "oym", "oym", "Wayampi", nil},
+	{"", "oyy", "oyy", "Oya'oya", nil},
+	{"", "ozm", "ozm", "Koonzime", nil},
+	{"", "paa", "paa", "Papuan languages", nil},
+	{"", "pab", "pab", "Parecís", nil},
+	{"", "pac", "pac", "Pacoh", nil},
+	{"", "pad", "pad", "Paumarí", nil},
+	{"", "pae", "pae", "Pagibete", nil},
+	{"", "paf", "paf", "Paranawát", nil},
+	{"", "pag", "pag", "Pangasinan", nil},
+	{"", "pah", "pah", "Tenharim", nil},
+	{"", "pai", "pai", "Pe", nil},
+	{"", "pak", "pak", "Parakanã", nil},
+	{"", "pal", "pal", "Pahlavi", nil},
+	{"", "pam", "pam", "Pampanga; Kapampangan", nil},
+	{"pa", "pan", "pan", "Panjabi; Punjabi", nil},
+	{"", "pao", "pao", "Northern Paiute", nil},
+	{"", "pap", "pap", "Papiamento", nil},
+	{"", "paq", "paq", "Parya", nil},
+	{"", "par", "par", "Panamint", nil},
+	{"", "pas", "pas", "Papasena", nil},
+	{"", "pau", "pau", "Palauan", nil},
+	{"", "pav", "pav", "Pakaásnovos", nil},
+	{"", "paw", "paw", "Pawnee", nil},
+	{"", "pax", "pax", "Pankararé", nil},
+	{"", "pay", "pay", "Pech", nil},
+	{"", "paz", "paz", "Pankararú", nil},
+	{"", "pbb", "pbb", "Páez", nil},
+	{"", "pbc", "pbc", "Patamona", nil},
+	{"", "pbe", "pbe", "Mezontla Popoloca", nil},
+	{"", "pbf", "pbf", "Coyotepec Popoloca", nil},
+	{"", "pbg", "pbg", "Paraujano", nil},
+	{"", "pbh", "pbh", "E'ñapa Woromaipu", nil},
+	{"", "pbi", "pbi", "Parkwa", nil},
+	{"", "pbl", "pbl", "Mak (Nigeria)", nil},
+	{"", "pbm", "pbm", "Puebla Mazatec", nil},
+	{"", "pbn", "pbn", "Kpasam", nil},
+	{"", "pbo", "pbo", "Papel", nil},
+	{"", "pbp", "pbp", "Badyara", nil},
+	{"", "pbr", "pbr", "Pangwa", nil},
+	{"", "pbs", "pbs", "Central Pame", nil},
+	{"", "pbt", "pbt", "Southern Pashto", nil},
+	{"", "pbu", "pbu", "Northern Pashto", nil},
+	{"", "pbv", "pbv", "Pnar", nil},
+	{"", "pby", "pby", "Pyu (Papua New Guinea)", nil},
+	{"", "pca", "pca", "Santa Inés Ahuatempan Popoloca", nil},
+	{"", "pcb", "pcb", "Pear", nil},
+	{"", "pcc", "pcc", "Bouyei", nil},
+	{"", "pcd", "pcd", "Picard", nil},
+	{"", "pce", "pce", "Ruching Palaung", nil},
+	{"", "pcf", "pcf", "Paliyan", nil},
+	{"", "pcg", "pcg", "Paniya", nil},
+	{"", "pch", "pch", "Pardhan", nil},
+	{"", "pci", "pci", "Duruwa", nil},
+	{"", "pcj", "pcj", "Parenga", nil},
+	{"", "pck", "pck", "Paite Chin", nil},
+	{"", "pcl", "pcl", "Pardhi", nil},
+	{"", "pcm", "pcm", "Nigerian Pidgin", nil},
+	{"", "pcn", "pcn", "Piti", nil},
+	{"", "pcp", "pcp", "Pacahuara", nil},
+	{"", "pcw", "pcw", "Pyapun", nil},
+	{"", "pda", "pda", "Anam", nil},
+	{"", "pdc", "pdc", "Pennsylvania German", nil},
+	{"", "pdi", "pdi", "Pa Di", nil},
+	{"", "pdn", "pdn", "Podena", nil},
+	{"", "pdo", "pdo", "Padoe", nil},
+	{"", "pdt", "pdt", "Plautdietsch", nil},
+	{"", "pdu", "pdu", "Kayan", nil},
+	{"", "pea", "pea", "Peranakan Indonesian", nil},
+	{"", "peb", "peb", "Eastern Pomo", nil},
+	{"", "ped", "ped", "Mala (Papua New Guinea)", nil},
+	{"", "pee", "pee", "Taje", nil},
+	{"", "pef", "pef", "Northeastern Pomo", nil},
+	{"", "peg", "peg", "Pengo", nil},
+	{"", "peh", "peh", "Bonan", nil},
+	{"", "pei", "pei", "Chichimeca-Jonaz", nil},
+	{"", "pej", "pej", "Northern Pomo", nil},
+	{"", "pek", "pek", "Penchal", nil},
+	{"", "pel", "pel", "Pekal", nil},
+	{"", "pem", "pem", "Phende", nil},
+	{"", "peo", "peo", "Persian, Old (ca. 600-400 B.C.)", nil},
+	{"", "pep", "pep", "Kunja", nil},
+	{"", "peq", "peq", "Southern Pomo", nil},
+	{"", "pes", "pes", "Iranian Persian", nil},
+	{"", "pev", "pev", "Pémono", nil},
+	{"", "pex", "pex", "Petats", nil},
+	{"", "pey", "pey", "Petjo", nil},
+	{"", "pez", "pez", "Eastern Penan", nil},
+	{"", "pfa", "pfa", "Pááfang", nil},
+	{"", "pfe", "pfe", "Pere", nil},
+	{"", "pfl", "pfl", "Pfaelzisch", nil},
+	{"", "pga", "pga", "Sudanese Creole Arabic", nil},
+	{"", "pgd", "pgd", "Gāndhārī", nil},
+	{"", "pgg", "pgg", "Pangwali", nil},
+	{"", "pgi", "pgi", "Pagi", nil},
+	{"", "pgk", "pgk", "Rerep", nil},
+	{"", "pgl", "pgl", "Primitive Irish", nil},
+	{"", "pgn", "pgn", "Paelignian", nil},
+	{"", "pgs", "pgs", "Pangseng", nil},
+	{"", "pgu", "pgu", "Pagu", nil},
+	{"", "pgz", "pgz", "Papua New Guinean Sign Language", nil},
+	{"", "pha", "pha", "Pa-Hng", nil},
+	{"", "phd", "phd", "Phudagi", nil},
+	{"", "phg", "phg", "Phuong", nil},
+	{"", "phh", "phh", "Phukha", nil},
+	{"", "phi", "phi", "Philippine languages", nil},
+	{"", "phj", "phj", "Pahari", nil},
+	{"", "phk", "phk", "Phake", nil},
+	{"", "phl", "phl", "Phalura", nil},
+	{"", "phm", "phm", "Phimbi", nil},
+	{"", "phn", "phn", "Phoenician", nil},
+	{"", "pho", "pho", "Phunoi", nil},
+	{"", "phq", "phq", "Phana'", nil},
+	{"", "phr", "phr", "Pahari-Potwari", nil},
+	{"", "pht", "pht", "Phu Thai", nil},
+	{"", "phu", "phu", "Phuan", nil},
+	{"", "phv", "phv", "Pahlavani", nil},
+	{"", "phw", "phw", "Phangduwali", nil},
+	{"", "pia", "pia", "Pima Bajo", nil},
+	{"", "pib", "pib", "Yine", nil},
+	{"", "pic", "pic", "Pinji", nil},
+	{"", "pid", "pid", "Piaroa", nil},
+	{"", "pie", "pie", "Piro", nil},
+	{"", "pif", "pif", "Pingelapese", nil},
+	{"", "pig", "pig", "Pisabo", nil},
+	{"", "pih", "pih", "Pitcairn-Norfolk", nil},
+	{"", "pij", "pij", "Pijao", nil},
+	{"", "pil", "pil", "Yom", nil},
+	{"", "pim", "pim", "Powhatan", nil},
+	{"", "pin", "pin", "Piame", nil},
+	{"", "pio", "pio", "Piapoco", nil},
+	{"", "pip", "pip", "Pero", nil},
+	{"", "pir", "pir", "Piratapuyo", nil},
+	{"", "pis", "pis", "Pijin", nil},
+	{"", "pit", "pit", "Pitta Pitta", nil},
+	{"", "piu", "piu", "Pintupi-Luritja", nil},
+	{"", "piv", "piv", "Pileni", nil},
+	{"", "piw", "piw", "Pimbwe", nil},
+	{"", "pix", "pix", "Piu", nil},
+	{"", "piy", "piy", "Piya-Kwonci", nil},
+	{"", "piz", "piz", "Pije", nil},
+	{"", "pjt", "pjt", "Pitjantjatjara", nil},
+	{"", "pka", "pka", "Ardhamāgadhī Prākrit", nil},
+	{"", "pkb", "pkb", "Pokomo", nil},
+	{"", "pkc", "pkc", "Paekche", nil},
+	{"", "pkg", "pkg", "Pak-Tong", nil},
+	{"", "pkh", "pkh", "Pankhu", nil},
+	{"", "pkn", "pkn", "Pakanha", nil},
+	{"", "pko", "pko", "Pökoot", nil},
+	{"", "pkp", "pkp", "Pukapuka", nil},
+	{"", "pkr", "pkr", "Attapady Kurumba", nil},
+	{"", "pks", "pks", "Pakistan Sign Language", nil},
+	{"", "pkt", "pkt", "Maleng", nil},
+	{"", "pku", "pku", "Paku", nil},
+	{"", "pla", "pla", "Miani", nil},
+	{"", "plb", "plb", "Polonombauk", nil},
+	{"", "plc", "plc", "Central Palawano", nil},
+	{"", "pld", "pld", "Polari", nil},
+	{"", "ple", "ple", "Palu'e", nil},
+	{"", "plg", "plg", "Pilagá", nil},
+	{"", "plh", "plh", "Paulohi", nil},
+	{"pi", "pli", "pli", "Pali", nil},
+	{"", "plj", "plj", "Polci", nil},
+	{"", "plk", "plk", "Kohistani Shina", nil},
+	{"", "pll", "pll", "Shwe Palaung", nil},
+	{"", "pln", "pln", "Palenquero", nil},
+	{"", "plo", "plo", "Oluta Popoluca", nil},
+	{"", "plq", "plq", "Palaic", nil},
+	{"", "plr", "plr", "Palaka Senoufo", nil},
+	{"", "pls", "pls", "San Marcos Tlacoyalco Popoloca", nil},
+	{"", "plt", "plt", "Plateau Malagasy", nil},
+	{"", "plu", "plu", "Palikúr", nil},
+	{"", "plv", "plv", "Southwest Palawano", nil},
+	{"", "plw", "plw", "Brooke's Point Palawano", nil},
+	{"", "ply", "ply", "Bolyu", nil},
+	{"", "plz", "plz", "Paluan", nil},
+	{"", "pma", "pma", "Paama", nil},
+	{"", "pmb", "pmb", "Pambia", nil},
+	{"", "pmd", "pmd", "Pallanganmiddang", nil},
+	{"", "pme", "pme", "Pwaamei", nil},
+	{"", "pmf", "pmf", "Pamona", nil},
+	{"", "pmh", "pmh", "Māhārāṣṭri Prākrit", nil},
+	{"", "pmi", "pmi", "Northern Pumi", nil},
+	{"", "pmj", "pmj", "Southern Pumi", nil},
+	{"", "pmk", "pmk", "Pamlico", nil},
+	{"", "pml", "pml", "Lingua Franca", nil},
+	{"", "pmm", "pmm", "Pomo", nil},
+	{"", "pmn", "pmn", "Pam", nil},
+	{"", "pmo", "pmo", "Pom", nil},
+	{"", "pmq", "pmq", "Northern Pame", nil},
+	{"", "pmr", "pmr", "Paynamar", nil},
+	{"", "pms", "pms", "Piemontese", nil},
+	{"", "pmt", "pmt", "Tuamotuan", nil},
+	{"", "pmw", "pmw", "Plains Miwok", nil},
+	{"", "pmx", "pmx", "Poumei Naga", nil},
+	{"", "pmy", "pmy", "Papuan Malay", nil},
+	{"", "pmz", "pmz", "Southern Pame", nil},
+	{"", "pna", "pna", "Punan Bah-Biau", nil},
+	{"", "pnb", "pnb", "Western Panjabi", nil},
+	{"", "pnc", "pnc", "Pannei", nil},
+	{"", "pnd", "pnd", "Mpinda", nil},
+	{"", "pne", "pne", "Western Penan", nil},
+	{"", "png", "png", "Pangu", nil},
+	{"", "pnh", "pnh", "Penrhyn", nil},
+	{"", "pni", "pni", "Aoheng", nil},
+	{"", "pnj", "pnj", "Pinjarup", nil},
+	{"", "pnk", "pnk", "Paunaka", nil},
+	{"", "pnl", "pnl", "Paleni", nil},
+	{"", "pnm", "pnm", "Punan Batu 1", nil},
+	{"", "pnn", "pnn", "Pinai-Hagahai", nil},
+	{"", "pno", "pno", "Panobo", nil},
+	{"", "pnp", "pnp", "Pancana", nil},
+	{"", "pnq", "pnq", "Pana (Burkina Faso)", nil},
+	{"", "pnr", "pnr", "Panim", nil},
+	{"", "pns", "pns", "Ponosakan", nil},
+	{"", "pnt", "pnt", "Pontic", nil},
+	{"", "pnu", "pnu", "Jiongnai Bunu", nil},
+	{"", "pnv", "pnv", "Pinigura", nil},
+	{"", "pnw", "pnw", "Banyjima", nil},
+	{"", "pnx", "pnx", "Phong-Kniang", nil},
+	{"", "pny", "pny", "Pinyin", nil},
+	{"", "pnz", "pnz", "Pana (Central African Republic)", nil},
+	{"", "poc", "poc", "Poqomam", nil},
+	{"", "poe", "poe", "San Juan Atzingo Popoloca", nil},
+	{"", "pof", "pof", "Poke", nil},
+	{"", "pog", "pog", "Potiguára", nil},
+	{"", "poh", "poh", "Poqomchi'", nil},
+	{"", "poi", "poi", "Highland Popoluca", nil},
+	{"", "pok", "pok", "Pokangá", nil},
+	{"pl", "pol", "pol", "Polish", nil},
+	{"", "pom", "pom", "Southeastern Pomo", nil},
+	{"", "pon", "pon", "Pohnpeian", nil},
+	{"", "poo", "poo", "Central Pomo", nil},
+	{"", "pop", "pop", "Pwapwâ", nil},
+	{"", "poq", "poq", "Texistepec Popoluca", nil},
+	{"pt", "por", "por", "Portuguese", nil},
+	{"", "pos", "pos", "Sayula Popoluca", nil},
+	{"", "pot", "pot", "Potawatomi", nil},
+	{"", "pov", "pov", "Upper Guinea Crioulo", nil},
+	{"", "pow", "pow", "San Felipe Otlaltepec Popoloca", nil},
+	{"", "pox", "pox", "Polabian", nil},
+	{"", "poy", "poy", "Pogolo", nil},
+	{"", "ppe", "ppe", "Papi", nil},
+	{"", "ppi", "ppi", "Paipai", nil},
+	{"", "ppk", "ppk", "Uma", nil},
+	{"", "ppl", "ppl", "Pipil", nil},
+	{"", "ppm", "ppm", "Papuma", nil},
+	{"", "ppn", "ppn", "Papapana", nil},
+	{"", "ppo", "ppo", "Folopa", nil},
+	{"", "ppp", "ppp", "Pelende", nil},
+	{"", "ppq", "ppq", "Pei", nil},
+	{"", "pps", "pps", "San Luís Temalacayuca Popoloca", nil},
+	{"", "ppt", "ppt", "Pare", nil},
+	{"", "ppu", "ppu", "Papora", nil},
+	{"", "pqa", "pqa", "Pa'a", nil},
+	{"", "pqm", "pqm", "Malecite-Passamaquoddy", nil},
+	{"", "pra", "pra", "Prakrit languages", nil},
+	{"", "prc", "prc", "Parachi", nil},
+	{"", "prd", "prd", "Parsi-Dari", nil},
+	{"", "pre", "pre", "Principense", nil},
+	{"", "prf", "prf", "Paranan", nil},
+	{"", "prg", "prg", "Prussian", nil},
+	{"", "prh", "prh", "Porohanon", nil},
+	{"", "pri", "pri", "Paicî", nil},
+	{"", "prk", "prk", "Parauk", nil},
+	{"", "prl", "prl", "Peruvian Sign Language", nil},
+	{"", "prm", "prm", "Kibiri", nil},
+	{"", "prn", "prn", "Prasuni", nil},
+	{"", "pro", "pro", "Provençal, Old (to 1500)", nil},
+	{"", "prp", "prp", "Parsi", nil},
+	{"", "prq", "prq", "Ashéninka Perené", nil},
+	{"", "prr", "prr", "Puri", nil},
+	{"", "prs", "prs", "Dari", nil},
+	{"", "prt", "prt", "Phai", nil},
+	{"", "pru", "pru", "Puragi", nil},
+	{"", "prw", "prw", "Parawen", nil},
+	{"", "prx", "prx", "Purik", nil},
+	{"", "prz", "prz", "Providencia Sign Language", nil},
+	{"", "psa", "psa", "Asue Awyu", nil},
+	{"", "psc", "psc", "Iranian Sign Language", nil},
+	{"", "psd", "psd", "Plains Indian Sign Language", nil},
+	{"", "pse", "pse", "Central Malay", nil},
+	{"", "psg", "psg", "Penang Sign Language", nil},
+	{"", "psh", "psh", "Southwest Pashai", nil},
+	{"", "psi", "psi", "Southeast Pashai", nil},
+	{"", "psl", "psl", "Puerto Rican Sign Language", nil},
+	{"", "psm", "psm", "Pauserna", nil},
+	{"", "psn", "psn", "Panasuan", nil},
+	{"", "pso", "pso", "Polish Sign Language", nil},
+	{"", "psp", "psp", "Philippine Sign Language", nil},
+	{"", "psq", "psq", "Pasi", nil},
+	{"", "psr", "psr", "Portuguese Sign Language", nil},
+	{"", "pss", "pss", "Kaulong", nil},
+	{"", "pst", "pst", "Central Pashto", nil},
+	{"", "psu", "psu", "Sauraseni Prākrit", nil},
+	{"", "psw", "psw", "Port Sandwich", nil},
+	{"", "psy", "psy", "Piscataway", nil},
+	{"", "pta", "pta", "Pai Tavytera", nil},
+	{"", "pth", "pth", "Pataxó Hã-Ha-Hãe", nil},
+	{"", "pti", "pti", "Pindiini", nil},
+	{"", "ptn", "ptn", "Patani", nil},
+	{"", "pto", "pto", "Zo'é", nil},
+	{"", "ptp", "ptp", "Patep", nil},
+	{"", "ptq", "ptq", "Pattapu", nil},
+	{"", "ptr", "ptr", "Piamatsina", nil},
+	{"", "ptt", "ptt", "Enrekang", nil},
+	{"", "ptu", "ptu", "Bambam", nil},
+	{"", "ptv", "ptv", "Port Vato", nil},
+	{"", "ptw", "ptw", "Pentlatch", nil},
+	{"", "pty", "pty", "Pathiya", nil},
+	{"", "pua", "pua", "Western Highland Purepecha", nil},
+	{"", "pub", "pub", "Purum", nil},
+	{"", "puc", "puc", "Punan Merap", nil},
+	{"", "pud", "pud", "Punan Aput", nil},
+	{"", "pue", "pue", "Puelche", nil},
+	{"", "puf", "puf", "Punan Merah", nil},
+	{"", "pug", "pug", "Phuie", nil},
+	{"", "pui", "pui", "Puinave", nil},
+	{"", "puj", "puj", "Punan Tubu", nil},
+	{"", "pum", "pum", "Puma", nil},
+	{"", "puo", "puo", "Puoc", nil},
+	{"", "pup", "pup", "Pulabu", nil},
+	{"", "puq", "puq", "Puquina", nil},
+	{"", "pur", "pur", "Puruborá", nil},
+	{"ps", "pus", "pus", "Pushto; Pashto", nil},
+	{"", "put", "put", "Putoh", nil},
+	{"", "puu", "puu", "Punu", nil},
+	{"", "puw", "puw", "Puluwatese", nil},
+	{"", "pux", "pux", "Puare", nil},
+	{"", "puy", "puy", "Purisimeño", nil},
+	{"", "pwa", "pwa", "Pawaia", nil},
+	{"", "pwb", "pwb", "Panawa", nil},
+	{"", "pwg", "pwg", "Gapapaiwa", nil},
+	{"", "pwi", "pwi", "Patwin", nil},
+	{"", "pwm", "pwm", "Molbog", nil},
+	{"", "pwn", "pwn", "Paiwan", nil},
+	{"", "pwo", "pwo", "Pwo Western Karen", nil},
+	{"", "pwr", "pwr", "Powari", nil},
+	{"", "pww", "pww", "Pwo Northern Karen", nil},
+	{"", "pxm", "pxm", "Quetzaltepec Mixe", nil},
+	{"", "pye", "pye", "Pye Krumen", nil},
+	{"", "pym", "pym", "Fyam", nil},
+	{"", "pyn", "pyn", "Poyanáwa", nil},
+	{"", "pys", "pys", "Paraguayan Sign Language", nil},
+	{"", "pyu", "pyu", "Puyuma", nil},
+	{"", "pyx", "pyx", "Pyu (Myanmar)", nil},
+	{"", "pyy", "pyy", "Pyen", nil},
+	{"", "pzh", "pzh", "Pazeh", nil},
+	{"", "pzn", "pzn", "Jejara Naga", nil},
+	{"", "qua", "qua", "Quapaw", nil},
+	{"", "qub", "qub", "Huallaga Huánuco Quechua", nil},
+	{"", "quc", "quc", "K'iche'", nil},
+	{"", "qud", "qud", "Calderón Highland Quichua", nil},
+	{"qu", "que", "que", "Quechua", nil},
+	{"", "quf", "quf", "Lambayeque Quechua", nil},
+	{"", "qug", "qug", "Chimborazo Highland Quichua", nil},
+	{"", "quh", "quh", "South Bolivian Quechua", nil},
+	{"", "qui", "qui", "Quileute", nil},
+	{"", "quk", "quk", "Chachapoyas Quechua", nil},
+	{"", "qul", "qul", "North Bolivian Quechua", nil},
+	{"", "qum", "qum", "Sipacapense", nil},
+	{"", "qun", "qun", "Quinault", nil},
+	{"", "qup", "qup", "Southern Pastaza Quechua", nil},
+	{"", "quq", "quq", "Quinqui", nil},
+	{"", "qur", "qur", "Yanahuanca Pasco Quechua", nil},
+	{"", "qus", "qus", "Santiago del Estero Quichua", nil},
+	{"", "quv", "quv", "Sacapulteco", nil},
+	{"", "quw", "quw", "Tena Lowland Quichua", nil},
+	{"", "qux", "qux", "Yauyos Quechua", nil},
+	{"", "quy", "quy", "Ayacucho Quechua", nil},
+	{"", "quz", "quz", "Cusco Quechua", nil},
+	{"", "qva", "qva", "Ambo-Pasco Quechua", nil},
+	{"", "qvc", "qvc", "Cajamarca Quechua", nil},
+	{"", "qve", "qve", "Eastern Apurímac Quechua", nil},
+	{"", "qvh", "qvh", "Huamalíes-Dos de Mayo Huánuco Quechua", nil},
+	{"", "qvi", "qvi", "Imbabura Highland Quichua", nil},
+	{"", "qvj", "qvj", "Loja Highland Quichua", nil},
+	{"", "qvl", "qvl", "Cajatambo North Lima Quechua", nil},
+	{"", "qvm", "qvm", "Margos-Yarowilca-Lauricocha Quechua", nil},
+	{"", "qvn", "qvn", "North Junín Quechua", nil},
+	{"", "qvo", "qvo", "Napo Lowland Quechua", nil},
+	{"", "qvp", "qvp", "Pacaraos Quechua", nil},
+	{"", "qvs", "qvs", "San Martín Quechua", nil},
+	{"", "qvw", "qvw", "Huaylla Wanca Quechua", nil},
+	{"", "qvy", "qvy", "Queyu", nil},
+	{"", "qvz", "qvz", "Northern Pastaza Quichua", nil},
+	{"", "qwa", "qwa", "Corongo Ancash Quechua", nil},
+	{"", "qwc", "qwc", "Classical Quechua", nil},
+	{"", "qwh", "qwh", "Huaylas Ancash Quechua", nil},
+	{"", "qwm", "qwm", "Kuman (Russia)", nil},
+	{"", "qws", "qws", "Sihuas Ancash Quechua", nil},
+	{"", "qwt", "qwt", "Kwalhioqua-Tlatskanai", nil},
+	{"", "qxa", "qxa", "Chiquián Ancash Quechua", nil},
+	{"", "qxc", "qxc", "Chincha Quechua", nil},
+	{"", "qxh", "qxh", "Panao Huánuco Quechua", nil},
+	{"", "qxl", "qxl", "Salasaca Highland Quichua", nil},
+	{"", "qxn", "qxn", "Northern Conchucos Ancash Quechua", nil},
+	{"", "qxo", "qxo", "Southern Conchucos Ancash Quechua", nil},
+	{"", "qxp", "qxp", "Puno Quechua", nil},
+	{"", "qxq", "qxq", "Qashqa'i", nil},
+	{"", "qxr", "qxr", "Cañar Highland Quichua", nil},
+	{"", "qxs", "qxs", "Southern Qiang", nil},
+	{"", "qxt", "qxt", "Santa Ana de Tusi Pasco Quechua", nil},
+	{"", "qxu", "qxu", "Arequipa-La Unión Quechua", nil},
+	{"", "qxw", "qxw", "Jauja Wanca Quechua", nil},
+	{"", "qya", "qya", "Quenya", nil},
+	{"", "qyp", "qyp", "Quiripi", nil},
+	{"", "raa", "raa", "Dungmali", nil},
+	{"", "rab", "rab", "Camling", nil},
+	{"", "rac", "rac", "Rasawa", nil},
+	{"", "rad", "rad", "Rade", nil},
+	{"", "raf", "raf", "Western Meohang", nil},
+	{"", "rag", "rag", "Logooli", nil},
+	{"", "rah", "rah", "Rabha", nil},
+	{"", "rai", "rai", "Ramoaaina", nil},
+	{"", "raj", "raj", "Rajasthani", nil},
+	{"", "rak", "rak", "Tulu-Bohuai", nil},
+	{"", "ral", "ral", "Ralte", nil},
+	{"", "ram", "ram", "Canela", nil},
+	{"", "ran", "ran", "Riantana", nil},
+	{"", "rao", "rao", "Rao", nil},
+	{"", "rap", "rap", "Rapanui", nil},
+	{"", "raq", "raq", "Saam", nil},
+	{"", "rar", "rar", "Rarotongan; Cook Islands Maori", nil},
+	{"", "ras", "ras", "Tegali", nil},
+	{"", "rat", "rat", "Razajerdi", nil},
+	{"", "rau", "rau", "Raute", nil},
+	{"", "rav", "rav", "Sampang", nil},
+	{"", "raw", "raw", "Rawang", nil},
+	{"", "rax", "rax", "Rang", nil},
+	{"", "ray", "ray", "Rapa", nil},
+	{"", "raz", "raz", "Rahambuu", nil},
+	{"", "rbb", "rbb", "Rumai Palaung", nil},
+	{"", "rbk", "rbk", "Northern Bontok", nil},
+	{"", "rbl", "rbl", "Miraya Bikol", nil},
+	{"", "rbp", "rbp", "Barababaraba", nil},
+	{"", "rcf", "rcf", "Réunion Creole French", nil},
+	{"", "rdb", "rdb", "Rudbari", nil},
+	{"", "rea", "rea", "Rerau", nil},
+	{"", "reb", "reb", "Rembong", nil},
+	{"", "ree", "ree", "Rejang Kayan", nil},
+	{"", "reg", "reg", "Kara (Tanzania)", nil},
+	{"", "rei", "rei", "Reli", nil},
+	{"", "rej", "rej", "Rejang", nil},
+	{"", "rel", "rel", "Rendille", nil},
+	{"", "rem", "rem", "Remo", nil},
+	{"", "ren", "ren", "Rengao", nil},
+	{"", "rer", "rer", "Rer Bare", nil},
+	{"", "res", "res", "Reshe", nil},
+	{"", "ret", "ret", "Retta", nil},
+	{"", "rey", "rey", "Reyesano", nil},
+	{"", "rga", "rga", "Roria", nil},
+	{"", "rge", "rge", "Romano-Greek", nil},
+	{"", "rgk", "rgk", "Rangkas", nil},
+	{"", "rgn", "rgn", "Romagnol", nil},
+	{"", "rgr", "rgr", "Resígaro", nil},
+	{"", "rgs", "rgs", "Southern Roglai", nil},
+	{"", "rgu", "rgu", "Ringgou", nil},
+	{"", "rhg", "rhg", "Rohingya", nil},
+	{"", "rhp", "rhp", "Yahang", nil},
+	{"", "ria", "ria", "Riang (India)", nil},
+	{"", "rib", "rib", "Bribri Sign Language", nil},
+	{"", "rif", "rif", "Tarifit", nil},
+	{"", "ril", "ril", "Riang Lang", nil},
+	{"", "rim", "rim", "Nyaturu", nil},
+	{"", "rin", "rin", "Nungu", nil},
+	{"", "rir", "rir", "Ribun", nil},
+	{"", "rit", "rit", "Ritharrngu", nil},
+	{"", "riu", "riu", "Riung", nil},
+	{"", "rjg", "rjg", "Rajong", nil},
+	{"", "rji", "rji", "Raji", nil},
+	{"", "rjs", "rjs", "Rajbanshi", nil},
+	{"", "rka", "rka", "Kraol", nil},
+	{"", "rkb", "rkb", "Rikbaktsa", nil},
+	{"", "rkh", "rkh", "Rakahanga-Manihiki", nil},
+	{"", "rki", "rki", "Rakhine", nil},
+	{"", "rkm", "rkm", "Marka", nil},
+	{"", "rkt", "rkt", "Rangpuri", nil},
+	{"", "rkw", "rkw", "Arakwal", nil},
+	{"", "rma", "rma", "Rama", nil},
+	{"", "rmb", "rmb", "Rembarrnga", nil},
+	{"", "rmc", "rmc", "Carpathian Romani", nil},
+	{"", "rmd", "rmd", "Traveller Danish", nil},
+	{"", "rme", "rme", "Angloromani", nil},
+	{"", "rmf", "rmf", "Kalo Finnish Romani", nil},
+	{"", "rmg", "rmg", "Traveller Norwegian", nil},
+	{"", "rmh", "rmh", "Murkim", nil},
+	{"", "rmi", "rmi", "Lomavren", nil},
+	{"", "rmk", "rmk", "Romkun", nil},
+	{"", "rml", "rml", "Baltic Romani", nil},
+	{"", "rmm", "rmm", "Roma", nil},
+	{"", "rmn", "rmn", "Balkan Romani", nil},
+	{"", "rmo", "rmo", "Sinte Romani", nil},
+	{"", "rmp", "rmp", "Rempi", nil},
+	{"", "rmq", "rmq", "Caló", nil},
+	{"", "rms", "rms", "Romanian Sign Language", nil},
+	{"", "rmt", "rmt", "Domari", nil},
+	{"", "rmu", "rmu", "Tavringer Romani", nil},
+	{"", "rmv", "rmv", "Romanova", nil},
+	{"", "rmw", "rmw", "Welsh Romani", nil},
+	{"", "rmx", "rmx", "Romam", nil},
+	{"", "rmy", "rmy", "Vlax Romani", nil},
+	{"", "rmz", "rmz", "Marma", nil},
+	{"", "rnb", "rnb", "Brunca Sign Language", nil},
+	{"", "rnd", "rnd", "Ruund", nil},
+	{"", "rng", "rng", "Ronga", nil},
+	{"", "rnl", "rnl", "Ranglong", nil},
+	{"", "rnn", "rnn", "Roon", nil},
+	{"", "rnp", "rnp", "Rongpo", nil},
+	{"", "rnr", "rnr", "Nari Nari", nil},
+	{"", "rnw", "rnw", "Rungwa", nil},
+	{"", "roa", "roa", "Romance languages", nil},
+	{"", "rob", "rob", "Tae'", nil},
+	{"", "roc", "roc", "Cacgia Roglai", nil},
+	{"", "rod", "rod", "Rogo", nil},
+	{"", "roe", "roe", "Ronji", nil},
+	{"", "rof", "rof", "Rombo", nil},
+	{"", "rog", "rog", "Northern Roglai", nil},
+	{"rm", "roh", "roh", "Romansh", nil},
+	{"", "rol", "rol", "Romblomanon", nil},
+	{"", "rom", "rom", "Romany", nil},
+	{"ro", "rum", "ron", "Romanian; Moldavian; Moldovan", nil},
+	{"", "roo", "roo", "Rotokas", nil},
+	{"", "rop", "rop", "Kriol", nil},
+	{"", "ror", "ror", "Rongga", nil},
+	{"", "rou", "rou", "Runga", nil},
+	{"", "row", "row", "Dela-Oenale", nil},
+	{"", "rpn", "rpn", "Repanbitip", nil},
+	{"", "rpt", "rpt", "Rapting", nil},
+	{"", "rri", "rri", "Ririo", nil},
+	{"", "rro", "rro", "Waima", nil},
+	{"", "rrt", "rrt", "Arritinngithigh", nil},
+	{"", "rsb", "rsb", "Romano-Serbian", nil},
+	{"", "rsk", "rsk", "Ruthenian", nil},
+	{"", "rsl", "rsl", "Russian Sign Language", nil},
+	{"", "rsm", "rsm", "Miriwoong Sign Language", nil},
+	{"", "rsn", "rsn", "Rwandan Sign Language", nil},
+	{"", "rtc", "rtc", "Rungtu Chin", nil},
+	{"", "rth", "rth", "Ratahan", nil},
+	{"", "rtm", "rtm", "Rotuman", nil},
+	{"", "rts", "rts", "Yurats", nil},
+	{"", "rtw", "rtw", "Rathawi", nil},
+	{"", "rub", "rub", "Gungu", nil},
+	{"", "ruc", "ruc", "Ruuli", nil},
+	{"", "rue", "rue", "Rusyn", nil},
+	{"", "ruf", "ruf", "Luguru", nil},
+	{"", "rug", "rug", "Roviana", nil},
+	{"", "ruh", "ruh", "Ruga", nil},
+	{"", "rui", "rui", "Rufiji", nil},
+	{"", "ruk", "ruk", "Che", nil},
+	{"rn", "run", "run", "Rundi", nil},
+	{"", "ruo", "ruo", "Istro Romanian", nil},
+	{"", "rup", "rup", "Aromanian; Arumanian; Macedo-Romanian", nil},
+	{"", "ruq", "ruq", "Megleno Romanian", nil},
+	{"ru", "rus", "rus", "Russian", nil},
+	{"", "rut", "rut", "Rutul", nil},
+	{"", "ruu", "ruu", "Lanas Lobu", nil},
+	{"", "ruy", "ruy", "Mala (Nigeria)", nil},
+	{"", "ruz", "ruz", "Ruma", nil},
+	{"", "rwa", "rwa", "Rawo", nil},
+	{"", "rwk", "rwk", "Rwa", nil},
+	{"", "rwl", "rwl", "Ruwila", nil},
+	{"", "rwm", "rwm", "Amba (Uganda)", nil},
+	{"", "rwo", "rwo", "Rawa", nil},
+	{"", "rwr", "rwr", "Marwari (India)", nil},
+	{"", "rxd", "rxd", "Ngardi", nil},
+	{"", "rxw", "rxw", "Karuwali", nil},
+	{"", "ryn", "ryn", "Northern Amami-Oshima", nil},
+	{"", "rys", "rys", "Yaeyama", nil},
+	{"", "ryu", "ryu", "Central Okinawan", nil},
+	{"", "rzh", "rzh", "Rāziḥī", nil},
+	{"", "saa", "saa", "Saba", nil},
+	{"", "sab", "sab", "Buglere", nil},
+	{"", "sac", "sac", "Meskwaki", nil},
+	{"", "sad", "sad", "Sandawe", nil},
+	{"", "sae", "sae", "Sabanê", nil},
+	{"", "saf", "saf", "Safaliba", nil},
+	{"sg", "sag", "sag", "Sango", nil},
+	{"", "sah", "sah", "Yakut", nil},
+	{"", "sai", "sai", "South American Indian (Other)", nil},
+	{"", "saj", "saj", "Sahu", nil},
+	{"", "sak", "sak", "Sake", nil},
+	{"", "sal", "sal", "Salishan languages", nil},
+	{"", "sam", "sam", "Samaritan Aramaic", nil},
+	{"sa", "san", "san", "Sanskrit", nil},
+	{"", "sao", "sao", "Sause", nil},
+	{"", "saq", "saq", "Samburu", nil},
+	{"", "sar", "sar", "Saraveca", nil},
+	{"", "sas", "sas", "Sasak", nil},
+	{"", "sat", "sat", "Santali", nil},
+	{"", "sau", "sau", "Saleman", nil},
+	{"", "sav", "sav", "Saafi-Saafi", nil},
+	{"", "saw", "saw", "Sawi", nil},
+	{"", "sax", "sax", "Sa", nil},
+	{"", "say", "say", "Saya", nil},
+	{"", "saz", "saz", "Saurashtra", nil},
+	{"", "sba", "sba", "Ngambay", nil},
+	{"", "sbb", "sbb", "Simbo", nil},
+	{"", "sbc", "sbc", "Kele (Papua New Guinea)", nil},
+	{"", "sbd", "sbd", "Southern Samo", nil},
+	{"", "sbe", "sbe", "Saliba", nil},
+	{"", "sbf", "sbf", "Chabu", nil},
+	{"", "sbg", "sbg", "Seget", nil},
+	{"", "sbh", "sbh", "Sori-Harengan", nil},
+	{"", "sbi", "sbi", "Seti", nil},
+	{"", "sbj", "sbj", "Surbakhal", nil},
+	{"", "sbk", "sbk", "Safwa", nil},
+	{"", "sbl", "sbl", "Botolan Sambal", nil},
+	{"", "sbm", "sbm", "Sagala", nil},
+	{"", "sbn", "sbn", "Sindhi Bhil", nil},
+	{"", "sbo", "sbo", "Sabüm", nil},
+	{"", "sbp", "sbp", "Sangu (Tanzania)", nil},
+	{"", "sbq", "sbq", "Sileibi", nil},
+	{"", "sbr", "sbr", "Sembakung Murut", nil},
+	{"", "sbs", "sbs", "Subiya", nil},
+	{"", "sbt", "sbt", "Kimki", nil},
+	{"", "sbu", "sbu", "Stod Bhoti", nil},
+	{"", "sbv", "sbv", "Sabine", nil},
+	{"", "sbw", "sbw", "Simba", nil},
+	{"", "sbx", "sbx", "Seberuang", nil},
+	{"", "sby", "sby", "Soli", nil},
+	{"", "sbz", "sbz", "Sara Kaba", nil},
+	{"", "scb", "scb", "Chut", nil},
+	{"", "sce", "sce", "Dongxiang", nil},
+	{"", "scf", "scf", "San Miguel Creole French", nil},
+	{"", "scg", "scg", "Sanggau", nil},
+	{"", "sch", "sch", "Sakachep", nil},
+	{"", "sci", "sci", "Sri Lankan Creole Malay", nil},
+	{"", "sck", "sck", "Sadri", nil},
+	{"", "scl", "scl", "Shina", nil},
+	{"", "scn", "scn", "Sicilian", nil},
+	{"", "sco", "sco", "Scots", nil},
+	{"", "scp", "scp", "Hyolmo", nil},
+	{"", "scq", "scq", "Sa'och", nil},
+	{"", "scs", "scs", "North Slavey", nil},
+	{"", "sct", "sct", "Southern Katang", nil},
+	{"", "scu", "scu", "Shumcho", nil},
+	{"", "scv", "scv", "Sheni", nil},
+	{"", "scw", "scw", "Sha", nil},
+	{"", "scx", "scx", "Sicel", nil},
+	{"", "sda", "sda", "Toraja-Sa'dan", nil},
+	{"", "sdb", "sdb", "Shabak", nil},
+	{"", "sdc", "sdc", "Sassarese Sardinian", nil},
+	{"", "sde", "sde", "Surubu", nil},
+	{"", "sdf", "sdf", "Sarli", nil},
+	{"", "sdg", "sdg", "Savi", nil},
+	{"", "sdh", "sdh", "Southern Kurdish", nil},
+	{"", "sdj", "sdj", "Suundi", nil},
+	{"", "sdk", "sdk", "Sos Kundi", nil},
+	{"", "sdl", "sdl", "Saudi Arabian Sign Language", nil},
+	{"", "sdn", "sdn", "Gallurese Sardinian", nil},
+	{"", "sdo", "sdo", "Bukar-Sadung Bidayuh", nil},
+	{"", "sdp", "sdp", "Sherdukpen", nil},
+	{"", "sdq", "sdq", "Semandang", nil},
+	{"", "sdr", "sdr", "Oraon Sadri", nil},
+	{"", "sds", "sds", "Sened", nil},
+	{"", "sdt", "sdt", "Shuadit", nil},
+	{"", "sdu", "sdu", "Sarudu", nil},
+	{"", "sdx", "sdx", "Sibu Melanau", nil},
+	{"", "sdz", "sdz", "Sallands", nil},
+	{"", "sea", "sea", "Semai", nil},
+	{"", "seb", "seb", "Shempire Senoufo", nil},
+	{"", "sec", "sec", "Sechelt", nil},
+	{"", "sed", "sed", "Sedang", nil},
+	{"", "see", "see", "Seneca", nil},
+	{"", "sef", "sef", "Cebaara Senoufo", nil},
+	{"", "seg", "seg", "Segeju", nil},
+	{"", "seh", "seh", "Sena", nil},
+	{"", "sei", "sei", "Seri", nil},
+	{"", "sej", "sej", "Sene", nil},
+	{"", "sek", "sek", "Sekani", nil},
+	{"", "sel", "sel", "Selkup", nil},
+	{"", "sem", "sem", "Semitic languages", nil},
+	{"", "sen", "sen", "Nanerigé Sénoufo", nil},
+	{"", "seo", "seo", "Suarmin", nil},
+	{"", "sep", "sep", "Sìcìté Sénoufo", nil},
+	{"", "seq", "seq", "Senara Sénoufo", nil},
+	{"", "ser", "ser", "Serrano", nil},
+	{"", "ses", "ses", "Koyraboro Senni Songhai", nil},
+	{"", "set", "set", "Sentani", nil},
+	{"", "seu", "seu", "Serui-Laut", nil},
+	{"", "sev", "sev", "Nyarafolo Senoufo", nil},
+	{"", "sew", "sew", "Sewa Bay", nil},
+	{"", "sey", "sey", "Secoya", nil},
+	{"", "sez", "sez", "Senthang Chin", nil},
+	{"", "sfb", "sfb", "Langue des signes de Belgique Francophone", nil},
+	{"", "sfe", "sfe", "Eastern Subanen", nil},
+	{"", "sfm", "sfm", "Small Flowery Miao", nil},
+	{"", "sfs", "sfs", "South African Sign Language", nil},
+	{"", "sfw", "sfw", "Sehwi", nil},
+	{"", "sga", "sga", "Irish, Old (to 900)", nil},
+	{"", "sgb", "sgb", "Mag-antsi Ayta", nil},
+	{"", "sgc", "sgc", "Kipsigis", nil},
+	{"", "sgd", "sgd", "Surigaonon", nil},
+	{"", "sge", "sge", "Segai", nil},
+	{"", "sgg", "sgg", "Swiss-German Sign Language", nil},
+	{"", "sgh", "sgh", "Shughni", nil},
+	{"", "sgi", "sgi", "Suga", nil},
+	{"", "sgj", "sgj", "Surgujia", nil},
+	{"", "sgk", "sgk", "Sangkong", nil},
+	{"", "sgm", "sgm", "Singa", nil},
+	{"", "sgn", "sgn", "Sign Languages", nil},
+	{"", "sgp", "sgp", "Singpho", nil},
+	{"", "sgr", "sgr", "Sangisari", nil},
+	{"", "sgs", "sgs", "Samogitian", nil},
+	{"", "sgt", "sgt", "Brokpake", nil},
+	{"", "sgu", "sgu", "Salas", nil},
+	{"", "sgw", "sgw", "Sebat Bet Gurage", nil},
+	{"", "sgx", "sgx", "Sierra Leone Sign Language", nil},
+	{"", "sgy", "sgy", "Sanglechi", nil},
+	{"", "sgz", "sgz", "Sursurunga", nil},
+	{"", "sha", "sha", "Shall-Zwall", nil},
+	{"", "shb", "shb", "Ninam", nil},
+	{"", "shc", "shc", "Sonde", nil},
+	{"", "shd", "shd", "Kundal Shahi", nil},
+	{"", "she", "she", "Sheko", nil},
+	{"", "shg", "shg", "Shua", nil},
+	{"", "shh", "shh", "Shoshoni", nil},
+	{"", "shi", "shi", "Tachelhit", nil},
+	{"", "shj", "shj", "Shatt", nil},
+	{"", "shk", "shk", "Shilluk", nil},
+	{"", "shl", "shl", "Shendu", nil},
+	{"", "shm", "shm", "Shahrudi", nil},
+	{"", "shn", "shn", "Shan", nil},
+	{"", "sho", "sho", "Shanga", nil},
+	{"", "shp", "shp", "Shipibo-Conibo", nil},
+	{"", "shq", "shq", "Sala", nil},
+	{"", "shr", "shr", "Shi", nil},
+	{"", "shs", "shs", "Shuswap", nil},
+	{"", "sht", "sht", "Shasta", nil},
+	{"", "shu", "shu", "Chadian Arabic", nil},
+	{"", "shv", "shv", "Shehri", nil},
+	{"", "shw", "shw", "Shwai", nil},
+	{"", "shx", "shx", "She", nil},
+	{"", "shy", "shy", "Tachawit", nil},
+	{"", "shz", "shz", "Syenara Senoufo", nil},
+	{"", "sia", "sia", "Akkala Sami", nil},
+	{"", "sib", "sib", "Sebop", nil},
+	{"", "sid", "sid", "Sidamo", nil},
+	{"", "sie", "sie", "Simaa", nil},
+	{"", "sif", "sif", "Siamou", nil},
+	{"", "sig", "sig", "Paasaal", nil},
+	{"", "sih", "sih", "Zire", nil},
+	{"", "sii", "sii", "Shom Peng", nil},
+	{"", "sij", "sij", "Numbami", nil},
+	{"", "sik", "sik", "Sikiana", nil},
+	{"", "sil", "sil", "Tumulung Sisaala", nil},
+	{"", "sim", "sim", "Mende (Papua New Guinea)", nil},
+	{"si", "sin", "sin", "Sinhala; Sinhalese", nil},
+	{"", "sio", "sio", "Siouan languages", nil},
+	{"", "sip", "sip", "Sikkimese", nil},
+	{"", "siq", "siq", "Sonia", nil},
+	{"", "sir", "sir", "Siri", nil},
+	{"", "sis", "sis", "Siuslaw", nil},
+	{"", "sit", "sit", "Sino-Tibetan languages", nil},
+	{"", "siu", "siu", "Sinagen", nil},
+	{"", "siv", "siv", "Sumariup", nil},
+	{"", "siw", "siw", "Siwai", nil},
+	{"", "six", "six", "Sumau", nil},
+	{"", "siy", "siy", "Sivandi", nil},
+	{"", "siz", "siz", "Siwi", nil},
+	{"", "sja", "sja", "Epena", nil},
+	{"", "sjb", "sjb", "Sajau Basap", nil},
+	{"", "sjd", "sjd", "Kildin Sami", nil},
+	{"", "sje", "sje", "Pite Sami", nil},
+	{"", "sjg", "sjg", "Assangori", nil},
+	{"", "sjk", "sjk", "Kemi Sami", nil},
+	{"", "sjl", "sjl", "Sajalong", nil},
+	{"", "sjm", "sjm", "Mapun", nil},
+	{"", "sjn", "sjn", "Sindarin", nil},
+	{"", "sjo", "sjo", "Xibe", nil},
+	{"", "sjp", "sjp", "Surjapuri", nil},
+	{"", "sjr", "sjr", "Siar-Lak", nil},
+	{"", "sjs", "sjs", "Senhaja De Srair", nil},
+	{"", "sjt", "sjt", "Ter Sami", nil},
+	{"", "sju", "sju", "Ume Sami", nil},
+	{"", "sjw", "sjw", "Shawnee", nil},
+	{"", "ska", "ska", "Skagit", nil},
+	{"", "skb", "skb", "Saek", nil},
+	{"", "skc", "skc", "Ma Manda", nil},
+	{"", "skd", "skd", "Southern Sierra Miwok", nil},
+	{"", "ske", "ske", "Seke (Vanuatu)", nil},
+	{"", "skf", "skf", "Sakirabiá", nil},
+	{"", "skg", "skg", "Sakalava Malagasy", nil},
+	{"", "skh", "skh", "Sikule", nil},
+	{"", "ski", "ski", "Sika", nil},
+	{"", "skj", "skj", "Seke (Nepal)", nil},
+	{"", "skm", "skm", "Kutong", nil},
+	{"", "skn", "skn", "Kolibugan Subanon", nil},
+	{"", "sko", "sko", "Seko Tengah", nil},
+	{"", "skp", "skp", "Sekapan", nil},
+	{"", "skq", "skq", "Sininkere", nil},
+	{"", "skr", "skr", "Saraiki", nil},
+	{"", "sks", "sks", "Maia", nil},
+	{"", "skt", "skt", "Sakata", nil},
+	{"", "sku", "sku", "Sakao", nil},
+	{"", "skv", "skv", "Skou", nil},
+	{"", "skw", "skw", "Skepi Creole Dutch", nil},
+	{"", "skx", "skx", "Seko Padang", nil},
+	{"", "sky", "sky", "Sikaiana", nil},
+	{"", "skz", "skz", "Sekar", nil},
+	{"", "sla", "sla", "Slavic languages", nil},
+	{"", "slc", "slc", "Sáliba", nil},
+	{"", "sld", "sld", "Sissala", nil},
+	{"", "sle", "sle", "Sholaga", nil},
+	{"", "slf", "slf", "Swiss-Italian Sign Language", nil},
+	{"", "slg", "slg", "Selungai Murut", nil},
+	{"", "slh", "slh", "Southern Puget Sound Salish", nil},
+	{"", "sli", "sli", "Lower Silesian", nil},
+	{"", "slj", "slj", "Salumá", nil},
+	{"sk", "slo", "slk", "Slovak", nil},
+	{"", "sll", "sll", "Salt-Yui", nil},
+	{"", "slm", "slm", "Pangutaran Sama", nil},
+	{"", "sln", "sln", "Salinan", nil},
+	{"", "slp", "slp", "Lamaholot", nil},
+	{"", "slq", "slq", "Salchuq", nil},
+	{"", "slr", "slr", "Salar", nil},
+	{"", "sls", "sls", "Singapore Sign Language", nil},
+	{"", "slt", "slt", "Sila", nil},
+	{"", "slu", "slu", "Selaru", nil},
+	{"sl", "slv", "slv", "Slovenian", nil},
+	{"", "slw", "slw", "Sialum", nil},
+	{"", "slx", "slx", "Salampasu", nil},
+	{"", "sly", "sly", "Selayar", nil},
+	{"", "slz", "slz", "Ma'ya", nil},
+	{"", "sma", "sma", "Southern Sami", nil},
+	{"", "smb", "smb", "Simbari", nil},
+	{"", "smc", "smc", "Som", nil},
+	{"se", "sme", "sme", "Northern Sami", nil},
+	{"", "smf", "smf", "Auwe", nil},
+	{"", "smg", "smg", "Simbali", nil},
+	{"", "smh", "smh", "Samei", nil},
+	{"", "smi", "smi", "Sami languages", nil},
+	{"", "smj", "smj", "Lule Sami", nil},
+	{"", "smk", "smk", "Bolinao", nil},
+	{"", "sml", "sml", "Central Sama", nil},
+	{"", "smm", "smm", "Musasa", nil},
+	{"", "smn", "smn", "Inari Sami", nil},
+	{"sm", "smo", "smo", "Samoan", nil},
+	{"", "smp", "smp", "Samaritan", nil},
+	{"", "smq", "smq", "Samo", nil},
+	{"", "smr", "smr", "Simeulue", nil},
+	{"", "sms", "sms", "Skolt Sami", nil},
+	{"", "smt", "smt", "Simte", nil},
+	{"", "smu", "smu", "Somray", nil},
+	{"", "smv", "smv", "Samvedi", nil},
+	{"", "smw", "smw", "Sumbawa", nil},
+	{"", "smx", "smx", "Samba", nil},
+	{"", "smy", "smy", "Semnani", nil},
+	{"", "smz", "smz", "Simeku", nil},
+	{"sn", "sna", "sna", "Shona", nil},
+	{"", "snc", "snc", "Sinaugoro", nil},
+	{"sd", "snd", "snd", "Sindhi", nil},
+	{"", "sne", "sne", "Bau Bidayuh", nil},
+	{"", "snf", "snf", "Noon", nil},
+	{"", "sng", "sng", "Sanga (Democratic Republic of Congo)", nil},
+	{"", "sni", "sni", "Sensi", nil},
+	{"", "snj", "snj", "Riverain Sango", nil},
+	{"", "snk", "snk", "Soninke", nil},
+	{"", "snl", "snl", "Sangil", nil},
+	{"", "snm", "snm", "Southern Ma'di", nil},
+	{"", "snn", "snn", "Siona", nil},
+	{"", "sno", "sno", "Snohomish", nil},
+	{"", "snp", "snp", "Siane", nil},
+	{"", "snq", "snq", "Sangu (Gabon)", nil},
+	{"", "snr", "snr", "Sihan", nil},
+	{"", "sns", "sns", "South West Bay", nil},
+	{"", "snu", "snu", "Senggi", nil},
+	{"", "snv", "snv", "Sa'ban", nil},
+	{"", "snw", "snw", "Selee", nil},
+	{"", "snx", "snx", "Sam", nil},
+	{"", "sny", "sny", "Saniyo-Hiyewe", nil},
+	{"", "snz", "snz", "Kou", nil},
+	{"", "soa", "soa", "Thai Song", nil},
+	{"", "sob", "sob", "Sobei", nil},
+	{"", "soc", "soc", "So (Democratic Republic of Congo)", nil},
+	{"", "sod", "sod", "Songoora", nil},
+	{"", "soe", "soe", "Songomeno", nil},
+	{"", "sog", "sog", "Sogdian", nil},
+	{"", "soh", "soh", "Aka", nil},
+	{"", "soi", "soi", "Sonha", nil},
+	{"", "soj", "soj", "Soi", nil},
+	{"", "sok", "sok", "Sokoro", nil},
+	{"", "sol", "sol", "Solos", nil},
+	{"so", "som", "som", "Somali", nil},
+	{"", "son", "son", "Songhai languages", nil},
+	{"", "soo", "soo", "Songo", nil},
+	{"", "sop", "sop", "Songe", nil},
+	{"", "soq", "soq", "Kanasi", nil},
+	{"", "sor", "sor", "Somrai", nil},
+	{"", "sos", "sos", "Seeku", nil},
+	{"st", "sot", "sot", "Sotho, Southern", nil},
+	{"", "sou", "sou", "Southern Thai", nil},
+	{"", "sov", "sov", "Sonsorol", nil},
+	{"", "sow", "sow", "Sowanda", nil},
+	{"", "sox", "sox", "Swo", nil},
+	{"", "soy", "soy", "Miyobe", nil},
+	{"", "soz", "soz", "Temi", nil},
+	{"es", "spa", "spa", "Spanish; Castilian", nil},
+	{"", "spb", "spb", "Sepa (Indonesia)", nil},
+	{"", "spc", "spc", "Sapé", nil},
+	{"", "spd", "spd", "Saep", nil},
+	{"", "spe", "spe", "Sepa (Papua New Guinea)", nil},
+	{"", "spg", "spg", "Sian", nil},
+	{"", "spi", "spi", "Saponi", nil},
+	{"", "spk", "spk", "Sengo", nil},
+	{"", "spl", "spl", "Selepet", nil},
+	{"", "spm", "spm", "Akukem", nil},
+	{"", "spn", "spn", "Sanapaná", nil},
+	{"", "spo", "spo", "Spokane", nil},
+	{"", "spp", "spp", "Supyire Senoufo", nil},
+	{"", "spq", "spq", "Loreto-Ucayali Spanish", nil},
+	{"", "spr", "spr", "Saparua", nil},
+	{"", "sps", "sps", "Saposa", nil},
+	{"", "spt", "spt", "Spiti Bhoti", nil},
+	{"", "spu", "spu", "Sapuan", nil},
+	{"", "spv", "spv", "Sambalpuri", nil},
+	{"", "spx", "spx", "South Picene", nil},
+	{"", "spy", "spy", "Sabaot", nil},
+	{"", "sqa", "sqa", "Shama-Sambuga", nil},
+	{"", "sqh", "sqh", "Shau", nil},
+	{"sq", "alb", "sqi", "Albanian", nil},
+	{"", "sqk", "sqk", "Albanian Sign Language", nil},
+	{"", "sqm", "sqm", "Suma", nil},
+	{"", "sqn", "sqn", "Susquehannock", nil},
+	{"", "sqo", "sqo", "Sorkhei", nil},
+	{"", "sqq", "sqq", "Sou", nil},
+	{"", "sqr", "sqr", "Siculo Arabic", nil},
+	{"", "sqs", "sqs", "Sri Lankan Sign Language", nil},
+	{"", "sqt", "sqt", "Soqotri", nil},
+	{"", "squ", "squ", "Squamish", nil},
+	{"", "sqx", "sqx", "Kufr Qassem Sign Language (KQSL)", nil},
+	{"", "sra", "sra", "Saruga", nil},
+	{"", "srb", "srb", "Sora", nil},
+	{"", "src", "src", "Logudorese Sardinian", nil},
+	{"sc", "srd", "srd", "Sardinian", nil},
+	{"", "sre", "sre", "Sara", nil},
+	{"", "srf", "srf", "Nafi", nil},
+	{"", "srg", "srg", "Sulod", nil},
+	{"", "srh", "srh", "Sarikoli", nil},
+	{"", "sri", "sri", "Siriano", nil},
+	{"", "srk", "srk", "Serudung Murut", nil},
+	{"", "srl", "srl", "Isirawa", nil},
+	{"", "srm", "srm", "Saramaccan", nil},
+	{"", "srn", "srn", "Sranan Tongo", nil},
+	{"", "sro", "sro", "Campidanese Sardinian", nil},
+	{"sr", "srp", "srp", "Serbian", nil},
+	{"", "srq", "srq", "Sirionó", nil},
+	{"", "srr", "srr", "Serer", nil},
+	{"", "srs", "srs", "Sarsi", nil},
+	{"", "srt", "srt", "Sauri", nil},
+	{"", "sru", "sru", "Suruí", nil},
+	{"", "srv", "srv", "Southern Sorsoganon", nil},
+	{"", "srw", "srw", "Serua", nil},
+	{"", "srx", "srx", "Sirmauri", nil},
+	{"", "sry", "sry", "Sera", nil},
+	{"", "srz", "srz", "Shahmirzadi", nil},
+	{"", "ssa", "ssa", "Nilo-Saharan languages", nil},
+	{"", "ssb", "ssb", "Southern Sama", nil},
+	{"", "ssc", "ssc", "Suba-Simbiti", nil},
+	{"", "ssd", "ssd", "Siroi", nil},
+	{"", "sse", "sse", "Balangingi", nil},
+	{"", "ssf", "ssf", "Thao", nil},
+	{"", "ssg", "ssg", "Seimat", nil},
+	{"", "ssh", "ssh", "Shihhi Arabic", nil},
+	{"", "ssi", "ssi", "Sansi", nil},
+	{"", "ssj", "ssj", "Sausi", nil},
+	{"", "ssk", "ssk", "Sunam", nil},
+	{"", "ssl", "ssl", "Western Sisaala", nil},
+	{"", "ssm", "ssm", "Semnam", nil},
+	{"", "ssn", "ssn", "Waata", nil},
+	{"", "sso", "sso", "Sissano", nil},
+	{"", "ssp", "ssp", "Spanish Sign Language", nil},
+	{"", "ssq", "ssq", "So'a", nil},
+	{"", "ssr", "ssr", "Swiss-French Sign Language", nil},
+	{"", "sss", "sss", "Sô", nil},
+	{"", "sst", "sst", "Sinasina", nil},
+	{"", "ssu", "ssu", "Susuami", nil},
+	{"", "ssv", "ssv", "Shark Bay", nil},
+	{"ss", "ssw", "ssw", "Swati", nil},
+	{"", "ssx", "ssx", "Samberigi", nil},
+	{"", "ssy", "ssy", "Saho", nil},
+	{"", "ssz", "ssz", "Sengseng", nil},
+	{"", "sta", "sta", "Settla", nil},
+	{"", "stb", "stb", "Northern Subanen", nil},
+	{"", "std", "std", "Sentinel", nil},
+	{"", "ste", "ste", "Liana-Seti", nil},
+	{"", "stf", "stf", "Seta", nil},
+	{"", "stg", "stg", "Trieng", nil},
+	{"", "sth", "sth", "Shelta", nil},
+	{"", "sti", "sti", "Bulo Stieng", nil},
+	{"", "stj", "stj", "Matya Samo", nil},
+	{"", "stk", "stk", "Arammba", nil},
+	{"", "stl", "stl", "Stellingwerfs", nil},
+	{"", "stm", "stm", "Setaman", nil},
+	{"", "stn", "stn", "Owa", nil},
+	{"", "sto", "sto", "Stoney", nil},
+	{"", "stp", "stp", "Southeastern Tepehuan", nil},
+	{"", "stq", "stq", "Saterfriesisch", nil},
+	{"", "str", "str", "Straits Salish", nil},
+	{"", "sts", "sts", "Shumashti", nil},
+	{"", "stt", "stt", "Budeh Stieng", nil},
+	{"", "stu", "stu", "Samtao", nil},
+	{"", "stv", "stv", "Silt'e", nil},
+	{"", "stw", "stw", "Satawalese", nil},
+	{"", "sty", "sty", "Siberian Tatar", nil},
+	{"", "sua", "sua", "Sulka", nil},
+	{"", "sub", "sub", "Suku", nil},
+	{"", "suc", "suc", "Western Subanon", nil},
+	{"", "sue", "sue", "Suena", nil},
+	{"", "sug", "sug", "Suganga", nil},
+	{"", "sui", "sui", "Suki", nil},
+	{"", "suj", "suj", "Shubi", nil},
+	{"", "suk", "suk", "Sukuma", nil},
+	{"su", "sun", "sun", "Sundanese", nil},
+	{"", "suo", "suo", "Bouni", nil},
+	{"", "suq", "suq", "Tirmaga-Chai Suri", nil},
+	{"", "sur", "sur", "Mwaghavul", nil},
+	{"", "sus", "sus", "Susu", nil},
+	{"", "sut", "sut", "Subtiaba", nil},
+	{"", "suv", "suv", "Puroik", nil},
+	{"", "suw", "suw", "Sumbwa", nil},
+	{"", "sux", "sux", "Sumerian", nil},
+	{"", "suy", "suy", "Suyá", nil},
+	{"", "suz", "suz", "Sunwar", nil},
+	{"", "sva", "sva", "Svan", nil},
+	{"", "svb", "svb", "Ulau-Suain", nil},
+	{"", "svc", "svc", "Vincentian Creole English", nil},
+	{"", "sve", "sve", "Serili", nil},
+	{"", "svk", "svk", "Slovakian Sign Language", nil},
+	{"", "svm", "svm", "Slavomolisano", nil},
+	{"", "svs", "svs", "Savosavo", nil},
+	{"", "svx", "svx", "Skalvian", nil},
+	{"sw", "swa", "swa", "Swahili", nil},
+	{"", "swb", "swb", "Maore Comorian", nil},
+	{"", "swc", "swc", "Congo Swahili", nil},
+	{"sv", "swe", "swe", "Swedish", nil},
+	{"", "swf", "swf", "Sere", nil},
+	{"", "swg", "swg", "Swabian", nil},
+	{"", "swh", "swh", "Swahili (individual language)", nil},
+	{"", "swi", "swi", "Sui", nil},
+	{"", "swj", "swj", "Sira", nil},
+	{"", "swk", "swk", "Malawi Sena", nil},
+	{"", "swl", "swl", "Swedish Sign Language", nil},
+	{"", "swm", "swm", "Samosa", nil},
+	{"", "swn", "swn", "Sawknah", nil},
+	{"", "swo", "swo", "Shanenawa", nil},
+	{"", "swp", "swp", "Suau", nil},
+	{"", "swq", "swq", "Sharwa", nil},
+	{"", "swr", "swr", "Saweru", nil},
+	{"", "sws", "sws", "Seluwasan", nil},
+	{"", "swt", "swt", "Sawila", nil},
+	{"", "swu", "swu", "Suwawa", nil},
+	{"", "swv", "swv", "Shekhawati", nil},
+	{"", "sww", "sww", "Sowa", nil},
+	{"", "swx", "swx", "Suruahá", nil},
+	{"", "swy", "swy", "Sarua", nil},
+	{"", "sxb", "sxb", "Suba", nil},
+	{"", "sxc", "sxc", "Sicanian", nil},
+	{"", "sxe", "sxe", "Sighu", nil},
+	{"", "sxg", "sxg", "Shuhi", nil},
+	{"", "sxk", "sxk", "Southern Kalapuya", nil},
+	{"", "sxl", "sxl", "Selian", nil},
+	{"", "sxm", "sxm", "Samre", nil},
+	{"", "sxn", "sxn", "Sangir", nil},
+	{"", "sxo", "sxo", "Sorothaptic", nil},
+	{"", "sxr", "sxr", "Saaroa", nil},
+	{"", "sxs", "sxs", "Sasaru", nil},
+	{"", "sxu", "sxu", "Upper Saxon", nil},
+	{"", "sxw", "sxw", "Saxwe Gbe", nil},
+	{"", "sya", "sya", "Siang", nil},
+	{"", "syb", "syb", "Central Subanen", nil},
+	{"", "syc", "syc", "Classical Syriac", nil},
+	{"", "syi", "syi", "Seki", nil},
+	{"", "syk", "syk", "Sukur", nil},
+	{"", "syl", "syl", "Sylheti", nil},
+	{"", "sym", "sym", "Maya Samo", nil},
+	{"", "syn", "syn", "Senaya", nil},
+	{"", "syo", "syo", "Suoy", nil},
+	{"", "syr", "syr", "Syriac", nil},
+	{"", "sys", "sys", "Sinyar", nil},
+	{"", "syw", "syw", "Kagate", nil},
+	{"", "syx", "syx", "Samay", nil},
+	{"", "syy", "syy", "Al-Sayyid Bedouin Sign Language", nil},
+	{"", "sza", "sza", "Semelai", nil},
+	{"", "szb", "szb", "Ngalum", nil},
+	{"", "szc", "szc", "Semaq Beri", nil},
+	{"", "szd", "szd", "Seru", nil},
+	{"", "sze", "sze", "Seze", nil},
+	{"", "szg", "szg", "Sengele", nil},
+	{"", "szl", "szl", "Silesian", nil},
+	{"", "szn", "szn", "Sula", nil},
+	{"", "szp", "szp", "Suabo", nil},
+	{"", "szs", "szs", "Solomon Islands Sign Language", nil},
+	{"", "szv", "szv", "Isu (Fako Division)", nil},
+	{"", "szw", "szw", "Sawai", nil},
+	{"", "szy", "szy", "Sakizaya", nil},
+	{"", "taa", "taa", "Lower Tanana", nil},
+	{"", "tab", "tab", "Tabassaran", nil},
+	{"", "tac", "tac", "Lowland Tarahumara", nil},
+	{"", "tad", "tad", "Tause", nil},
+	{"", "tae", "tae", "Tariana", nil},
+	{"", "taf", "taf", "Tapirapé", nil},
+	{"", "tag", "tag", "Tagoi", nil},
+	{"ty", "tah", "tah", "Tahitian", nil},
+	{"", "tai", "tai", "Tai languages", nil},
+	{"", "taj", "taj", "Eastern Tamang", nil},
+	{"", "tak", "tak", "Tala", nil},
+	{"", "tal", "tal", "Tal", nil},
+	{"ta", "tam", "tam", "Tamil", nil},
+	{"", "tan", "tan", "Tangale", nil},
+	{"", "tao", "tao", "Yami", nil},
+	{"", "tap", "tap", "Taabwa", nil},
+	{"", "taq", "taq", "Tamasheq", nil},
+	{"", "tar", "tar", "Central Tarahumara", nil},
+	{"", "tas", "tas", "Tay Boi", nil},
+	{"tt", "tat", "tat", "Tatar", nil},
+	{"", "tau", "tau", "Upper Tanana", nil},
+	{"", "tav", "tav", "Tatuyo", nil},
+	{"", "taw", "taw", "Tai", nil},
+	{"", "tax", "tax", "Tamki", nil},
+	{"", "tay", "tay", "Atayal", nil},
+	{"", "taz", "taz", "Tocho", nil},
+	{"", "tba", "tba", "Aikanã", nil},
+	{"", "tbc", "tbc", "Takia", nil},
+	{"", "tbd", "tbd", "Kaki Ae", nil},
+	{"", "tbe", "tbe", "Tanimbili", nil},
+	{"", "tbf", "tbf", "Mandara", nil},
+	{"", "tbg", "tbg", "North Tairora", nil},
+	{"", "tbh", "tbh", "Dharawal", nil},
+	{"", "tbi", "tbi", "Gaam", nil},
+	{"", "tbj", "tbj", "Tiang", nil},
+	{"", "tbk", "tbk", "Calamian Tagbanwa", nil},
+	{"", "tbl", "tbl", "Tboli", nil},
+	{"", "tbm", "tbm", "Tagbu", nil},
+	{"", "tbn", "tbn", "Barro Negro Tunebo", nil},
+	{"", "tbo", "tbo", "Tawala", nil},
+	{"", "tbp", "tbp", "Taworta", nil},
+	{"", "tbr", "tbr", "Tumtum", nil},
+	{"", "tbs", "tbs", "Tanguat", nil},
+	{"", "tbt", "tbt", "Tembo (Kitembo)", nil},
+	{"", "tbu", "tbu", "Tubar", nil},
+	{"", "tbv", "tbv", "Tobo", nil},
+	{"", "tbw", "tbw", "Tagbanwa", nil},
+	{"", "tbx", "tbx", "Kapin", nil},
+	{"", "tby", "tby", "Tabaru", nil},
+	{"", "tbz", "tbz", "Ditammari", nil},
+	{"", "tca", "tca", "Ticuna", nil},
+	{"", "tcb", "tcb", "Tanacross", nil},
+	{"", "tcc", "tcc", "Datooga", nil},
+	{"", "tcd", "tcd", "Tafi", nil},
+	{"", "tce", "tce", "Southern Tutchone", nil},
+	{"", "tcf", "tcf", "Malinaltepec Me'phaa", nil},
+	{"", "tcg", "tcg", "Tamagario", nil},
+	{"", "tch", "tch", "Turks And Caicos Creole English", nil},
+	{"", "tci", "tci", "Wára", nil},
+	{"", "tck", "tck", "Tchitchege", nil},
+	{"", "tcl", "tcl", "Taman (Myanmar)", nil},
+	{"", "tcm", "tcm", "Tanahmerah", nil},
+	{"", "tcn", "tcn", "Tichurong", nil},
+	{"", "tco", "tco", "Taungyo", nil},
+	{"", "tcp", "tcp", "Tawr Chin", nil},
+	{"", "tcq", "tcq", "Kaiy", nil},
+	{"", "tcs", "tcs", "Torres Strait Creole", nil},
+	{"", "tct", "tct", "T'en", nil},
+	{"", "tcu", "tcu", "Southeastern Tarahumara", nil},
+	{"", "tcw", "tcw", "Tecpatlán Totonac", nil},
+	{"", "tcx", "tcx", "Toda", nil},
+	{"", "tcy", "tcy", "Tulu", nil},
+	{"", "tcz", "tcz", "Thado Chin", nil},
+	{"", "tda", "tda", "Tagdal", nil},
+	{"", "tdb", "tdb", "Panchpargania", nil},
+	{"", "tdc", "tdc", "Emberá-Tadó", nil},
+	{"", "tdd", "tdd", "Tai Nüa", nil},
+	{"", "tde", "tde", "Tiranige Diga Dogon", nil},
+	{"", "tdf", "tdf", "Talieng", nil},
+	{"", "tdg", "tdg", "Western Tamang", nil},
+	{"", "tdh", "tdh", "Thulung", nil},
+	{"", "tdi", "tdi", "Tomadino", nil},
+	{"", "tdj", "tdj", "Tajio", nil},
+	{"", "tdk", "tdk", "Tambas", nil},
+	{"", "tdl", "tdl", "Sur", nil},
+	{"", "tdm", "tdm", "Taruma", nil},
+	{"", "tdn", "tdn", "Tondano", nil},
+	{"", "tdo", "tdo", "Teme", nil},
+	{"", "tdq", "tdq", "Tita", nil},
+	{"", "tdr", "tdr", "Todrah", nil},
+	{"", "tds", "tds", "Doutai", nil},
+	{"", "tdt", "tdt", "Tetun Dili", nil},
+	{"", "tdv", "tdv", "Toro", nil},
+	{"", "tdx", "tdx", "Tandroy-Mahafaly Malagasy", nil},
+	{"", "tdy", "tdy", "Tadyawan", nil},
+	{"", "tea", "tea", "Temiar", nil},
+	{"", "teb", "teb", "Tetete", nil},
+	{"", "tec", "tec", "Terik", nil},
+	{"", "ted", "ted", "Tepo Krumen", nil},
+	{"", "tee", "tee", "Huehuetla Tepehua", nil},
+	{"", "tef", "tef", "Teressa", nil},
+	{"", "teg", "teg", "Teke-Tege", nil},
+	{"", "teh", "teh", "Tehuelche", nil},
+	{"", "tei", "tei", "Torricelli", nil},
+	{"", "tek", "tek", "Ibali Teke", nil},
+	{"te", "tel", "tel", "Telugu", nil},
+	{"", "tem", "tem", "Timne", nil},
+	{"", "ten", "ten", "Tama (Colombia)", nil},
+	{"", "teo", "teo", "Teso", nil},
+	{"", "tep", "tep", "Tepecano", nil},
+	{"", "teq", "teq", "Temein", nil},
+	{"", "ter", "ter", "Tereno", nil},
+	{"", "tes", "tes", "Tengger", nil},
+	{"", "tet", "tet", "Tetum", nil},
+	{"", "teu", "teu", "Soo", nil},
+	{"", "tev", "tev", "Teor", nil},
+	{"", "tew", "tew", "Tewa (USA)", nil},
+	{"", "tex", "tex", "Tennet", nil},
+	{"", "tey", "tey", "Tulishi", nil},
+	{"", "tez", "tez", "Tetserret", nil},
+	{"", "tfi", "tfi", "Tofin Gbe", nil},
+	{"", "tfn", "tfn", "Tanaina", nil},
+	{"", "tfo", "tfo", "Tefaro", nil},
+	{"", "tfr", "tfr", "Teribe", nil},
+	{"", "tft", "tft", "Ternate", nil},
+	{"", "tga", "tga", "Sagalla", nil},
+	{"", "tgb", "tgb", "Tobilung", nil},
+	{"", "tgc", "tgc", "Tigak", nil},
+	{"", "tgd", "tgd", "Ciwogai", nil},
+	{"", "tge", "tge", "Eastern Gorkha Tamang", nil},
+	{"", "tgf", "tgf", "Chalikha", nil},
+	{"", "tgh", "tgh", "Tobagonian Creole English", nil},
+	{"", "tgi", "tgi", "Lawunuia", nil},
+	{"", "tgj", "tgj", "Tagin", nil},
+	{"tg", "tgk", "tgk", "Tajik", nil},
+	{"tl", "tgl", "tgl", "Tagalog", nil},
+	{"", "tgn", "tgn", "Tandaganon", nil},
+	{"", "tgo", "tgo", "Sudest", nil},
+	{"", "tgp", "tgp", "Tangoa", nil},
+	{"", "tgq", "tgq", "Tring", nil},
+	{"", "tgr", "tgr", "Tareng", nil},
+	{"", "tgs", "tgs", "Nume", nil},
+	{"", "tgt", "tgt", "Central Tagbanwa", nil},
+	{"", "tgu", "tgu", "Tanggu", nil},
+	{"", "tgv", "tgv", "Tingui-Boto", nil},
+	{"", "tgw", "tgw", "Tagwana Senoufo", nil},
+	{"", "tgx", "tgx", "Tagish", nil},
+	{"", "tgy", "tgy", "Togoyo", nil},
+	{"", "tgz", "tgz", "Tagalaka", nil},
+	{"th", "tha", "tha", "Thai", nil},
+	{"", "thd", "thd", "Kuuk Thaayorre", nil},
+	{"", "the", "the", "Chitwania Tharu", nil},
+	{"", "thf", "thf", "Thangmi", nil},
+	{"", "thh", "thh", "Northern Tarahumara", nil},
+	{"", "thi", "thi", "Tai Long", nil},
+	{"", "thk", "thk", "Tharaka", nil},
+	{"", "thl", "thl", "Dangaura Tharu", nil},
+	{"", "thm", "thm", "Aheu", nil},
+	{"", "thn", "thn", "Thachanadan", nil},
+	{"", "thp", "thp", "Thompson", nil},
+	{"", "thq", "thq", "Kochila Tharu", nil},
+	{"", "thr", "thr", "Rana Tharu", nil},
+	{"", "ths", "ths", "Thakali", nil},
+	{"", "tht", "tht", "Tahltan", nil},
+	{"", "thu", "thu", "Thuri", nil},
+	{"", "thv", "thv", "Tahaggart Tamahaq", nil},
+	{"", "thy", "thy", "Tha", nil},
+	{"", "thz", "thz", "Tayart Tamajeq", nil},
+	{"", "tia", "tia", "Tidikelt Tamazight", nil},
+	{"", "tic", "tic", "Tira", nil},
+	{"", "tif", "tif", "Tifal", nil},
+	{"", "tig", "tig", "Tigre", nil},
+	{"", "tih", "tih", "Timugon Murut", nil},
+	{"", "tii", "tii", "Tiene", nil},
+	{"", "tij", "tij", "Tilung", nil},
+	{"", "tik", "tik", "Tikar", nil},
+	{"", "til", "til", "Tillamook", nil},
+	{"", "tim", "tim", "Timbe", nil},
+	{"", "tin", "tin", "Tindi", nil},
+	{"", "tio", "tio", "Teop", nil},
+	{"", "tip", "tip", "Trimuris", nil},
+	{"", "tiq", "tiq", "Tiéfo", nil},
+	{"ti", "tir", "tir", "Tigrinya", nil},
+	{"", "tis", "tis", "Masadiit Itneg", nil},
+	{"", "tit", "tit", "Tinigua", nil},
+	{"", "tiu", "tiu", "Adasen", nil},
+	{"", "tiv", "tiv", "Tiv", nil},
+	{"", "tiw", "tiw", "Tiwi", nil},
+	{"", "tix", "tix", "Southern Tiwa", nil},
+	{"", "tiy", "tiy", "Tiruray", nil},
+	{"", "tiz", "tiz", "Tai Hongjin", nil},
+	{"", "tja", "tja", "Tajuasohn", nil},
+	{"", "tjg", "tjg", "Tunjung", nil},
+	{"", "tji", "tji", "Northern Tujia", nil},
+	{"", "tjj", "tjj", "Tjungundji", nil},
+	{"", "tjl", "tjl", "Tai Laing", nil},
+	{"", "tjm", "tjm", "Timucua", nil},
+	{"", "tjn", "tjn", "Tonjon", nil},
+	{"", "tjo", "tjo", "Temacine Tamazight", nil},
+	{"", "tjp", "tjp", "Tjupany", nil},
+	{"", "tjs", "tjs", "Southern Tujia", nil},
+	{"", "tju", "tju", "Tjurruru", nil},
+	{"", "tjw", "tjw", "Djabwurrung", nil},
+	{"", "tka", "tka", "Truká", nil},
+	{"", "tkb", "tkb", "Buksa", nil},
+	{"", "tkd", "tkd", "Tukudede", nil},
+	{"", "tke", "tke", "Takwane", nil},
+	{"", "tkf", "tkf", "Tukumanféd", nil},
+	{"", "tkg", "tkg", "Tesaka Malagasy", nil},
+	{"", "tkl", "tkl", "Tokelau", nil},
+	{"", "tkm", "tkm", "Takelma", nil},
+	{"", "tkn", "tkn", "Toku-No-Shima", nil},
+	{"", "tkp", "tkp", "Tikopia", nil},
+	{"", "tkq", "tkq", "Tee", nil},
+	{"", "tkr", "tkr", "Tsakhur", nil},
+	{"", "tks", "tks", "Takestani", nil},
+	{"", "tkt", "tkt", "Kathoriya Tharu", nil},
+	{"", "tku", "tku", "Upper Necaxa Totonac", nil},
+	{"", "tkv", "tkv", "Mur Pano", nil},
+	{"", "tkw", "tkw", "Teanu", nil},
+	{"", "tkx", "tkx", "Tangko", nil},
+	{"", "tkz", "tkz", "Takua", nil},
+	{"", "tla", "tla", "Southwestern Tepehuan", nil},
+	{"", "tlb", "tlb", "Tobelo", nil},
+	{"", "tlc", "tlc", "Yecuatla Totonac", nil},
+	{"", "tld", "tld", "Talaud", nil},
+	{"", "tlf", "tlf", "Telefol", nil},
+	{"", "tlg", "tlg", "Tofanma", nil},
+	{"", "tlh", "tlh", "Klingon; tlhIngan-Hol", nil},
+	{"", "tli", "tli", "Tlingit", nil},
+	{"", "tlj", "tlj", "Talinga-Bwisi", nil},
+	{"", "tlk", "tlk", "Taloki", nil},
+	{"", "tll", "tll", "Tetela", nil},
+	{"", "tlm", "tlm", "Tolomako", nil},
+	{"", "tln", "tln", "Talondo'", nil},
+	{"", "tlo", "tlo", "Talodi", nil},
+	{"", "tlp", "tlp", "Filomena Mata-Coahuitlán Totonac", nil},
+	{"", "tlq", "tlq", "Tai Loi", nil},
+	{"", "tlr", "tlr", "Talise", nil},
+	{"", "tls", "tls", "Tambotalo", nil},
+	{"", "tlt", "tlt", "Sou Nama", nil},
+	{"", "tlu", "tlu", "Tulehu", nil},
+	{"", "tlv", "tlv", "Taliabu", nil},
+	{"", "tlx", "tlx", "Khehek", nil},
+	{"", "tly", "tly", "Talysh", nil},
+	{"", "tma", "tma", "Tama (Chad)", nil},
+	{"", "tmb", "tmb", "Katbol", nil},
+	{"", "tmc", "tmc", "Tumak", nil},
+	{"", "tmd", "tmd", "Haruai", nil},
+	{"", "tme", "tme", "Tremembé", nil},
+	{"", "tmf", "tmf", "Toba-Maskoy", nil},
+	{"", "tmg", "tmg", "Ternateño", nil},
+	{"", "tmh", "tmh", "Tamashek", nil},
+	{"", "tmi", "tmi", "Tutuba", nil},
+	{"", "tmj", "tmj", "Samarokena", nil},
+	{"", "tmk", "tmk", "Northwestern Tamang", nil},
+	{"", "tml", "tml", "Tamnim Citak", nil},
+	{"", "tmm", "tmm", "Tai Thanh", nil},
+	{"", "tmn", "tmn", "Taman (Indonesia)", nil},
+	{"", "tmo", "tmo", "Temoq", nil},
+	{"", "tmq", "tmq", "Tumleo", nil},
+	{"", "tmr", "tmr", "Jewish Babylonian Aramaic (ca. 200-1200 CE)", nil},
+	{"", "tms", "tms", "Tima", nil},
+	{"", "tmt", "tmt", "Tasmate", nil},
+	{"", "tmu", "tmu", "Iau", nil},
+	{"", "tmv", "tmv", "Tembo (Motembo)", nil},
+	{"", "tmw", "tmw", "Temuan", nil},
+	{"", "tmy", "tmy", "Tami", nil},
+	{"", "tmz", "tmz", "Tamanaku", nil},
+	{"", "tna", "tna", "Tacana", nil},
+	{"", "tnb", "tnb", "Western Tunebo", nil},
+	{"", "tnc", "tnc", "Tanimuca-Retuarã", nil},
+	{"", "tnd", "tnd", "Angosturas Tunebo", nil},
+	{"", "tng", "tng", "Tobanga", nil},
+	{"", "tnh", "tnh", "Maiani", nil},
+	{"", "tni", "tni", "Tandia", nil},
+	{"", "tnk", "tnk", "Kwamera", nil},
+	{"", "tnl", "tnl", "Lenakel", nil},
+	{"", "tnm", "tnm", "Tabla", nil},
+	{"", "tnn", "tnn", "North Tanna", nil},
+	{"", "tno", "tno", "Toromono", nil},
+	{"", "tnp", "tnp", "Whitesands", nil},
+	{"", "tnq", "tnq", "Taino", nil},
+	{"", "tnr", "tnr", "Ménik", nil},
+	{"", "tns", "tns", "Tenis", nil},
+	{"", "tnt", "tnt", "Tontemboan", nil},
+	{"", "tnu", "tnu", "Tay Khang", nil},
+	{"", "tnv", "tnv", "Tangchangya", nil},
+	{"", "tnw", "tnw", "Tonsawang", nil},
+	{"", "tnx", "tnx", "Tanema", nil},
+	{"", "tny", "tny", "Tongwe", nil},
+	{"", "tnz", "tnz", "Ten'edn", nil},
+	{"", "tob", "tob", "Toba", nil},
+	{"", "toc", "toc", "Coyutla Totonac", nil},
+	{"", "tod", "tod", "Toma", nil},
+	{"", "tof", "tof", "Gizrra", nil},
+	{"", "tog", "tog", "Tonga (Nyasa)", nil},
+	{"", "toh", "toh", "Gitonga", nil},
+	{"", "toi", "toi", "Tonga (Zambia)", nil},
+	{"", "toj", "toj", "Tojolabal", nil},
+	{"", "tok", "tok", "Toki Pona", nil},
+	{"", "tol", "tol", "Tolowa", nil},
+	{"", "tom", "tom", "Tombulu", nil},
+	{"to", "ton", "ton", "Tonga (Tonga Islands)", nil},
+	{"", "too", "too", "Xicotepec De Juárez Totonac", nil},
+	{"", "top", "top", "Papantla Totonac", nil},
+	{"", "toq", "toq", "Toposa", nil},
+	{"", "tor", "tor", "Togbo-Vara Banda", nil},
+	{"", "tos", "tos", "Highland Totonac", nil},
+	{"", "tou", "tou", "Tho", nil},
+	{"", "tov", "tov", "Upper Taromi", nil},
+	{"", "tow", "tow", "Jemez", nil},
+	{"", "tox", "tox", "Tobian", nil},
+	{"", "toy", "toy", "Topoiyo", nil},
+	{"", "toz", "toz", "To", nil},
+	{"", "tpa", "tpa", "Taupota", nil},
+	{"", "tpc", "tpc", "Azoyú Me'phaa", nil},
+	{"", "tpe", "tpe", "Tippera", nil},
+	{"", "tpf", "tpf", "Tarpia", nil},
+	{"", "tpg", "tpg", "Kula", nil},
+	{"", "tpi", "tpi", "Tok Pisin", nil},
+	{"", "tpj", "tpj", "Tapieté", nil},
+	{"", "tpk", "tpk", "Tupinikin", nil},
+	{"", "tpl", "tpl", "Tlacoapa Me'phaa", nil},
+	{"", "tpm", "tpm", "Tampulma", nil},
+	{"", "tpn", "tpn", "Tupinambá", nil},
+	{"", "tpo", "tpo", "Tai Pao", nil},
+	{"", "tpp", "tpp", "Pisaflores Tepehua", nil},
+	{"", "tpq", "tpq", "Tukpa", nil},
+	{"", "tpr", "tpr", "Tuparí", nil},
+	{"", "tpt", "tpt", "Tlachichilco Tepehua", nil},
+	{"", "tpu", "tpu", "Tampuan", nil},
+	{"", "tpv", "tpv", "Tanapag", nil},
+	{"", "tpw", "tpw", "Tupí", nil},
+	{"", "tpx", "tpx", "Acatepec Me'phaa", nil},
+	{"", "tpy", "tpy", "Trumai", nil},
+	{"", "tpz", "tpz", "Tinputz", nil},
+	{"", "tqb", "tqb", "Tembé", nil},
+	{"", "tql", "tql", "Lehali", nil},
+	{"", "tqm", "tqm", "Turumsa", nil},
+	{"", "tqn", "tqn", "Tenino", nil},
+	{"", "tqo", "tqo", "Toaripi", nil},
+	{"", "tqp", "tqp", "Tomoip", nil},
+	{"", "tqq", "tqq", "Tunni", nil},
+	{"", "tqr", "tqr", "Torona", nil},
+	{"", "tqt", "tqt", "Western Totonac", nil},
+	{"", "tqu", "tqu", "Touo", nil},
+	{"", "tqw", "tqw", "Tonkawa", nil},
+	{"", "tra", "tra", "Tirahi", nil},
+	{"", "trb", "trb", "Terebu", nil},
+	{"", "trc", "trc", "Copala Triqui", nil},
+	{"", "trd", "trd", "Turi", nil},
+	{"", "tre", "tre", "East Tarangan", nil},
+	{"", "trf", "trf", "Trinidadian Creole English", nil},
+	{"", "trg", "trg", "Lishán Didán", nil},
+	{"", "trh", "trh", "Turaka", nil},
+	{"", "tri", "tri", "Trió", nil},
+	{"", "trj", "trj", "Toram", nil},
+	{"", "trl", "trl", "Traveller Scottish", nil},
+	{"", "trm", "trm", "Tregami", nil},
+	{"", "trn", "trn", "Trinitario", nil},
+	{"", "tro", "tro", "Tarao Naga", nil},
+	{"", "trp", "trp", "Kok Borok", nil},
+	{"", "trq", "trq", "San Martín Itunyoso Triqui", nil},
+	{"", "trr", "trr", "Taushiro", nil},
+	{"", "trs", "trs", "Chicahuaxtla Triqui", nil},
+	{"", "trt", "trt", "Tunggare", nil},
+	{"", "tru", "tru", "Turoyo", nil},
+	{"", "trv", "trv", "Sediq", nil},
+	{"", "trw", "trw", "Torwali", nil},
+	{"", "trx", "trx", "Tringgus-Sembaan Bidayuh", nil},
+	{"", "try", "try", "Turung", nil},
+	{"", "trz", "trz", "Torá", nil},
+	{"", "tsa", "tsa", "Tsaangi", nil},
+	{"", "tsb", "tsb", "Tsamai", nil},
+	{"", "tsc", "tsc", "Tswa", nil},
+	{"", "tsd", "tsd", "Tsakonian", nil},
+	{"", "tse", "tse", "Tunisian Sign Language", nil},
+	{"", "tsg", "tsg", "Tausug", nil},
+	{"", "tsh", "tsh", "Tsuvan", nil},
+	{"", "tsi", "tsi", "Tsimshian", nil},
+	{"", "tsj", "tsj", "Tshangla", nil},
+	{"", "tsk", "tsk", "Tseku", nil},
+	{"", "tsl", "tsl", "Ts'ün-Lao", nil},
+	{"", "tsm", "tsm", "Turkish Sign Language", nil},
+	{"tn", "tsn", "tsn", "Tswana", nil},
+	{"ts", "tso", "tso", "Tsonga", nil},
+	{"", "tsp", "tsp", "Northern Toussian", nil},
+	{"", "tsq", "tsq", "Thai Sign Language", nil},
+	{"", "tsr", "tsr", "Akei", nil},
+	{"", "tss", "tss", "Taiwan Sign Language", nil},
+	{"", "tst", "tst", "Tondi Songway Kiini", nil},
+	{"", "tsu", "tsu", "Tsou", nil},
+	{"", "tsv", "tsv", "Tsogo", nil},
+	{"", "tsw", "tsw", "Tsishingini", nil},
+	{"", "tsx", "tsx", "Mubami", nil},
+	{"", "tsy", "tsy", "Tebul Sign Language", nil},
+	{"", "tsz", "tsz", "Purepecha", nil},
+	{"", "tta", "tta", "Tutelo", nil},
+	{"", "ttb", "ttb", "Gaa", nil},
+	{"", "ttc", "ttc", "Tektiteko", nil},
+	{"", "ttd", "ttd", "Tauade", nil},
+	{"", "tte", "tte", "Bwanabwana", nil},
+	{"", "ttf", "ttf", "Tuotomb", nil},
+	{"", "ttg", "ttg", "Tutong", nil},
+	{"", "tth", "tth", "Upper Ta'oih", nil},
+	{"", "tti", "tti", "Tobati", nil},
+	{"", "ttj", "ttj", "Tooro", nil},
+	{"", "ttk", "ttk", "Totoro", nil},
+	{"", "ttl", "ttl", "Totela", nil},
+	{"", "ttm", "ttm", "Northern Tutchone", nil},
+	{"", "ttn", "ttn", "Towei", nil},
+	{"", "tto", "tto", "Lower Ta'oih", nil},
+	{"", "ttp", "ttp", "Tombelala", nil},
+	{"", "ttq", "ttq", "Tawallammat Tamajaq", nil},
+	{"", "ttr", "ttr", "Tera", nil},
+	{"", "tts", "tts", "Northeastern Thai", nil},
+	{"", "ttt", "ttt", "Muslim Tat", nil},
+	{"", "ttu", "ttu", "Torau", nil},
+	{"", "ttv", "ttv", "Titan", nil},
+	{"", "ttw", "ttw", "Long Wat", nil},
+	{"", "tty", "tty", "Sikaritai", nil},
+	{"", "ttz", "ttz", "Tsum", nil},
+	{"", "tua", "tua", "Wiarumus", nil},
+	{"", "tub", "tub", "Tübatulabal", nil},
+	{"", "tuc", "tuc", "Mutu", nil},
+	{"", "tud", "tud", "Tuxá", nil},
+	{"", "tue", "tue", "Tuyuca", nil},
+	{"", "tuf", "tuf", "Central Tunebo", nil},
+	{"", "tug", "tug", "Tunia", nil},
+	{"", "tuh", "tuh", "Taulil", nil},
+	{"", "tui", "tui", "Tupuri", nil},
+	{"", "tuj", "tuj", "Tugutil", nil},
+	{"tk", "tuk", "tuk", "Turkmen", nil},
+	{"", "tul", "tul", "Tula", nil},
+	{"", "tum", "tum", "Tumbuka", nil},
+	{"", "tun", "tun", "Tunica", nil},
+	{"", "tuo", "tuo", "Tucano", nil},
+	{"", "tup", "tup", "Tupi languages", nil},
+	{"", "tuq", "tuq", "Tedaga", nil},
+	{"tr", "tur", "tur", "Turkish", nil},
+	{"", "tus", "tus", "Tuscarora", nil},
+	{"", "tut", "tut", "Altaic languages", nil},
+	{"", "tuu", "tuu", "Tututni", nil},
+	{"", "tuv", "tuv", "Turkana", nil},
+	{"", "tux", "tux", "Tuxináwa", nil},
+	{"", "tuy", "tuy", "Tugen", nil},
+	{"", "tuz", "tuz", "Turka", nil},
+	{"", "tva", "tva", "Vaghua", nil},
+	{"", "tvd", "tvd", "Tsuvadi", nil},
+	{"", "tve", "tve", "Te'un", nil},
+	{"", "tvk", "tvk", "Southeast Ambrym", nil},
+	{"", "tvl", "tvl", "Tuvalu", nil},
+	{"", "tvm", "tvm", "Tela-Masbuar", nil},
+	{"", "tvn", "tvn", "Tavoyan", nil},
+	{"", "tvo", "tvo", "Tidore", nil},
+	{"", "tvs", "tvs", "Taveta", nil},
+	{"", "tvt", "tvt", "Tutsa Naga", nil},
+	{"", "tvu", "tvu", "Tunen", nil},
+	{"", "tvw", "tvw", "Sedoa", nil},
+	{"", "tvx", "tvx", "Taivoan", nil},
+	{"", "tvy", "tvy", "Timor Pidgin", nil},
+	{"", "twa", "twa", "Twana", nil},
+	{"", "twb", "twb", "Western Tawbuid", nil},
+	{"", "twc", "twc", "Teshenawa", nil},
+	{"", "twd", "twd", "Twents", nil},
+	{"", "twe", "twe", "Tewa (Indonesia)", nil},
+	{"", "twf", "twf", "Northern Tiwa", nil},
+	{"", "twg", "twg", "Tereweng", nil},
+	{"", "twh", "twh", "Tai Dón", nil},
+	{"tw", "twi", "twi", "Twi", nil},
+	{"", "twl", "twl", "Tawara", nil},
+	{"", "twm", "twm", "Tawang Monpa", nil},
+	{"", "twn", "twn", "Twendi", nil},
+	{"", "two", "two", "Tswapong", nil},
+	{"", "twp", "twp", "Ere", nil},
+	{"", "twq", "twq", "Tasawaq", nil},
+	{"", "twr", "twr", "Southwestern Tarahumara", nil},
+	{"", "twt", "twt", "Turiwára", nil},
+	{"", "twu", "twu", "Termanu", nil},
+	{"", "tww", "tww", "Tuwari", nil},
+	{"", "twx", "twx", "Tewe", nil},
+	{"", "twy", "twy", "Tawoyan", nil},
+	{"", "txa", "txa", "Tombonuo", nil},
+	{"", "txb", "txb", "Tokharian B", nil},
+	{"", "txc", "txc", "Tsetsaut", nil},
+	{"", "txe", "txe", "Totoli", nil},
+	{"", "txg", "txg", "Tangut", nil},
+	{"", "txh", "txh", "Thracian", nil},
+	{"", "txi", "txi", "Ikpeng", nil},
+	{"", "txj", "txj", "Tarjumo", nil},
+	{"", "txm", "txm", "Tomini", nil},
+	{"", "txn", "txn", "West Tarangan", nil},
+	{"", "txo", "txo", "Toto", nil},
+	{"", "txq", "txq", "Tii", nil},
+	{"", "txr", "txr", "Tartessian", nil},
+	{"", "txs", "txs", "Tonsea", nil},
+	{"", "txt", "txt", "Citak", nil},
+	{"", "txu", "txu", "Kayapó", nil},
+	{"", "txx", "txx", "Tatana", nil},
+	{"", "txy", "txy", "Tanosy Malagasy", nil},
+	{"", "tya", "tya", "Tauya", nil},
+	{"", "tye", "tye", "Kyanga", nil},
+	{"", "tyh", "tyh", "O'du", nil},
+	{"", "tyi", "tyi", "Teke-Tsaayi", nil},
+	{"", "tyj", "tyj", "Tai Do", nil},
+	{"", "tyl", "tyl", "Thu Lao", nil},
+	{"", "tyn", "tyn", "Kombai", nil},
+	{"", "typ", "typ", "Thaypan", nil},
+	{"", "tyr", "tyr", "Tai Daeng", nil},
+	{"", "tys", "tys", "Tày Sa Pa", nil},
+	{"", "tyt", "tyt", "Tày Tac", nil},
+	{"", "tyu", "tyu", "Kua", nil},
+	{"", "tyv", "tyv", "Tuvinian", nil},
+	{"", "tyx", "tyx", "Teke-Tyee", nil},
+	{"", "tyy", "tyy", "Tiyaa", nil},
+	{"", "tyz", "tyz", "Tày", nil},
+	{"", "tza", "tza", "Tanzanian Sign Language", nil},
+	{"", "tzh", "tzh", "Tzeltal", nil},
+	{"", "tzj", "tzj", "Tz'utujil", nil},
+	{"", "tzl", "tzl", "Talossan", nil},
+	{"", "tzm", "tzm", "Central Atlas Tamazight", nil},
+	{"", "tzn", "tzn", "Tugun", nil},
+	{"", "tzo", "tzo", "Tzotzil", nil},
+	{"", "tzx", "tzx", "Tabriak", nil},
+	{"", "uam", "uam", "Uamué", nil},
+	{"", "uan", "uan", "Kuan", nil},
+	{"", "uar", "uar", "Tairuma", nil},
+	{"", "uba", "uba", "Ubang", nil},
+	{"", "ubi", "ubi", "Ubi", nil},
+	{"", "ubl", "ubl", "Buhi'non Bikol", nil},
+	{"", "ubr", "ubr", "Ubir", nil},
+	{"", "ubu", "ubu", "Umbu-Ungu", nil},
+	{"", "uby", "uby", "Ubykh", nil},
+	{"", "uda", "uda", "Uda", nil},
+	{"", "ude", "ude", "Udihe", nil},
+	{"", "udg", "udg", "Muduga", nil},
+	{"", "udi", "udi", "Udi", nil},
+	{"", "udj", "udj", "Ujir", nil},
+	{"", "udl", "udl", "Wuzlam", nil},
+	{"", "udm", "udm", "Udmurt", nil},
+	{"", "udu", "udu", "Uduk", nil},
+	{"", "ues", "ues", "Kioko", nil},
+	{"", "ufi", "ufi", "Ufim", nil},
+	{"", "uga", "uga", "Ugaritic", nil},
+	{"", "ugb", "ugb", "Kuku-Ugbanh", nil},
+	{"", "uge", "uge", "Ughele", nil},
+	{"", "ugh", "ugh", "Kubachi", nil},
+	{"", "ugn", "ugn", "Ugandan Sign Language", nil},
+	{"", "ugo", "ugo", "Ugong", nil},
+	{"", "ugy", "ugy", "Uruguayan Sign Language", nil},
+	{"", "uha", "uha", "Uhami", nil},
+	{"", "uhn", "uhn", "Damal", nil},
+	{"ug", "uig", "uig", "Uighur; Uyghur", nil},
+	{"", "uis", "uis", "Uisai", nil},
+	{"", "uiv", "uiv", "Iyive", nil},
+	{"", "uji", "uji", "Tanjijili", nil},
+	{"", "uka", "uka", "Kaburi", nil},
+	{"", "ukg", "ukg", "Ukuriguma", nil},
+	{"", "ukh", "ukh", "Ukhwejo", nil},
+	{"", "uki", "uki", "Kui (India)", nil},
+	{"", "ukk", "ukk", "Muak Sa-aak", nil},
+	{"", "ukl", "ukl", "Ukrainian Sign Language", nil},
+	{"", "ukp", "ukp", "Ukpe-Bayobiri", nil},
+	{"", "ukq", "ukq", "Ukwa", nil},
+	{"uk", "ukr", "ukr", "Ukrainian", nil},
+	{"", "uks", "uks", "Urubú-Kaapor Sign Language", nil},
+	{"", "uku", "uku", "Ukue", nil},
+	{"", "ukv", "ukv", "Kuku", nil},
+	{"", "ukw", "ukw", "Ukwuani-Aboh-Ndoni", nil},
+	{"", "uky", "uky", "Kuuk-Yak", nil},
+	{"", "ula", "ula", "Fungwa", nil},
+	{"", "ulb", "ulb", "Ulukwumi", nil},
+	{"", "ulc", "ulc", "Ulch", nil},
+	{"", "ule", "ule", "Lule", nil},
+	{"", "ulf", "ulf", "Usku", nil},
+	{"", "uli", "uli", "Ulithian", nil},
+	{"", "ulk", "ulk", "Meriam Mir", nil},
+	{"", "ull", "ull", "Ullatan", nil},
+	{"", "ulm", "ulm", "Ulumanda'", nil},
+	{"", "uln", "uln", "Unserdeutsch", nil},
+	{"", "ulu", "ulu", "Uma' Lung", nil},
+	{"", "ulw", "ulw", "Ulwa", nil},
+	{"", "uma", "uma", "Umatilla", nil},
+	{"", "umb", "umb", "Umbundu", nil},
+	{"", "umc", "umc", "Marrucinian", nil},
+	{"", "umd", "umd", "Umbindhamu", nil},
+	{"", "umg", "umg", "Morrobalama", nil},
+	{"", "umi", "umi", "Ukit", nil},
+	{"", "umm", "umm", "Umon", nil},
+	{"", "umn", "umn", "Makyan Naga", nil},
+	{"", "umo", "umo", "Umotína", nil},
+	{"", "ump", "ump", "Umpila", nil},
+	{"", "umr", "umr", "Umbugarla", nil},
+	{"", "ums", "ums", "Pendau", nil},
+	{"", "umu", "umu", "Munsee", nil},
+	{"", "una", "una", "North Watut", nil},
+	{"", "und", "und", "Undetermined", nil},
+	{"", "une", "une", "Uneme", nil},
+	{"", "ung", "ung", "Ngarinyin", nil},
+	{"", "uni", "uni", "Uni", nil},
+	{"", "unk", "unk", "Enawené-Nawé", nil},
+	{"", "unm", "unm", "Unami", nil},
+	{"", "unn", "unn", "Kurnai", nil},
+	{"", "unr", "unr", "Mundari", nil},
+	{"", "unu", "unu", "Unubahe", nil},
+	{"", "unx", "unx", "Munda", nil},
+	{"", "unz", "unz", "Unde Kaili", nil},
+	{"", "uon", "uon", "Kulon", nil},
+	{"", "upi", "upi", "Umeda", nil},
+	{"", "upv", "upv", "Uripiv-Wala-Rano-Atchin", nil},
+	{"", "ura", "ura", "Urarina", nil},
+	{"", "urb", "urb", "Urubú-Kaapor", nil},
+	{"", "urc", "urc", "Urningangg", nil},
+	{"ur", "urd", "urd", "Urdu", nil},
+	{"", "ure", "ure", "Uru", nil},
+	{"", "urf", "urf", "Uradhi", nil},
+	{"", "urg", "urg", "Urigina", nil},
+	{"", "urh", "urh", "Urhobo", nil},
+	{"", "uri", "uri", "Urim", nil},
+	{"", "urk", "urk", "Urak Lawoi'", nil},
+	{"", "url", "url", "Urali", nil},
+	{"", "urm", "urm", "Urapmin", nil},
+	{"", "urn", "urn", "Uruangnirin", nil},
+	{"", "uro", "uro", "Ura (Papua New Guinea)", nil},
+	{"", "urp", "urp", "Uru-Pa-In", nil},
+	{"", "urr", "urr", "Lehalurup", nil},
+	{"", "urt", "urt", "Urat", nil},
+	{"", "uru", "uru", "Urumi", nil},
+	{"", "urv", "urv", "Uruava", nil},
+	{"", "urw", "urw", "Sop", nil},
+	{"", "urx", "urx", "Urimo", nil},
+	{"", "ury", "ury", "Orya", nil},
+	{"", "urz", "urz", "Uru-Eu-Wau-Wau", nil},
+	{"", "usa", "usa", "Usarufa", nil},
+	{"", "ush", "ush", "Ushojo", nil},
+	{"", "usi", "usi", "Usui", nil},
+	{"", "usk", "usk", "Usaghade", nil},
+	{"", "usp", "usp", "Uspanteco", nil},
+	{"", "uss", "uss", "us-Saare", nil},
+	{"", "usu", "usu", "Uya", nil},
+	{"", "uta", "uta", "Otank", nil},
+	{"", "ute", "ute", "Ute-Southern Paiute", nil},
+	{"", "uth", "uth", "ut-Hun", nil},
+	{"", "utp", "utp", "Amba (Solomon Islands)", nil},
+	{"", "utr", "utr", "Etulo", nil},
+	{"", "utu", "utu", "Utu", nil},
+	{"", "uum", "uum", "Urum", nil},
+	{"", "uur", "uur", "Ura (Vanuatu)", nil},
+	{"", "uuu", "uuu", "U", nil},
+	{"", "uve", "uve", "West Uvean", nil},
+	{"", "uvh", "uvh", "Uri", nil},
+	{"", "uvl", "uvl", "Lote", nil},
+	{"", "uwa", "uwa", "Kuku-Uwanh", nil},
+	{"", "uya", "uya", "Doko-Uyanga", nil},
+	{"uz", "uzb", "uzb", "Uzbek", nil},
+	{"", "uzn", "uzn", "Northern Uzbek", nil},
+	{"", "uzs", "uzs", "Southern Uzbek", nil},
+	{"", "vaa", "vaa", "Vaagri Booli", nil},
+	{"", "vae", "vae", "Vale", nil},
+	{"", "vaf", "vaf", "Vafsi", nil},
+	{"", "vag", "vag", "Vagla", nil},
+	{"", "vah", "vah", "Varhadi-Nagpuri", nil},
+	{"", "vai", "vai", "Vai", nil},
+	{"", "vaj", "vaj", "Sekele", nil},
+	{"", "val", "val", "Vehes", nil},
+	{"", "vam", "vam", "Vanimo", nil},
+	{"", "van", "van", "Valman", nil},
+	{"", "vao", "vao", "Vao", nil},
+	{"", "vap", "vap", "Vaiphei", nil},
+	{"", "var", "var", "Huarijio", nil},
+	{"", "vas", "vas", "Vasavi", nil},
+	{"", "vau", "vau", "Vanuma", nil},
+	{"", "vav", "vav", "Varli", nil},
+	{"", "vay", "vay", "Wayu", nil},
+	{"", "vbb", "vbb", "Southeast Babar", nil},
+	{"", "vbk", "vbk", "Southwestern Bontok", nil},
+	{"", "vec", "vec", "Venetian", nil},
+	{"", "ved", "ved", "Veddah", nil},
+	{"", "vel", "vel", "Veluws", nil},
+	{"", "vem", "vem", "Vemgo-Mabas", nil},
+	{"ve", "ven", "ven", "Venda", nil},
+	{"", "veo", "veo", "Ventureño", nil},
+	{"", "vep", "vep", "Veps", nil},
+	{"", "ver", "ver", "Mom Jango", nil},
+	{"", "vgr", "vgr", "Vaghri", nil},
+	{"", "vgt", "vgt", "Vlaamse Gebarentaal", nil},
+	{"", "vic", "vic", "Virgin Islands Creole English", nil},
+	{"", "vid", "vid", "Vidunda", nil},
+	{"vi", "vie", "vie", "Vietnamese", nil},
+	{"", "vif", "vif", "Vili", nil},
+	{"", "vig", "vig", "Viemo", nil},
+	{"", "vil", "vil", "Vilela", nil},
+	{"", "vin", "vin", "Vinza", nil},
+	{"", "vis", "vis", "Vishavan", nil},
+	{"", "vit", "vit", "Viti", nil},
+	{"", "viv", "viv", "Iduna", nil},
+	{"", "vka", "vka", "Kariyarra", nil},
+	{"", "vkj", "vkj", "Kujarge", nil},
+	{"", "vkk", "vkk", "Kaur", nil},
+	{"", "vkl", "vkl", "Kulisusu", nil},
+	{"", "vkm", "vkm", "Kamakan", nil},
+	{"", "vkn", "vkn", "Koro Nulu", nil},
+	{"", "vko", "vko", "Kodeoha", nil},
+	{"", "vkp", "vkp", "Korlai Creole Portuguese", nil},
+	{"", "vkt", "vkt", "Tenggarong Kutai Malay", nil},
+	{"", "vku", "vku", "Kurrama", nil},
+	{"", "vkz", "vkz", "Koro Zuba", nil},
+	{"", "vlp", "vlp", "Valpei", nil},
+	{"", "vls", "vls", "Vlaams", nil},
+	{"", "vma", "vma", "Martuyhunira", nil},
+	{"", "vmb", "vmb", "Barbaram", nil},
+	{"", "vmc", "vmc", "Juxtlahuaca Mixtec", nil},
+	{"", "vmd", "vmd", "Mudu Koraga", nil},
+	{"", "vme", "vme", "East Masela", nil},
+	{"", "vmf", "vmf", "Mainfränkisch", nil},
+	{"", "vmg", "vmg", "Lungalunga", nil},
+	{"", "vmh", "vmh", "Maraghei", nil},
+	{"", "vmi", "vmi", "Miwa", nil},
+	{"", "vmj", "vmj", "Ixtayutla Mixtec", nil},
+	{"", "vmk", "vmk", "Makhuwa-Shirima", nil},
+	{"", "vml", "vml", "Malgana", nil},
+	{"", "vmm", "vmm", "Mitlatongo Mixtec", nil},
+	{"", "vmp", "vmp", "Soyaltepec Mazatec", nil},
+	{"", "vmq", "vmq", "Soyaltepec Mixtec", nil},
+	{"", "vmr", "vmr", "Marenje", nil},
+	{"", "vms", "vms", "Moksela", nil},
+	{"", "vmu", "vmu", "Muluridyi", nil},
+	{"", "vmv", "vmv", "Valley Maidu", nil},
+	{"", "vmw", "vmw", "Makhuwa", nil},
+	{"", "vmx", "vmx", "Tamazola Mixtec", nil},
+	{"", "vmy", "vmy", "Ayautla Mazatec", nil},
+	{"", "vmz", "vmz", "Mazatlán Mazatec", nil},
+	{"", "vnk", "vnk", "Vano", nil},
+	{"", "vnm", "vnm", "Vinmavis", nil},
+	{"", "vnp", "vnp", "Vunapu", nil},
+	{"vo", "vol", "vol", "Volapük", nil},
+	{"", "vor", "vor", "Voro", nil},
+	{"", "vot", "vot", "Votic", nil},
+	{"", "vra", "vra", "Vera'a", nil},
+	{"", "vro", "vro", "Võro", nil},
+	{"", "vrs", "vrs", "Varisi", nil},
+	{"", "vrt", "vrt", "Burmbar", nil},
+	{"", "vsi", "vsi", "Moldova Sign Language", nil},
+	{"", "vsl", "vsl", "Venezuelan Sign Language", nil},
+	{"", "vsv", "vsv", "Valencian Sign Language", nil},
+	{"", "vto", "vto", "Vitou", nil},
+	{"", "vum", "vum", "Vumbu", nil},
+	{"", "vun", "vun", "Vunjo", nil},
+	{"", "vut", "vut", "Vute", nil},
+	{"", "vwa", "vwa", "Awa (China)", nil},
+	{"", "waa", "waa", "Walla Walla", nil},
+	{"", "wab", "wab", "Wab", nil},
+	{"", "wac", "wac", "Wasco-Wishram", nil},
+	{"", "wad", "wad", "Wamesa", nil},
+	{"", "wae", "wae", "Walser", nil},
+	{"", "waf", "waf", "Wakoná", nil},
+	{"", "wag", "wag", "Wa'ema", nil},
+	{"", "wah", "wah", "Watubela", nil},
+	{"", "wai", "wai", "Wares", nil},
+	{"", "waj", "waj", "Waffa", nil},
+	{"", "wak", "wak", "Wakashan languages", nil},
+	{"", "wal", "wal", "Walamo", nil},
+	{"", "wam", "wam", "Wampanoag", nil},
+	{"", "wan", "wan", "Wan", nil},
+	{"", "wao", "wao", "Wappo", nil},
+	{"", "wap", "wap", "Wapishana", nil},
+	{"", "waq", "waq", "Wagiman", nil},
+	{"", "war", "war", "Waray", nil},
+	{"", "was", "was", "Washo", nil},
+	{"", "wat", "wat", "Kaninuwa", nil},
+	{"", "wau", "wau", "Waurá", nil},
+	{"", "wav", "wav", "Waka", nil},
+	{"", "waw", "waw", "Waiwai", nil},
+	{"", "wax", "wax", "Watam", nil},
+	{"", "way", "way", "Wayana", nil},
+	{"", "waz", "waz", "Wampur", nil},
+	{"", "wba", "wba", "Warao", nil},
+	{"", "wbb", "wbb", "Wabo", nil},
+	{"", "wbe", "wbe", "Waritai", nil},
+	{"", "wbf", "wbf", "Wara", nil},
+	{"", "wbh", "wbh", "Wanda", nil},
+	{"", "wbi", "wbi", "Vwanji", nil},
+	{"", "wbj", "wbj", "Alagwa", nil},
+	{"", "wbk", "wbk", "Waigali", nil},
+	{"", "wbl", "wbl", "Wakhi", nil},
+	{"", "wbm", "wbm", "Wa", nil},
+	{"", "wbp", "wbp", "Warlpiri", nil},
+	{"", "wbq", "wbq", "Waddar", nil},
+	{"", "wbr", "wbr", "Wagdi", nil},
+	{"", "wbs", "wbs", "West Bengal Sign Language", nil},
+	{"", "wbt", "wbt", "Warnman", nil},
+	{"", "wbv", "wbv", "Wajarri", nil},
+	{"", "wbw", "wbw", "Woi", nil},
+	{"", "wca", "wca", "Yanomámi", nil},
+	{"", "wci", "wci", "Waci Gbe", nil},
+	{"", "wdd", "wdd", "Wandji", nil},
+	{"", "wdg", "wdg", "Wadaginam", nil},
+	{"", "wdj", "wdj", "Wadjiginy", nil},
+	{"", "wdk", "wdk", "Wadikali", nil},
+	{"", "wdt", "wdt", "Wendat", nil},
+	{"", "wdu", "wdu", "Wadjigu", nil},
+	{"", "wdy", "wdy", "Wadjabangayi", nil},
+	{"", "wea", "wea", "Wewaw", nil},
+	{"", "wec", "wec", "Wè Western", nil},
+	{"", "wed", "wed", "Wedau", nil},
+	{"", "weg", "weg", "Wergaia", nil},
+	{"", "weh", "weh", "Weh", nil},
+	{"", "wei", "wei", "Kiunum", nil},
+	{"", "wem", "wem", "Weme Gbe", nil},
+	{"", "wen", "wen", "Sorbian languages", nil},
+	{"", "weo", "weo", "Wemale", nil},
+	{"", "wep", "wep", "Westphalien", nil},
+	{"", "wer", "wer", "Weri", nil},
+	{"", "wes", "wes", "Cameroon Pidgin", nil},
+	{"", "wet", "wet", "Perai", nil},
+	{"", "weu", "weu", "Rawngtu Chin", nil},
+	{"", "wew", "wew", "Wejewa", nil},
+	{"", "wfg", "wfg", "Yafi", nil},
+	{"", "wga", "wga", "Wagaya", nil},
+	{"", "wgb", "wgb", "Wagawaga", nil},
+	{"", "wgg", "wgg", "Wangkangurru", nil},
+	{"", "wgi", "wgi", "Wahgi", nil},
+	{"", "wgo", "wgo", "Waigeo", nil},
+	{"", "wgu", "wgu", "Wirangu", nil},
+	{"", "wgy", "wgy", "Warrgamay", nil},
+	{"", "wha", "wha", "Sou Upaa", nil},
+	{"", "whg", "whg", "North Wahgi", nil},
+	{"", "whk", "whk", "Wahau Kenyah", nil},
+	{"", "whu", "whu", "Wahau Kayan", nil},
+	{"", "wib", "wib", "Southern Toussian", nil},
+	{"", "wic", "wic", "Wichita", nil},
+	{"", "wie", "wie", "Wik-Epa", nil},
+	{"", "wif", "wif", "Wik-Keyangan", nil},
+	{"", "wig", "wig", "Wik Ngathan", nil},
+	{"", "wih", "wih", "Wik-Me'anha", nil},
+	{"", "wii", "wii", "Minidien", nil},
+	{"", "wij", "wij", "Wik-Iiyanh", nil},
+	{"", "wik", "wik", "Wikalkan", nil},
+	{"", "wil", "wil", "Wilawila", nil},
+	{"", "wim", "wim", "Wik-Mungkan", nil},
+	{"", "win", "win", "Ho-Chunk", nil},
+	{"", "wir", "wir", "Wiraféd", nil},
+	{"", "wiu", "wiu", "Wiru", nil},
+	{"", "wiv", "wiv", "Vitu", nil},
+	{"", "wiy", "wiy", "Wiyot", nil},
+	{"", "wja", "wja", "Waja", nil},
+	{"", "wji", "wji", "Warji", nil},
+	{"", "wka", "wka", "Kw'adza", nil},
+	{"", "wkb", "wkb", "Kumbaran", nil},
+	{"", "wkd", "wkd", "Wakde", nil},
+	{"", "wkl", "wkl", "Kalanadi", nil},
+	{"", "wkr", "wkr", "Keerray-Woorroong", nil},
+	{"", "wku", "wku", "Kunduvadi", nil},
+	{"", "wkw", "wkw", "Wakawaka", nil},
+	{"", "wky", "wky", "Wangkayutyuru", nil},
+	{"", "wla", "wla", "Walio", nil},
+	{"", "wlc", "wlc", "Mwali Comorian", nil},
+	{"", "wle", "wle", "Wolane", nil},
+	{"", "wlg", "wlg", "Kunbarlang", nil},
+	{"", "wlh", "wlh", "Welaun", nil},
+	{"", "wli", "wli", "Waioli", nil},
+	{"", "wlk", "wlk", "Wailaki", nil},
+	{"", "wll", "wll", "Wali (Sudan)", nil},
+	{"", "wlm", "wlm", "Middle Welsh", nil},
+	{"wa", "wln", "wln", "Walloon", nil},
+	{"", "wlo", "wlo", "Wolio", nil},
+	{"", "wlr", "wlr", "Wailapa", nil},
+	{"", "wls", "wls", "Wallisian", nil},
+	{"", "wlu", "wlu", "Wuliwuli", nil},
+	{"", "wlv", "wlv", "Wichí Lhamtés Vejoz", nil},
+	{"", "wlw", "wlw", "Walak", nil},
+	{"", "wlx", "wlx", "Wali (Ghana)", nil},
+	{"", "wly", "wly", "Waling", nil},
+	{"", "wma", "wma", "Mawa (Nigeria)", nil},
+	{"", "wmb", "wmb", "Wambaya", nil},
+	{"", "wmc", "wmc", "Wamas", nil},
+	{"", "wmd", "wmd", "Mamaindé", nil},
+	{"", "wme", "wme", "Wambule", nil},
+	{"", "wmg", "wmg", "Western Minyag", nil},
+	{"", "wmh", "wmh", "Waima'a", nil},
+	{"", "wmi", "wmi", "Wamin", nil},
+	{"", "wmm", "wmm", "Maiwa (Indonesia)", nil},
+	{"", "wmn", "wmn", "Waamwang", nil},
+	{"", "wmo", "wmo", "Wom (Papua New Guinea)", nil},
+	{"", "wms", "wms", "Wambon", nil},
+	{"", "wmt", "wmt", "Walmajarri", nil},
+	{"", "wmw", "wmw", "Mwani", nil},
+	{"", "wmx", "wmx", "Womo", nil},
+	{"", "wnb", "wnb", "Wanambre", nil},
+	{"", "wnc", "wnc", "Wantoat", nil},
+	{"", "wnd", "wnd", "Wandarang", nil},
+	{"", "wne", "wne", "Waneci", nil},
+	{"", "wng", "wng", "Wanggom", nil},
+	{"", "wni", "wni", "Ndzwani Comorian", nil},
+	{"", "wnk", "wnk", "Wanukaka", nil},
+	{"", "wnm", "wnm", "Wanggamala", nil},
+	{"", "wnn", "wnn", "Wunumara", nil},
+	{"", "wno", "wno", "Wano", nil},
+	{"", "wnp", "wnp", "Wanap", nil},
+	{"", "wnu", "wnu", "Usan", nil},
+	{"", "wnw", "wnw", "Wintu", nil},
+	{"", "wny", "wny", "Wanyi", nil},
+	{"", "woa", "woa", "Kuwema", nil},
+	{"", "wob", "wob", "Wè Northern", nil},
+	{"", "woc", "woc", "Wogeo", nil},
+	{"", "wod", "wod", "Wolani", nil},
+	{"", "woe", "woe", "Woleaian", nil},
+	{"", "wof", "wof", "Gambian Wolof", nil},
+	{"", "wog", "wog", "Wogamusin", nil},
+	{"", "woi", "woi", "Kamang", nil},
+	{"", "wok", "wok", "Longto", nil},
+	{"wo", "wol", "wol", "Wolof", nil},
+	{"", "wom", "wom", "Wom (Nigeria)", nil},
+	{"", "won", "won", "Wongo", nil},
+	{"", "woo", "woo", "Manombai", nil},
+	{"", "wor", "wor", "Woria", nil},
+	{"", "wos", "wos", "Hanga Hundi", nil},
+	{"", "wow", "wow", "Wawonii", nil},
+	{"", "woy", "woy", "Weyto", nil},
+	{"", "wpc", "wpc", "Maco", nil},
+	{"", "wrb", "wrb", "Waluwarra", nil},
+	{"", "wrg", "wrg", "Warungu", nil},
+	{"", "wrh", "wrh", "Wiradjuri", nil},
+	{"", "wri", "wri", "Wariyangga", nil},
+	{"", "wrk", "wrk", "Garrwa", nil},
+	{"", "wrl", "wrl", "Warlmanpa", nil},
+	{"", "wrm", "wrm", "Warumungu", nil},
+	{"", "wrn", "wrn", "Warnang", nil},
+	{"", "wro", "wro", "Worrorra", nil},
+	{"", "wrp", "wrp", "Waropen", nil},
+	{"", "wrr", "wrr", "Wardaman", nil},
+	{"", "wrs", "wrs", "Waris", nil},
+	{"", "wru", "wru", "Waru", nil},
+	{"", "wrv", "wrv", "Waruna", nil},
+	{"", "wrw", "wrw", "Gugu Warra", nil},
+	{"", "wrx", "wrx", "Wae Rana", nil},
+	{"", "wry", "wry", "Merwari", nil},
+	{"", "wrz", "wrz", "Waray (Australia)", nil},
+	{"", "wsa", "wsa", "Warembori", nil},
+	{"", "wsg", "wsg", "Adilabad Gondi", nil},
+	{"", "wsi", "wsi", "Wusi", nil},
+	{"", "wsk", "wsk", "Waskia", nil},
+	{"", "wsr", "wsr", "Owenia", nil},
+	{"", "wss", "wss", "Wasa", nil},
+	{"", "wsu", "wsu", "Wasu", nil},
+	{"", "wsv", "wsv", "Wotapuri-Katarqalai", nil},
+	{"", "wtf", "wtf", "Watiwa", nil},
+	{"", "wth", "wth", "Wathawurrung", nil},
+	{"", "wti", "wti", "Berta", nil},
+	{"", "wtk", "wtk", "Watakataui", nil},
+	{"", "wtm", "wtm", "Mewati", nil},
+	{"", "wtw", "wtw", "Wotu", nil},
+	{"", "wua", "wua", "Wikngenchera", nil},
+	{"", "wub", "wub", "Wunambal", nil},
+	{"", "wud", "wud", "Wudu", nil},
+	{"", "wuh", "wuh", "Wutunhua", nil},
+	{"", "wul", "wul", "Silimo", nil},
+	{"", "wum", "wum", "Wumbvu", nil},
+	{"", "wun", "wun", "Bungu", nil},
+	{"", "wur", "wur", "Wurrugu", nil},
+	{"", "wut", "wut", "Wutung", nil},
+	{"", "wuu", "wuu", "Wu Chinese", nil},
+	{"", "wuv", "wuv", "Wuvulu-Aua", nil},
+	{"", "wux", "wux", "Wulna", nil},
+	{"", "wuy", "wuy", "Wauyai", nil},
+	{"", "wwa", "wwa", "Waama", nil},
+	{"", "wwb", "wwb", "Wakabunga", nil},
+	{"", "wwo", "wwo", "Wetamut", nil},
+	{"", "wwr", "wwr", "Warrwa", nil},
+	{"", "www", "www", "Wawa", nil},
+	{"", "wxa", "wxa", "Waxianghua", nil},
+	{"", "wxw", "wxw", "Wardandi", nil},
+	{"", "wyb", "wyb", "Wangaaybuwan-Ngiyambaa", nil},
+	{"", "wyi", "wyi", "Woiwurrung", nil},
+	{"", "wym", "wym", "Wymysorys", nil},
+	{"", "wyn", "wyn", "Wyandot", nil},
+	{"", "wyr", "wyr", "Wayoró", nil},
+	{"", "wyy", "wyy", "Western Fijian", nil},
+	{"", "xaa", "xaa", "Andalusian Arabic", nil},
+	{"", "xab", "xab", "Sambe", nil},
+	{"", "xac", "xac", "Kachari", nil},
+	{"", "xad", "xad", "Adai", nil},
+	{"", "xae", "xae", "Aequian", nil},
+	{"", "xag", "xag", "Aghwan", nil},
+	{"", "xai", "xai", "Kaimbé", nil},
+	{"", "xaj", "xaj", "Ararandewára", nil},
+	{"", "xak", "xak", "Máku", nil},
+	{"", "xal", "xal", "Kalmyk; Oirat", nil},
+	{"", "xam", "xam", "ǀXam", nil},
+	{"", "xan", "xan", "Xamtanga", nil},
+	{"", "xao", "xao", "Khao", nil},
+	{"", "xap", "xap", "Apalachee", nil},
+	{"", "xaq", "xaq", "Aquitanian", nil},
+	{"", "xar", "xar", "Karami", nil},
+	{"", "xas", "xas", "Kamas", nil},
+	{"", "xat", "xat", "Katawixi", nil},
+	{"", "xau", "xau", "Kauwera", nil},
+	{"", "xav", "xav", "Xavánte", nil},
+	{"", "xaw", "xaw", "Kawaiisu", nil},
+	{"", "xay", "xay", "Kayan Mahakam", nil},
+	{"", "xbb", "xbb", "Lower Burdekin", nil},
+	{"", "xbc", "xbc", "Bactrian", nil},
+	{"", "xbd", "xbd", "Bindal", nil},
+	{"", "xbe", "xbe", "Bigambal", nil},
+	{"", "xbg", "xbg", "Bunganditj", nil},
+	{"", "xbi", "xbi", "Kombio", nil},
+	{"", "xbj", "xbj", "Birrpayi", nil},
+	{"", "xbm", "xbm", "Middle Breton", nil},
+	{"", "xbn", "xbn", "Kenaboi", nil},
+	{"", "xbo", "xbo", "Bolgarian", nil},
+	{"", "xbp", "xbp", "Bibbulman", nil},
+	{"", "xbr", "xbr", "Kambera", nil},
+	{"", "xbw", "xbw", "Kambiwá", nil},
+	{"", "xby", "xby", "Batjala", nil},
+	{"", "xcb", "xcb", "Cumbric", nil},
+	{"", "xcc", "xcc", "Camunic", nil},
+	{"", "xce", "xce", "Celtiberian", nil},
+	{"", "xcg", "xcg", "Cisalpine Gaulish", nil},
+	{"", "xch", "xch", "Chemakum", nil},
+	{"", "xcl", "xcl", "Classical Armenian", nil},
+	{"", "xcm", "xcm", "Comecrudo", nil},
+	{"", "xcn", "xcn", "Cotoname", nil},
+	{"", "xco", "xco", "Chorasmian", nil},
+	{"", "xcr", "xcr", "Carian", nil},
+	{"", "xct", "xct", "Classical Tibetan", nil},
+	{"", "xcu", "xcu", "Curonian", nil},
+	{"", "xcv", "xcv", "Chuvantsy", nil},
+	{"", "xcw", "xcw", "Coahuilteco", nil},
+	{"", "xcy", "xcy", "Cayuse", nil},
+	{"", "xda", "xda", "Darkinyung", nil},
+	{"", "xdc", "xdc", "Dacian", nil},
+	{"", "xdk", "xdk", "Dharuk", nil},
+	{"", "xdm", "xdm", "Edomite", nil},
+	{"", "xdo", "xdo", "Kwandu", nil},
+	{"", "xdq", "xdq", "Kaitag", nil},
+	{"", "xdy", "xdy", "Malayic Dayak", nil},
+	{"", "xeb", "xeb", "Eblan", nil},
+	{"", "xed", "xed", "Hdi", nil},
+	{"", "xeg", "xeg", "ǁXegwi", nil},
+	{"", "xel", "xel", "Kelo", nil},
+	{"", "xem", "xem", "Kembayan", nil},
+	{"", "xep", "xep", "Epi-Olmec", nil},
+	{"", "xer", "xer", "Xerénte", nil},
+	{"", "xes", "xes", "Kesawai", nil},
+	{"", "xet", "xet", "Xetá", nil},
+	{"", "xeu", "xeu", "Keoru-Ahia", nil},
+	{"", "xfa", "xfa", "Faliscan", nil},
+	{"", "xga", "xga", "Galatian", nil},
+	{"", "xgb", "xgb", "Gbin", nil},
+	{"", "xgd", "xgd", "Gudang", nil},
+	{"", "xgf", "xgf", "Gabrielino-Fernandeño", nil},
+	{"", "xgg", "xgg", "Goreng", nil},
+	{"", "xgi", "xgi", "Garingbal", nil},
+	{"", "xgl", "xgl", "Galindan", nil},
+	{"", "xgm", "xgm", "Dharumbal", nil},
+	{"", "xgr", "xgr", "Garza", nil},
+	{"", "xgu", "xgu", "Unggumi", nil},
+	{"", "xgw", "xgw", "Guwa", nil},
+	{"", "xha", "xha", "Harami", nil},
+	{"", "xhc", "xhc", "Hunnic", nil},
+	{"", "xhd", "xhd", "Hadrami", nil},
+	{"", "xhe", "xhe", "Khetrani", nil},
+	{"", "xhm", "xhm", "Middle Khmer (1400 to 1850 CE)", nil},
+	{"xh", "xho", "xho", "Xhosa", nil},
+	{"", "xhr", "xhr", "Hernican", nil},
+	{"", "xht", "xht", "Hattic", nil},
+	{"", "xhu", "xhu", "Hurrian", nil},
+	{"", "xhv", "xhv", "Khua", nil},
+	{"", "xib", "xib", "Iberian", nil},
+	{"", "xii", "xii", "Xiri", nil},
+	{"", "xil", "xil", "Illyrian", nil},
+	{"", "xin", "xin", "Xinca", nil},
+	{"", "xir", "xir", "Xiriâna", nil},
+	{"", "xis", "xis", "Kisan", nil},
+	{"", "xiv", "xiv", "Indus Valley Language", nil},
+	{"", "xiy", "xiy", "Xipaya", nil},
+	{"", "xjb", "xjb", "Minjungbal", nil},
+	{"", "xjt", "xjt", "Jaitmatang", nil},
+	{"", "xka", "xka", "Kalkoti", nil},
+	{"", "xkb", "xkb", "Northern Nago", nil},
+	{"", "xkc", "xkc", "Kho'ini", nil},
+	{"", "xkd", "xkd", "Mendalam Kayan", nil},
+	{"", "xke", "xke", "Kereho", nil},
+	{"", "xkf", "xkf", "Khengkha", nil},
+	{"", "xkg", "xkg", "Kagoro", nil},
+	{"", "xki", "xki", "Kenyan Sign Language", nil},
+	{"", "xkj", "xkj", "Kajali", nil},
+	{"", "xkk", "xkk", "Kachok", nil},
+	{"", "xkl", "xkl", "Mainstream Kenyah", nil},
+	{"", "xkn", "xkn", "Kayan River Kayan", nil},
+	{"", "xko", "xko", "Kiorr", nil},
+	{"", "xkp", "xkp", "Kabatei", nil},
+	{"", "xkq", "xkq", "Koroni", nil},
+	{"", "xkr", "xkr", "Xakriabá", nil},
+	{"", "xks", "xks", "Kumbewaha", nil},
+	{"", "xkt", "xkt", "Kantosi", nil},
+	{"", "xku", "xku", "Kaamba", nil},
+	{"", "xkv", "xkv", "Kgalagadi", nil},
+	{"", "xkw", "xkw", "Kembra", nil},
+	{"", "xkx", "xkx", "Karore", nil},
+	{"", "xky", "xky", "Uma' Lasan", nil},
+	{"", "xkz", "xkz", "Kurtokha", nil},
+	{"", "xla", "xla", "Kamula", nil},
+	{"", "xlb", "xlb", "Loup B", nil},
+	{"", "xlc", "xlc", "Lycian", nil},
+	{"", "xld", "xld", "Lydian", nil},
+	{"", "xle", "xle", "Lemnian", nil},
+	{"", "xlg", "xlg", "Ligurian (Ancient)", nil},
+	{"", "xli", "xli", "Liburnian", nil},
+	{"", "xln", "xln", "Alanic", nil},
+	{"", "xlo", "xlo", "Loup A", nil},
+	{"", "xlp", "xlp", "Lepontic", nil},
+	{"", "xls", "xls", "Lusitanian", nil},
+	{"", "xlu", "xlu", "Cuneiform Luwian", nil},
+	{"", "xly", "xly", "Elymian", nil},
+	{"", "xma", "xma", "Mushungulu", nil},
+	{"", "xmb", "xmb", "Mbonga", nil},
+	{"", "xmc", "xmc", "Makhuwa-Marrevone", nil},
+	{"", "xmd", "xmd", "Mbudum", nil},
+	{"", "xme", "xme", "Median", nil},
+	{"", "xmf", "xmf", "Mingrelian", nil},
+	{"", "xmg", "xmg", "Mengaka", nil},
+	{"", "xmh", "xmh", "Kugu-Muminh", nil},
+	{"", "xmj", "xmj", "Majera", nil},
+	{"", "xmk", "xmk", "Ancient Macedonian", nil},
+	{"", "xml", "xml", "Malaysian Sign Language", nil},
+	{"", "xmm", "xmm", "Manado Malay", nil},
+	{"", "xmn", "xmn", "Manichaean Middle Persian", nil},
+	{"", "xmo", "xmo", "Morerebi", nil},
+	{"", "xmp", "xmp", "Kuku-Mu'inh", nil},
+	{"", "xmq", "xmq", "Kuku-Mangk", nil},
+	{"", "xmr", "xmr", "Meroitic", nil},
+	{"", "xms", "xms", "Moroccan Sign Language", nil},
+	{"", "xmt", "xmt", "Matbat", nil},
+	{"", "xmu", "xmu", "Kamu", nil},
+	{"", "xmv", "xmv", "Antankarana Malagasy", nil},
+	{"", "xmw", "xmw", "Tsimihety Malagasy", nil},
+	{"", "xmx", "xmx", "Salawati", nil},
+	{"", "xmy", "xmy", "Mayaguduna", nil},
+	{"", "xmz", "xmz", "Mori Bawah", nil},
+	{"", "xna", "xna", "Ancient North Arabian", nil},
+	{"", "xnb", "xnb", "Kanakanabu", nil},
+	{"", "xng", "xng", "Middle Mongolian", nil},
+	{"", "xnh", "xnh", "Kuanhua", nil},
+	{"", "xni", "xni", "Ngarigu", nil},
+	{"", "xnj", "xnj", "Ngoni (Tanzania)", nil},
+	{"", "xnk", "xnk", "Nganakarti", nil},
+	{"", "xnm", "xnm", "Ngumbarl", nil},
+	{"", "xnn", "xnn", "Northern Kankanay", nil},
+	{"", "xno", "xno", "Anglo-Norman", nil},
+	{"", "xnq", "xnq", "Ngoni (Mozambique)", nil},
+	{"", "xnr", "xnr", "Kangri", nil},
+	{"", "xns", "xns", "Kanashi", nil},
+	{"", "xnt", "xnt", "Narragansett", nil},
+	{"", "xnu", "xnu", "Nukunul", nil},
+	{"", "xny", "xny", "Nyiyaparli", nil},
+	{"", "xnz", "xnz", "Kenzi", nil},
+	{"", "xoc", "xoc", "O'chi'chi'", nil},
+	{"", "xod", "xod", "Kokoda", nil},
+	{"", "xog", "xog", "Soga", nil},
+	{"", "xoi", "xoi", "Kominimung", nil},
+	{"", "xok", "xok", "Xokleng", nil},
+	{"", "xom", "xom", "Komo (Sudan)", nil},
+	{"", "xon", "xon", "Konkomba", nil},
+	{"", "xoo", "xoo", "Xukurú", nil},
+	{"", "xop", "xop", "Kopar", nil},
+	{"", "xor", "xor", "Korubo", nil},
+	{"", "xow", "xow", "Kowaki", nil},
+	{"", "xpa", "xpa", "Pirriya", nil},
+	{"", "xpb", "xpb", "Northeastern Tasmanian", nil},
+	{"", "xpc", "xpc", "Pecheneg", nil},
+	{"", "xpd", "xpd", "Oyster Bay Tasmanian", nil},
+	{"", "xpe", "xpe", "Liberia Kpelle", nil},
+	{"", "xpf", "xpf", "Southeast Tasmanian", nil},
+	{"", "xpg", "xpg", "Phrygian", nil},
+	{"", "xph", "xph", "North Midlands Tasmanian", nil},
+	{"", "xpi", "xpi", "Pictish", nil},
+	{"", "xpj", "xpj", "Mpalitjanh", nil},
+	{"", "xpk", "xpk", "Kulina Pano", nil},
+	{"", "xpl", "xpl", "Port Sorell Tasmanian", nil},
+	{"", "xpm", "xpm", "Pumpokol", nil},
+	{"", "xpn", "xpn", "Kapinawá", nil},
+	{"", "xpo", "xpo", "Pochutec", nil},
+	{"", "xpp", "xpp", "Puyo-Paekche", nil},
+	{"", "xpq", "xpq", "Mohegan-Pequot", nil},
+	{"", "xpr", "xpr", "Parthian", nil},
+	{"", "xps", "xps", "Pisidian", nil},
+	{"", "xpt", "xpt", "Punthamara", nil},
+	{"", "xpu", "xpu", "Punic", nil},
+	{"", "xpv", "xpv", "Northern Tasmanian", nil},
+	{"", "xpw", "xpw", "Northwestern Tasmanian", nil},
+	{"", "xpx", "xpx", "Southwestern Tasmanian", nil},
+	{"", "xpy", "xpy", "Puyo", nil},
+	{"", "xpz", "xpz", "Bruny Island Tasmanian", nil},
+	{"", "xqa", "xqa", "Karakhanid", nil},
+	{"", "xqt", "xqt", "Qatabanian", nil},
+	{"", "xra", "xra", "Krahô", nil},
+	{"", "xrb", "xrb", "Eastern Karaboro", nil},
+	{"", "xrd", "xrd", "Gundungurra", nil},
+	{"", "xre", "xre", "Kreye", nil},
+	{"", "xrg", "xrg", "Minang", nil},
+	{"", "xri", "xri", "Krikati-Timbira", nil},
+	{"", "xrm", "xrm", "Armazic", nil},
+	{"", "xrn", "xrn", "Arin", nil},
+	{"", "xrr", "xrr", "Raetic", nil},
+	{"", "xrt", "xrt", "Aranama-Tamique", nil},
+	{"", "xru", "xru", "Marriammu", nil},
+	{"", "xrw", "xrw", "Karawa", nil},
+	{"", "xsa", "xsa", "Sabaean", nil},
+	{"", "xsb", "xsb", "Sambal", nil},
+	{"", "xsc", "xsc", "Scythian", nil},
+	{"", "xsd", "xsd", "Sidetic", nil},
+	{"", "xse", "xse", "Sempan", nil},
+	{"", "xsh", "xsh", "Shamang", nil},
+	{"", "xsi", "xsi", "Sio", nil},
+	{"", "xsj", "xsj", "Subi", nil},
+	{"", "xsl", "xsl", "South Slavey", nil},
+	{"", "xsm", "xsm", "Kasem", nil},
+	{"", "xsn", "xsn", "Sanga (Nigeria)", nil},
+	{"", "xso", "xso", "Solano", nil},
+	{"", "xsp", "xsp", "Silopi", nil},
+	{"", "xsq", "xsq", "Makhuwa-Saka", nil},
+	{"", "xsr", "xsr", "Sherpa", nil},
+	{"", "xss", "xss", "Assan", nil},
+	{"", "xsu", "xsu", "Sanumá", nil},
+	{"", "xsv", "xsv", "Sudovian", nil},
+	{"", "xsy", "xsy", "Saisiyat", nil},
+	{"", "xta", "xta", "Alcozauca Mixtec", nil},
+	{"", "xtb", "xtb", "Chazumba Mixtec", nil},
+	{"", "xtc", "xtc", "Katcha-Kadugli-Miri", nil},
+	{"", "xtd", "xtd", "Diuxi-Tilantongo Mixtec", nil},
+	{"", "xte", "xte", "Ketengban", nil},
+	{"", "xtg", "xtg", "Transalpine Gaulish", nil},
+	{"", "xth", "xth", "Yitha Yitha", nil},
+	{"", "xti", "xti", "Sinicahua Mixtec", nil},
+	{"", "xtj", "xtj", "San Juan Teita Mixtec", nil},
+	{"", "xtl", "xtl", "Tijaltepec Mixtec", nil},
+	{"", "xtm", "xtm", "Magdalena Peñasco Mixtec", nil},
+	{"", "xtn", "xtn", "Northern Tlaxiaco Mixtec", nil},
+	{"", "xto", "xto", "Tokharian A", nil},
+	{"", "xtp", "xtp", "San Miguel Piedras Mixtec", nil},
+	{"", "xtq", "xtq", "Tumshuqese", nil},
+	{"", "xtr", "xtr", "Early Tripuri", nil},
+	{"", "xts", "xts", "Sindihui Mixtec", nil},
+	{"", "xtt", "xtt", "Tacahua Mixtec", nil},
+	{"", "xtu", "xtu", "Cuyamecalco Mixtec", nil},
+	{"", "xtv", "xtv", "Thawa", nil},
+	{"", "xtw", "xtw", "Tawandê", nil},
+	{"", "xty", "xty", "Yoloxochitl Mixtec", nil},
+	{"", "xua", "xua", "Alu Kurumba", nil},
+	{"", "xub", "xub", "Betta Kurumba", nil},
+	{"", "xud", "xud", "Umiida", nil},
+	{"", "xug", "xug", "Kunigami", nil},
+	{"", "xuj", "xuj", "Jennu Kurumba", nil},
+	{"", "xul", "xul", "Ngunawal", nil},
+	{"", "xum", "xum", "Umbrian", nil},
+	{"", "xun", "xun", "Unggaranggu", nil},
+	{"", "xuo", "xuo", "Kuo", nil},
+	{"", "xup", "xup", "Upper Umpqua", nil},
+	{"", "xur", "xur", "Urartian", nil},
+	{"", "xut", "xut", "Kuthant", nil},
+	{"", "xuu", "xuu", "Kxoe", nil},
+	{"", "xve", "xve", "Venetic", nil},
+	{"", "xvi", "xvi", "Kamviri", nil},
+	{"", "xvn", "xvn", "Vandalic", nil},
+	{"", "xvo", "xvo", "Volscian", nil},
+	{"", "xvs", "xvs", "Vestinian", nil},
+	{"", "xwa", "xwa", "Kwaza", nil},
+	{"", "xwc", "xwc", "Woccon", nil},
+	{"", "xwd", "xwd", "Wadi Wadi", nil},
+	{"", "xwe", "xwe", "Xwela Gbe", nil},
+	{"", "xwg", "xwg", "Kwegu", nil},
+	{"", "xwj", "xwj", "Wajuk", nil},
+	{"", "xwk", "xwk", "Wangkumara", nil},
+	{"", "xwl", "xwl", "Western Xwla Gbe", nil},
+	{"", "xwo", "xwo", "Written Oirat", nil},
+	{"", "xwr", "xwr", "Kwerba Mamberamo", nil},
+	{"", "xwt", "xwt", "Wotjobaluk", nil},
+	{"", "xww", "xww", "Wemba Wemba", nil},
+	{"", "xxb", "xxb", "Boro (Ghana)", nil},
+	{"", "xxk", "xxk", "Ke'o", nil},
+	{"", "xxm", "xxm", "Minkin", nil},
+	{"", "xxr", "xxr", "Koropó", nil},
+	{"", "xxt", "xxt", "Tambora", nil},
+	{"", "xya", "xya", "Yaygir", nil},
+	{"", "xyb", "xyb", "Yandjibara", nil},
+	{"", "xyj", "xyj", "Mayi-Yapi", nil},
+	{"", "xyk", "xyk", "Mayi-Kulan", nil},
+	{"", "xyl", "xyl", "Yalakalore", nil},
+	{"", "xyt", "xyt", "Mayi-Thakurti", nil},
+	{"", "xyy", "xyy", "Yorta Yorta", nil},
+	{"", "xzh", "xzh", "Zhang-Zhung", nil},
+	{"", "xzm", "xzm", "Zemgalian", nil},
+	{"", "xzp", "xzp", "Ancient Zapotec", nil},
+	{"", "yaa", "yaa", "Yaminahua", nil},
+	{"", "yab", "yab", "Yuhup", nil},
+	{"", "yac", "yac", "Pass Valley Yali", nil},
+	{"", "yad", "yad", "Yagua", nil},
+	{"", "yae", "yae", "Pumé", nil},
+	{"", "yaf", "yaf", "Yaka (Democratic Republic of Congo)", nil},
+	{"", "yag", "yag", "Yámana", nil},
+	{"", "yah", "yah", "Yazgulyam", nil},
+	{"", "yai", "yai", "Yagnobi", nil},
+	{"", "yaj", "yaj", "Banda-Yangere", nil},
+	{"", "yak", "yak", "Yakama", nil},
+	{"", "yal", "yal", "Yalunka", nil},
+	{"", "yam", "yam", "Yamba", nil},
+	{"", "yan", "yan", "Mayangna", nil},
+	{"", "yao", "yao", "Yao", nil},
+	{"", "yap", "yap", "Yapese", nil},
+	{"", "yaq", "yaq", "Yaqui", nil},
+	{"", "yar", "yar", "Yabarana", nil},
+	{"", "yas", "yas", "Nugunu (Cameroon)", nil},
+	{"", "yat", "yat", "Yambeta", nil},
+	{"", "yau", "yau", "Yuwana", nil},
+	{"", "yav", "yav", "Yangben", nil},
+	{"", "yaw", "yaw", "Yawalapití", nil},
+	{"", "yax", "yax", "Yauma", nil},
+	{"", "yay", "yay", "Agwagwune", nil},
+	{"", "yaz", "yaz", "Lokaa", nil},
+	{"", "yba", "yba", "Yala", nil},
+	{"", "ybb", "ybb", "Yemba", nil},
+	{"", "ybe", "ybe", "West Yugur", nil},
+	{"", "ybh", "ybh", "Yakha", nil},
+	{"", "ybi", "ybi", "Yamphu", nil},
+	{"", "ybj", "ybj", "Hasha", nil},
+	{"", "ybk", "ybk", "Bokha", nil},
+	{"", "ybl", "ybl", "Yukuben", nil},
+	{"", "ybm", "ybm", "Yaben", nil},
+	{"", "ybn", "ybn", "Yabaâna", nil},
+	{"", "ybo", "ybo", "Yabong", nil},
+	{"", "ybx", "ybx", "Yawiyo", nil},
+	{"", "yby", "yby", "Yaweyuha", nil},
+	{"", "ych", "ych", "Chesu", nil},
+	{"", "ycl", "ycl", "Lolopo", nil},
+	{"", "ycn", "ycn", "Yucuna", nil},
+	{"", "ycp", "ycp", "Chepya", nil},
+	{"", "yda", "yda", "Yanda", nil},
+	{"", "ydd", "ydd", "Eastern Yiddish", nil},
+	{"", "yde", "yde", "Yangum Dey", nil},
+	{"", "ydg", "ydg", "Yidgha", nil},
+	{"", "ydk", "ydk", "Yoidik", nil},
+	{"", "yea", "yea", "Ravula", nil},
+	{"", "yec", "yec", "Yeniche", nil},
+	{"", "yee", "yee", "Yimas", nil},
+	{"", "yei", "yei", "Yeni", nil},
+	{"", "yej", "yej", "Yevanic", nil},
+	{"", "yel", "yel", "Yela", nil},
+	{"", "yer", "yer", "Tarok", nil},
+	{"", "yes", "yes", "Nyankpa", nil},
+	{"", "yet", "yet", "Yetfa", nil},
+	{"", "yeu", "yeu", "Yerukula", nil},
+	{"", "yev", "yev", "Yapunda", nil},
+	{"", "yey", "yey", "Yeyi", nil},
+	{"", "yga", "yga", "Malyangapa", nil},
+	{"", "ygi", "ygi", "Yiningayi", nil},
+	{"", "ygl", "ygl", "Yangum Gel", nil},
+	{"", "ygm", "ygm", "Yagomi", nil},
+	{"", "ygp", "ygp", "Gepo", nil},
+	{"", "ygr", "ygr", "Yagaria", nil},
+	{"", "ygs", "ygs", "Yolŋu Sign Language", nil},
+	{"", "ygu", "ygu", "Yugul", nil},
+	{"", "ygw", "ygw", "Yagwoia", nil},
+	{"", "yha", "yha", "Baha Buyang", nil},
+	{"", "yhd", "yhd", "Judeo-Iraqi Arabic", nil},
+	{"", "yhl", "yhl", "Hlepho Phowa", nil},
+	{"", "yhs", "yhs", "Yan-nhaŋu Sign Language", nil},
+	{"", "yia", "yia", "Yinggarda", nil},
+	{"yi", "yid", "yid", "Yiddish", nil},
+	{"", "yif", "yif", "Ache", nil},
+	{"", "yig", "yig", "Wusa Nasu", nil},
+	{"", "yih", "yih", "Western Yiddish", nil},
+	{"", "yii", "yii", "Yidiny", nil},
+	{"", "yij", "yij", "Yindjibarndi", nil},
+	{"", "yik", "yik", "Dongshanba Lalo", nil},
+	{"", "yil", "yil", "Yindjilandji", nil},
+	{"", "yim", "yim", "Yimchungru Naga", nil},
+	{"", "yin", "yin", "Riang Lai", nil},
+	{"", "yip", "yip", "Pholo", nil},
+	{"", "yiq", "yiq", "Miqie", nil},
+	{"", "yir", "yir", "North Awyu", nil},
+	{"", "yis", "yis", "Yis", nil},
+	{"", "yit", "yit", "Eastern Lalu", nil},
+	{"", "yiu", "yiu", "Awu", nil},
+	{"", "yiv", "yiv", "Northern Nisu", nil},
+	{"", "yix", "yix", "Axi Yi", nil},
+	{"", "yiz", "yiz", "Azhe", nil},
+	{"", "yka", "yka", "Yakan", nil},
+	{"", "ykg", "ykg", "Northern Yukaghir", nil},
+	{"", "yki", "yki", "Yoke", nil},
+	{"", "ykk", "ykk", "Yakaikeke", nil},
+	{"", "ykl", "ykl", "Khlula", nil},
+	{"", "ykm", "ykm", "Kap", nil},
+	{"", "ykn", "ykn", "Kua-nsi", nil},
+	{"", "yko", "yko", "Yasa", nil},
+	{"", "ykr", "ykr", "Yekora", nil},
+	{"", "ykt", "ykt", "Kathu", nil},
+	{"", "yku", "yku", "Kuamasi", nil},
+	{"", "yky", "yky", "Yakoma", nil},
+	{"", "yla", "yla", "Yaul", nil},
+	{"", "ylb", "ylb", "Yaleba", nil},
+	{"", "yle", "yle", "Yele", nil},
+	{"", "ylg", "ylg", "Yelogu", nil},
+	{"", "yli", "yli", "Angguruk Yali", nil},
+	{"", "yll", "yll", "Yil", nil},
+	{"", "ylm", "ylm", "Limi", nil},
+	{"", "yln", "yln", "Langnian Buyang", nil},
+	{"", "ylo", "ylo", "Naluo Yi", nil},
+	{"", "ylr", "ylr", "Yalarnnga", nil},
+	{"", "ylu", "ylu", "Aribwaung", nil},
+	{"", "yly", "yly", "Nyâlayu", nil},
+	{"", "ymb", "ymb", "Yambes", nil},
+	{"", "ymc", "ymc", "Southern Muji", nil},
+	{"", "ymd", "ymd", "Muda", nil},
+	{"", "yme", "yme", "Yameo", nil},
+	{"", "ymg", "ymg", "Yamongeri", nil},
+	{"", "ymh", "ymh", "Mili", nil},
+	{"", "ymi", "ymi", "Moji", nil},
+	{"", "ymk", "ymk", "Makwe", nil},
+	{"", "yml", "yml", "Iamalele", nil},
+	{"", "ymm", "ymm", "Maay", nil},
+	{"", "ymn", "ymn", "Yamna", nil},
+	{"", "ymo", "ymo", "Yangum Mon", nil},
+	{"", "ymp", "ymp", "Yamap", nil},
+	{"", "ymq", "ymq", "Qila Muji", nil},
+	{"", "ymr", "ymr", "Malasar", nil},
+	{"", "yms", "yms", "Mysian", nil},
+	{"", "ymx", "ymx", "Northern Muji", nil},
+	{"", "ymz", "ymz", "Muzi", nil},
+	{"", "yna", "yna", "Aluo", nil},
+	{"", "ynd", "ynd", "Yandruwandha", nil},
+	{"", "yne", "yne", "Lang'e", nil},
+	{"", "yng", "yng", "Yango", nil},
+	{"", "ynk", "ynk", "Naukan Yupik", nil},
+	{"", "ynl", "ynl", "Yangulam", nil},
+	{"", "ynn", "ynn", "Yana", nil},
+	{"", "yno", "yno", "Yong", nil},
+	{"", "ynq", "ynq", "Yendang", nil},
+	{"", "yns", "yns", "Yansi", nil},
+	{"", "ynu", "ynu", "Yahuna", nil},
+	{"", "yob", "yob", "Yoba", nil},
+	{"", "yog", "yog", "Yogad", nil},
+	{"", "yoi", "yoi", "Yonaguni", nil},
+	{"", "yok", "yok", "Yokuts", nil},
+	{"", "yol", "yol", "Yola", nil},
+	{"", "yom", "yom", "Yombe", nil},
+	{"", "yon", "yon", "Yongkom", nil},
+	{"yo", "yor", "yor", "Yoruba", nil},
+	{"", "yot", "yot", "Yotti", nil},
+	{"", "yox", "yox", "Yoron", nil},
+	{"", "yoy", "yoy", "Yoy", nil},
+	{"", "ypa", "ypa", "Phala", nil},
+	{"", "ypb", "ypb", "Labo Phowa", nil},
+	{"", "ypg", "ypg", "Phola", nil},
+	{"", "yph", "yph", "Phupha", nil},
+	{"", "ypk", "ypk", "Yupik languages", nil},
+	{"", "ypm", "ypm", "Phuma", nil},
+	{"", "ypn", "ypn", "Ani Phowa", nil},
+	{"", "ypo", "ypo", "Alo Phola", nil},
+	{"", "ypp", "ypp", "Phupa", nil},
+	{"", "ypz", "ypz", "Phuza", nil},
+	{"", "yra", "yra", "Yerakai", nil},
+	{"", "yrb", "yrb", "Yareba", nil},
+	{"", "yre", "yre", "Yaouré", nil},
+	{"", "yrk", "yrk", "Nenets", nil},
+	{"", "yrl", "yrl", "Nhengatu", nil},
+	{"", "yrm", "yrm", "Yirrk-Mel", nil},
+	{"", "yrn", "yrn", "Yerong", nil},
+	{"", "yro", "yro", "Yaroamë", nil},
+	{"", "yrs", "yrs", "Yarsun", nil},
+	{"", "yrw", "yrw", "Yarawata", nil},
+	{"", "yry", "yry", "Yarluyandi", nil},
+	{"", "ysc", "ysc", "Yassic", nil},
+	{"", "ysd", "ysd", "Samatao", nil},
+	{"", "ysg", "ysg", "Sonaga", nil},
+	{"", "ysl", "ysl", "Yugoslavian Sign Language", nil},
+	{"", "ysm", "ysm", "Myanmar Sign Language", nil},
+	{"", "ysn", "ysn", "Sani", nil},
+	{"", "yso", "yso", "Nisi (China)", nil},
+	{"", "ysp", "ysp", "Southern Lolopo", nil},
+	{"", "ysr", "ysr", "Sirenik Yupik", nil},
+	{"", "yss", "yss", "Yessan-Mayo", nil},
+	{"", "ysy", "ysy", "Sanie", nil},
+	{"", "yta", "yta", "Talu", nil},
+	{"", "ytl", "ytl", "Tanglang", nil},
+	{"", "ytp", "ytp", "Thopho", nil},
+	{"", "ytw", "ytw", "Yout Wam", nil},
+	{"", "yty", "yty", "Yatay", nil},
+	{"", "yua", "yua", "Yucateco", nil},
+	{"", "yub", "yub", "Yugambal", nil},
+	{"", "yuc", "yuc", "Yuchi", nil},
+	{"", "yud", "yud", "Judeo-Tripolitanian Arabic", nil},
+	{"", "yue", "yue", "Yue Chinese", nil},
+	{"", "yuf", "yuf", "Havasupai-Walapai-Yavapai", nil},
+	{"", "yug", "yug", "Yug", nil},
+	{"", "yui", "yui", "Yurutí", nil},
+	{"", "yuj", "yuj", "Karkar-Yuri", nil},
+	{"", "yuk", "yuk", "Yuki", nil},
+	{"", "yul", "yul", "Yulu", nil},
+	{"", "yum", "yum", "Quechan", nil},
+	{"", "yun", "yun", "Bena (Nigeria)", nil},
+	{"", "yup", "yup", "Yukpa", nil},
+	{"", "yuq", "yuq", "Yuqui", nil},
+	{"", "yur", "yur", "Yurok", nil},
+	{"", "yut", "yut", "Yopno", nil},
+	{"", "yuw", "yuw", "Yau (Morobe Province)", nil},
+	{"", "yux", "yux", "Southern Yukaghir", nil},
+	{"", "yuy", "yuy", "East Yugur", nil},
+	{"", "yuz", "yuz", "Yuracare", nil},
+	{"", "yva", "yva", "Yawa", nil},
+	{"", "yvt", "yvt", "Yavitero", nil},
+	{"", "ywa", "ywa", "Kalou", nil},
+	{"", "ywg", "ywg", "Yinhawangka", nil},
+	{"", "ywl", "ywl", "Western Lalu", nil},
+	{"", "ywn", "ywn", "Yawanawa", nil},
+	{"", "ywq", "ywq", "Wuding-Luquan Yi", nil},
+	{"", "ywr", "ywr", "Yawuru", nil},
+	{"", "ywt", "ywt", "Xishanba Lalo", nil},
+	{"", "ywu", "ywu", "Wumeng Nasu", nil},
+	{"", "yww", "yww", "Yawarawarga", nil},
+	{"", "yxa", "yxa", "Mayawali", nil},
+	{"", "yxg", "yxg", "Yagara", nil},
+	{"", "yxl", "yxl", "Yardliyawarra", nil},
+	{"", "yxm", "yxm", "Yinwum", nil},
+	{"", "yxu", "yxu", "Yuyu", nil},
+	{"", "yxy", "yxy", "Yabula Yabula", nil},
+	{"", "yyr", "yyr", "Yir Yoront", nil},
+	{"", "yyu", "yyu", "Yau (Sandaun Province)", nil},
+	{"", "yyz", "yyz", "Ayizi", nil},
+	{"", "yzg", "yzg", "E'ma Buyang", nil},
+	{"", "yzk", "yzk", "Zokhuo", nil},
+	{"", "zaa", "zaa", "Sierra de Juárez Zapotec", nil},
+	{"", "zab", "zab", "Western Tlacolula Valley Zapotec", nil},
+	{"", "zac", "zac", "Ocotlán Zapotec", nil},
+	{"", "zad", "zad", "Cajonos Zapotec", nil},
+	{"", "zae", "zae", "Yareni Zapotec", nil},
+	{"", "zaf", "zaf", "Ayoquesco Zapotec", nil},
+	{"", "zag", "zag", "Zaghawa", nil},
+	{"", "zah", "zah", "Zangwal", nil},
+	{"", "zai", "zai", "Isthmus Zapotec", nil},
+	{"", "zaj", "zaj", "Zaramo", nil},
+	{"", "zak", "zak", "Zanaki", nil},
+	{"", "zal", "zal", "Zauzou", nil},
+	{"", "zam", "zam", "Miahuatlán Zapotec", nil},
+	{"", "zao", "zao", "Ozolotepec Zapotec", nil},
+	{"", "zap", "zap", "Zapotec", nil},
+	{"", "zaq", "zaq", "Aloápam Zapotec", nil},
+	{"", "zar", "zar", "Rincón Zapotec", nil},
+	{"", "zas", "zas", "Santo Domingo Albarradas Zapotec", nil},
+	{"", "zat", "zat", "Tabaa Zapotec", nil},
+	{"", "zau", "zau", "Zangskari", nil},
+	{"", "zav", "zav", "Yatzachi Zapotec", nil},
+	{"", "zaw", "zaw", "Mitla Zapotec", nil},
+	{"", "zax", "zax", "Xadani Zapotec", nil},
+	{"", "zay", "zay", "Zayse-Zergulla", nil},
+	{"", "zaz", "zaz", "Zari", nil},
+	{"", "zba", "zba", "Balaibalan", nil},
+	{"", "zbc", "zbc", "Central Berawan", nil},
+	{"", "zbe", "zbe", "East Berawan", nil},
+	{"", "zbl", "zbl", "Blissymbols; Blissymbolics; Bliss", nil},
+	{"", "zbt", "zbt", "Batui", nil},
+	{"", "zbu", "zbu", "Bu (Bauchi State)", nil},
+	{"", "zbw", "zbw", "West Berawan", nil},
+	{"", "zca", "zca", "Coatecas Altas Zapotec", nil},
+	{"", "zcd", "zcd", "Las Delicias Zapotec", nil},
+	{"", "zch", "zch", "Central Hongshuihe Zhuang", nil},
+	{"", "zdj", "zdj", "Ngazidja Comorian", nil},
+	{"", "zea", "zea", "Zeeuws", nil},
+	{"", "zeg", "zeg", "Zenag", nil},
+	{"", "zeh", "zeh", "Eastern Hongshuihe Zhuang", nil},
+	{"", "zen", "zen", "Zenaga", nil},
+	{"", "zga", "zga", "Kinga", nil},
+	{"", "zgb", "zgb", "Guibei Zhuang", nil},
+	{"", "zgh", "zgh", "Standard Moroccan Tamazight", nil},
+	{"", "zgm", "zgm", "Minz Zhuang", nil},
+	{"", "zgn", "zgn", "Guibian Zhuang", nil},
+	{"", "zgr", "zgr", "Magori", nil},
+	{"za", "zha", "zha", "Zhuang; Chuang", nil},
+	{"", "zhb", "zhb", "Zhaba", nil},
+	{"", "zhd", "zhd", "Dai Zhuang", nil},
+	{"", "zhi", "zhi", "Zhire", nil},
+	{"", "zhn", "zhn", "Nong Zhuang", nil},
+	{"zh", "chi", "zho", "Chinese", nil},
+	{"", "zhw", "zhw", "Zhoa", nil},
+	{"", "zia", "zia", "Zia", nil},
+	{"", "zib", "zib", "Zimbabwe Sign Language", nil},
+	{"", "zik", "zik", "Zimakani", nil},
+	{"", "zil", "zil", "Zialo", nil},
+	{"", "zim", "zim", "Mesme", nil},
+	{"", "zin", "zin", "Zinza", nil},
+	{"", "ziw", "ziw", "Zigula", nil},
+	{"", "ziz", "ziz", "Zizilivakan", nil},
+	{"", "zka", "zka", "Kaimbulawa", nil},
+	{"", "zkb", "zkb", "Koibal", nil},
+	{"", "zkd", "zkd", "Kadu", nil},
+	{"", "zkg", "zkg", "Koguryo", nil},
+	{"", "zkh", "zkh", "Khorezmian", nil},
+	{"", "zkk", "zkk", "Karankawa", nil},
+	{"", "zkn", "zkn", "Kanan", nil},
+	{"", "zko", "zko", "Kott", nil},
+	{"", "zkp", "zkp", "São Paulo Kaingáng", nil},
+	{"", "zkr", "zkr", "Zakhring", nil},
+	{"", "zkt", "zkt", "Kitan", nil},
+	{"", "zku", "zku", "Kaurna", nil},
+	{"", "zkv", "zkv", "Krevinian", nil},
+	{"", "zkz", "zkz", "Khazar", nil},
+	{"", "zla", "zla", "Zula", nil},
+	{"", "zlj", "zlj", "Liujiang Zhuang", nil},
+	{"", "zlm", "zlm", "Malay (individual language)", nil},
+	{"", "zln", "zln", "Lianshan Zhuang", nil},
+	{"", "zlq", "zlq", "Liuqian Zhuang", nil},
+	{"", "zma", "zma", "Manda (Australia)", nil},
+	{"", "zmb", "zmb", "Zimba", nil},
+	{"", "zmc", "zmc", "Margany", nil},
+	{"", "zmd", "zmd", "Maridan", nil},
+	{"", "zme", "zme", "Mangerr", nil},
+	{"", "zmf", "zmf", "Mfinu", nil},
+	{"", "zmg", "zmg", "Marti Ke", nil},
+	{"", "zmh", "zmh", "Makolkol", nil},
+	{"", "zmi", "zmi", "Negeri Sembilan Malay", nil},
+	{"", "zmj", "zmj", "Maridjabin", nil},
+	{"", "zmk", "zmk", "Mandandanyi", nil},
+	{"", "zml", "zml", "Matngala", nil},
+	{"", "zmm", "zmm", "Marimanindji", nil},
+	{"", "zmn", "zmn", "Mbangwe", nil},
+	{"", "zmo", "zmo", "Molo", nil},
+	{"", "zmp", "zmp", "Mpuono", nil},
+	{"", "zmq", "zmq", "Mituku", nil},
+	{"", "zmr", "zmr", "Maranunggu", nil},
+	{"", "zms", "zms", "Mbesa", nil},
+	{"", "zmt", "zmt", "Maringarr", nil},
+	{"", "zmu", "zmu", "Muruwari", nil},
+	{"", "zmv", "zmv", "Mbariman-Gudhinma", nil},
+	{"", "zmw", "zmw", "Mbo (Democratic Republic of Congo)", nil},
+	{"", "zmx", "zmx", "Bomitaba", nil},
+	{"", "zmy", "zmy", "Mariyedi", nil},
+	{"", "zmz", "zmz", "Mbandja", nil},
+	{"", "zna", "zna", "Zan Gula", nil},
+	{"", "znd", "znd", "Zande languages", nil},
+	{"", "zne", "zne", "Zande (individual language)", nil},
+	{"", "zng", "zng", "Mang", nil},
+	{"", "znk", "znk", "Manangkari", nil},
+	{"", "zns", "zns", "Mangas", nil},
+	{"", "zoc", "zoc", "Copainalá Zoque", nil},
+	{"", "zoh", "zoh", "Chimalapa Zoque", nil},
+	{"", "zom", "zom", "Zou", nil},
+	{"", "zoo", "zoo", "Asunción Mixtepec Zapotec", nil},
+	{"", "zoq", "zoq", "Tabasco Zoque", nil},
+	{"", "zor", "zor", "Rayón Zoque", nil},
+	{"", "zos", "zos", "Francisco León Zoque", nil},
+	{"", "zpa", "zpa", "Lachiguiri Zapotec", nil},
+	{"", "zpb", "zpb", "Yautepec Zapotec", nil},
+	{"", "zpc", "zpc", "Choapan Zapotec", nil},
+	{"", "zpd", "zpd", "Southeastern Ixtlán Zapotec", nil},
+	{"", "zpe", "zpe", "Petapa Zapotec", nil},
+	{"", "zpf", "zpf", "San Pedro Quiatoni Zapotec", nil},
+	{"", "zpg", "zpg", "Guevea De Humboldt Zapotec", nil},
+	{"", "zph", "zph", "Totomachapan Zapotec", nil},
+	{"", "zpi", "zpi", "Santa María Quiegolani Zapotec", nil},
+	{"", "zpj", "zpj", "Quiavicuzas Zapotec", nil},
+	{"", "zpk", "zpk", "Tlacolulita Zapotec", nil},
+	{"", "zpl", "zpl", "Lachixío Zapotec", nil},
+	{"", "zpm", "zpm", "Mixtepec Zapotec", nil},
+	{"", "zpn", "zpn", "Santa Inés Yatzechi Zapotec", nil},
+	{"", "zpo", "zpo", "Amatlán Zapotec", nil},
+	{"", "zpp", "zpp", "El Alto Zapotec", nil},
+	{"", "zpq", "zpq", "Zoogocho Zapotec", nil},
+	{"", "zpr", "zpr", "Santiago Xanica Zapotec", nil},
+	{"", "zps", "zps", "Coatlán Zapotec", nil},
+	{"", "zpt", "zpt", "San Vicente Coatlán Zapotec", nil},
+	{"", "zpu", "zpu", "Yalálag Zapotec", nil},
+	{"", "zpv", "zpv", "Chichicapan Zapotec", nil},
+	{"", "zpw", "zpw", "Zaniza Zapotec", nil},
+	{"", "zpx", "zpx", "San Baltazar Loxicha Zapotec", nil},
+	{"", "zpy", "zpy", "Mazaltepec Zapotec", nil},
+	{"", "zpz", "zpz", "Texmelucan Zapotec", nil},
+	{"", "zqe", "zqe", "Qiubei Zhuang", nil},
+	{"", "zra", "zra", "Kara (Korea)", nil},
+	{"", "zrg", "zrg", "Mirgan", nil},
+	{"", "zrn", "zrn", "Zerenkel", nil},
+	{"", "zro", "zro", "Záparo", nil},
+	{"", "zrp", "zrp", "Zarphatic", nil},
+	{"", "zrs", "zrs", "Mairasi", nil},
+	{"", "zsa", "zsa", "Sarasira", nil},
+	{"", "zsk", "zsk", "Kaskean", nil},
+	{"", "zsl", "zsl", "Zambian Sign Language", nil},
+	{"", "zsm", "zsm", "Standard Malay", nil},
+	{"", "zsr", "zsr", "Southern Rincon Zapotec", nil},
+	{"", "zsu", "zsu", "Sukurum", nil},
+	{"", "zte", "zte", "Elotepec Zapotec", nil},
+	{"", "ztg", "ztg", "Xanaguía Zapotec", nil},
+	{"", "ztl", "ztl", "Lapaguía-Guivini Zapotec", nil},
+	{"", "ztm", "ztm", "San Agustín Mixtepec Zapotec", nil},
+	{"", "ztn", "ztn", "Santa Catarina Albarradas Zapotec", nil},
+	{"", "ztp", "ztp", "Loxicha Zapotec", nil},
+	{"", "ztq", "ztq", "Quioquitani-Quierí Zapotec", nil},
+	{"", "zts", "zts", "Tilquiapan Zapotec", nil},
+	{"", "ztt", "ztt", "Tejalapan Zapotec", nil},
+	{"", "ztu", "ztu", "Güilá Zapotec", nil},
+	{"", "ztx", "ztx", "Zaachila Zapotec", nil},
+	{"", "zty", "zty", "Yatee Zapotec", nil},
+	{"", "zua", "zua", "Zeem", nil},
+	{"", "zuh", "zuh", "Tokano", nil},
+	{"zu", "zul", "zul", "Zulu", nil},
+	{"", "zum", "zum", "Kumzari", nil},
+	{"", "zun", "zun", "Zuni", nil},
+	{"", "zuy", "zuy", "Zumaya", nil},
+	{"", "zwa", "zwa", "Zay", nil},
+	{"", "zxx", "zxx", "No linguistic content; Not applicable", nil},
+	{"", "zyb", "zyb", "Yongbei Zhuang", nil},
+	{"", "zyg", "zyg", "Yang Zhuang", nil},
+	{"", "zyj", "zyj", "Youjiang Zhuang", nil},
+	{"", "zyn", "zyn", "Yongnan Zhuang", nil},
+	{"", "zyp", "zyp", "Zyphe Chin", nil},
+	{"", "zza", "zza", "Zaza; Dimili; Dimli; Kirdki; Kirmanjki; Zazaki", nil},
+	{"", "zzj", "zzj", "Zuojiang Zhuang", nil},
+}
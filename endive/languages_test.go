@@ -0,0 +1,59 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLanguage(t *testing.T) {
+	fmt.Println("+ Testing NormalizeLanguage()...")
+	assert := assert.New(t)
+	for language, expected := range map[string]string{
+		"de":          "de",
+		"de-DE":       "de",
+		"ger":         "de",
+		"deu":         "de",
+		"German":      "de",
+		"Deutsch":     "de",
+		"pt_BR":       "pt",
+		"zh-Hans-CN":  "zh",
+		"cmn":         "zh",
+		"Français":    "fr",
+		"nob":         "nb",
+		"grc":         "grc",
+		"Old English": "ang",
+		"und":         UndeterminedLanguage,
+		" EN ":        "en",
+		"":            "",
+		"xx-YY":       "xx-yy",
+		"Elvish":      "elvish",
+		"Klingon":     "tlh",
+		"Ladino":      "lad",
+		"Alsatian":    "gsw",
+		"haw":         "haw",
+		"ell":         "el",
+	} {
+		assert.Equal(expected, NormalizeLanguage(language, nil), language)
+	}
+	// overrides
+	overrides := map[string][]string{"fr-ca": {"fr-CA", "québécois"}}
+	assert.Equal("fr-ca", NormalizeLanguage("fr-CA", overrides))
+	assert.Equal("fr-ca", NormalizeLanguage("Québécois", overrides))
+	assert.Equal("fr", NormalizeLanguage("fr-FR", overrides))
+}
+
+func TestLanguageName(t *testing.T) {
+	fmt.Println("+ Testing LanguageName()...")
+	assert := assert.New(t)
+	assert.Equal("German", LanguageName("de"))
+	assert.Equal("Portuguese", LanguageName("pt-BR"))
+	assert.Equal("Ancient Greek", LanguageName("grc"))
+	assert.Equal("", LanguageName("elvish"))
+	assert.Equal("Klingon; tlhIngan-Hol", LanguageName("klingon"))
+	assert.Equal("Ghotuo", LanguageName("aaa"))
+	l, ok := LookupLanguage("fre")
+	assert.True(ok)
+	assert.Equal(Language{"fr", "fre", "fra", "French", []string{"français", "francais"}}, l)
+}
@@ -0,0 +1,69 @@
+package endive
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SavedQueryPrefix marks a saved query in search criteria: @name.
+const SavedQueryPrefix = "@"
+
+var (
+	savedQueryNameRegexp = regexp.MustCompile(`^[a-z0-9_\-]+$`)
+	savedQueryRegexp     = regexp.MustCompile(`(^|[\s+\-])` + SavedQueryPrefix + `([a-z0-9_\-]+)`)
+)
+
+// SavedQueries are search queries defined in the configuration file, by name.
+type SavedQueries map[string]string
+
+// ParseSavedQueries from the saved_queries section of the configuration file.
+func ParseSavedQueries(in interface{}) (SavedQueries, error) {
+	queries := make(SavedQueries)
+	conf, ok := in.(map[interface{}]interface{})
+	if !ok {
+		return queries, ErrorBadFormat
+	}
+	for k, v := range conf {
+		name := strings.ToLower(strings.TrimSpace(fmt.Sprint(k)))
+		if !savedQueryNameRegexp.MatchString(name) {
+			return queries, errors.New("Invalid saved query name " + name + ", expected lowercase letters, digits, - and _")
+		}
+		query, ok := v.(string)
+		if !ok || strings.TrimSpace(query) == "" {
+			return queries, errors.New("Invalid saved query " + name)
+		}
+		if savedQueryRegexp.MatchString(query) {
+			return queries, errors.New("Saved query " + name + " cannot use other saved queries")
+		}
+		queries[name] = strings.TrimSpace(query)
+	}
+	return queries, nil
+}
+
+// Expand the saved queries (@name) used in search criteria.
+func (s SavedQueries) Expand(query string) (string, error) {
+	var err error
+	expanded := savedQueryRegexp.ReplaceAllStringFunc(query, func(match string) string {
+		parts := savedQueryRegexp.FindStringSubmatch(match)
+		saved, ok := s[parts[2]]
+		if !ok {
+			err = errors.New("Unknown saved query " + SavedQueryPrefix + parts[2])
+			return match
+		}
+		return parts[1] + saved
+	})
+	return expanded, err
+}
+
+// Names of the SavedQueries, sorted.
+func (s SavedQueries) Names() []string {
+	names := []string{}
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
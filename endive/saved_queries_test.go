@@ -0,0 +1,43 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestSavedQueries(t *testing.T) {
+	fmt.Println("+ Testing SavedQueries...")
+	assert := assert.New(t)
+	conf := make(map[interface{}]interface{})
+	assert.Nil(yaml.Unmarshal([]byte("to-read: \"progress:unread +tag:shortlist\"\nCulture: series:culture\n"), &conf))
+	queries, err := ParseSavedQueries(conf)
+	assert.Nil(err)
+	assert.Equal([]string{"culture", "to-read"}, queries.Names())
+
+	expanded, err := queries.Expand("@to-read")
+	assert.Nil(err)
+	assert.Equal("progress:unread +tag:shortlist", expanded)
+	expanded, err = queries.Expand("author:banks +@culture")
+	assert.Nil(err)
+	assert.Equal("author:banks +series:culture", expanded)
+	expanded, err = queries.Expand("title:endive@home")
+	assert.Nil(err)
+	assert.Equal("title:endive@home", expanded, "not a saved query")
+	_, err = queries.Expand("@unknown")
+	assert.NotNil(err)
+
+	for _, invalid := range []string{
+		"to read: progress:unread\n",
+		"empty: \"\"\n",
+		"nested: \"@culture\"\n",
+		"list: [a, b]\n",
+	} {
+		conf := make(map[interface{}]interface{})
+		assert.Nil(yaml.Unmarshal([]byte(invalid), &conf))
+		_, err := ParseSavedQueries(conf)
+		assert.NotNil(err, invalid)
+	}
+}
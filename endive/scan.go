@@ -16,6 +16,8 @@ type EpubCandidate struct {
 	Hash               string
 	Imported           bool
 	ImportedButMissing bool
+	// ID of a Book with an epub flagged for replacement, that this candidate may replace
+	Replaces int
 }
 
 // String representation for EpubCandidate
@@ -0,0 +1,93 @@
+package endive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TagSeparator separates the levels of hierarchical tags, as in fiction/sf/space-opera.
+const TagSeparator = "/"
+
+// Subject code authorities that tags can be mapped to.
+const (
+	AuthorityBISAC = "BISAC"
+	AuthorityThema = "THEMA"
+)
+
+// TagHierarchy is a list of tag paths, such as fiction/sf/space-opera.
+type TagHierarchy []string
+
+// Path of a tag in the hierarchy.
+// Tags that are a level of a known path are replaced by the path up to that level.
+func (th TagHierarchy) Path(tag string) string {
+	if strings.Contains(tag, TagSeparator) {
+		return tag
+	}
+	for _, path := range th {
+		levels := strings.Split(path, TagSeparator)
+		for j, level := range levels {
+			if level == tag {
+				return strings.Join(levels[:j+1], TagSeparator)
+			}
+		}
+	}
+	return tag
+}
+
+// TagAncestors returns all levels of a tag path, from the root to the tag itself.
+func TagAncestors(tag string) []string {
+	ancestors := []string{}
+	levels := strings.Split(tag, TagSeparator)
+	for j := range levels {
+		ancestors = append(ancestors, strings.Join(levels[:j+1], TagSeparator))
+	}
+	return ancestors
+}
+
+// SubjectCode is a standard subject classification, such as BISAC:FIC028130.
+type SubjectCode struct {
+	Authority string
+	Code      string
+}
+
+// String representation of a SubjectCode.
+func (s SubjectCode) String() string {
+	return s.Authority + ":" + s.Code
+}
+
+// TagSubjects map tag paths to subject codes.
+type TagSubjects map[string]SubjectCode
+
+// ParseTagSubjects from the tag_subjects section of the configuration file.
+func ParseTagSubjects(in interface{}) (TagSubjects, error) {
+	subjects := make(TagSubjects)
+	conf, ok := in.(map[interface{}]interface{})
+	if !ok {
+		return subjects, ErrorBadFormat
+	}
+	for k, v := range conf {
+		tag := strings.ToLower(fmt.Sprint(k))
+		parts := strings.SplitN(fmt.Sprint(v), ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+			return subjects, errors.New("Invalid subject code for tag " + tag + ", expected authority:code")
+		}
+		authority := strings.ToUpper(strings.TrimSpace(parts[0]))
+		if authority != AuthorityBISAC && authority != AuthorityThema {
+			return subjects, errors.New("Unknown subject authority " + parts[0] + " for tag " + tag)
+		}
+		subjects[tag] = SubjectCode{Authority: authority, Code: strings.TrimSpace(parts[1])}
+	}
+	return subjects, nil
+}
+
+// Code for a tag, or for its closest ancestor.
+func (ts TagSubjects) Code(tag string) (SubjectCode, bool) {
+	ancestors := TagAncestors(tag)
+	for j := len(ancestors) - 1; j >= 0; j-- {
+		if code, ok := ts[ancestors[j]]; ok {
+			return code, true
+		}
+	}
+	return SubjectCode{}, false
+}
@@ -0,0 +1,69 @@
+package endive
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestTagHierarchy(t *testing.T) {
+	fmt.Println("+ Testing TagHierarchy...")
+	assert := assert.New(t)
+	th := TagHierarchy{"fiction/sf/space-opera", "fiction/fantasy", "nonfiction/history"}
+	for tag, expected := range map[string]string{
+		"space-opera":    "fiction/sf/space-opera",
+		"sf":             "fiction/sf",
+		"fiction":        "fiction",
+		"history":        "nonfiction/history",
+		"poetry":         "poetry",
+		"fiction/horror": "fiction/horror",
+	} {
+		assert.Equal(expected, th.Path(tag), tag)
+	}
+	assert.Equal([]string{"fiction", "fiction/sf", "fiction/sf/space-opera"}, TagAncestors("fiction/sf/space-opera"))
+	assert.Equal([]string{"poetry"}, TagAncestors("poetry"))
+}
+
+func TestTagSubjects(t *testing.T) {
+	fmt.Println("+ Testing TagSubjects...")
+	assert := assert.New(t)
+	conf := make(map[interface{}]interface{})
+	assert.Nil(yaml.Unmarshal([]byte("fiction/sf: bisac:FIC028000\nfiction/sf/space-opera: BISAC:FIC028130\nfiction/fantasy: Thema:FM"), &conf))
+	subjects, err := ParseTagSubjects(conf)
+	assert.Nil(err)
+	code, ok := subjects.Code("fiction/sf/space-opera")
+	assert.True(ok)
+	assert.Equal("BISAC:FIC028130", code.String())
+	// closest ancestor
+	code, ok = subjects.Code("fiction/sf/cyberpunk")
+	assert.True(ok)
+	assert.Equal(SubjectCode{Authority: AuthorityBISAC, Code: "FIC028000"}, code)
+	code, ok = subjects.Code("fiction/fantasy/urban")
+	assert.True(ok)
+	assert.Equal(AuthorityThema, code.Authority)
+	_, ok = subjects.Code("fiction")
+	assert.False(ok)
+
+	for _, invalid := range []string{"fiction: FIC028000", "fiction: 'DEWEY:800'", "fiction: 'BISAC:'"} {
+		conf := make(map[interface{}]interface{})
+		assert.Nil(yaml.Unmarshal([]byte(invalid), &conf))
+		_, err := ParseTagSubjects(conf)
+		assert.NotNil(err, invalid)
+	}
+}
+
+func TestTabulateTree(t *testing.T) {
+	fmt.Println("+ Testing TabulateTree()...")
+	assert := assert.New(t)
+	table := TabulateTree(map[string]int{"fiction": 3, "fiction/sf": 2, "fiction/sf/space-opera": 1, "fiction-classics": 1}, "Tags", "Books")
+	sf := strings.Index(table, "  sf ")
+	spaceOpera := strings.Index(table, "    space-opera ")
+	classics := strings.Index(table, "fiction-classics")
+	assert.NotEqual(-1, sf)
+	assert.True(sf < spaceOpera, "children follow their parents")
+	assert.True(spaceOpera < classics, "children follow their parents")
+	assert.Equal("", TabulateTree(map[string]int{}, "Tags", "Books"))
+}
@@ -0,0 +1,228 @@
+package endive
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sources of tags, which can have different limits.
+const (
+	TagSourceEpub      = "epub"
+	TagSourceGoodReads = "goodreads"
+)
+
+// TagRules define which tags are kept, and how they are renamed.
+// Unless NoDefaults is set, they complete the default rules.
+type TagRules struct {
+	Drop       []string          // exact tag names
+	DropGlob   []string          // shell patterns, such as to-*
+	DropRegexp []*regexp.Regexp  // regular expressions
+	Allow      []string          // exact names or shell patterns, overriding all drop rules
+	Rename     map[string]string // old name => new name
+	Limits     map[string]int    // maximum number of tags kept, by source
+	NoDefaults bool
+}
+
+// defaultTagRules remove shelf names that are obviously not genres.
+var defaultTagRules = TagRules{
+	Drop: []string{
+		"own", "owned", "i-own", "books-i-own", "owned-books", "my-books", "books",
+		"ebook", "ebooks", "e-book", "e-books", "kindle", "audible", "audio", "audiobook",
+		"audiobooks", "podcast", "paperback", "hardcover", "calibre", "library",
+		"read", "re-read", "reread", "currently-reading", "tbr", "dnf", "gave-up",
+		"abandoned", "moved-on", "unsorted", "default", "general", "maybe", "meh",
+		"favorite", "favorites", "favourite", "favourites", "all-time-favorites",
+		"recommended", "wishlist", "wish-list", "buy", "borrowed", "bundle", "series",
+		"stand-alone", "standalone", "arc", "netgalley", "giveaways", "review-copy",
+		"amazon", "english", "school", "adult", "book-club", "queue", "have",
+	},
+	DropGlob: []string{
+		"to-*", "not-*", "z-*", "*-list", "*-tbr", "tbr-*", "read-*", "*-read",
+		"owned-*", "*-owned", "own-*", "*-i-own", "*kindle*", "*calibre*", "*audible*",
+		"*favorite*", "*favourite*", "*-star", "*-stars", "*wishlist*", "*forthcoming*",
+		"*coming-soon*", "*anticipated*", "*release*", "*finish*", "*waiting*",
+		"*netgalley*", "*giveaway*", "*borrowed*", "*library-*", "*-library", "*livre*",
+	},
+	DropRegexp: []*regexp.Regexp{
+		// years, as in 2015 or read-in-2015
+		regexp.MustCompile(`(^|-)(19|20)[0-9]{2}(-|$)`),
+	},
+	Limits: map[string]int{
+		TagSourceGoodReads: 10,
+	},
+}
+
+// ParseTagRules from the tag_rules section of the configuration file.
+func ParseTagRules(in interface{}) (rules TagRules, err error) {
+	conf, ok := in.(map[interface{}]interface{})
+	if !ok {
+		return rules, ErrorBadFormat
+	}
+	for k, v := range conf {
+		key, ok := k.(string)
+		if !ok {
+			return rules, ErrorBadFormat
+		}
+		switch key {
+		case "drop":
+			rules.Drop, err = interfaceToStringSlice(v)
+		case "drop_glob":
+			rules.DropGlob, err = interfaceToStringSlice(v)
+			for _, pattern := range rules.DropGlob {
+				if _, globErr := path.Match(pattern, ""); globErr != nil {
+					return rules, errors.New("Invalid tag pattern: " + pattern)
+				}
+			}
+		case "drop_regexp":
+			var expressions []string
+			expressions, err = interfaceToStringSlice(v)
+			for _, expression := range expressions {
+				re, reErr := regexp.Compile(expression)
+				if reErr != nil {
+					return rules, errors.New("Invalid tag regular expression: " + expression)
+				}
+				rules.DropRegexp = append(rules.DropRegexp, re)
+			}
+		case "allow":
+			rules.Allow, err = interfaceToStringSlice(v)
+		case "rename":
+			rules.Rename = make(map[string]string)
+			renames, ok := v.(map[interface{}]interface{})
+			if !ok {
+				return rules, ErrorBadFormat
+			}
+			for oldName, newName := range renames {
+				rules.Rename[strings.ToLower(fmt.Sprint(oldName))] = strings.ToLower(fmt.Sprint(newName))
+			}
+		case "limits":
+			rules.Limits = make(map[string]int)
+			limits, ok := v.(map[interface{}]interface{})
+			if !ok {
+				return rules, ErrorBadFormat
+			}
+			for source, limit := range limits {
+				l, ok := limit.(int)
+				if !ok || l < 0 {
+					return rules, errors.New("Invalid tag limit for " + fmt.Sprint(source))
+				}
+				rules.Limits[fmt.Sprint(source)] = l
+			}
+		case "no_defaults":
+			rules.NoDefaults, ok = v.(bool)
+			if !ok {
+				return rules, ErrorBadFormat
+			}
+		default:
+			return rules, errors.New("Unknown tag rule: " + key)
+		}
+		if err != nil {
+			return rules, err
+		}
+	}
+	return rules, nil
+}
+
+// matches checks if a tag is one of the names or shell patterns.
+func matches(tag string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern == tag {
+			return true
+		}
+		if ok, err := path.Match(pattern, tag); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// drops checks if a tag is removed by the drop rules.
+func (r TagRules) drops(tag string) bool {
+	for _, name := range r.Drop {
+		if name == tag {
+			return true
+		}
+	}
+	if matches(tag, r.DropGlob) {
+		return true
+	}
+	for _, re := range r.DropRegexp {
+		if re.MatchString(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply the rules to a tag name, returning the cleaned name, and if it is kept.
+func (r TagRules) Apply(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if newName, ok := r.Rename[tag]; ok {
+		tag = newName
+	}
+	if tag == "" {
+		return "", false
+	}
+	if matches(tag, r.Allow) {
+		return tag, true
+	}
+	if r.drops(tag) || (!r.NoDefaults && defaultTagRules.drops(tag)) {
+		return tag, false
+	}
+	return tag, true
+}
+
+// Limit of tags kept from a source, or 0 if there is none.
+func (r TagRules) Limit(source string) int {
+	if limit, ok := r.Limits[source]; ok {
+		return limit
+	}
+	if !r.NoDefaults {
+		return defaultTagRules.Limits[source]
+	}
+	return 0
+}
+
+// rows describing the rules, for display.
+func (r TagRules) rows() (rows [][]string) {
+	if len(r.Drop) != 0 {
+		rows = append(rows, []string{"Tag rules: drop", strings.Join(r.Drop, ", ")})
+	}
+	if len(r.DropGlob) != 0 {
+		rows = append(rows, []string{"Tag rules: drop patterns", strings.Join(r.DropGlob, ", ")})
+	}
+	if len(r.DropRegexp) != 0 {
+		expressions := []string{}
+		for _, re := range r.DropRegexp {
+			expressions = append(expressions, re.String())
+		}
+		rows = append(rows, []string{"Tag rules: drop expressions", strings.Join(expressions, ", ")})
+	}
+	if len(r.Allow) != 0 {
+		rows = append(rows, []string{"Tag rules: allow", strings.Join(r.Allow, ", ")})
+	}
+	renames := []string{}
+	for oldName, newName := range r.Rename {
+		renames = append(renames, oldName+" => "+newName)
+	}
+	sort.Strings(renames)
+	if len(renames) != 0 {
+		rows = append(rows, []string{"Tag rules: rename", strings.Join(renames, ", ")})
+	}
+	sources := []string{}
+	for source := range r.Limits {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		rows = append(rows, []string{"Tag rules: limit for " + source, strconv.Itoa(r.Limits[source])})
+	}
+	if r.NoDefaults {
+		rows = append(rows, []string{"Tag rules: defaults", "disabled"})
+	}
+	return
+}
@@ -0,0 +1,85 @@
+package endive
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+const testTagRules = `
+drop:
+    - meh
+drop_glob:
+    - "*-to-read"
+drop_regexp:
+    - "^[0-9]+-stars?$"
+allow:
+    - science-fiction-classics
+    - "classics-*"
+rename:
+    SciFi: Science-Fiction
+limits:
+    goodreads: 5
+    epub: 3
+`
+
+func TestTagRules(t *testing.T) {
+	fmt.Println("+ Testing TagRules...")
+	assert := assert.New(t)
+	conf := make(map[interface{}]interface{})
+	assert.Nil(yaml.Unmarshal([]byte(testTagRules), &conf))
+	rules, err := ParseTagRules(conf)
+	assert.Nil(err)
+
+	for tag, expected := range map[string]string{
+		"Space Opera":              "space opera",
+		"scifi":                    "science-fiction",
+		"science-fiction-classics": "science-fiction-classics",
+		"classics-of-sf":           "classics-of-sf",
+		"ownership":                "ownership",
+		"coming-of-age":            "coming-of-age",
+		"meh":                      "",
+		"want-to-read":             "",
+		"5-stars":                  "",
+		"to-read":                  "",
+		"owned-books":              "",
+		"read-in-2015":             "",
+		"favorites":                "",
+		"":                         "",
+	} {
+		name, kept := rules.Apply(tag)
+		if expected == "" {
+			assert.False(kept, tag)
+		} else {
+			assert.True(kept, tag)
+			assert.Equal(expected, name, tag)
+		}
+	}
+	assert.Equal(5, rules.Limit(TagSourceGoodReads))
+	assert.Equal(3, rules.Limit(TagSourceEpub))
+	assert.Equal(0, rules.Limit("other"))
+
+	// without default rules
+	rules = TagRules{DropRegexp: []*regexp.Regexp{regexp.MustCompile("^x")}, NoDefaults: true}
+	_, kept := rules.Apply("to-read")
+	assert.True(kept)
+	_, kept = rules.Apply("xyz")
+	assert.False(kept)
+	assert.Equal(0, rules.Limit(TagSourceGoodReads))
+	// default rules only
+	rules = TagRules{}
+	_, kept = rules.Apply("to-read")
+	assert.False(kept)
+	assert.Equal(10, rules.Limit(TagSourceGoodReads))
+
+	// invalid rules
+	for _, invalid := range []string{"drop_regexp: ['(']", "drop_glob: ['[']", "limits: {goodreads: -1}", "unknown: []", "drop: x"} {
+		conf := make(map[interface{}]interface{})
+		assert.Nil(yaml.Unmarshal([]byte(invalid), &conf))
+		_, err := ParseTagRules(conf)
+		assert.NotNil(err, invalid)
+	}
+}
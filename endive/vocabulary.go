@@ -0,0 +1,156 @@
+package endive
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Progress states endive relies on, which must be part of any progress vocabulary.
+const (
+	ProgressUnread = "unread"
+	ProgressRead   = "read"
+)
+
+// Progress states derived from the reading log, which must be part of the
+// progress vocabulary to log readings.
+const (
+	ProgressReading   = "reading"
+	ProgressAbandoned = "abandoned"
+)
+
+// Vocabulary maps the valid values of a field to their aliases.
+type Vocabulary map[string][]string
+
+var (
+	defaultCategories = Vocabulary{
+		"fiction":    {"fic"},
+		"nonfiction": {"non fiction", "non-fiction", "nonfic"},
+	}
+	defaultTypes = Vocabulary{
+		"essay":         nil,
+		"biography":     nil,
+		"autobiography": nil,
+		"novel":         nil,
+		"shortstory":    {"short story", "short-story", "novella", "short-stories", "shortstories"},
+		"anthology":     {"anthologies"},
+		"poetry":        {"poems"},
+	}
+	defaultProgress = Vocabulary{
+		ProgressUnread:    nil,
+		ProgressRead:      nil,
+		ProgressReading:   nil,
+		ProgressAbandoned: {"dnf"},
+		"shortlisted":     nil,
+	}
+)
+
+// ParseVocabulary from a section of the configuration file.
+// Terms can be listed without aliases.
+func ParseVocabulary(in interface{}) (Vocabulary, error) {
+	vocabulary := make(Vocabulary)
+	conf, ok := in.(map[interface{}]interface{})
+	if !ok {
+		return vocabulary, ErrorBadFormat
+	}
+	for k, v := range conf {
+		term := strings.ToLower(strings.TrimSpace(fmt.Sprint(k)))
+		vocabulary[term] = []string{}
+		if v == nil {
+			continue
+		}
+		aliases, err := interfaceToStringSlice(v)
+		if err != nil {
+			return vocabulary, err
+		}
+		for _, alias := range aliases {
+			vocabulary[term] = append(vocabulary[term], strings.ToLower(strings.TrimSpace(alias)))
+		}
+	}
+	return vocabulary, vocabulary.Check()
+}
+
+// Check that terms and aliases are not ambiguous.
+func (v Vocabulary) Check() error {
+	if len(v) == 0 {
+		return errors.New("Empty vocabulary")
+	}
+	seen := make(map[string]string)
+	for _, term := range v.Terms() {
+		seen[term] = term
+	}
+	for _, term := range v.Terms() {
+		for _, alias := range v[term] {
+			if alias == "" {
+				return errors.New("Empty alias for " + term)
+			}
+			if other, ok := seen[alias]; ok && other != term {
+				return errors.New("Alias " + alias + " of " + term + " is already used by " + other)
+			}
+			seen[alias] = term
+		}
+	}
+	return nil
+}
+
+// Terms of the Vocabulary, sorted.
+func (v Vocabulary) Terms() []string {
+	terms := []string{}
+	for term := range v {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+// Has checks if a value is a valid term.
+func (v Vocabulary) Has(value string) bool {
+	_, ok := v[value]
+	return ok
+}
+
+// Clean a value, replacing aliases by their term.
+func (v Vocabulary) Clean(value string) (string, error) {
+	clean := strings.TrimSpace(strings.ToLower(value))
+	if v.Has(clean) {
+		return clean, nil
+	}
+	for term, aliases := range v {
+		for _, alias := range aliases {
+			if alias == clean {
+				return term, nil
+			}
+		}
+	}
+	return "", errors.New(value + " is not one of: " + v.String())
+}
+
+// String representation of a Vocabulary.
+func (v Vocabulary) String() string {
+	return strings.Join(v.Terms(), ", ")
+}
+
+// CategoryVocabulary from the configuration file, or the default one.
+func (c Config) CategoryVocabulary() Vocabulary {
+	if len(c.Categories) == 0 {
+		return defaultCategories
+	}
+	return c.Categories
+}
+
+// TypeVocabulary from the configuration file, or the default one.
+func (c Config) TypeVocabulary() Vocabulary {
+	if len(c.Types) == 0 {
+		return defaultTypes
+	}
+	return c.Types
+}
+
+// ProgressVocabulary from the configuration file, or the default one.
+func (c Config) ProgressVocabulary() Vocabulary {
+	if len(c.Progress) == 0 {
+		return defaultProgress
+	}
+	return c.Progress
+}
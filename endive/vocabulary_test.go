@@ -0,0 +1,41 @@
+package endive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestVocabulary(t *testing.T) {
+	fmt.Println("+ Testing Vocabulary...")
+	assert := assert.New(t)
+	conf := make(map[interface{}]interface{})
+	assert.Nil(yaml.Unmarshal([]byte("novel:\ngraphic novel:\n    - comics\n    - BD\nplay: []\n"), &conf))
+	v, err := ParseVocabulary(conf)
+	assert.Nil(err)
+	assert.Equal([]string{"graphic novel", "novel", "play"}, v.Terms())
+	assert.True(v.Has("play"))
+	assert.False(v.Has("comics"))
+	for value, expected := range map[string]string{"novel": "novel", " Graphic Novel ": "graphic novel", "bd": "graphic novel", "PLAY": "play"} {
+		clean, err := v.Clean(value)
+		assert.Nil(err, value)
+		assert.Equal(expected, clean, value)
+	}
+	_, err = v.Clean("essay")
+	assert.NotNil(err)
+
+	// ambiguous aliases
+	assert.NotNil(Vocabulary{"novel": {"book"}, "essay": {"book"}}.Check())
+	assert.NotNil(Vocabulary{"novel": {"essay"}, "essay": nil}.Check())
+	assert.NotNil(Vocabulary{}.Check())
+	_, err = ParseVocabulary([]interface{}{"novel"})
+	assert.Equal(ErrorBadFormat, err)
+
+	// defaults
+	c := Config{}
+	assert.Equal(defaultProgress, c.ProgressVocabulary())
+	c.Progress = Vocabulary{ProgressUnread: nil, ProgressRead: nil, "dnf": nil}
+	assert.Equal([]string{"dnf", ProgressRead, ProgressUnread}, c.ProgressVocabulary().Terms())
+}
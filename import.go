@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -43,7 +44,48 @@ func (e *Endive) analyzeSources(sources []string, retail bool) (en.EpubCandidate
 	newEpubs := candidates.New()
 	missingEpubs := candidates.Missing()
 	e.UI.SubTitle("Found %d new epubs and %d epubs previously imported and now missing.", len(newEpubs), len(missingEpubs))
-	return candidates.Importable(), nil
+	return e.proposeReplacements(candidates.Importable(), retail), nil
+}
+
+// proposeReplacements first: candidates matching books with an epub flagged
+// for replacement, by ISBN, or by metadata if confirmed.
+func (e *Endive) proposeReplacements(candidates en.EpubCandidates, retail bool) en.EpubCandidates {
+	replaceable := b.Books{}
+	for _, book := range e.Library.Collection.NeedsReplacement().Books() {
+		if book.(*b.Book).CanBeReplacedBy(retail) {
+			replaceable.Add(book)
+		}
+	}
+	if len(replaceable) == 0 {
+		return candidates
+	}
+	found := 0
+	for j, candidate := range candidates {
+		ep := b.Epub{Filename: candidate.Filename, UI: e.UI}
+		// metadata can be used even without ISBN
+		info, err := ep.ReadMetadata()
+		if err != nil && info.BookTitle == "" {
+			e.UI.Debug("Could not read metadata from " + candidate.Filename)
+			continue
+		}
+		if known, err := replaceable.FindByMetadata(info.ISBN, "", "", info.Identifiers.List()...); err == nil {
+			candidates[j].Replaces = known.ID()
+			found++
+		} else if similar, reasons := b.FindSimilar(&replaceable, info); similar != nil {
+			// similar Metadata must be confirmed
+			if e.UI.Accept(fmt.Sprintf("Epub %s is similar to book %s with ID %d (%s). Use it to replace its flagged epub", filepath.Base(candidate.Filename), similar.String(), similar.ID(), strings.Join(reasons, ", "))) {
+				candidates[j].Replaces = similar.ID()
+				found++
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(j, k int) bool {
+		return candidates[j].Replaces != 0 && candidates[k].Replaces == 0
+	})
+	if found != 0 {
+		e.UI.SubTitle("Found %d epubs that may replace epubs flagged for replacement.", found)
+	}
+	return candidates
 }
 
 // ImportRetail imports epubs from the Retail source.
@@ -88,6 +130,9 @@ func (e *Endive) ImportEpubs(candidates []en.EpubCandidate, isRetail bool) (err
 		if len(candidates) > 1 {
 			intro += fmt.Sprintf(" (%d / %d)", i+1, len(candidates))
 		}
+		if candidate.Replaces != 0 {
+			intro += fmt.Sprintf(", to replace an epub of book ID#%d flagged for replacement", candidate.Replaces)
+		}
 		e.UI.SubTitle(intro)
 		// new Epub
 		ep := b.Epub{Filename: candidate.Filename, UI: e.UI}
@@ -112,13 +157,30 @@ func (e *Endive) ImportEpubs(candidates []en.EpubCandidate, isRetail bool) (err
 		if e.UI.Accept(confirmText) {
 			// get isbn if not found automatically
 			if unknownISBN {
-				isbn, err := en.AskForISBN(e.UI)
+				var found []string
+				isbnCandidates, err := ep.FindISBNCandidates()
+				if err != nil {
+					e.UI.Debug(err.Error())
+				}
+				for _, c := range isbnCandidates {
+					found = append(found, c.String())
+				}
+				isbn, err := en.AskForISBN(e.UI, found...)
 				if err != nil {
 					e.UI.Warning("Warning: ISBN still unknown.")
 				} else {
 					info.ISBN = isbn
 				}
 			}
+			// detect language if unknown, the guess must be confirmed
+			if language := en.NormalizeLanguage(info.Language, e.Config.LanguageAliases); language == "" || language == en.UndeterminedLanguage {
+				detected, err := ep.DetectLanguage()
+				if err != nil {
+					e.UI.Debug("Could not detect language: " + err.Error())
+				} else if detected != en.UndeterminedLanguage && e.UI.Accept(fmt.Sprintf("Language not set in epub, detected from its text as %s (%s). Use it", en.LanguageName(detected), detected)) {
+					info.Language = detected
+				}
+			}
 
 			// get online data to prepare import
 			if err := info.SearchOnline(e.UI, e.Config); err != nil {
@@ -128,9 +190,23 @@ func (e *Endive) ImportEpubs(candidates []en.EpubCandidate, isRetail bool) (err
 				e.UI.Error("Could not merge metadata with online sources. Continuing importing nonetheless.")
 			}
 
-			// loop over Books to find similar Metadata
+			// loop over Books to find the same ISBN or identifiers
 			var imported bool
-			knownBook, err := e.Library.Collection.FindByMetadata(info.ISBN, info.Author(), info.Title())
+			var knownBook en.GenericBook
+			if candidate.Replaces != 0 {
+				// the book was found when proposing replacements
+				knownBook, err = e.Library.Collection.FindByID(candidate.Replaces)
+			} else {
+				knownBook, err = e.Library.Collection.FindByMetadata(info.ISBN, "", "", info.Identifiers.List()...)
+				if err != nil {
+					// similar Metadata must be confirmed
+					if similar, reasons := b.FindSimilar(e.Library.Collection, info); similar != nil {
+						if e.UI.Accept(fmt.Sprintf("Found similar book %s with ID %d (%s). Add epub to this book", similar.String(), similar.ID(), strings.Join(reasons, ", "))) {
+							knownBook, err = similar, nil
+						}
+					}
+				}
+			}
 			if err != nil {
 				e.UI.Debug("Creating new book.")
 				bk := b.NewBookWithMetadata(e.UI, e.Library.GenerateID(), candidate.Filename, e.Config, isRetail, info)
@@ -141,19 +217,32 @@ func (e *Endive) ImportEpubs(candidates []en.EpubCandidate, isRetail bool) (err
 				// adding new book
 				e.Library.Collection.Add(bk)
 				e.UI.SubTitle("Added epub %s to new book with ID %d", bk.String(), bk.ID())
+			} else if candidate.Replaces != 0 {
+				e.UI.Title("\nReplacing flagged epub of book %s with ID %d\n", knownBook.String(), knownBook.ID())
+				bk := knownBook.(*b.Book)
+				imported, err = bk.ReplaceEdition(candidate.Filename, isRetail, candidate.Hash, info)
+				if err != nil {
+					return err
+				}
+				e.UI.SubTitle("Replaced flagged epub of book %s with ID %d", knownBook.String(), knownBook.ID())
 			} else {
 				e.UI.Title("\nAdding epub to existing book %s with ID %d\n", knownBook.String(), knownBook.ID())
-				e.UI.SubTitle("Showing differences with current values")
-				// merging metadata
 				bk := knownBook.(*b.Book)
-				fmt.Println(en.TabulateRows(bk.Metadata.OutputDiffTable(&info, true), "Current Value", "Value from new Epub"))
-				e.UI.SubTitle("Merging the differences between the known metadata and metadata from the new epub")
-				if err := bk.Metadata.Merge(&info, e.Config, e.UI, true); err != nil {
-					e.UI.Error("Error merging metadata with trumping version.")
-					return err
+				if bk.IsNewEdition(info) {
+					// the book metadata describe its preferred edition
+					e.UI.SubTitle("New edition of the book, keeping the current metadata")
+				} else {
+					e.UI.SubTitle("Showing differences with current values")
+					// merging metadata
+					fmt.Println(en.TabulateRows(bk.Metadata.OutputDiffTable(&info, true), "Current Value", "Value from new Epub"))
+					e.UI.SubTitle("Merging the differences between the known metadata and metadata from the new epub")
+					if err := bk.Metadata.Merge(&info, e.Config, e.UI, true); err != nil {
+						e.UI.Error("Error merging metadata with trumping version.")
+						return err
+					}
 				}
 				// adding epub file
-				imported, err = knownBook.AddEpub(candidate.Filename, isRetail, candidate.Hash)
+				imported, err = bk.AddEdition(candidate.Filename, isRetail, candidate.Hash, info)
 				if err != nil {
 					return err
 				}
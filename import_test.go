@@ -26,11 +26,13 @@ func TestImportPaths(t *testing.T) {
 	c.LibraryRoot = "test/library"
 	c.DatabaseFile = "test/library/endive_test.json"
 	c.EpubFilenameFormat = "$a - $t"
+	c.TrashDir = "test/trash"
 	// makedirs c.LibraryRoot + defer removing all test files
 	if err := os.MkdirAll(c.LibraryRoot, 0777); err != nil {
 		panic(err)
 	}
 	defer os.RemoveAll(c.LibraryRoot)
+	defer os.RemoveAll(c.TrashDir)
 
 	// building endive struct
 	db := &db.JSONDB{}
@@ -70,6 +72,14 @@ func TestImportPaths(t *testing.T) {
 	book, err = endive.Library.Collection.FindByFullPath(importedFilename)
 	assert.Nil(err, "Imported epub should be in collection")
 	assert.Equal(1, book.ID(), "Trumped nonretail epub for book with ID 1.")
+	// testing the trumped epub is in the trash
+	entries, err := b.ListTrash(c, ui)
+	assert.Nil(err)
+	if assert.Equal(1, len(entries), "Trumped nonretail epub should be in the trash") {
+		assert.Equal(b.TrashReasonSuperseded, entries[0].Reason)
+		assert.Equal(1, entries[0].Book.ID())
+		assert.False(entries[0].Book.HasRetail())
+	}
 
 	fmt.Println("\n\t+ 3. import first retail again")
 	err = endive.ImportSpecific(true, "test/pg16328_empty.epub")
@@ -149,4 +159,16 @@ func TestImportSource(t *testing.T) {
 	assert.Nil(err, "import should be successful")
 	assert.Equal(4, len(candidates), "Expected to find 4 epubs.")
 	assert.Equal(4, len(candidates.Importable()), "Expected to find 4 epubs.")
+
+	// candidates matching books flagged for replacement are proposed first
+	flagged := b.NewBookWithMetadata(ui, 10, "flagged.epub", c, false, b.Metadata{BookTitle: "Le comte de Monte-Cristo, Tome I", Authors: []string{"Alexandre Dumas"}})
+	assert.Nil(flagged.FlagForReplacement(false, "OCR errors"))
+	endive.Library.Collection.Add(flagged)
+	candidates, err = endive.analyzeSources(endive.Config.NonRetailSource, false)
+	assert.Nil(err, "import should be successful")
+	if assert.Equal(4, len(candidates)) {
+		assert.Equal(10, candidates[0].Replaces)
+		assert.Equal("test/pg17989.epub", candidates[0].Filename)
+		assert.Equal(0, candidates[1].Replaces)
+	}
 }
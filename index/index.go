@@ -4,17 +4,31 @@ Package index is the endive subpackage that implements the Indexer interface.
 package index
 
 import (
+	"encoding/json"
 	"errors"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/mapping"
 
 	e "github.com/barsanuphe/endive/endive"
 )
 
+// mappingVersion must change with newMapping, so that older indexes are rebuilt.
+// The types of custom fields are also part of the version of an index.
+const mappingVersion = "4"
+
+// mappingVersionKey in the internal storage of the index.
+var mappingVersionKey = []byte("endive_mapping_version")
+
 // Index implements Indexer
 type Index struct {
 	Path string
+	// custom fields are indexed according to their type
+	CustomFields e.CustomFields
 }
 
 // SetPath for Index
@@ -82,6 +96,10 @@ func (i *Index) Check(all e.Collection) error {
 	if err != nil {
 		return err
 	}
+	if i.isOutdated(index) {
+		index.Close()
+		return i.Rebuild(all)
+	}
 	defer index.Close()
 
 	for _, v := range all.Books() {
@@ -90,7 +108,7 @@ func (i *Index) Check(all e.Collection) error {
 			return err
 		}
 		if d == nil {
-			err = index.Index(v.FullPath(), v)
+			err = i.index(index, v)
 			if err != nil {
 				return err
 			}
@@ -113,6 +131,9 @@ func (i *Index) Query(queryString string) (resultsPaths []string, err error) {
 	if isNew {
 		return resultsPaths, errors.New(e.EmptyIndexError)
 	}
+	if i.isOutdated(index) {
+		return resultsPaths, errors.New(e.OutdatedIndexError)
+	}
 
 	searchResults, err := index.Search(search)
 	if err != nil {
@@ -127,14 +148,130 @@ func (i *Index) Query(queryString string) (resultsPaths []string, err error) {
 	return
 }
 
+// newMapping for Books. Reading dates are indexed as text, so that years and
+// months can be searched (readings.finished:2016). Numeric and date custom
+// fields can be searched by range (custom.price:>10). Tag paths are keywords
+// (metadata.tags.path:"fiction/sf").
+func (i *Index) newMapping() mapping.IndexMapping {
+	readings := bleve.NewDocumentMapping()
+	for _, field := range []string{"started", "finished", "abandoned"} {
+		readings.AddFieldMappingsAt(field, bleve.NewTextFieldMapping())
+	}
+	custom := bleve.NewDocumentMapping()
+	for name, field := range i.CustomFields {
+		switch field.Type {
+		case e.FieldInt, e.FieldFloat:
+			custom.AddFieldMappingsAt(name, bleve.NewNumericFieldMapping())
+		case e.FieldDate:
+			custom.AddFieldMappingsAt(name, bleve.NewDateTimeFieldMapping())
+		default:
+			custom.AddFieldMappingsAt(name, bleve.NewTextFieldMapping())
+		}
+	}
+	// tag paths are matched exactly
+	tagPath := bleve.NewTextFieldMapping()
+	tagPath.Analyzer = keyword.Name
+	tags := bleve.NewDocumentMapping()
+	tags.AddFieldMappingsAt("path", tagPath)
+	metadata := bleve.NewDocumentMapping()
+	metadata.AddSubDocumentMapping("tags", tags)
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping.AddSubDocumentMapping("metadata", metadata)
+	m.DefaultMapping.AddSubDocumentMapping("readings", readings)
+	m.DefaultMapping.AddSubDocumentMapping("custom", custom)
+	return m
+}
+
+// version of the mapping of the Index.
+func (i *Index) version() string {
+	version := mappingVersion
+	for _, name := range i.CustomFields.Names() {
+		version += " " + name + ":" + i.CustomFields[name].Type
+	}
+	return version
+}
+
+// isOutdated checks if an index was built with another mapping.
+func (i *Index) isOutdated(index bleve.Index) bool {
+	version, err := index.GetInternal(mappingVersionKey)
+	return err != nil || string(version) != i.version()
+}
+
+// document for a GenericBook, as saved in the database, with:
+// - custom fields converted to their type: they are all saved as strings.
+// - the paths of hierarchical tags and their ancestors (fiction, fiction/sf
+// for fiction/sf), so that a tag path matches its descendants only.
+func (i *Index) document(book e.GenericBook) (interface{}, error) {
+	data, err := json.Marshal(book)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if metadata, ok := doc["metadata"].(map[string]interface{}); ok {
+		tags, _ := metadata["tags"].([]interface{})
+		for _, t := range tags {
+			tag, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := tag["name"].(string)
+			paths := []string{}
+			levels := strings.Split(strings.ToLower(name), e.TagSeparator)
+			for j := range levels {
+				paths = append(paths, strings.Join(levels[:j+1], e.TagSeparator))
+			}
+			tag["path"] = paths
+		}
+	}
+	custom, _ := doc["custom"].(map[string]interface{})
+	for name, value := range custom {
+		field, ok := i.CustomFields[name]
+		text, isText := value.(string)
+		if !ok || !isText {
+			continue
+		}
+		switch field.Type {
+		case e.FieldInt, e.FieldFloat:
+			if number, err := strconv.ParseFloat(text, 64); err == nil {
+				custom[name] = number
+			}
+		case e.FieldDate:
+			// dates can be a year or a month
+			switch len(text) {
+			case len("2006"):
+				custom[name] = text + "-01-01"
+			case len("2006-01"):
+				custom[name] = text + "-01"
+			}
+		}
+	}
+	return doc, nil
+}
+
+// index a GenericBook.
+func (i *Index) index(index bleve.Index, book e.GenericBook) error {
+	doc, err := i.document(book)
+	if err != nil {
+		return err
+	}
+	return index.Index(book.FullPath(), doc)
+}
+
 func (i *Index) open() (index bleve.Index, isNew bool, err error) {
 	// TODO check Path is set
 	index, err = bleve.Open(i.Path)
 	if err == bleve.ErrorIndexPathDoesNotExist {
-		index, err = bleve.New(i.Path, bleve.NewIndexMapping())
+		index, err = bleve.New(i.Path, i.newMapping())
 		if err != nil {
 			return
 		}
+		if err = index.SetInternal(mappingVersionKey, []byte(i.version())); err != nil {
+			index.Close()
+			return nil, false, err
+		}
 		isNew = true
 	}
 	return index, isNew, err
@@ -150,7 +287,7 @@ func (i *Index) add(books e.Collection) (err error) {
 	defer index.Close()
 
 	for _, v := range books.Books() {
-		err = index.Index(v.FullPath(), v)
+		err = i.index(index, v)
 		if err != nil {
 			return
 		}
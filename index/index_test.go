@@ -31,6 +31,12 @@ func TestSearch(t *testing.T) {
 	_, err = l.Index.Query("fr")
 	assert.NotNil(err, "Index not built yet")
 
+	// reading dates are searchable by year
+	l.Collection.Books()[0].(*b.Book).Readings = b.ReadingLog{{Started: "2015-12-20", Finished: "2016-01-02"}}
+	// hierarchical tags are searchable by path
+	l.Collection.Books()[0].(*b.Book).Metadata.Tags = b.Tags{{Name: "fiction/sf/space-opera"}}
+	l.Collection.Books()[1].(*b.Book).Metadata.Tags = b.Tags{{Name: "nonfiction/sf"}}
+
 	// index
 	err = l.Index.Rebuild(l.Collection)
 	assert.Nil(err, "Error indexing epubs from database")
@@ -68,9 +74,44 @@ func TestSearch(t *testing.T) {
 	assert.Nil(err, "Error searching year:2205")
 	assert.EqualValues(0, len(results), "Error searching year:2205, did not expect results")
 
+	// readings.finished:2016
+	results, err = l.Index.Query("readings.finished:2016")
+	assert.Nil(err, "Error searching readdate:2016")
+	assert.EqualValues(1, len(results), "Error searching readdate:2016, unexpected results")
+	results, err = l.Index.Query("readings.finished:2015 readings.started:2016")
+	assert.Nil(err, "Error searching readdate:2015")
+	assert.EqualValues(0, len(results), "Error searching readdate:2015, did not expect results")
+
+	// tag paths match their descendants only
+	for query, expected := range map[string]int{
+		`metadata.tags.path:"fiction/sf"`:             1,
+		`metadata.tags.path:"fiction"`:                1,
+		`metadata.tags.path:"fiction/sf/space-opera"`: 1,
+		`metadata.tags.path:"sf"`:                     0,
+		`metadata.tags.path:"nonfiction/sf"`:          1,
+	} {
+		results, err = l.Index.Query(query)
+		assert.Nil(err, "Error searching "+query)
+		assert.Equal(expected, len(results), "Unexpected results for "+query)
+	}
+
+	// indexes built with an older mapping are rebuilt when checked
+	idx := l.Index.(*Index)
+	index, _, err := idx.open()
+	assert.Nil(err, "Error opening index")
+	assert.Nil(index.SetInternal(mappingVersionKey, []byte("1")))
+	index.Close()
+	_, err = l.Index.Query("readings.finished:2016")
+	if assert.NotNil(err, "Index is outdated") {
+		assert.Equal(e.OutdatedIndexError, err.Error())
+	}
+
 	// check index
 	err = l.Index.Check(l.Collection)
 	assert.Nil(err, "Error checking collection")
+	results, err = l.Index.Query("readings.finished:2016")
+	assert.Nil(err, "Error searching readdate:2016 after rebuilding")
+	assert.EqualValues(1, len(results), "Error searching readdate:2016 after rebuilding")
 
 	// update: mod first book, remove last book
 	tempCollection := l.Collection.Last(1)
@@ -103,3 +144,50 @@ func TestSearch(t *testing.T) {
 				l.Search("tags:sf")
 	*/
 }
+
+func TestSearchCustomFields(t *testing.T) {
+	indexPath := "../test/test_index_custom"
+	assert := assert.New(t)
+
+	fields := e.CustomFields{
+		"price":  {Name: "price", Type: e.FieldFloat},
+		"bought": {Name: "bought", Type: e.FieldDate},
+		"source": {Name: "source", Type: e.FieldString},
+	}
+	c := e.Config{LibraryRoot: "..", CustomFields: fields}
+	ui := &mock.UserInterface{}
+	db := &db.JSONDB{}
+	db.SetPath("../test/endive.json")
+	idx := &Index{CustomFields: fields}
+	l := library.Library{Collection: &b.Books{}, Index: idx, UI: ui, Config: c, DB: db}
+	assert.Nil(l.Load(), "Error loading epubs from database")
+	l.Index.SetPath(indexPath)
+	defer os.RemoveAll(indexPath)
+
+	first, second := l.Collection.Books()[0].(*b.Book), l.Collection.Books()[1].(*b.Book)
+	first.Custom = map[string]string{"price": "12.5", "bought": "2016", "source": "gift"}
+	second.Custom = map[string]string{"price": "8", "bought": "2014-03-02"}
+	assert.Nil(l.Index.Rebuild(l.Collection), "Error indexing epubs from database")
+
+	// numeric and date ranges
+	for query, expected := range map[string]int{
+		"custom.price:>10":                    1,
+		"custom.price:<=12.5":                 2,
+		"custom.price:>20":                    0,
+		`custom.bought:>"2015-01-01"`:         1,
+		`custom.bought:<"2014-12-31"`:         1,
+		"custom.source:gift":                  1,
+		"custom.price:>5 -custom.source:gift": 1,
+	} {
+		results, err := l.Index.Query(query)
+		assert.Nil(err, "Error searching "+query)
+		assert.Equal(expected, len(results), "Unexpected results for "+query)
+	}
+
+	// changing the type of a custom field makes the index outdated
+	other := &Index{Path: indexPath, CustomFields: e.CustomFields{"price": {Name: "price", Type: e.FieldString}}}
+	_, err := other.Query("custom.price:>10")
+	if assert.NotNil(err, "Index is outdated") {
+		assert.Equal(e.OutdatedIndexError, err.Error())
+	}
+}
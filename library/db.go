@@ -22,6 +22,12 @@ func (l *Library) Load() error {
 	err := l.DB.Load(l.Collection)
 	if err == nil {
 		l.Collection.Propagate(l.UI, l.Config)
+		if migrated := l.Collection.MigrateVocabularies(); migrated != 0 {
+			l.UI.Infof("Updated the category, type or progress of %d books to the configured terms.", migrated)
+		}
+		if migrated := l.Collection.MigrateImportDates(); migrated != 0 {
+			l.UI.Infof("Saved the import date of %d books, from the modification date of their epubs.", migrated)
+		}
 	}
 	return err
 }
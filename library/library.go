@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	e "github.com/barsanuphe/endive/endive"
@@ -21,6 +22,37 @@ import (
 	i "github.com/barsanuphe/helpers/ui"
 )
 
+var (
+	isbnQueryRegexp       = regexp.MustCompile(`(^|[\s+\-])id:isbn:`)
+	identifierQueryRegexp = regexp.MustCompile(`(^|[\s+\-])id:([a-z]+):`)
+	tagPathQueryRegexp    = regexp.MustCompile(`(^|[\s+\-])tags?:([^\s"/]+(?:/[^\s"/]+)+)/?`)
+	languageQueryRegexp   = regexp.MustCompile(`(^|[\s+\-])language:([^\s"]+)`)
+	queryFieldRegexp      = regexp.MustCompile(`(^|[\s+\-])([a-z_]+):`)
+)
+
+// queryFields are shortcuts for the indexed fields.
+var queryFields = map[string]string{
+	"author":      "metadata.authors",
+	"translator":  "metadata.contributors.trl",
+	"editor":      "metadata.contributors.edt",
+	"subtitle":    "metadata.subtitle",
+	"title":       "metadata.title",
+	"year":        "metadata.year",
+	"language":    "metadata.language",
+	"series":      "metadata.series.name",
+	"tags":        "metadata.tags.name",
+	"tag":         "metadata.tags.name",
+	"publisher":   "metadata.publisher",
+	"category":    "metadata.category",
+	"type":        "metadata.type",
+	"genre":       "metadata.genre",
+	"description": "metadata.description",
+	"work":        "metadata.work.title",
+	"readdate":    "readings.finished",
+	"started":     "readings.started",
+	"abandoned":   "readings.abandoned",
+}
+
 // Library manages Epubs
 type Library struct {
 	Config     e.Config
@@ -83,7 +115,7 @@ func (l *Library) ExportToEReader(books e.Collection, path string) (err error) {
 			}
 			if _, exists := h.FileExists(destination); exists != nil {
 				l.UI.Info(" - Exporting " + book.String())
-				err = h.CopyFile(book.FullPath(), destination)
+				err = book.ExportTo(destination)
 				if err != nil {
 					return err
 				}
@@ -125,6 +157,14 @@ func (l *Library) markExported() error {
 		// if found in library, mark as exported
 		if err == nil {
 			b.SetExported(true)
+			continue
+		}
+		// exported copies with an embedded cover have their own hash
+		for _, book := range l.Collection.Books() {
+			if book.HasHash(exportedEpub.Hash) {
+				book.SetExported(true)
+				break
+			}
 		}
 	}
 	return nil
@@ -135,11 +175,15 @@ func (l *Library) Search(query, sortBy string, limitFirst, limitLast int, in e.C
 	if err != nil {
 		return
 	}
+	query, err = l.Config.SavedQueries.Expand(query)
+	if err != nil {
+		return nil, err
+	}
 	query = l.prepareQuery(query)
 
 	booksPaths, err := l.Index.Query(query)
 	if err != nil {
-		if err.Error() == e.EmptyIndexError {
+		if err.Error() == e.EmptyIndexError || err.Error() == e.OutdatedIndexError {
 			// rebuild index
 			if err := l.RebuildIndex(); err != nil {
 				return in, err
@@ -189,22 +233,31 @@ func (l *Library) SearchAndPrint(query, sortBy string, limitFirst, limitLast int
 
 // prepareQuery before search
 func (l *Library) prepareQuery(queryString string) string {
+	// identifiers: id:kind:value
+	queryString = isbnQueryRegexp.ReplaceAllString(queryString, "${1}metadata.isbn:")
+	queryString = identifierQueryRegexp.ReplaceAllString(queryString, "${1}metadata.identifiers.${2}:")
+	// hierarchical tags: tag:fiction/sf also matches fiction/sf/space-opera,
+	// but not nonfiction/sf
+	queryString = tagPathQueryRegexp.ReplaceAllStringFunc(queryString, func(match string) string {
+		parts := tagPathQueryRegexp.FindStringSubmatch(match)
+		return parts[1] + `metadata.tags.path:"` + strings.ToLower(parts[2]) + `"`
+	})
+	// languages: language:German, language:ger and language:de-DE all mean language:de
+	queryString = languageQueryRegexp.ReplaceAllStringFunc(queryString, func(match string) string {
+		parts := languageQueryRegexp.FindStringSubmatch(match)
+		return parts[1] + "language:" + e.NormalizeLanguage(parts[2], l.Config.LanguageAliases)
+	})
 	// replace fields for simpler queries
-	r := strings.NewReplacer(
-		"author:", "metadata.authors:",
-		"title:", "metadata.title:",
-		"year:", "metadata.year:",
-		"language:", "metadata.language:",
-		"series:", "metadata.series.name:",
-		"tags:", "metadata.tags.name:",
-		"tag:", "metadata.tags.name:",
-		"publisher:", "metadata.publisher:",
-		"category:", "metadata.category:",
-		"type:", "metadata.type:",
-		"genre:", "metadata.genre:",
-		"description:", "metadata.description:",
-	)
-	return r.Replace(queryString)
+	return queryFieldRegexp.ReplaceAllStringFunc(queryString, func(match string) string {
+		parts := queryFieldRegexp.FindStringSubmatch(match)
+		if field, ok := queryFields[parts[2]]; ok {
+			return parts[1] + field + ":"
+		}
+		if _, ok := l.Config.CustomFields[parts[2]]; ok {
+			return parts[1] + "custom." + parts[2] + ":"
+		}
+		return match
+	})
 }
 
 // ShowInfo returns a table with relevant information about a book.
@@ -219,14 +272,10 @@ func (l *Library) ShowInfo() string {
 	rows = append(rows, []string{"Number of tags", fmt.Sprintf("%d", len(infoMap))})
 	infoMap = l.Collection.Series()
 	rows = append(rows, []string{"Number of series", fmt.Sprintf("%d", len(infoMap))})
-	bks = l.Collection.Progress("read").Books()
-	rows = append(rows, []string{"Number of read books", fmt.Sprintf("%d", len(bks))})
-	bks = l.Collection.Progress("reading").Books()
-	rows = append(rows, []string{"Number of books currently being read", fmt.Sprintf("%d", len(bks))})
-	bks = l.Collection.Progress("shortlisted").Books()
-	rows = append(rows, []string{"Number of books shortlisted for imminent reading", fmt.Sprintf("%d", len(bks))})
-	bks = l.Collection.Progress("unread").Books()
-	rows = append(rows, []string{"Number of unread books", fmt.Sprintf("%d", len(bks))})
+	for _, progress := range l.Config.ProgressVocabulary().Terms() {
+		bks = l.Collection.Progress(progress).Books()
+		rows = append(rows, []string{"Number of books: " + progress, fmt.Sprintf("%d", len(bks))})
+	}
 	bks = l.Collection.Exported().Books()
 	rows = append(rows, []string{"Number of exported books", fmt.Sprintf("%d", len(bks))})
 	return e.TabulateRows(rows, "Library", l.Config.LibraryRoot)
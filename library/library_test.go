@@ -136,3 +136,24 @@ func TestGenerateID(t *testing.T) {
 	id = l.GenerateID()
 	assert.Equal(1790, id, "ID shoudl be 1789+1")
 }
+
+func TestPrepareQuery(t *testing.T) {
+	fmt.Println("+ Testing Library.prepareQuery()...")
+	assert := assert.New(t)
+	l := Library{Config: e.Config{CustomFields: e.CustomFields{"lent_to": {Name: "lent_to", Type: e.FieldString}}}}
+	for query, expected := range map[string]string{
+		"lent_to:alice -booktitle:x":                   "custom.lent_to:alice -booktitle:x",
+		"author:stross +title:singularity":             "metadata.authors:stross +metadata.title:singularity",
+		"subtitle:novel":                               "metadata.subtitle:novel",
+		"translator:stok":                              "metadata.contributors.trl:stok",
+		"id:goodreads:1234":                            "metadata.identifiers.goodreads:1234",
+		"+id:asin:B002TXZRQI -id:isbn:978":             "+metadata.identifiers.asin:B002TXZRQI -metadata.isbn:978",
+		"tag:fiction/sf -tags:fiction/sf/space-opera/": `metadata.tags.path:"fiction/sf" -metadata.tags.path:"fiction/sf/space-opera"`,
+		"tag:fantasy":                                  "metadata.tags.name:fantasy",
+		"language:German -language:fre":                "metadata.language:de -metadata.language:fr",
+		"+language:pt-BR":                              "+metadata.language:pt",
+		"readdate:2016 -abandoned:2017":                "readings.finished:2016 -readings.abandoned:2017",
+	} {
+		assert.Equal(expected, l.prepareQuery(query))
+	}
+}
@@ -60,6 +60,11 @@ func main() {
 		// nothing to do
 		return
 	}
+	// books selected by search criteria are shown before being modified
+	if cli.selected && cli.changesSelection() && !confirmSelection(e, cli.collection) {
+		e.UI.Title("Nothing was changed.")
+		return
+	}
 	// now dealing with commands
 	if cli.showConfig {
 		e.UI.Display(e.Config.String())
@@ -84,6 +89,20 @@ func main() {
 		if err := e.Library.CheckIndex(); err != nil {
 			e.UI.Error(err.Error())
 		}
+	} else if cli.embedMetadata {
+		if err := embedMetadata(e, cli.books); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.retag {
+		retagCollection(e, cli.dryRun)
+	} else if cli.lint {
+		if err := lintCollection(e, cli.lintChecks, cli.lintAliases, cli.lintFix); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.duplicates {
+		if err := findDuplicates(e, cli.mergeDuplicates); err != nil {
+			e.UI.Error(err.Error())
+		}
 	} else if cli.importEpubs {
 		if cli.listImport {
 			listImportableEpubs(e, cli.importRetail)
@@ -102,13 +121,69 @@ func main() {
 			showInfo(e, nil)
 		case infoBook:
 			showInfo(e, cli.books[0])
+		case infoTags:
+			e.UI.Display(en.TabulateTree(cli.collectionMap, cli.info, numberOfBooksHeader))
 		default:
 			e.UI.Display(en.TabulateMap(cli.collectionMap, cli.info, numberOfBooksHeader))
 		}
+	} else if cli.series != "" {
+		if err := showSeries(e, cli.series, cli.seriesOnline); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.next {
+		showNextInSeries(e)
+	} else if cli.merge {
+		if _, err := mergeBooks(e, cli.books[0], cli.books[1]); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.flagReplace {
+		if err := flagForReplacement(e, cli.books[0], cli.flagEpub, cli.flagReason, cli.clearFlag); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.remove {
+		if err := removeBooks(e, cli.collection, cli.forget); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.showTrash {
+		if err := showTrash(e); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.restoreTrash {
+		if err := restoreFromTrash(e, cli.restoreIDs); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.emptyTrash {
+		if err := emptyTrash(e, cli.trashOlderThan, cli.forget); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.split {
+		if err := splitBook(e, cli.books[0], cli.splitEdition, cli.splitRetail); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.stats {
+		if err := showStats(e, cli.statsYear, cli.statsJSON); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.reading != "" {
+		if err := logReading(e, cli.books, cli.reading, cli.readingDate, cli.readingFormat, cli.readingNotes); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.linkWork {
+		if err := linkWork(e, cli.books); err != nil {
+			e.UI.Error(err.Error())
+		}
+	} else if cli.unlinkWork {
+		unlinkWork(e, cli.books)
 	} else if cli.review {
-		reviewBook(e, cli.books[0], cli.rating, cli.reviewText)
+		for _, book := range cli.books {
+			reviewBook(e, book, cli.rating, cli.reviewText)
+		}
 	} else if cli.edit {
-		if cli.field != "" {
+		if cli.bulk {
+			if err := bulkEdit(e, cli.books); err != nil {
+				e.UI.Error(err.Error())
+			}
+		} else if cli.field != "" {
 			editMetadata(e, cli.books, cli.field)
 		} else {
 			editMetadata(e, cli.books)
@@ -120,12 +195,18 @@ func main() {
 			refreshMetadata(e, cli.books)
 		}
 	} else if cli.set {
-		if cli.field != "" {
+		if cli.field != "" && cli.listEdit {
+			editListField(e, cli.books, cli.field, cli.value)
+		} else if cli.field != "" {
 			editMetadata(e, cli.books, cli.field, cli.value)
 		} else {
 			setProgress(e, cli.books, cli.progress)
 		}
 
+	} else if cli.cover {
+		if err := manageCover(e, cli.books[0], cli.coverAction, cli.coverSource); err != nil {
+			e.UI.Error(err.Error())
+		}
 	} else if cli.search {
 		search(e, cli.searchTerms, cli.firstN, cli.lastN, cli.sortBy)
 	} else if cli.list {
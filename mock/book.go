@@ -65,3 +65,9 @@ func (b *Book) Check() (bool, bool, error) {
 func (b *Book) SetExported(bool) {
 	fmt.Println("mock Book: SetExported")
 }
+
+// ExportTo implementation for tests
+func (b *Book) ExportTo(destination string) error {
+	fmt.Println("mock Book: ExportTo " + destination)
+	return nil
+}
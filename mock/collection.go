@@ -52,7 +52,7 @@ func (c *Collection) FindByHash(string) (endive.GenericBook, error) {
 }
 
 // FindByMetadata implementation for tests
-func (c *Collection) FindByMetadata(a, b, d string) (endive.GenericBook, error) {
+func (c *Collection) FindByMetadata(a, b, d string, ids ...string) (endive.GenericBook, error) {
 	fmt.Println("mock Collection: FindByMetadata")
 	return nil, nil
 }
@@ -93,12 +93,24 @@ func (c *Collection) Incomplete() endive.Collection {
 	return nil
 }
 
+// NeedsReplacement implementation for tests
+func (c *Collection) NeedsReplacement() endive.Collection {
+	fmt.Println("mock Collection: NeedsReplacement")
+	return nil
+}
+
 // WithID implementation for tests
 func (c *Collection) WithID() endive.Collection {
 	fmt.Println("mock Collection: WithID")
 	return nil
 }
 
+// Work implementation for tests
+func (c *Collection) Work(id string) endive.Collection {
+	fmt.Println("mock Collection: Work")
+	return nil
+}
+
 // Authors implementation for tests
 func (c *Collection) Authors() map[string]int {
 	fmt.Println("mock Collection: Authors")
@@ -111,6 +123,12 @@ func (c *Collection) Publishers() map[string]int {
 	return nil
 }
 
+// TagTree implementation for tests
+func (c *Collection) TagTree() map[string]int {
+	fmt.Println("mock Collection: TagTree")
+	return nil
+}
+
 // Tags implementation for tests
 func (c *Collection) Tags() map[string]int {
 	fmt.Println("mock Collection: Tags")
@@ -123,6 +141,18 @@ func (c *Collection) Series() map[string]int {
 	return nil
 }
 
+// MigrateVocabularies implementation for tests
+func (c *Collection) MigrateVocabularies() int {
+	fmt.Println("mock Collection: MigrateVocabularies")
+	return 0
+}
+
+// MigrateImportDates implementation for tests
+func (c *Collection) MigrateImportDates() int {
+	fmt.Println("mock Collection: MigrateImportDates")
+	return 0
+}
+
 // Table implementation for tests
 func (c *Collection) Table() string {
 	fmt.Println("mock Collection: Table")